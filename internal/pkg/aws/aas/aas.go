@@ -15,12 +15,15 @@ import (
 
 const (
 	// ECS service resource ID format: service/${clusterName}/${serviceName}.
-	fmtECSResourceID    = "service/%s/%s"
-	ecsServiceNamespace = "ecs"
+	fmtECSResourceID         = "service/%s/%s"
+	ecsServiceNamespace      = "ecs"
+	ecsDesiredCountDimension = "ecs:service:DesiredCount"
 )
 
 type api interface {
 	DescribeScalingPolicies(input *aas.DescribeScalingPoliciesInput) (*aas.DescribeScalingPoliciesOutput, error)
+	DescribeScalableTargets(input *aas.DescribeScalableTargetsInput) (*aas.DescribeScalableTargetsOutput, error)
+	RegisterScalableTarget(input *aas.RegisterScalableTargetInput) (*aas.RegisterScalableTargetOutput, error)
 }
 
 // ApplicationAutoscaling wraps an Amazon Application Auto Scaling client.
@@ -62,3 +65,51 @@ func (a *ApplicationAutoscaling) ECSServiceAlarmNames(cluster, service string) (
 	}
 	return alarms, nil
 }
+
+// SuspendECSServiceScaling suspends the scaling policies and scheduled actions of the ECS service
+// so that its desired count stays unchanged. It's a no-op if the service doesn't autoscale.
+func (a *ApplicationAutoscaling) SuspendECSServiceScaling(cluster, service string) error {
+	if err := a.setECSServiceScalingSuspended(cluster, service, true); err != nil {
+		return fmt.Errorf("suspend scaling of ECS service %s/%s: %w", cluster, service, err)
+	}
+	return nil
+}
+
+// ResumeECSServiceScaling resumes the scaling policies and scheduled actions of the ECS service.
+// It's a no-op if the service doesn't autoscale.
+func (a *ApplicationAutoscaling) ResumeECSServiceScaling(cluster, service string) error {
+	if err := a.setECSServiceScalingSuspended(cluster, service, false); err != nil {
+		return fmt.Errorf("resume scaling of ECS service %s/%s: %w", cluster, service, err)
+	}
+	return nil
+}
+
+func (a *ApplicationAutoscaling) setECSServiceScalingSuspended(cluster, service string, suspended bool) error {
+	resourceID := fmt.Sprintf(fmtECSResourceID, cluster, service)
+	resp, err := a.client.DescribeScalableTargets(&aas.DescribeScalableTargetsInput{
+		ResourceIds:       aws.StringSlice([]string{resourceID}),
+		ScalableDimension: aws.String(ecsDesiredCountDimension),
+		ServiceNamespace:  aws.String(ecsServiceNamespace),
+	})
+	if err != nil {
+		return fmt.Errorf("describe scalable targets: %w", err)
+	}
+	if len(resp.ScalableTargets) == 0 {
+		return nil
+	}
+	// Registering an existing scalable target updates it.
+	_, err = a.client.RegisterScalableTarget(&aas.RegisterScalableTargetInput{
+		ResourceId:        aws.String(resourceID),
+		ScalableDimension: aws.String(ecsDesiredCountDimension),
+		ServiceNamespace:  aws.String(ecsServiceNamespace),
+		SuspendedState: &aas.SuspendedState{
+			DynamicScalingInSuspended:  aws.Bool(suspended),
+			DynamicScalingOutSuspended: aws.Bool(suspended),
+			ScheduledScalingSuspended:  aws.Bool(suspended),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("register scalable target: %w", err)
+	}
+	return nil
+}
@@ -139,3 +139,124 @@ func TestCloudWatch_ECSServiceAutoscalingAlarms(t *testing.T) {
 
 	}
 }
+
+func TestApplicationAutoscaling_SuspendECSServiceScaling(t *testing.T) {
+	const (
+		mockCluster    = "mockCluster"
+		mockService    = "mockService"
+		mockResourceID = "service/mockCluster/mockService"
+	)
+	mockError := errors.New("some error")
+	describeInput := &aas.DescribeScalableTargetsInput{
+		ResourceIds:       aws.StringSlice([]string{mockResourceID}),
+		ScalableDimension: aws.String(ecsDesiredCountDimension),
+		ServiceNamespace:  aws.String(ecsServiceNamespace),
+	}
+
+	testCases := map[string]struct {
+		setupMocks func(m aasMocks)
+
+		wantErr error
+	}{
+		"errors if failed to describe the scalable targets": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(describeInput).Return(nil, mockError)
+			},
+
+			wantErr: fmt.Errorf("suspend scaling of ECS service mockCluster/mockService: describe scalable targets: some error"),
+		},
+		"does nothing if the service doesn't autoscale": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(describeInput).Return(&aas.DescribeScalableTargetsOutput{}, nil)
+				m.client.EXPECT().RegisterScalableTarget(gomock.Any()).Times(0)
+			},
+		},
+		"errors if failed to update the scalable target": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(describeInput).Return(&aas.DescribeScalableTargetsOutput{
+					ScalableTargets: []*aas.ScalableTarget{{ResourceId: aws.String(mockResourceID)}},
+				}, nil)
+				m.client.EXPECT().RegisterScalableTarget(gomock.Any()).Return(nil, mockError)
+			},
+
+			wantErr: fmt.Errorf("suspend scaling of ECS service mockCluster/mockService: register scalable target: some error"),
+		},
+		"suspends the scaling of the service": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(describeInput).Return(&aas.DescribeScalableTargetsOutput{
+					ScalableTargets: []*aas.ScalableTarget{{ResourceId: aws.String(mockResourceID)}},
+				}, nil)
+				m.client.EXPECT().RegisterScalableTarget(&aas.RegisterScalableTargetInput{
+					ResourceId:        aws.String(mockResourceID),
+					ScalableDimension: aws.String(ecsDesiredCountDimension),
+					ServiceNamespace:  aws.String(ecsServiceNamespace),
+					SuspendedState: &aas.SuspendedState{
+						DynamicScalingInSuspended:  aws.Bool(true),
+						DynamicScalingOutSuspended: aws.Bool(true),
+						ScheduledScalingSuspended:  aws.Bool(true),
+					},
+				}).Return(&aas.RegisterScalableTargetOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(aasMocks{
+				client: mockClient,
+			})
+
+			aasSvc := ApplicationAutoscaling{
+				client: mockClient,
+			}
+
+			// WHEN
+			err := aasSvc.SuspendECSServiceScaling(mockCluster, mockService)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestApplicationAutoscaling_ResumeECSServiceScaling(t *testing.T) {
+	const mockResourceID = "service/mockCluster/mockService"
+
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockapi(ctrl)
+	mockClient.EXPECT().DescribeScalableTargets(gomock.Any()).Return(&aas.DescribeScalableTargetsOutput{
+		ScalableTargets: []*aas.ScalableTarget{{ResourceId: aws.String(mockResourceID)}},
+	}, nil)
+	mockClient.EXPECT().RegisterScalableTarget(&aas.RegisterScalableTargetInput{
+		ResourceId:        aws.String(mockResourceID),
+		ScalableDimension: aws.String(ecsDesiredCountDimension),
+		ServiceNamespace:  aws.String(ecsServiceNamespace),
+		SuspendedState: &aas.SuspendedState{
+			DynamicScalingInSuspended:  aws.Bool(false),
+			DynamicScalingOutSuspended: aws.Bool(false),
+			ScheduledScalingSuspended:  aws.Bool(false),
+		},
+	}).Return(&aas.RegisterScalableTargetOutput{}, nil)
+
+	aasSvc := ApplicationAutoscaling{
+		client: mockClient,
+	}
+
+	// WHEN
+	err := aasSvc.ResumeECSServiceScaling("mockCluster", "mockService")
+
+	// THEN
+	require.NoError(t, err)
+}
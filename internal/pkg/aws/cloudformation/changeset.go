@@ -51,6 +51,24 @@ type changeSetDescription struct {
 	changes         []*cloudformation.Change
 }
 
+// resourceChanges returns the changes of the change set that apply to resources.
+func (d *changeSetDescription) resourceChanges() []ResourceChange {
+	changes := []ResourceChange{}
+	for _, change := range d.changes {
+		if change.ResourceChange == nil {
+			continue
+		}
+		changes = append(changes, ResourceChange{
+			Action:      aws.StringValue(change.ResourceChange.Action),
+			LogicalID:   aws.StringValue(change.ResourceChange.LogicalResourceId),
+			PhysicalID:  aws.StringValue(change.ResourceChange.PhysicalResourceId),
+			Type:        aws.StringValue(change.ResourceChange.ResourceType),
+			Replacement: aws.StringValue(change.ResourceChange.Replacement),
+		})
+	}
+	return changes
+}
+
 func newCreateChangeSet(cfnClient changeSetAPI, stackName string) (*changeSet, error) {
 	id, err := uuid.NewRandom()
 	if err != nil {
@@ -198,6 +216,32 @@ func (cs *changeSet) createAndExecute(conf *stackConfig) error {
 	return cs.execute()
 }
 
+// preview creates the change set, collects the resource changes that it would apply and then deletes it
+// without executing it.
+func (cs *changeSet) preview(conf *stackConfig) ([]ResourceChange, error) {
+	if err := cs.create(conf); err != nil {
+		// Same as createAndExecute, the creation fails if there are no changes between the stacks.
+		descr, descrErr := cs.describe()
+		if descrErr != nil {
+			return nil, fmt.Errorf("check if changeset is empty: %v: %w", err, descrErr)
+		}
+		_ = cs.delete()
+		if len(descr.changes) == 0 {
+			return []ResourceChange{}, nil
+		}
+		return nil, err
+	}
+	descr, err := cs.describe()
+	if err != nil {
+		_ = cs.delete()
+		return nil, err
+	}
+	if err := cs.delete(); err != nil {
+		return nil, err
+	}
+	return descr.resourceChanges(), nil
+}
+
 // delete removes the change set.
 func (cs *changeSet) delete() error {
 	_, err := cs.client.DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
@@ -118,6 +118,37 @@ func (c *CloudFormation) WaitForUpdate(stackName string) error {
 	return nil
 }
 
+// PreviewChanges creates a change set for the stack and returns the resource changes that it would apply without
+// executing it. The change set is deleted before returning.
+// If the stack does not exist yet, the stack that CloudFormation creates to hold the change set is deleted as well.
+func (c *CloudFormation) PreviewChanges(stack *Stack) ([]ResourceChange, error) {
+	newChangeSet := newUpdateChangeSet
+	descr, err := c.Describe(stack.Name)
+	if err != nil {
+		var stackNotFound *ErrStackNotFound
+		if !errors.As(err, &stackNotFound) {
+			return nil, err
+		}
+		newChangeSet = newCreateChangeSet
+	} else if stackStatus(aws.StringValue(descr.StackStatus)).inProgress() {
+		return nil, &ErrStackUpdateInProgress{
+			Name: stack.Name,
+		}
+	}
+	cs, err := newChangeSet(c.client, stack.Name)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := cs.preview(stack.stackConfig)
+	if cs.csType == createChangeSetType {
+		// The stack is left in REVIEW_IN_PROGRESS, which would block any future deployment.
+		if deleteErr := c.Delete(stack.Name); deleteErr != nil && err == nil {
+			return nil, fmt.Errorf("clean up stack %s created for the change set: %w", stack.Name, deleteErr)
+		}
+	}
+	return changes, err
+}
+
 // Delete removes an existing CloudFormation stack.
 // If the stack doesn't exist then do nothing.
 func (c *CloudFormation) Delete(stackName string) error {
@@ -148,6 +179,63 @@ func (c *CloudFormation) DeleteAndWaitWithRoleARN(stackName, roleARN string) err
 	})
 }
 
+// SetTerminationProtection enables or disables termination protection on an existing stack.
+// If the stack does not exist, returns ErrStackNotFound.
+func (c *CloudFormation) SetTerminationProtection(stackName string, enabled bool) error {
+	_, err := c.client.UpdateTerminationProtection(&cloudformation.UpdateTerminationProtectionInput{
+		EnableTerminationProtection: aws.Bool(enabled),
+		StackName:                   aws.String(stackName),
+	})
+	if err != nil {
+		if stackDoesNotExist(err) {
+			return &ErrStackNotFound{name: stackName}
+		}
+		return fmt.Errorf("update termination protection of stack %s: %w", stackName, err)
+	}
+	return nil
+}
+
+// CancelUpdate cancels the update of a stack in progress, which rolls the stack back to its previous configuration.
+// If the stack does not exist, returns ErrStackNotFound.
+func (c *CloudFormation) CancelUpdate(stackName string) error {
+	_, err := c.client.CancelUpdateStack(&cloudformation.CancelUpdateStackInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		if stackDoesNotExist(err) {
+			return &ErrStackNotFound{name: stackName}
+		}
+		return fmt.Errorf("cancel update of stack %s: %w", stackName, err)
+	}
+	return nil
+}
+
+// ContinueUpdateRollback resumes the rollback of a stack whose update failed to roll back.
+// If the stack does not exist, returns ErrStackNotFound.
+func (c *CloudFormation) ContinueUpdateRollback(stackName string) error {
+	_, err := c.client.ContinueUpdateRollback(&cloudformation.ContinueUpdateRollbackInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		if stackDoesNotExist(err) {
+			return &ErrStackNotFound{name: stackName}
+		}
+		return fmt.Errorf("continue update rollback of stack %s: %w", stackName, err)
+	}
+	return nil
+}
+
+// WaitForUpdateRollback blocks until the update of the stack is rolled back or until the max attempt window expires.
+func (c *CloudFormation) WaitForUpdateRollback(stackName string) error {
+	err := c.client.WaitUntilStackRollbackCompleteWithContext(context.Background(), &cloudformation.DescribeStacksInput{
+		StackName: aws.String(stackName),
+	}, waiters...)
+	if err != nil {
+		return fmt.Errorf("wait until stack %s update rollback is complete: %w", stackName, err)
+	}
+	return nil
+}
+
 // Describe returns a description of an existing stack.
 // If the stack does not exist, returns ErrStackNotFound.
 func (c *CloudFormation) Describe(name string) (*StackDescription, error) {
@@ -182,6 +270,27 @@ func (c *CloudFormation) TemplateBody(name string) (string, error) {
 	return aws.StringValue(out.TemplateBody), nil
 }
 
+// NestedStackTemplateBody returns the template body of the nested stack with the given logical ID in an existing stack.
+// If the stack or its nested stack does not exist, returns ErrStackNotFound.
+func (c *CloudFormation) NestedStackTemplateBody(name, logicalID string) (string, error) {
+	out, err := c.client.DescribeStackResource(&cloudformation.DescribeStackResourceInput{
+		StackName:         aws.String(name),
+		LogicalResourceId: aws.String(logicalID),
+	})
+	if err != nil {
+		if stackDoesNotExist(err) {
+			return "", &ErrStackNotFound{name: name}
+		}
+		return "", fmt.Errorf("describe resource %s of stack %s: %w", logicalID, name, err)
+	}
+	id := aws.StringValue(out.StackResourceDetail.PhysicalResourceId)
+	if id == "" {
+		// The nested stack is in the template but wasn't created.
+		return "", &ErrStackNotFound{name: fmt.Sprintf("%s/%s", name, logicalID)}
+	}
+	return c.TemplateBody(id)
+}
+
 // Events returns the list of stack events in **chronological** order.
 func (c *CloudFormation) Events(stackName string) ([]StackEvent, error) {
 	var nextToken *string
@@ -305,6 +305,149 @@ func TestCloudFormation_UpdateAndWait(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_PreviewChanges(t *testing.T) {
+	changeSetInput := &cloudformation.DescribeChangeSetInput{
+		ChangeSetName: aws.String(mockChangeSetName),
+		StackName:     aws.String(mockStack.Name),
+	}
+	changeSetOutput := &cloudformation.DescribeChangeSetOutput{
+		Changes: []*cloudformation.Change{
+			{
+				ResourceChange: &cloudformation.ResourceChange{
+					Action:             aws.String(cloudformation.ChangeActionModify),
+					LogicalResourceId:  aws.String("Service"),
+					PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:1111:service/phonetool-test-api"),
+					ResourceType:       aws.String("AWS::ECS::Service"),
+					Replacement:        aws.String(cloudformation.ReplacementFalse),
+				},
+				Type: aws.String(cloudformation.ChangeTypeResource),
+			},
+		},
+		ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+	}
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) api
+
+		wantedChanges []ResourceChange
+		wantedErr     error
+	}{
+		"fail if the stack is already in progress": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							StackStatus: aws.String(cloudformation.StackStatusUpdateInProgress),
+						},
+					},
+				}, nil)
+				return m
+			},
+			wantedErr: &ErrStackUpdateInProgress{
+				Name: mockStack.Name,
+			},
+		},
+		"returns the changes of an existing stack and deletes the change set": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							StackStatus: aws.String(cloudformation.StackStatusUpdateComplete),
+						},
+					},
+				}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).DoAndReturn(func(in *cloudformation.CreateChangeSetInput) (*cloudformation.CreateChangeSetOutput, error) {
+					require.Equal(t, cloudformation.ChangeSetTypeUpdate, aws.StringValue(in.ChangeSetType))
+					return nil, nil
+				})
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), changeSetInput, gomock.Any())
+				m.EXPECT().DescribeChangeSet(changeSetInput).Return(changeSetOutput, nil)
+				m.EXPECT().DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetName),
+					StackName:     aws.String(mockStack.Name),
+				})
+				return m
+			},
+			wantedChanges: []ResourceChange{
+				{
+					Action:      "Modify",
+					LogicalID:   "Service",
+					PhysicalID:  "arn:aws:ecs:us-west-2:1111:service/phonetool-test-api",
+					Type:        "AWS::ECS::Service",
+					Replacement: "False",
+				},
+			},
+		},
+		"returns no changes if the change set is empty": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							StackStatus: aws.String(cloudformation.StackStatusUpdateComplete),
+						},
+					},
+				}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return(nil, nil)
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), changeSetInput, gomock.Any()).Return(errors.New("waiter failed"))
+				m.EXPECT().DescribeChangeSet(changeSetInput).Return(&cloudformation.DescribeChangeSetOutput{
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusUnavailable),
+					StatusReason:    aws.String(noChangesReason),
+				}, nil)
+				m.EXPECT().DeleteChangeSet(gomock.Any())
+				return m
+			},
+			wantedChanges: []ResourceChange{},
+		},
+		"deletes the stack created for the change set if the stack did not exist": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(nil, errDoesNotExist)
+				m.EXPECT().CreateChangeSet(gomock.Any()).DoAndReturn(func(in *cloudformation.CreateChangeSetInput) (*cloudformation.CreateChangeSetOutput, error) {
+					require.Equal(t, cloudformation.ChangeSetTypeCreate, aws.StringValue(in.ChangeSetType))
+					return nil, nil
+				})
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), changeSetInput, gomock.Any())
+				m.EXPECT().DescribeChangeSet(changeSetInput).Return(changeSetOutput, nil)
+				m.EXPECT().DeleteChangeSet(gomock.Any())
+				m.EXPECT().DeleteStack(&cloudformation.DeleteStackInput{
+					StackName: aws.String(mockStack.Name),
+				}).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("clean up stack %s created for the change set: %w", mockStack.Name,
+				fmt.Errorf("delete stack %s: %w", mockStack.Name, errors.New("some error"))),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			seed := bytes.NewBufferString("12345678901233456789") // always generate the same UUID
+			uuid.SetRand(seed)
+			defer uuid.SetRand(nil)
+
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			changes, err := c.PreviewChanges(mockStack)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedChanges, changes)
+			}
+		})
+	}
+}
+
 func TestCloudFormation_Delete(t *testing.T) {
 	testCases := map[string]struct {
 		createMock func(ctrl *gomock.Controller) api
@@ -401,6 +544,199 @@ func TestCloudFormation_DeleteAndWait(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_SetTerminationProtection(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) api
+		wantedErr  error
+	}{
+		"return ErrStackNotFound if stack does not exist": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().UpdateTerminationProtection(gomock.Any()).Return(nil, errDoesNotExist)
+				return m
+			},
+			wantedErr: &ErrStackNotFound{name: mockStack.Name},
+		},
+		"wraps other errors": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().UpdateTerminationProtection(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("update termination protection of stack %s: %w", mockStack.Name, errors.New("some error")),
+		},
+		"enables termination protection on the stack": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().UpdateTerminationProtection(&cloudformation.UpdateTerminationProtectionInput{
+					EnableTerminationProtection: aws.Bool(true),
+					StackName:                   aws.String(mockStack.Name),
+				}).Return(&cloudformation.UpdateTerminationProtectionOutput{}, nil)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			err := c.SetTerminationProtection(mockStack.Name, true)
+
+			// THEN
+			require.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
+func TestCloudFormation_CancelUpdate(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) api
+		wantedErr  error
+	}{
+		"return ErrStackNotFound if stack does not exist": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().CancelUpdateStack(gomock.Any()).Return(nil, errDoesNotExist)
+				return m
+			},
+			wantedErr: &ErrStackNotFound{name: mockStack.Name},
+		},
+		"wraps other errors": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().CancelUpdateStack(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("cancel update of stack %s: %w", mockStack.Name, errors.New("some error")),
+		},
+		"cancels the update of the stack": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().CancelUpdateStack(&cloudformation.CancelUpdateStackInput{
+					StackName: aws.String(mockStack.Name),
+				}).Return(&cloudformation.CancelUpdateStackOutput{}, nil)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			err := c.CancelUpdate(mockStack.Name)
+
+			// THEN
+			require.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
+func TestCloudFormation_ContinueUpdateRollback(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) api
+		wantedErr  error
+	}{
+		"return ErrStackNotFound if stack does not exist": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ContinueUpdateRollback(gomock.Any()).Return(nil, errDoesNotExist)
+				return m
+			},
+			wantedErr: &ErrStackNotFound{name: mockStack.Name},
+		},
+		"wraps other errors": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ContinueUpdateRollback(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("continue update rollback of stack %s: %w", mockStack.Name, errors.New("some error")),
+		},
+		"continues the rollback of the stack": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ContinueUpdateRollback(&cloudformation.ContinueUpdateRollbackInput{
+					StackName: aws.String(mockStack.Name),
+				}).Return(&cloudformation.ContinueUpdateRollbackOutput{}, nil)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			err := c.ContinueUpdateRollback(mockStack.Name)
+
+			// THEN
+			require.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
+func TestCloudFormation_WaitForUpdateRollback(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) api
+		wantedErr  error
+	}{
+		"wraps errors": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().WaitUntilStackRollbackCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("wait until stack %s update rollback is complete: %w", mockStack.Name, errors.New("some error")),
+		},
+		"waits until the stack is rolled back": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().WaitUntilStackRollbackCompleteWithContext(gomock.Any(), &cloudformation.DescribeStacksInput{
+					StackName: aws.String(mockStack.Name),
+				}, gomock.Any()).Return(nil)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			err := c.WaitForUpdateRollback(mockStack.Name)
+
+			// THEN
+			require.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
 func TestCloudFormation_Describe(t *testing.T) {
 	testCases := map[string]struct {
 		createMock  func(ctrl *gomock.Controller) api
@@ -509,6 +845,79 @@ func TestCloudFormation_TemplateBody(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_NestedStackTemplateBody(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) api
+		wantedBody string
+		wantedErr  error
+	}{
+		"return ErrStackNotFound if stack does not exist": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStackResource(gomock.Any()).Return(nil, errDoesNotExist)
+				return m
+			},
+			wantedErr: &ErrStackNotFound{name: mockStack.Name},
+		},
+		"return ErrStackNotFound if the nested stack wasn't created": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStackResource(gomock.Any()).Return(&cloudformation.DescribeStackResourceOutput{
+					StackResourceDetail: &cloudformation.StackResourceDetail{},
+				}, nil)
+				return m
+			},
+			wantedErr: &ErrStackNotFound{name: mockStack.Name + "/AddonsStack"},
+		},
+		"wrap error if the resource can't be described": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStackResource(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("describe resource AddonsStack of stack %s: %w", mockStack.Name, errors.New("some error")),
+		},
+		"returns the template body of the nested stack": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStackResource(&cloudformation.DescribeStackResourceInput{
+					StackName:         aws.String(mockStack.Name),
+					LogicalResourceId: aws.String("AddonsStack"),
+				}).Return(&cloudformation.DescribeStackResourceOutput{
+					StackResourceDetail: &cloudformation.StackResourceDetail{
+						PhysicalResourceId: aws.String("arn:aws:cloudformation:us-west-2:1111:stack/nested/1234"),
+					},
+				}, nil)
+				m.EXPECT().GetTemplate(&cloudformation.GetTemplateInput{
+					StackName: aws.String("arn:aws:cloudformation:us-west-2:1111:stack/nested/1234"),
+				}).Return(&cloudformation.GetTemplateOutput{
+					TemplateBody: aws.String("hello"),
+				}, nil)
+				return m
+			},
+			wantedBody: "hello",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			body, err := c.NestedStackTemplateBody(mockStack.Name, "AddonsStack")
+
+			// THEN
+			require.Equal(t, tc.wantedBody, body)
+			require.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
 func TestCloudFormation_Events(t *testing.T) {
 	testCases := map[string]struct {
 		createMock   func(ctrl *gomock.Controller) api
@@ -261,6 +261,21 @@ func (mr *MockapiMockRecorder) GetTemplate(input interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplate", reflect.TypeOf((*Mockapi)(nil).GetTemplate), input)
 }
 
+// DescribeStackResource mocks base method
+func (m *Mockapi) DescribeStackResource(arg0 *cloudformation.DescribeStackResourceInput) (*cloudformation.DescribeStackResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeStackResource", arg0)
+	ret0, _ := ret[0].(*cloudformation.DescribeStackResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeStackResource indicates an expected call of DescribeStackResource
+func (mr *MockapiMockRecorder) DescribeStackResource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStackResource", reflect.TypeOf((*Mockapi)(nil).DescribeStackResource), arg0)
+}
+
 // DeleteStack mocks base method
 func (m *Mockapi) DeleteStack(arg0 *cloudformation.DeleteStackInput) (*cloudformation.DeleteStackOutput, error) {
 	m.ctrl.T.Helper()
@@ -276,6 +291,51 @@ func (mr *MockapiMockRecorder) DeleteStack(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStack", reflect.TypeOf((*Mockapi)(nil).DeleteStack), arg0)
 }
 
+// UpdateTerminationProtection mocks base method
+func (m *Mockapi) UpdateTerminationProtection(arg0 *cloudformation.UpdateTerminationProtectionInput) (*cloudformation.UpdateTerminationProtectionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTerminationProtection", arg0)
+	ret0, _ := ret[0].(*cloudformation.UpdateTerminationProtectionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTerminationProtection indicates an expected call of UpdateTerminationProtection
+func (mr *MockapiMockRecorder) UpdateTerminationProtection(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTerminationProtection", reflect.TypeOf((*Mockapi)(nil).UpdateTerminationProtection), arg0)
+}
+
+// CancelUpdateStack mocks base method
+func (m *Mockapi) CancelUpdateStack(arg0 *cloudformation.CancelUpdateStackInput) (*cloudformation.CancelUpdateStackOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelUpdateStack", arg0)
+	ret0, _ := ret[0].(*cloudformation.CancelUpdateStackOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelUpdateStack indicates an expected call of CancelUpdateStack
+func (mr *MockapiMockRecorder) CancelUpdateStack(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUpdateStack", reflect.TypeOf((*Mockapi)(nil).CancelUpdateStack), arg0)
+}
+
+// ContinueUpdateRollback mocks base method
+func (m *Mockapi) ContinueUpdateRollback(arg0 *cloudformation.ContinueUpdateRollbackInput) (*cloudformation.ContinueUpdateRollbackOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContinueUpdateRollback", arg0)
+	ret0, _ := ret[0].(*cloudformation.ContinueUpdateRollbackOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContinueUpdateRollback indicates an expected call of ContinueUpdateRollback
+func (mr *MockapiMockRecorder) ContinueUpdateRollback(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContinueUpdateRollback", reflect.TypeOf((*Mockapi)(nil).ContinueUpdateRollback), arg0)
+}
+
 // WaitUntilStackCreateCompleteWithContext mocks base method
 func (m *Mockapi) WaitUntilStackCreateCompleteWithContext(arg0 aws.Context, arg1 *cloudformation.DescribeStacksInput, arg2 ...request.WaiterOption) error {
 	m.ctrl.T.Helper()
@@ -332,3 +392,22 @@ func (mr *MockapiMockRecorder) WaitUntilStackDeleteCompleteWithContext(arg0, arg
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilStackDeleteCompleteWithContext", reflect.TypeOf((*Mockapi)(nil).WaitUntilStackDeleteCompleteWithContext), varargs...)
 }
+
+// WaitUntilStackRollbackCompleteWithContext mocks base method
+func (m *Mockapi) WaitUntilStackRollbackCompleteWithContext(arg0 aws.Context, arg1 *cloudformation.DescribeStacksInput, arg2 ...request.WaiterOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WaitUntilStackRollbackCompleteWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilStackRollbackCompleteWithContext indicates an expected call of WaitUntilStackRollbackCompleteWithContext
+func (mr *MockapiMockRecorder) WaitUntilStackRollbackCompleteWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilStackRollbackCompleteWithContext", reflect.TypeOf((*Mockapi)(nil).WaitUntilStackRollbackCompleteWithContext), varargs...)
+}
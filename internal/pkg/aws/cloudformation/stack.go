@@ -76,6 +76,15 @@ func WithRoleARN(roleARN string) StackOption {
 // StackEvent represents a stack event for a resource.
 type StackEvent cloudformation.StackEvent
 
+// ResourceChange represents a change that a change set would apply to a resource of the stack.
+type ResourceChange struct {
+	Action      string // One of "Add", "Modify", "Remove", "Import" or "Dynamic".
+	LogicalID   string
+	PhysicalID  string
+	Type        string
+	Replacement string // One of "True", "False" or "Conditional" if the action is "Modify", empty otherwise.
+}
+
 // StackDescription represents an existing AWS CloudFormation stack.
 type StackDescription cloudformation.Stack
 
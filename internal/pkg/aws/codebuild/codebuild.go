@@ -0,0 +1,96 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package codebuild provides a client to make API requests to AWS CodeBuild.
+package codebuild
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	cb "github.com/aws/aws-sdk-go/service/codebuild"
+)
+
+type api interface {
+	ListBuildsForProject(*cb.ListBuildsForProjectInput) (*cb.ListBuildsForProjectOutput, error)
+	BatchGetBuilds(*cb.BatchGetBuildsInput) (*cb.BatchGetBuildsOutput, error)
+}
+
+// CodeBuild wraps the AWS CodeBuild client.
+type CodeBuild struct {
+	client api
+}
+
+// Build represents a build of a CodeBuild project.
+type Build struct {
+	ID            string
+	ProjectName   string
+	Status        string
+	LogGroupName  string // Empty until the build starts writing logs.
+	LogStreamName string
+}
+
+// InProgress returns true if the build is still running.
+func (b *Build) InProgress() bool {
+	return b.Status == cb.StatusTypeInProgress
+}
+
+// New returns a CodeBuild client configured against the input session.
+func New(s *session.Session) *CodeBuild {
+	return &CodeBuild{
+		client: cb.New(s),
+	}
+}
+
+// LatestBuild returns the most recent build of the project.
+// It returns nil if the project has never been built.
+func (c *CodeBuild) LatestBuild(projectName string) (*Build, error) {
+	resp, err := c.client.ListBuildsForProject(&cb.ListBuildsForProjectInput{
+		ProjectName: aws.String(projectName),
+		SortOrder:   aws.String(cb.SortOrderTypeDescending),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list builds for project %s: %w", projectName, err)
+	}
+	if len(resp.Ids) == 0 {
+		return nil, nil
+	}
+	builds, err := c.Builds([]string{aws.StringValue(resp.Ids[0])})
+	if err != nil {
+		return nil, err
+	}
+	return builds[0], nil
+}
+
+// Builds returns the builds with the given IDs, in the same order.
+func (c *CodeBuild) Builds(ids []string) ([]*Build, error) {
+	resp, err := c.client.BatchGetBuilds(&cb.BatchGetBuildsInput{
+		Ids: aws.StringSlice(ids),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get builds: %w", err)
+	}
+	found := make(map[string]*Build)
+	for _, b := range resp.Builds {
+		build := &Build{
+			ID:          aws.StringValue(b.Id),
+			ProjectName: aws.StringValue(b.ProjectName),
+			Status:      aws.StringValue(b.BuildStatus),
+		}
+		if b.Logs != nil {
+			build.LogGroupName = aws.StringValue(b.Logs.GroupName)
+			build.LogStreamName = aws.StringValue(b.Logs.StreamName)
+		}
+		found[build.ID] = build
+	}
+	var builds []*Build
+	for _, id := range ids {
+		build, ok := found[id]
+		if !ok {
+			return nil, fmt.Errorf("build %s does not exist", id)
+		}
+		builds = append(builds, build)
+	}
+	return builds, nil
+}
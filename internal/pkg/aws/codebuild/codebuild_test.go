@@ -0,0 +1,159 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package codebuild
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cb "github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeBuild_LatestBuild(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted    *Build
+		wantedErr error
+	}{
+		"returns nil if the project was never built": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ListBuildsForProject(&cb.ListBuildsForProjectInput{
+					ProjectName: aws.String("BuildProject"),
+					SortOrder:   aws.String("DESCENDING"),
+				}).Return(&cb.ListBuildsForProjectOutput{}, nil)
+			},
+		},
+		"returns the most recent build": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ListBuildsForProject(gomock.Any()).Return(&cb.ListBuildsForProjectOutput{
+					Ids: aws.StringSlice([]string{"BuildProject:2", "BuildProject:1"}),
+				}, nil)
+				m.EXPECT().BatchGetBuilds(&cb.BatchGetBuildsInput{
+					Ids: aws.StringSlice([]string{"BuildProject:2"}),
+				}).Return(&cb.BatchGetBuildsOutput{
+					Builds: []*cb.Build{
+						{
+							Id:          aws.String("BuildProject:2"),
+							ProjectName: aws.String("BuildProject"),
+							BuildStatus: aws.String("IN_PROGRESS"),
+							Logs: &cb.LogsLocation{
+								GroupName:  aws.String("/aws/codebuild/BuildProject"),
+								StreamName: aws.String("2"),
+							},
+						},
+					},
+				}, nil)
+			},
+			wanted: &Build{
+				ID:            "BuildProject:2",
+				ProjectName:   "BuildProject",
+				Status:        "IN_PROGRESS",
+				LogGroupName:  "/aws/codebuild/BuildProject",
+				LogStreamName: "2",
+			},
+		},
+		"wraps error from listing builds": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ListBuildsForProject(gomock.Any()).Return(nil, mockError)
+			},
+			wantedErr: fmt.Errorf("list builds for project BuildProject: %w", mockError),
+		},
+		"wraps error from getting builds": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ListBuildsForProject(gomock.Any()).Return(&cb.ListBuildsForProjectOutput{
+					Ids: aws.StringSlice([]string{"BuildProject:2"}),
+				}, nil)
+				m.EXPECT().BatchGetBuilds(gomock.Any()).Return(nil, mockError)
+			},
+			wantedErr: fmt.Errorf("get builds: %w", mockError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := CodeBuild{
+				client: m,
+			}
+
+			// WHEN
+			build, err := client.LatestBuild("BuildProject")
+
+			// THEN
+			require.Equal(t, tc.wantedErr, err)
+			require.Equal(t, tc.wanted, build)
+		})
+	}
+}
+
+func TestCodeBuild_Builds(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted    []*Build
+		wantedErr error
+	}{
+		"returns the builds in the order of the ids": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetBuilds(gomock.Any()).Return(&cb.BatchGetBuildsOutput{
+					Builds: []*cb.Build{
+						{Id: aws.String("b:1"), BuildStatus: aws.String("SUCCEEDED")},
+						{Id: aws.String("a:1"), BuildStatus: aws.String("FAILED")},
+					},
+				}, nil)
+			},
+			wanted: []*Build{
+				{ID: "a:1", Status: "FAILED"},
+				{ID: "b:1", Status: "SUCCEEDED"},
+			},
+		},
+		"errors if a build does not exist": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetBuilds(gomock.Any()).Return(&cb.BatchGetBuildsOutput{
+					Builds: []*cb.Build{
+						{Id: aws.String("b:1"), BuildStatus: aws.String("SUCCEEDED")},
+					},
+				}, nil)
+			},
+			wantedErr: errors.New("build a:1 does not exist"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := CodeBuild{
+				client: m,
+			}
+
+			// WHEN
+			builds, err := client.Builds([]string{"a:1", "b:1"})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, builds)
+			}
+		})
+	}
+}
@@ -24,6 +24,10 @@ const (
 type api interface {
 	GetPipeline(*cp.GetPipelineInput) (*cp.GetPipelineOutput, error)
 	GetPipelineState(*cp.GetPipelineStateInput) (*cp.GetPipelineStateOutput, error)
+	StartPipelineExecution(*cp.StartPipelineExecutionInput) (*cp.StartPipelineExecutionOutput, error)
+	RetryStageExecution(*cp.RetryStageExecutionInput) (*cp.RetryStageExecutionOutput, error)
+	ListPipelineExecutions(*cp.ListPipelineExecutionsInput) (*cp.ListPipelineExecutionsOutput, error)
+	GetPipelineExecution(*cp.GetPipelineExecutionInput) (*cp.GetPipelineExecutionOutput, error)
 }
 
 type resourceGetter interface {
@@ -54,6 +58,13 @@ type Stage struct {
 	Details  string `json:"details"`
 }
 
+// BuildAction is an action of a pipeline that runs a CodeBuild project.
+type BuildAction struct {
+	StageName   string
+	Name        string
+	ProjectName string
+}
+
 // PipelineState represents a Pipeline's status.
 type PipelineState struct {
 	PipelineName string        `json:"pipelineName"`
@@ -63,15 +74,28 @@ type PipelineState struct {
 
 // StageState wraps a CodePipeline stage state.
 type StageState struct {
-	StageName  string        `json:"stageName"`
-	Actions    []StageAction `json:"actions,omitempty"`
-	Transition string        `json:"transition"`
+	StageName   string        `json:"stageName"`
+	Actions     []StageAction `json:"actions,omitempty"`
+	Transition  string        `json:"transition"`
+	ExecutionID string        `json:"executionId,omitempty"` // ID of the latest pipeline execution that ran the stage.
 }
 
 // StageAction wraps a CodePipeline stage action.
 type StageAction struct {
-	Name   string `json:"name"`
-	Status string `json:"status"`
+	Name        string `json:"name"`
+	Status      string `json:"status"`
+	ExternalURL string `json:"externalUrl,omitempty"` // Link to the execution details, such as the CodeBuild logs.
+}
+
+// FailedActions returns the actions of the stage that failed or were abandoned.
+func (ss StageState) FailedActions() []StageAction {
+	var actions []StageAction
+	for _, action := range ss.Actions {
+		if action.Status == "Failed" || action.Status == "Abandoned" {
+			actions = append(actions, action)
+		}
+	}
+	return actions
 }
 
 // AggregateStatus returns the collective status of a stage by looking at each individual action's status.
@@ -147,6 +171,30 @@ func (c *CodePipeline) GetPipeline(name string) (*Pipeline, error) {
 	}, nil
 }
 
+// BuildActions returns the actions of the pipeline that run a CodeBuild project, in the order of the stages.
+func (c *CodePipeline) BuildActions(name string) ([]*BuildAction, error) {
+	resp, err := c.client.GetPipeline(&cp.GetPipelineInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get pipeline %s: %w", name, err)
+	}
+	var actions []*BuildAction
+	for _, stage := range resp.Pipeline.Stages {
+		for _, action := range stage.Actions {
+			if aws.StringValue(action.ActionTypeId.Provider) != "CodeBuild" {
+				continue
+			}
+			actions = append(actions, &BuildAction{
+				StageName:   aws.StringValue(stage.Name),
+				Name:        aws.StringValue(action.Name),
+				ProjectName: aws.StringValue(action.Configuration["ProjectName"]),
+			})
+		}
+	}
+	return actions, nil
+}
+
 func (c *CodePipeline) getStage(s *cp.StageDeclaration) (*Stage, error) {
 	name := aws.StringValue(s.Name)
 	var category, provider, details string
@@ -162,8 +210,15 @@ func (c *CodePipeline) getStage(s *cp.StageDeclaration) (*Stage, error) {
 		switch category {
 
 		case "Source":
-			// Currently, our only source provider is GitHub: https://docs.aws.amazon.com/codepipeline/latest/userguide/reference-pipeline-structure.html#structure-configuration-examples
-			details = fmt.Sprintf("Repository: %s/%s", aws.StringValue(config["Owner"]), aws.StringValue(config["Repo"]))
+			// See https://docs.aws.amazon.com/codepipeline/latest/userguide/reference-pipeline-structure.html#structure-configuration-examples
+			switch provider {
+			case "CodeCommit":
+				details = fmt.Sprintf("Repository: %s", aws.StringValue(config["RepositoryName"]))
+			case "CodeStarSourceConnection":
+				details = fmt.Sprintf("Repository: %s", aws.StringValue(config["FullRepositoryId"]))
+			default:
+				details = fmt.Sprintf("Repository: %s/%s", aws.StringValue(config["Owner"]), aws.StringValue(config["Repo"]))
+			}
 		case "Build":
 			// Currently, we use CodeBuild only for the build stage: https://docs.aws.amazon.com/codepipeline/latest/userguide/action-reference-CodeBuild.html#action-reference-CodeBuild-config
 			details = fmt.Sprintf("BuildProject: %s", aws.StringValue(config["ProjectName"]))
@@ -241,19 +296,25 @@ func (c *CodePipeline) GetPipelineState(name string) (*PipelineState, error) {
 				transition = "ENABLED"
 			}
 		}
+		var executionID string
+		if stage.LatestExecution != nil {
+			executionID = aws.StringValue(stage.LatestExecution.PipelineExecutionId)
+		}
 		var actions []StageAction
 		for _, actionState := range stage.ActionStates {
 			if actionState.LatestExecution != nil {
 				actions = append(actions, StageAction{
-					Name:   aws.StringValue(actionState.ActionName),
-					Status: aws.StringValue(actionState.LatestExecution.Status),
+					Name:        aws.StringValue(actionState.ActionName),
+					Status:      aws.StringValue(actionState.LatestExecution.Status),
+					ExternalURL: aws.StringValue(actionState.LatestExecution.ExternalExecutionUrl),
 				})
 			}
 		}
 		stageStates = append(stageStates, &StageState{
-			StageName:  stageName,
-			Actions:    actions,
-			Transition: transition,
+			StageName:   stageName,
+			Actions:     actions,
+			Transition:  transition,
+			ExecutionID: executionID,
 		})
 	}
 	return &PipelineState{
@@ -263,6 +324,58 @@ func (c *CodePipeline) GetPipelineState(name string) (*PipelineState, error) {
 	}, nil
 }
 
+// StartExecution starts a new execution of the pipeline and returns the ID of the execution.
+func (c *CodePipeline) StartExecution(name string) (string, error) {
+	resp, err := c.client.StartPipelineExecution(&cp.StartPipelineExecutionInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		return "", fmt.Errorf("start execution of pipeline %s: %w", name, err)
+	}
+	return aws.StringValue(resp.PipelineExecutionId), nil
+}
+
+// RetryStageExecution retries the failed actions of a stage in the pipeline execution with the given ID.
+func (c *CodePipeline) RetryStageExecution(pipelineName, stageName, executionID string) error {
+	_, err := c.client.RetryStageExecution(&cp.RetryStageExecutionInput{
+		PipelineName:        aws.String(pipelineName),
+		StageName:           aws.String(stageName),
+		PipelineExecutionId: aws.String(executionID),
+		RetryMode:           aws.String(cp.StageRetryModeFailedActions),
+	})
+	if err != nil {
+		return fmt.Errorf("retry stage %s of pipeline %s: %w", stageName, pipelineName, err)
+	}
+	return nil
+}
+
+// LatestExecutionID returns the ID of the most recent execution of the pipeline, or an empty string if it never ran.
+func (c *CodePipeline) LatestExecutionID(name string) (string, error) {
+	resp, err := c.client.ListPipelineExecutions(&cp.ListPipelineExecutionsInput{
+		PipelineName: aws.String(name),
+		MaxResults:   aws.Int64(1),
+	})
+	if err != nil {
+		return "", fmt.Errorf("list executions of pipeline %s: %w", name, err)
+	}
+	if len(resp.PipelineExecutionSummaries) == 0 {
+		return "", nil
+	}
+	return aws.StringValue(resp.PipelineExecutionSummaries[0].PipelineExecutionId), nil
+}
+
+// ExecutionStatus returns the status of the pipeline execution with the given ID, such as "InProgress" or "Succeeded".
+func (c *CodePipeline) ExecutionStatus(pipelineName, executionID string) (string, error) {
+	resp, err := c.client.GetPipelineExecution(&cp.GetPipelineExecutionInput{
+		PipelineName:        aws.String(pipelineName),
+		PipelineExecutionId: aws.String(executionID),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get execution %s of pipeline %s: %w", executionID, pipelineName, err)
+	}
+	return aws.StringValue(resp.PipelineExecution.Status), nil
+}
+
 func (sa StageAction) humanString() string {
 	return sa.Name + "\t\t" + fmtStatus(sa.Status)
 }
@@ -113,6 +113,30 @@ func TestCodePipeline_GetPipeline(t *testing.T) {
 	}
 	mockStages := []*codepipeline.StageDeclaration{mockSourceStage, mockBuildStage, mockTestStage}
 
+	mockConnectionSourceStage := &codepipeline.StageDeclaration{
+		Name: aws.String("Source"),
+		Actions: []*codepipeline.ActionDeclaration{
+			{
+				ActionTypeId: &codepipeline.ActionTypeId{
+					Category: aws.String("Source"),
+					Owner:    aws.String("AWS"),
+					Provider: aws.String("CodeStarSourceConnection"),
+					Version:  aws.String("1"),
+				},
+				Configuration: map[string]*string{
+					"BranchName":       aws.String("main"),
+					"ConnectionArn":    aws.String("arn:aws:codestar-connections:us-west-2:1234567890:connection/abcd"),
+					"FullRepositoryId": aws.String("badgoose/repo"),
+				},
+				Name: aws.String("SourceCodeFor-dinder"),
+				OutputArtifacts: []*codepipeline.OutputArtifact{
+					{Name: aws.String("SCCheckoutArtifact")},
+				},
+				RunOrder: aws.Int64(1),
+			},
+		},
+	}
+
 	mockStageWithNoAction := &codepipeline.StageDeclaration{
 		Name:    aws.String("DummyStage"),
 		Actions: []*codepipeline.ActionDeclaration{},
@@ -215,6 +239,42 @@ func TestCodePipeline_GetPipeline(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		"should describe a source stage that uses a CodeStar connection": {
+			inPipelineName: mockPipelineName,
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipeline(&codepipeline.GetPipelineInput{
+					Name: aws.String(mockPipelineName),
+				}).Return(
+					&codepipeline.GetPipelineOutput{
+						Pipeline: &codepipeline.PipelineDeclaration{
+							Name:   aws.String(mockPipelineName),
+							Stages: []*codepipeline.StageDeclaration{mockConnectionSourceStage},
+						},
+						Metadata: &codepipeline.PipelineMetadata{
+							Created:     &mockTime,
+							Updated:     &mockTime,
+							PipelineArn: aws.String(mockArn),
+						},
+					}, nil)
+
+			},
+			expectedOut: &Pipeline{
+				Name:      mockPipelineName,
+				Region:    "us-west-2",
+				AccountID: "1234567890",
+				Stages: []*Stage{
+					{
+						Name:     "Source",
+						Category: "Source",
+						Provider: "CodeStarSourceConnection",
+						Details:  "Repository: badgoose/repo",
+					},
+				},
+				CreatedAt: mockTime,
+				UpdatedAt: mockTime,
+			},
+			expectedError: nil,
+		},
 		"should wrap error from codepipeline client": {
 			inPipelineName: mockPipelineName,
 			callMocks: func(m codepipelineMocks) {
@@ -355,10 +415,17 @@ func TestCodePipeline_GetPipelineState(t *testing.T) {
 			},
 			{
 				InboundTransitionState: &codepipeline.TransitionState{Enabled: aws.Bool(true)},
+				LatestExecution: &codepipeline.StageExecution{
+					PipelineExecutionId: aws.String("1234"),
+					Status:              aws.String(codepipeline.StageExecutionStatusFailed),
+				},
 				ActionStates: []*codepipeline.ActionState{
 					{
-						ActionName:      aws.String("action1"),
-						LatestExecution: &codepipeline.ActionExecution{Status: aws.String(codepipeline.ActionExecutionStatusFailed)},
+						ActionName: aws.String("action1"),
+						LatestExecution: &codepipeline.ActionExecution{
+							Status:               aws.String(codepipeline.ActionExecutionStatusFailed),
+							ExternalExecutionUrl: aws.String("https://console.aws.amazon.com/codebuild/home?region=us-west-2#/builds/action1:1234/view/new"),
+						},
 					},
 					{
 						ActionName:      aws.String("action2"),
@@ -430,8 +497,9 @@ func TestCodePipeline_GetPipelineState(t *testing.T) {
 						StageName: "Build",
 						Actions: []StageAction{
 							{
-								Name:   "action1",
-								Status: "Failed",
+								Name:        "action1",
+								Status:      "Failed",
+								ExternalURL: "https://console.aws.amazon.com/codebuild/home?region=us-west-2#/builds/action1:1234/view/new",
 							},
 							{
 								Name:   "action2",
@@ -442,7 +510,8 @@ func TestCodePipeline_GetPipelineState(t *testing.T) {
 								Status: "Succeeded",
 							},
 						},
-						Transition: "ENABLED",
+						Transition:  "ENABLED",
+						ExecutionID: "1234",
 					},
 					{
 						StageName: "DeployTo-test",
@@ -505,3 +574,327 @@ func TestCodePipeline_GetPipelineState(t *testing.T) {
 		})
 	}
 }
+
+func TestCodePipeline_StartExecution(t *testing.T) {
+	mockPipelineName := "pipeline-dinder-badgoose-repo"
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		expectedOut   string
+		expectedError error
+	}{
+		"returns the execution id": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().StartPipelineExecution(&codepipeline.StartPipelineExecutionInput{
+					Name: aws.String(mockPipelineName),
+				}).Return(&codepipeline.StartPipelineExecutionOutput{
+					PipelineExecutionId: aws.String("1234"),
+				}, nil)
+			},
+			expectedOut: "1234",
+		},
+		"should wrap error from CodePipeline client": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().StartPipelineExecution(gomock.Any()).Return(nil, mockError)
+			},
+			expectedError: fmt.Errorf("start execution of pipeline %s: %w", mockPipelineName, mockError),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{
+				cp: mockClient,
+			})
+
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			actualOut, err := cp.StartExecution(mockPipelineName)
+
+			// THEN
+			require.Equal(t, tc.expectedError, err)
+			require.Equal(t, tc.expectedOut, actualOut)
+		})
+	}
+}
+
+func TestCodePipeline_RetryStageExecution(t *testing.T) {
+	mockPipelineName := "pipeline-dinder-badgoose-repo"
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		expectedError error
+	}{
+		"retries the failed actions of the stage": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().RetryStageExecution(&codepipeline.RetryStageExecutionInput{
+					PipelineName:        aws.String(mockPipelineName),
+					StageName:           aws.String("DeployTo-test"),
+					PipelineExecutionId: aws.String("1234"),
+					RetryMode:           aws.String("FAILED_ACTIONS"),
+				}).Return(&codepipeline.RetryStageExecutionOutput{}, nil)
+			},
+		},
+		"should wrap error from CodePipeline client": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().RetryStageExecution(gomock.Any()).Return(nil, mockError)
+			},
+			expectedError: fmt.Errorf("retry stage DeployTo-test of pipeline %s: %w", mockPipelineName, mockError),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{
+				cp: mockClient,
+			})
+
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			err := cp.RetryStageExecution(mockPipelineName, "DeployTo-test", "1234")
+
+			// THEN
+			require.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestCodePipeline_LatestExecutionID(t *testing.T) {
+	mockPipelineName := "pipeline-dinder-badgoose-repo"
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		expectedOut   string
+		expectedError error
+	}{
+		"returns the id of the latest execution": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListPipelineExecutions(&codepipeline.ListPipelineExecutionsInput{
+					PipelineName: aws.String(mockPipelineName),
+					MaxResults:   aws.Int64(1),
+				}).Return(&codepipeline.ListPipelineExecutionsOutput{
+					PipelineExecutionSummaries: []*codepipeline.PipelineExecutionSummary{
+						{PipelineExecutionId: aws.String("1234")},
+					},
+				}, nil)
+			},
+			expectedOut: "1234",
+		},
+		"returns an empty id if the pipeline never ran": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListPipelineExecutions(gomock.Any()).Return(&codepipeline.ListPipelineExecutionsOutput{}, nil)
+			},
+		},
+		"should wrap error from CodePipeline client": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListPipelineExecutions(gomock.Any()).Return(nil, mockError)
+			},
+			expectedError: fmt.Errorf("list executions of pipeline %s: %w", mockPipelineName, mockError),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{
+				cp: mockClient,
+			})
+
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			actualOut, err := cp.LatestExecutionID(mockPipelineName)
+
+			// THEN
+			require.Equal(t, tc.expectedError, err)
+			require.Equal(t, tc.expectedOut, actualOut)
+		})
+	}
+}
+
+func TestCodePipeline_ExecutionStatus(t *testing.T) {
+	mockPipelineName := "pipeline-dinder-badgoose-repo"
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		expectedOut   string
+		expectedError error
+	}{
+		"returns the status of the execution": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineExecution(&codepipeline.GetPipelineExecutionInput{
+					PipelineName:        aws.String(mockPipelineName),
+					PipelineExecutionId: aws.String("1234"),
+				}).Return(&codepipeline.GetPipelineExecutionOutput{
+					PipelineExecution: &codepipeline.PipelineExecution{
+						Status: aws.String("Stopped"),
+					},
+				}, nil)
+			},
+			expectedOut: "Stopped",
+		},
+		"should wrap error from CodePipeline client": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineExecution(gomock.Any()).Return(nil, mockError)
+			},
+			expectedError: fmt.Errorf("get execution 1234 of pipeline %s: %w", mockPipelineName, mockError),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{
+				cp: mockClient,
+			})
+
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			actualOut, err := cp.ExecutionStatus(mockPipelineName, "1234")
+
+			// THEN
+			require.Equal(t, tc.expectedError, err)
+			require.Equal(t, tc.expectedOut, actualOut)
+		})
+	}
+}
+
+func TestStageState_FailedActions(t *testing.T) {
+	// GIVEN
+	state := StageState{
+		Actions: []StageAction{
+			{Name: "a", Status: "Succeeded"},
+			{Name: "b", Status: "Failed"},
+			{Name: "c", Status: "Abandoned"},
+			{Name: "d", Status: "InProgress"},
+		},
+	}
+
+	// WHEN
+	actual := state.FailedActions()
+
+	// THEN
+	require.Equal(t, []StageAction{{Name: "b", Status: "Failed"}, {Name: "c", Status: "Abandoned"}}, actual)
+}
+
+func TestCodePipeline_BuildActions(t *testing.T) {
+	mockPipelineName := "pipeline-dinder-badgoose-repo"
+	mockError := errors.New("mockError")
+	codeBuildAction := func(name, project string) *codepipeline.ActionDeclaration {
+		return &codepipeline.ActionDeclaration{
+			ActionTypeId: &codepipeline.ActionTypeId{
+				Category: aws.String("Build"),
+				Provider: aws.String("CodeBuild"),
+			},
+			Configuration: map[string]*string{
+				"ProjectName": aws.String(project),
+			},
+			Name: aws.String(name),
+		}
+	}
+
+	tests := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		expectedOut   []*BuildAction
+		expectedError error
+	}{
+		"returns the CodeBuild actions of every stage": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipeline(&codepipeline.GetPipelineInput{
+					Name: aws.String(mockPipelineName),
+				}).Return(&codepipeline.GetPipelineOutput{
+					Pipeline: &codepipeline.PipelineDeclaration{
+						Stages: []*codepipeline.StageDeclaration{
+							{
+								Name: aws.String("Build"),
+								Actions: []*codepipeline.ActionDeclaration{
+									codeBuildAction("Build", "BuildProject-1234"),
+								},
+							},
+							{
+								Name: aws.String("DeployTo-test"),
+								Actions: []*codepipeline.ActionDeclaration{
+									{
+										ActionTypeId: &codepipeline.ActionTypeId{
+											Category: aws.String("Deploy"),
+											Provider: aws.String("CloudFormation"),
+										},
+										Name: aws.String("CreateOrUpdate-api-test"),
+									},
+									codeBuildAction("TestCommands", "BuildTestCommands-5678"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			expectedOut: []*BuildAction{
+				{StageName: "Build", Name: "Build", ProjectName: "BuildProject-1234"},
+				{StageName: "DeployTo-test", Name: "TestCommands", ProjectName: "BuildTestCommands-5678"},
+			},
+		},
+		"should wrap error from CodePipeline client": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipeline(gomock.Any()).Return(nil, mockError)
+			},
+			expectedError: fmt.Errorf("get pipeline %s: %w", mockPipelineName, mockError),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{
+				cp: mockClient,
+			})
+
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			actualOut, err := cp.BuildActions(mockPipelineName)
+
+			// THEN
+			require.Equal(t, tc.expectedError, err)
+			require.Equal(t, tc.expectedOut, actualOut)
+		})
+	}
+}
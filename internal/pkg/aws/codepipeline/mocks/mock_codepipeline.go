@@ -64,6 +64,66 @@ func (mr *MockapiMockRecorder) GetPipelineState(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineState", reflect.TypeOf((*Mockapi)(nil).GetPipelineState), arg0)
 }
 
+// StartPipelineExecution mocks base method
+func (m *Mockapi) StartPipelineExecution(arg0 *codepipeline.StartPipelineExecutionInput) (*codepipeline.StartPipelineExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartPipelineExecution", arg0)
+	ret0, _ := ret[0].(*codepipeline.StartPipelineExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartPipelineExecution indicates an expected call of StartPipelineExecution
+func (mr *MockapiMockRecorder) StartPipelineExecution(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPipelineExecution", reflect.TypeOf((*Mockapi)(nil).StartPipelineExecution), arg0)
+}
+
+// RetryStageExecution mocks base method
+func (m *Mockapi) RetryStageExecution(arg0 *codepipeline.RetryStageExecutionInput) (*codepipeline.RetryStageExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryStageExecution", arg0)
+	ret0, _ := ret[0].(*codepipeline.RetryStageExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryStageExecution indicates an expected call of RetryStageExecution
+func (mr *MockapiMockRecorder) RetryStageExecution(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryStageExecution", reflect.TypeOf((*Mockapi)(nil).RetryStageExecution), arg0)
+}
+
+// ListPipelineExecutions mocks base method
+func (m *Mockapi) ListPipelineExecutions(arg0 *codepipeline.ListPipelineExecutionsInput) (*codepipeline.ListPipelineExecutionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPipelineExecutions", arg0)
+	ret0, _ := ret[0].(*codepipeline.ListPipelineExecutionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPipelineExecutions indicates an expected call of ListPipelineExecutions
+func (mr *MockapiMockRecorder) ListPipelineExecutions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelineExecutions", reflect.TypeOf((*Mockapi)(nil).ListPipelineExecutions), arg0)
+}
+
+// GetPipelineExecution mocks base method
+func (m *Mockapi) GetPipelineExecution(arg0 *codepipeline.GetPipelineExecutionInput) (*codepipeline.GetPipelineExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipelineExecution", arg0)
+	ret0, _ := ret[0].(*codepipeline.GetPipelineExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipelineExecution indicates an expected call of GetPipelineExecution
+func (mr *MockapiMockRecorder) GetPipelineExecution(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineExecution", reflect.TypeOf((*Mockapi)(nil).GetPipelineExecution), arg0)
+}
+
 // MockresourceGetter is a mock of resourceGetter interface
 type MockresourceGetter struct {
 	ctrl     *gomock.Controller
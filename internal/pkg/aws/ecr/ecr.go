@@ -125,6 +125,26 @@ func (c ECR) ListImages(repoName string) ([]Image, error) {
 	return images, nil
 }
 
+// ImageDigest calls the ECR DescribeImages API and returns the digest of the image
+// tagged with the input tag in the input ECR repository name.
+func (c ECR) ImageDigest(repoName, tag string) (string, error) {
+	resp, err := c.client.DescribeImages(&ecr.DescribeImagesInput{
+		RepositoryName: aws.String(repoName),
+		ImageIds: []*ecr.ImageIdentifier{
+			{
+				ImageTag: aws.String(tag),
+			},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("ecr repo %s describe image %s: %w", repoName, tag, err)
+	}
+	if len(resp.ImageDetails) == 0 {
+		return "", fmt.Errorf("ecr repo %s: no image found with tag %s", repoName, tag)
+	}
+	return aws.StringValue(resp.ImageDetails[0].ImageDigest), nil
+}
+
 // DeleteImages calls the ECR BatchDeleteImage API with the input image list and repository name.
 func (c ECR) DeleteImages(images []Image, repoName string) error {
 	if len(images) == 0 {
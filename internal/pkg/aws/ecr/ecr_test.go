@@ -260,6 +260,71 @@ func TestListImages(t *testing.T) {
 	}
 }
 
+func TestImageDigest(t *testing.T) {
+	mockRepoName := "mockRepoName"
+	mockError := errors.New("mockError")
+	mockInput := &ecr.DescribeImagesInput{
+		RepositoryName: aws.String(mockRepoName),
+		ImageIds: []*ecr.ImageIdentifier{
+			{
+				ImageTag: aws.String("v1"),
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantDigest string
+		wantError  error
+	}{
+		"should wrap error returned by ECR DescribeImages": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(mockInput).Return(nil, mockError)
+			},
+			wantError: fmt.Errorf("ecr repo %s describe image v1: %w", mockRepoName, mockError),
+		},
+		"should return error if the image does not exist": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(mockInput).Return(&ecr.DescribeImagesOutput{}, nil)
+			},
+			wantError: fmt.Errorf("ecr repo %s: no image found with tag v1", mockRepoName),
+		},
+		"should return the image digest": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(mockInput).Return(&ecr.DescribeImagesOutput{
+					ImageDetails: []*ecr.ImageDetail{
+						{
+							ImageDigest: aws.String("sha256:abc"),
+						},
+					},
+				}, nil)
+			},
+			wantDigest: "sha256:abc",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			gotDigest, gotError := client.ImageDigest(mockRepoName, "v1")
+
+			require.Equal(t, tc.wantDigest, gotDigest)
+			require.Equal(t, tc.wantError, gotError)
+		})
+	}
+}
+
 func TestDeleteImages(t *testing.T) {
 	mockRepoName := "mockRepoName"
 	mockError := errors.New("mockError")
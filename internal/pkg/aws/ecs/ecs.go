@@ -41,6 +41,8 @@ type api interface {
 	DescribeClusters(input *ecs.DescribeClustersInput) (*ecs.DescribeClustersOutput, error)
 	RunTask(input *ecs.RunTaskInput) (*ecs.RunTaskOutput, error)
 	WaitUntilTasksRunning(input *ecs.DescribeTasksInput) error
+	ExecuteCommand(input *ecs.ExecuteCommandInput) (*ecs.ExecuteCommandOutput, error)
+	UpdateService(input *ecs.UpdateServiceInput) (*ecs.UpdateServiceOutput, error)
 }
 
 // ECS wraps an AWS ECS client.
@@ -57,6 +59,9 @@ type Service ecs.Service
 // Task wraps up ECS Task struct.
 type Task ecs.Task
 
+// Session wraps up ECS Session struct, the Session Manager session of an executed command.
+type Session ecs.Session
+
 // ServiceStatus contains the status info of a service.
 type ServiceStatus struct {
 	DesiredCount     int64     `json:"desiredCount"`
@@ -136,6 +141,14 @@ type RunTaskInput struct {
 	StartedBy      string
 }
 
+// ExecuteCommandInput holds the fields needed to execute commands in a running container.
+type ExecuteCommandInput struct {
+	Cluster   string
+	Command   string
+	Task      string
+	Container string
+}
+
 // New returns a Service configured against the input session.
 func New(s *session.Session) *ECS {
 	return &ECS{
@@ -173,6 +186,19 @@ func (e *ECS) Service(clusterName, serviceName string) (*Service, error) {
 	return nil, fmt.Errorf("cannot find service %s", serviceName)
 }
 
+// UpdateServiceDesiredCount calls ECS API and sets the desired count of tasks of the service running in the cluster.
+func (e *ECS) UpdateServiceDesiredCount(clusterName, serviceName string, desiredCount int64) error {
+	_, err := e.client.UpdateService(&ecs.UpdateServiceInput{
+		Cluster:      aws.String(clusterName),
+		Service:      aws.String(serviceName),
+		DesiredCount: aws.Int64(desiredCount),
+	})
+	if err != nil {
+		return fmt.Errorf("update desired count of service %s to %d: %w", serviceName, desiredCount, err)
+	}
+	return nil
+}
+
 // ServiceTasks calls ECS API and returns ECS tasks running in the cluster.
 func (e *ECS) ServiceTasks(clusterName, serviceName string) ([]*Task, error) {
 	var tasks []*Task
@@ -205,6 +231,64 @@ func (e *ECS) ServiceTasks(clusterName, serviceName string) ([]*Task, error) {
 	return tasks, nil
 }
 
+// RunningTasksInFamily calls ECS API and returns the running ECS tasks of the task definition family in the cluster.
+func (e *ECS) RunningTasksInFamily(clusterName, family string) ([]*Task, error) {
+	var tasks []*Task
+	var err error
+	listTaskResp := &ecs.ListTasksOutput{}
+	for {
+		listTaskResp, err = e.client.ListTasks(&ecs.ListTasksInput{
+			Cluster:       aws.String(clusterName),
+			Family:        aws.String(family),
+			DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+			NextToken:     listTaskResp.NextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list running tasks of family %s: %w", family, err)
+		}
+		if len(listTaskResp.TaskArns) != 0 {
+			running, err := e.DescribeTasks(clusterName, aws.StringValueSlice(listTaskResp.TaskArns))
+			if err != nil {
+				return nil, err
+			}
+			tasks = append(tasks, running...)
+		}
+		if listTaskResp.NextToken == nil {
+			break
+		}
+	}
+	return tasks, nil
+}
+
+// StoppedServiceTasks calls ECS API and returns the recently stopped ECS tasks of the service in the cluster.
+func (e *ECS) StoppedServiceTasks(clusterName, serviceName string) ([]*Task, error) {
+	var tasks []*Task
+	var err error
+	listTaskResp := &ecs.ListTasksOutput{}
+	for {
+		listTaskResp, err = e.client.ListTasks(&ecs.ListTasksInput{
+			Cluster:       aws.String(clusterName),
+			ServiceName:   aws.String(serviceName),
+			DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+			NextToken:     listTaskResp.NextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list stopped tasks of service %s: %w", serviceName, err)
+		}
+		if len(listTaskResp.TaskArns) != 0 {
+			stopped, err := e.DescribeTasks(clusterName, aws.StringValueSlice(listTaskResp.TaskArns))
+			if err != nil {
+				return nil, err
+			}
+			tasks = append(tasks, stopped...)
+		}
+		if listTaskResp.NextToken == nil {
+			break
+		}
+	}
+	return tasks, nil
+}
+
 // DefaultCluster returns the default cluster ARN in the account and region.
 func (e *ECS) DefaultCluster() (string, error) {
 	resp, err := e.client.DescribeClusters(&ecs.DescribeClustersInput{})
@@ -236,11 +320,12 @@ func (e *ECS) HasDefaultCluster() (bool, error) {
 // the task(s) is running or fails to run, along with task ARNs if possible.
 func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
 	resp, err := e.client.RunTask(&ecs.RunTaskInput{
-		Cluster:        aws.String(input.Cluster),
-		Count:          aws.Int64(int64(input.Count)),
-		LaunchType:     aws.String(ecs.LaunchTypeFargate),
-		StartedBy:      aws.String(input.StartedBy),
-		TaskDefinition: aws.String(input.TaskFamilyName),
+		Cluster:              aws.String(input.Cluster),
+		Count:                aws.Int64(int64(input.Count)),
+		LaunchType:           aws.String(ecs.LaunchTypeFargate),
+		StartedBy:            aws.String(input.StartedBy),
+		TaskDefinition:       aws.String(input.TaskFamilyName),
+		EnableExecuteCommand: aws.Bool(true),
 		NetworkConfiguration: &ecs.NetworkConfiguration{
 			AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
 				AssignPublicIp: aws.String(ecs.AssignPublicIpEnabled),
@@ -304,6 +389,28 @@ func (e *ECS) DescribeTasks(cluster string, taskARNs []string) ([]*Task, error)
 	return tasks, nil
 }
 
+// ExecuteCommand executes the command interactively in a running container,
+// and returns the Session Manager session to connect to.
+func (e *ECS) ExecuteCommand(in ExecuteCommandInput) (*Session, error) {
+	resp, err := e.client.ExecuteCommand(&ecs.ExecuteCommandInput{
+		Cluster:     aws.String(in.Cluster),
+		Command:     aws.String(in.Command),
+		Container:   aws.String(in.Container),
+		Interactive: aws.Bool(true),
+		Task:        aws.String(in.Task),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("execute command %s in container %s: %w", in.Command, in.Container, err)
+	}
+	sess := Session(*resp.Session)
+	return &sess, nil
+}
+
+// ExecuteCommandEnabled returns true if commands can be executed in the containers of the task.
+func (t *Task) ExecuteCommandEnabled() bool {
+	return aws.BoolValue(t.EnableExecuteCommand)
+}
+
 // TaskStatus returns the status of the running task.
 func (t *Task) TaskStatus() (*TaskStatus, error) {
 	taskID, err := TaskID(aws.StringValue(t.TaskArn))
@@ -187,6 +187,55 @@ func TestECS_Service(t *testing.T) {
 	}
 }
 
+func TestECS_UpdateServiceDesiredCount(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr error
+	}{
+		"success": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().UpdateService(&ecs.UpdateServiceInput{
+					Cluster:      aws.String("mockCluster"),
+					Service:      aws.String("mockService"),
+					DesiredCount: aws.Int64(0),
+				}).Return(&ecs.UpdateServiceOutput{}, nil)
+			},
+		},
+		"errors if failed to update service": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().UpdateService(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("update desired count of service mockService to 0: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			err := service.UpdateServiceDesiredCount("mockCluster", "mockService", 0)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestECS_Tasks(t *testing.T) {
 	testCases := map[string]struct {
 		clusterName   string
@@ -329,6 +378,276 @@ func TestECS_Tasks(t *testing.T) {
 	}
 }
 
+func TestECS_RunningTasksInFamily(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr   error
+		wantTasks []*Task
+	}{
+		"errors if failed to list running tasks": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					Family:        aws.String("copilot-db-migrate"),
+					DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("list running tasks of family copilot-db-migrate: some error"),
+		},
+		"returns no tasks if none is running": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(gomock.Any()).Return(&ecs.ListTasksOutput{}, nil)
+			},
+		},
+		"success with pagination": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					Family:        aws.String("copilot-db-migrate"),
+					DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+				}).Return(&ecs.ListTasksOutput{
+					NextToken: aws.String("mockNextToken"),
+					TaskArns:  aws.StringSlice([]string{"mockTaskArn1"}),
+				}, nil)
+				m.EXPECT().DescribeTasks(&ecs.DescribeTasksInput{
+					Cluster: aws.String("mockCluster"),
+					Tasks:   aws.StringSlice([]string{"mockTaskArn1"}),
+				}).Return(&ecs.DescribeTasksOutput{
+					Tasks: []*ecs.Task{
+						{
+							TaskArn: aws.String("mockTaskArn1"),
+						},
+					},
+				}, nil)
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					Family:        aws.String("copilot-db-migrate"),
+					DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+					NextToken:     aws.String("mockNextToken"),
+				}).Return(&ecs.ListTasksOutput{
+					TaskArns: aws.StringSlice([]string{"mockTaskArn2"}),
+				}, nil)
+				m.EXPECT().DescribeTasks(&ecs.DescribeTasksInput{
+					Cluster: aws.String("mockCluster"),
+					Tasks:   aws.StringSlice([]string{"mockTaskArn2"}),
+				}).Return(&ecs.DescribeTasksOutput{
+					Tasks: []*ecs.Task{
+						{
+							TaskArn: aws.String("mockTaskArn2"),
+						},
+					},
+				}, nil)
+			},
+			wantTasks: []*Task{
+				{
+					TaskArn: aws.String("mockTaskArn1"),
+				},
+				{
+					TaskArn: aws.String("mockTaskArn2"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			gotTasks, gotErr := service.RunningTasksInFamily("mockCluster", "copilot-db-migrate")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantTasks, gotTasks)
+			}
+		})
+	}
+}
+
+func TestECS_StoppedServiceTasks(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr   error
+		wantTasks []*Task
+	}{
+		"errors if failed to list stopped tasks": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					ServiceName:   aws.String("mockService"),
+					DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("list stopped tasks of service mockService: some error"),
+		},
+		"returns no tasks if none is stopped": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(gomock.Any()).Return(&ecs.ListTasksOutput{}, nil)
+			},
+		},
+		"success with pagination": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					ServiceName:   aws.String("mockService"),
+					DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+				}).Return(&ecs.ListTasksOutput{
+					NextToken: aws.String("mockNextToken"),
+					TaskArns:  aws.StringSlice([]string{"mockTaskArn1"}),
+				}, nil)
+				m.EXPECT().DescribeTasks(&ecs.DescribeTasksInput{
+					Cluster: aws.String("mockCluster"),
+					Tasks:   aws.StringSlice([]string{"mockTaskArn1"}),
+				}).Return(&ecs.DescribeTasksOutput{
+					Tasks: []*ecs.Task{
+						{
+							TaskArn:       aws.String("mockTaskArn1"),
+							StoppedReason: aws.String("Essential container in task exited"),
+						},
+					},
+				}, nil)
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					ServiceName:   aws.String("mockService"),
+					DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+					NextToken:     aws.String("mockNextToken"),
+				}).Return(&ecs.ListTasksOutput{
+					TaskArns: aws.StringSlice([]string{"mockTaskArn2"}),
+				}, nil)
+				m.EXPECT().DescribeTasks(&ecs.DescribeTasksInput{
+					Cluster: aws.String("mockCluster"),
+					Tasks:   aws.StringSlice([]string{"mockTaskArn2"}),
+				}).Return(&ecs.DescribeTasksOutput{
+					Tasks: []*ecs.Task{
+						{
+							TaskArn: aws.String("mockTaskArn2"),
+						},
+					},
+				}, nil)
+			},
+			wantTasks: []*Task{
+				{
+					TaskArn:       aws.String("mockTaskArn1"),
+					StoppedReason: aws.String("Essential container in task exited"),
+				},
+				{
+					TaskArn: aws.String("mockTaskArn2"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			gotTasks, gotErr := service.StoppedServiceTasks("mockCluster", "mockService")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantTasks, gotTasks)
+			}
+		})
+	}
+}
+
+func TestECS_ExecuteCommand(t *testing.T) {
+	mockInput := ExecuteCommandInput{
+		Cluster:   "mockCluster",
+		Command:   "/bin/sh",
+		Task:      "mockTaskArn",
+		Container: "frontend",
+	}
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr     error
+		wantSession *Session
+	}{
+		"errors if failed to execute the command": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ExecuteCommand(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("execute command /bin/sh in container frontend: some error"),
+		},
+		"returns the session of the command": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ExecuteCommand(&ecs.ExecuteCommandInput{
+					Cluster:     aws.String("mockCluster"),
+					Command:     aws.String("/bin/sh"),
+					Container:   aws.String("frontend"),
+					Interactive: aws.Bool(true),
+					Task:        aws.String("mockTaskArn"),
+				}).Return(&ecs.ExecuteCommandOutput{
+					Session: &ecs.Session{
+						SessionId:  aws.String("mockSessionID"),
+						StreamUrl:  aws.String("mockStreamURL"),
+						TokenValue: aws.String("mockToken"),
+					},
+				}, nil)
+			},
+			wantSession: &Session{
+				SessionId:  aws.String("mockSessionID"),
+				StreamUrl:  aws.String("mockStreamURL"),
+				TokenValue: aws.String("mockToken"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			gotSession, gotErr := service.ExecuteCommand(mockInput)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantSession, gotSession)
+			}
+		})
+	}
+}
+
 func TestECS_DefaultCluster(t *testing.T) {
 	testCases := map[string]struct {
 		mockECSClient func(m *mocks.Mockapi)
@@ -478,11 +797,12 @@ func TestECS_RunTask(t *testing.T) {
 			input: runTaskInput,
 			mockECSClient: func(m *mocks.Mockapi) {
 				m.EXPECT().RunTask(&ecs.RunTaskInput{
-					Cluster:        aws.String("my-cluster"),
-					Count:          aws.Int64(3),
-					LaunchType:     aws.String(ecs.LaunchTypeFargate),
-					StartedBy:      aws.String("task"),
-					TaskDefinition: aws.String("my-task"),
+					Cluster:              aws.String("my-cluster"),
+					Count:                aws.Int64(3),
+					LaunchType:           aws.String(ecs.LaunchTypeFargate),
+					StartedBy:            aws.String("task"),
+					TaskDefinition:       aws.String("my-task"),
+					EnableExecuteCommand: aws.Bool(true),
 					NetworkConfiguration: &ecs.NetworkConfiguration{
 						AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
 							AssignPublicIp: aws.String(ecs.AssignPublicIpEnabled),
@@ -543,11 +863,12 @@ func TestECS_RunTask(t *testing.T) {
 
 			mockECSClient: func(m *mocks.Mockapi) {
 				m.EXPECT().RunTask(&ecs.RunTaskInput{
-					Cluster:        aws.String("my-cluster"),
-					Count:          aws.Int64(3),
-					LaunchType:     aws.String(ecs.LaunchTypeFargate),
-					StartedBy:      aws.String("task"),
-					TaskDefinition: aws.String("my-task"),
+					Cluster:              aws.String("my-cluster"),
+					Count:                aws.Int64(3),
+					LaunchType:           aws.String(ecs.LaunchTypeFargate),
+					StartedBy:            aws.String("task"),
+					TaskDefinition:       aws.String("my-task"),
+					EnableExecuteCommand: aws.Bool(true),
 					NetworkConfiguration: &ecs.NetworkConfiguration{
 						AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
 							AssignPublicIp: aws.String(ecs.AssignPublicIpEnabled),
@@ -0,0 +1,56 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package elbv2 provides a client to make API requests to Elastic Load Balancing.
+package elbv2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+)
+
+type api interface {
+	DescribeTargetHealth(input *elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error)
+}
+
+// ELBV2 wraps an Elastic Load Balancing client.
+type ELBV2 struct {
+	client api
+}
+
+// New returns an ELBV2 struct configured against the input session.
+func New(s *session.Session) *ELBV2 {
+	return &ELBV2{
+		client: elbv2.New(s),
+	}
+}
+
+// TargetHealth wraps up elbv2 TargetHealthDescription struct.
+type TargetHealth elbv2.TargetHealthDescription
+
+// TargetsHealth returns the health of the targets registered with the target group.
+func (e *ELBV2) TargetsHealth(targetGroupARN string) ([]*TargetHealth, error) {
+	out, err := e.client.DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: aws.String(targetGroupARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe health of targets in target group %s: %w", targetGroupARN, err)
+	}
+	var targets []*TargetHealth
+	for _, description := range out.TargetHealthDescriptions {
+		target := TargetHealth(*description)
+		targets = append(targets, &target)
+	}
+	return targets, nil
+}
+
+// IsHealthy returns true if the target passes the health checks of the target group.
+func (t *TargetHealth) IsHealthy() bool {
+	if t.TargetHealth == nil {
+		return false
+	}
+	return aws.StringValue(t.TargetHealth.State) == elbv2.TargetHealthStateEnumHealthy
+}
@@ -0,0 +1,118 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package elbv2
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestELBV2_TargetsHealth(t *testing.T) {
+	const mockTargetGroupARN = "arn:aws:elasticloadbalancing:us-west-2:1111:targetgroup/my-tg/1234"
+	unhealthyTarget := &elbv2.TargetHealthDescription{
+		Target: &elbv2.TargetDescription{
+			Id:   aws.String("10.0.0.1"),
+			Port: aws.Int64(80),
+		},
+		TargetHealth: &elbv2.TargetHealth{
+			State:       aws.String(elbv2.TargetHealthStateEnumUnhealthy),
+			Reason:      aws.String(elbv2.TargetHealthReasonEnumTargetResponseCodeMismatch),
+			Description: aws.String("Health checks failed with these codes: [404]"),
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedTargets []*TargetHealth
+		wantedErr     error
+	}{
+		"errors if failed to describe the health of the targets": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTargetHealth(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedErr: fmt.Errorf("describe health of targets in target group %s: some error", mockTargetGroupARN),
+		},
+		"returns the health of the targets": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+					TargetGroupArn: aws.String(mockTargetGroupARN),
+				}).Return(&elbv2.DescribeTargetHealthOutput{
+					TargetHealthDescriptions: []*elbv2.TargetHealthDescription{unhealthyTarget},
+				}, nil)
+			},
+
+			wantedTargets: []*TargetHealth{
+				{
+					Target:       unhealthyTarget.Target,
+					TargetHealth: unhealthyTarget.TargetHealth,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockAPI)
+			client := ELBV2{
+				client: mockAPI,
+			}
+
+			// WHEN
+			targets, err := client.TargetsHealth(mockTargetGroupARN)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTargets, targets)
+			}
+		})
+	}
+}
+
+func TestTargetHealth_IsHealthy(t *testing.T) {
+	testCases := map[string]struct {
+		in     *TargetHealth
+		wanted bool
+	}{
+		"healthy": {
+			in: &TargetHealth{
+				TargetHealth: &elbv2.TargetHealth{
+					State: aws.String(elbv2.TargetHealthStateEnumHealthy),
+				},
+			},
+			wanted: true,
+		},
+		"unhealthy": {
+			in: &TargetHealth{
+				TargetHealth: &elbv2.TargetHealth{
+					State: aws.String(elbv2.TargetHealthStateEnumUnhealthy),
+				},
+			},
+		},
+		"unknown": {
+			in: &TargetHealth{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.IsHealthy())
+		})
+	}
+}
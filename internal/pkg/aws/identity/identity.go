@@ -29,6 +29,7 @@ func New(s *session.Session) STS {
 
 // Caller holds information about a calling entity.
 type Caller struct {
+	ARN         string
 	RootUserARN string
 	Account     string
 	UserID      string
@@ -43,6 +44,7 @@ func (s STS) Get() (Caller, error) {
 	}
 
 	return Caller{
+		ARN:         *out.Arn,
 		RootUserARN: fmt.Sprintf("arn:aws:iam::%s:root", *out.Account),
 		Account:     *out.Account,
 		UserID:      *out.UserId,
@@ -7,6 +7,7 @@ package s3
 import (
 	"fmt"
 	"io"
+	"io/ioutil"
 	"path"
 	"strconv"
 	"time"
@@ -28,6 +29,7 @@ type s3ManagerApi interface {
 type s3Api interface {
 	ListObjectVersions(input *s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error)
 	DeleteObjects(input *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
+	GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error)
 }
 
 // S3 wraps an Amazon Simple Storage Service client.
@@ -61,6 +63,36 @@ func (s *S3) PutArtifact(bucket, fileName string, data io.Reader) (string, error
 	return resp.Location, nil
 }
 
+// PutObject uploads data to a S3 bucket under the given key.
+func (s *S3) PutObject(bucket, key string, data io.Reader) error {
+	_, err := s.s3Manager.Upload(&s3manager.UploadInput{
+		Body:   data,
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("put %s to bucket %s: %w", key, bucket, err)
+	}
+	return nil
+}
+
+// GetObject returns the content of the object stored in a S3 bucket under the given key.
+func (s *S3) GetObject(bucket, key string) (string, error) {
+	resp, err := s.s3Client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get %s from bucket %s: %w", key, bucket, err)
+	}
+	defer resp.Body.Close()
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("read %s from bucket %s: %w", key, bucket, err)
+	}
+	return string(content), nil
+}
+
 // EmptyBucket deletes all objects within the bucket.
 func (s *S3) EmptyBucket(bucket string) error {
 	var listResp *s3.ListObjectVersionsOutput
@@ -7,7 +7,9 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -89,6 +91,109 @@ func TestS3_PutArtifact(t *testing.T) {
 	}
 }
 
+func TestS3_PutObject(t *testing.T) {
+	buf := &bytes.Buffer{}
+	fmt.Fprint(buf, "some data")
+	testCases := map[string]struct {
+		mockS3ManagerClient func(m *mocks.Mocks3ManagerApi)
+
+		wantErr error
+	}{
+		"should put the object to the s3 bucket": {
+			mockS3ManagerClient: func(m *mocks.Mocks3ManagerApi) {
+				m.EXPECT().Upload(&s3manager.UploadInput{
+					Body:   buf,
+					Bucket: aws.String("mockBucket"),
+					Key:    aws.String("mockKey"),
+				}).Return(&s3manager.UploadOutput{}, nil)
+			},
+		},
+		"should return error if fail to upload": {
+			mockS3ManagerClient: func(m *mocks.Mocks3ManagerApi) {
+				m.EXPECT().Upload(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantErr: errors.New("put mockKey to bucket mockBucket: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3ManagerClient := mocks.NewMocks3ManagerApi(ctrl)
+			tc.mockS3ManagerClient(mockS3ManagerClient)
+
+			service := S3{
+				s3Manager: mockS3ManagerClient,
+			}
+
+			gotErr := service.PutObject("mockBucket", "mockKey", buf)
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestS3_GetObject(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3Api)
+
+		wantContent string
+		wantErr     error
+	}{
+		"should return the content of the object": {
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().GetObject(&s3.GetObjectInput{
+					Bucket: aws.String("mockBucket"),
+					Key:    aws.String("mockKey"),
+				}).Return(&s3.GetObjectOutput{
+					Body: ioutil.NopCloser(strings.NewReader("some data")),
+				}, nil)
+			},
+
+			wantContent: "some data",
+		},
+		"should return error if fail to get the object": {
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().GetObject(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantErr: errors.New("get mockKey from bucket mockBucket: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3Api(ctrl)
+			tc.mockS3Client(mockS3Client)
+
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			gotContent, gotErr := service.GetObject("mockBucket", "mockKey")
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantContent, gotContent)
+			}
+		})
+	}
+}
+
 func TestS3_EmptyBucket(t *testing.T) {
 	batchObject1 := make([]*s3.ObjectVersion, 1000)
 	batchObject2 := make([]*s3.ObjectVersion, 10)
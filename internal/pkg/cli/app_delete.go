@@ -44,6 +44,7 @@ var (
 type deleteAppVars struct {
 	name             string
 	skipConfirmation bool
+	force            bool
 }
 
 type deleteAppOpts struct {
@@ -121,6 +122,7 @@ func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
 			if err != nil {
 				return nil, err
 			}
+			opts.prodConfirmed = true // Production environments are confirmed before anything is deleted.
 			return opts, nil
 		},
 		deletePipelineRunner: func() (deletePipelineRunner, error) {
@@ -147,21 +149,19 @@ func (o *deleteAppOpts) Validate() error {
 
 // Ask prompts the user for any required flags that they didn't provide.
 func (o *deleteAppOpts) Ask() error {
-	if o.skipConfirmation {
-		return nil
-	}
-
-	manualConfirm, err := o.prompt.Confirm(
-		fmt.Sprintf(fmtDeleteAppConfirmPrompt, o.name),
-		deleteAppConfirmHelp,
-		prompt.WithTrueDefault())
-	if err != nil {
-		return fmt.Errorf("confirm app deletion: %w", err)
-	}
-	if !manualConfirm {
-		return errOperationCancelled
+	if !o.skipConfirmation {
+		manualConfirm, err := o.prompt.Confirm(
+			fmt.Sprintf(fmtDeleteAppConfirmPrompt, o.name),
+			deleteAppConfirmHelp,
+			prompt.WithTrueDefault())
+		if err != nil {
+			return fmt.Errorf("confirm app deletion: %w", err)
+		}
+		if !manualConfirm {
+			return errOperationCancelled
+		}
 	}
-	return nil
+	return o.confirmProdEnvs()
 }
 
 // Execute deletes the application.
@@ -207,6 +207,25 @@ func (o *deleteAppOpts) Execute() error {
 	return nil
 }
 
+// confirmProdEnvs asks the user to type the name of each production environment of the application
+// so that nothing is deleted until all of them are confirmed.
+func (o *deleteAppOpts) confirmProdEnvs() error {
+	envs, err := o.store.ListEnvironments(o.name)
+	if err != nil {
+		return fmt.Errorf("list environments for application %s: %w", o.name, err)
+	}
+	guard := prodGuard{
+		force:  o.force,
+		prompt: o.prompt,
+	}
+	for _, env := range envs {
+		if err := guard.confirm(env, fmt.Sprintf("delete application %s", o.name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (o *deleteAppOpts) deleteSvcs() error {
 	svcs, err := o.store.ListServices(o.name)
 	if err != nil {
@@ -356,5 +375,6 @@ func buildAppDeleteCommand() *cobra.Command {
 
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&vars.force, forceFlag, false, forceFlagDescription)
 	return cmd
 }
@@ -55,9 +55,11 @@ func TestDeleteAppOpts_Validate(t *testing.T) {
 func TestDeleteAppOpts_Ask(t *testing.T) {
 	const mockAppName = "phonetool"
 	var mockPrompter *mocks.Mockprompter
+	var mockStore *mocks.Mockstore
 	mockError := errors.New("some error")
 	tests := map[string]struct {
 		skipConfirmation bool
+		force            bool
 
 		setupMocks func(ctrl *gomock.Controller)
 
@@ -65,8 +67,32 @@ func TestDeleteAppOpts_Ask(t *testing.T) {
 	}{
 		"return nil if skipConfirmation is enabled": {
 			skipConfirmation: true,
-			setupMocks:       func(ctrl *gomock.Controller) {},
-			want:             nil,
+			setupMocks: func(ctrl *gomock.Controller) {
+				mockStore = mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().ListEnvironments(mockAppName).Return([]*config.Environment{{Name: "test"}}, nil)
+			},
+			want: nil,
+		},
+		"require typing the name of production environments even if skipConfirmation is enabled": {
+			skipConfirmation: true,
+			setupMocks: func(ctrl *gomock.Controller) {
+				mockStore = mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().ListEnvironments(mockAppName).Return([]*config.Environment{{Name: "test"}, {Name: "prod", Prod: true}}, nil)
+				mockPrompter = mocks.NewMockprompter(ctrl)
+				mockPrompter.EXPECT().Get(gomock.Any(), prodConfirmHelp, nil).Return("test", nil)
+			},
+			want: errProdConfirmationMismatch,
+		},
+		"skip the production environments guardrails if forced": {
+			skipConfirmation: true,
+			force:            true,
+			setupMocks: func(ctrl *gomock.Controller) {
+				mockStore = mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().ListEnvironments(mockAppName).Return([]*config.Environment{{Name: "prod", Prod: true}}, nil)
+				mockPrompter = mocks.NewMockprompter(ctrl)
+				mockPrompter.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			want: nil,
 		},
 		"wrap error returned from prompting": {
 			skipConfirmation: false,
@@ -100,6 +126,8 @@ func TestDeleteAppOpts_Ask(t *testing.T) {
 						deleteAppConfirmHelp,
 						gomock.Any()).
 					Return(true, nil)
+				mockStore = mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().ListEnvironments(mockAppName).Return(nil, nil)
 			},
 			want: nil,
 		},
@@ -114,7 +142,9 @@ func TestDeleteAppOpts_Ask(t *testing.T) {
 				deleteAppVars: deleteAppVars{
 					name:             mockAppName,
 					skipConfirmation: test.skipConfirmation,
+					force:            test.force,
 				},
+				store:  mockStore,
 				prompt: mockPrompter,
 			}
 
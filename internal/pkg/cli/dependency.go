@@ -0,0 +1,115 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+type dependencyEndpointsInput struct {
+	app  string
+	env  string
+	svc  string
+	deps []string
+	// Services deployed to the environment in the same command before the dependent service.
+	// They don't need to be deployed already.
+	deploying []string
+
+	deployStore deployedEnvironmentLister
+	ws          wsSvcReader
+	unmarshal   func([]byte) (interface{}, error)
+}
+
+// dependencyEndpoints returns an error if a dependency of a service is not a service of the workspace or is not deployed
+// to the environment. Otherwise, it returns the service discovery endpoints of the dependencies that expose a port,
+// keyed by service name.
+func dependencyEndpoints(in dependencyEndpointsInput) (map[string]string, error) {
+	if len(in.deps) == 0 {
+		return nil, nil
+	}
+	svcNames, err := in.ws.ServiceNames()
+	if err != nil {
+		return nil, fmt.Errorf("list services in the workspace: %w", err)
+	}
+	endpoints := make(map[string]string)
+	for _, dep := range in.deps {
+		if !contains(dep, svcNames) {
+			return nil, fmt.Errorf("dependency %s of service %s is not a service in the workspace", color.HighlightUserInput(dep), in.svc)
+		}
+		if !contains(dep, in.deploying) {
+			deployed, err := in.deployStore.IsServiceDeployed(in.app, in.env, dep)
+			if err != nil {
+				return nil, fmt.Errorf("check if dependency %s is deployed to environment %s: %w", dep, in.env, err)
+			}
+			if !deployed {
+				return nil, fmt.Errorf("dependency %s of service %s is not deployed to environment %s", color.HighlightUserInput(dep), in.svc, color.HighlightUserInput(in.env))
+			}
+		}
+		wl, err := readLocalWorkload(dep, "service", in.ws.ReadServiceManifest, in.unmarshal)
+		if err != nil {
+			return nil, err
+		}
+		port, ok := manifest.ServicePort(wl.manifest)
+		if !ok {
+			continue
+		}
+		endpoints[dep] = fmt.Sprintf("http://%s.%s.local:%d", dep, in.app, port)
+	}
+	return endpoints, nil
+}
+
+// deploymentKey identifies the deployment of a workload to an environment.
+type deploymentKey struct {
+	workload string
+	env      string
+}
+
+type workloadsDependencyEndpointsInput struct {
+	app       string
+	envs      []*config.Environment
+	workloads []*localWorkload // Workloads deployed together, in the order they're deployed.
+
+	deployStore deployedEnvironmentLister
+	ws          wsSvcReader
+	unmarshal   func([]byte) (interface{}, error)
+}
+
+// workloadsDependencyEndpoints validates that the dependencies of each service are either deployed along with it, or already deployed
+// to the environments, before anything gets built. It returns the endpoints of the dependencies of each deployment.
+func workloadsDependencyEndpoints(in workloadsDependencyEndpointsInput) (map[deploymentKey]map[string]string, error) {
+	var deploying []string
+	for _, wl := range in.workloads {
+		if wl.typeName == "service" {
+			deploying = append(deploying, wl.name)
+		}
+	}
+	endpoints := make(map[deploymentKey]map[string]string)
+	for _, wl := range in.workloads {
+		deps := manifest.ServiceDependencies(wl.manifest)
+		if len(deps) == 0 {
+			continue
+		}
+		for _, env := range in.envs {
+			wlEndpoints, err := dependencyEndpoints(dependencyEndpointsInput{
+				app:         in.app,
+				env:         env.Name,
+				svc:         wl.name,
+				deps:        deps,
+				deploying:   deploying,
+				deployStore: in.deployStore,
+				ws:          in.ws,
+				unmarshal:   in.unmarshal,
+			})
+			if err != nil {
+				return nil, err
+			}
+			endpoints[deploymentKey{workload: wl.name, env: env.Name}] = wlEndpoints
+		}
+	}
+	return endpoints, nil
+}
@@ -0,0 +1,120 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDependencyEndpoints(t *testing.T) {
+	webMft := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+		WorkloadProps: &manifest.WorkloadProps{
+			Name:  "api",
+			Image: "nginx",
+		},
+		Path: "/",
+		Port: 8080,
+	})
+	testCases := map[string]struct {
+		inDeps      []string
+		inDeploying []string
+		setupMocks  func(ws *mocks.MockwsSvcReader, store *mocks.MockdeployedEnvironmentLister)
+
+		wantedEndpoints map[string]string
+		wantedError     error
+	}{
+		"returns nil if the service has no dependencies": {
+			setupMocks: func(ws *mocks.MockwsSvcReader, store *mocks.MockdeployedEnvironmentLister) {},
+		},
+		"errors if a dependency is not a service in the workspace": {
+			inDeps: []string{"report"},
+			setupMocks: func(ws *mocks.MockwsSvcReader, store *mocks.MockdeployedEnvironmentLister) {
+				ws.EXPECT().ServiceNames().Return([]string{"frontend", "api"}, nil)
+			},
+
+			wantedError: errors.New("dependency report of service frontend is not a service in the workspace"),
+		},
+		"errors if a dependency is not deployed to the environment": {
+			inDeps: []string{"api"},
+			setupMocks: func(ws *mocks.MockwsSvcReader, store *mocks.MockdeployedEnvironmentLister) {
+				ws.EXPECT().ServiceNames().Return([]string{"frontend", "api"}, nil)
+				store.EXPECT().IsServiceDeployed("phonetool", "test", "api").Return(false, nil)
+			},
+
+			wantedError: errors.New("dependency api of service frontend is not deployed to environment test"),
+		},
+		"wraps the error if fails to check if a dependency is deployed": {
+			inDeps: []string{"api"},
+			setupMocks: func(ws *mocks.MockwsSvcReader, store *mocks.MockdeployedEnvironmentLister) {
+				ws.EXPECT().ServiceNames().Return([]string{"frontend", "api"}, nil)
+				store.EXPECT().IsServiceDeployed("phonetool", "test", "api").Return(false, mockError)
+			},
+
+			wantedError: errors.New("check if dependency api is deployed to environment test: mock error"),
+		},
+		"returns the service discovery endpoint of a deployed dependency": {
+			inDeps: []string{"api"},
+			setupMocks: func(ws *mocks.MockwsSvcReader, store *mocks.MockdeployedEnvironmentLister) {
+				ws.EXPECT().ServiceNames().Return([]string{"frontend", "api"}, nil)
+				store.EXPECT().IsServiceDeployed("phonetool", "test", "api").Return(true, nil)
+				ws.EXPECT().ReadServiceManifest("api").Return([]byte("api"), nil)
+			},
+
+			wantedEndpoints: map[string]string{
+				"api": "http://api.phonetool.local:8080",
+			},
+		},
+		"doesn't check dependencies deployed along with the service": {
+			inDeps:      []string{"api"},
+			inDeploying: []string{"api", "frontend"},
+			setupMocks: func(ws *mocks.MockwsSvcReader, store *mocks.MockdeployedEnvironmentLister) {
+				ws.EXPECT().ServiceNames().Return([]string{"frontend", "api"}, nil)
+				ws.EXPECT().ReadServiceManifest("api").Return([]byte("api"), nil)
+			},
+
+			wantedEndpoints: map[string]string{
+				"api": "http://api.phonetool.local:8080",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsSvcReader(ctrl)
+			store := mocks.NewMockdeployedEnvironmentLister(ctrl)
+			tc.setupMocks(ws, store)
+
+			// WHEN
+			endpoints, err := dependencyEndpoints(dependencyEndpointsInput{
+				app:         "phonetool",
+				env:         "test",
+				svc:         "frontend",
+				deps:        tc.inDeps,
+				deploying:   tc.inDeploying,
+				deployStore: store,
+				ws:          ws,
+				unmarshal: func(in []byte) (interface{}, error) {
+					return webMft, nil
+				},
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedEndpoints, endpoints)
+			}
+		})
+	}
+}
@@ -4,21 +4,94 @@
 package cli
 
 import (
+	"fmt"
+
 	"github.com/aws/copilot-cli/cmd/copilot/template"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/cobra"
 )
 
-// BuildDeployCmd is the deploy command - which is
-// an alias for app deploy.
+// BuildDeployCmd is the deploy command - which is an alias for svc deploy or job deploy
+// when deploying a single workload to a single environment.
 func BuildDeployCmd() *cobra.Command {
-	deployCmd := buildSvcDeployCmd()
-	deployCmd.Use = "deploy"
-	deployCmd.Short = "Deploy your service."
-	deployCmd.Long = `Command for deploying services to your environments.`
-	deployCmd.Example = `
+	vars := deployWorkloadsVars{}
+	pipelineVars := deployPipelineVars{}
+	var isPipeline bool
+	deployCmd := &cobra.Command{
+		Use:   "deploy",
+		Short: "Deploy your services and jobs.",
+		Long: `Command for deploying services and jobs to your environments.
+Several services, jobs and environments can be deployed at once: each image is built once and the stacks are deployed concurrently.`,
+		Example: `
 	Deploys a service named "frontend" to a "test" environment.
-	/code $ copilot deploy --name frontend --env test`
+	/code $ copilot deploy --name frontend --env test
+	Deploys the "frontend" and "api" services to the "test" and "prod" environments, three stacks at a time.
+	/code $ copilot deploy -n frontend -n api -e test -e prod --parallelism 3
+	Deploys every service and job in the workspace to a "test" environment.
+	/code $ copilot deploy --all --env test
+	Packages every service and job in the workspace from a pipeline's build stage.
+	/code $ copilot deploy --pipeline --tag $tag --output-dir ./infrastructure`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if isPipeline {
+				if len(vars.names) != 0 || vars.all {
+					return fmt.Errorf("cannot specify --%s or --%s with --%s, every service and job is packaged", nameFlag, allFlag, pipelineFlag)
+				}
+				if len(vars.envNames) > 1 {
+					return fmt.Errorf("cannot specify more than one --%s with --%s", envFlag, pipelineFlag)
+				}
+				pipelineVars.appName = vars.appName
+				pipelineVars.envName = first(vars.envNames)
+				pipelineVars.imageTag = vars.imageTag
+				pipelineVars.resourceTags = vars.resourceTags
+				opts, err := newDeployPipelineOpts(pipelineVars)
+				if err != nil {
+					return err
+				}
+				if err := opts.Validate(); err != nil {
+					return err
+				}
+				return opts.Execute()
+			}
+			if !vars.all && len(vars.names) <= 1 && len(vars.envNames) <= 1 {
+				opts, err := newSingleWorkloadDeployer(vars)
+				if err != nil {
+					return err
+				}
+				if err := opts.Validate(); err != nil {
+					return err
+				}
+				if err := opts.Ask(); err != nil {
+					return err
+				}
+				return opts.Execute()
+			}
+			opts, err := newDeployWorkloadsOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	deployCmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	deployCmd.Flags().StringSliceVarP(&vars.names, nameFlag, nameFlagShort, nil, deployNamesFlagDescription)
+	deployCmd.Flags().StringSliceVarP(&vars.envNames, envFlag, envFlagShort, nil, deployEnvsFlagDescription)
+	deployCmd.Flags().BoolVar(&vars.all, allFlag, false, deployAllFlagDescription)
+	deployCmd.Flags().IntVar(&vars.parallelism, parallelismFlag, defaultDeployParallelism, deployParallelismFlagDescription)
+	deployCmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
+	deployCmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	deployCmd.Flags().BoolVar(&vars.force, forceFlag, false, forceFlagDescription)
+	deployCmd.Flags().BoolVar(&vars.allowDirty, allowDirtyFlag, false, allowDirtyFlagDescription)
+	deployCmd.Flags().BoolVar(&vars.allowAnyBranch, allowAnyBranchFlag, false, allowAnyBranchFlagDescription)
+	deployCmd.Flags().BoolVar(&vars.stealLock, stealLockFlag, false, stealLockFlagDescription)
+	deployCmd.Flags().BoolVar(&isPipeline, pipelineFlag, false, deployPipelineFlagDescription)
+	deployCmd.Flags().StringVar(&pipelineVars.outputDir, stackOutputDirFlag, defaultPipelineOutputDir, stackOutputDirFlagDescription)
 
 	deployCmd.SetUsageTemplate(template.Usage)
 
@@ -27,3 +100,51 @@ func BuildDeployCmd() *cobra.Command {
 	}
 	return deployCmd
 }
+
+// newSingleWorkloadDeployer returns the job deploy command if the workload to deploy is a job of the workspace,
+// and the svc deploy command otherwise.
+func newSingleWorkloadDeployer(vars deployWorkloadsVars) (actionCommand, error) {
+	name := first(vars.names)
+	if name != "" {
+		ws, err := workspace.New()
+		if err != nil {
+			return nil, fmt.Errorf("new workspace: %w", err)
+		}
+		jobs, err := ws.JobNames()
+		if err != nil {
+			return nil, fmt.Errorf("list jobs in the workspace: %w", err)
+		}
+		if contains(name, jobs) {
+			return newJobDeployOpts(deployJobVars{
+				appName:        vars.appName,
+				name:           name,
+				envName:        first(vars.envNames),
+				imageTag:       vars.imageTag,
+				resourceTags:   vars.resourceTags,
+				force:          vars.force,
+				allowDirty:     vars.allowDirty,
+				allowAnyBranch: vars.allowAnyBranch,
+				stealLock:      vars.stealLock,
+			})
+		}
+	}
+	return newSvcDeployOpts(deploySvcVars{
+		appName:        vars.appName,
+		name:           name,
+		envName:        first(vars.envNames),
+		imageTag:       vars.imageTag,
+		resourceTags:   vars.resourceTags,
+		force:          vars.force,
+		allowDirty:     vars.allowDirty,
+		allowAnyBranch: vars.allowAnyBranch,
+		stealLock:      vars.stealLock,
+	})
+}
+
+// first returns the first value, or the empty string if there are none.
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,171 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/google/uuid"
+)
+
+const (
+	// A held lock is refreshed well within its TTL so that it only expires once its holder is gone,
+	// however long the deployment takes.
+	deployLockTTL             = 10 * time.Minute
+	deployLockRefreshInterval = 2 * time.Minute
+	deployLockPollInterval    = 10 * time.Second
+	deployLockWaitTimeout     = 15 * time.Minute
+)
+
+// deployLocker serializes the deployments of a workload to an environment with the deploy locks of the config store.
+type deployLocker struct {
+	store   deployLockStore
+	spinner progress
+	steal   bool // Take over locks held by someone else instead of waiting.
+
+	pollInterval    time.Duration
+	waitTimeout     time.Duration
+	refreshInterval time.Duration
+
+	refreshes map[string]*lockRefresh // Refresh of each held lock by ID.
+}
+
+// lockRefresh is the background refresh of a held deploy lock.
+type lockRefresh struct {
+	stop chan struct{} // Stops the refresh.
+	lost error         // Set by the refresh if the lock was taken over by someone else, read once the refresh is stopped.
+}
+
+func newDeployLocker(store deployLockStore, spinner progress, steal bool) *deployLocker {
+	return &deployLocker{
+		store:           store,
+		spinner:         spinner,
+		steal:           steal,
+		pollInterval:    deployLockPollInterval,
+		waitTimeout:     deployLockWaitTimeout,
+		refreshInterval: deployLockRefreshInterval,
+		refreshes:       make(map[string]*lockRefresh),
+	}
+}
+
+// acquire takes the deploy lock of a workload in an environment and keeps refreshing it until it's released.
+// If the lock is taken over by someone else in the meantime, release returns an error.
+// While the lock is held by someone else, it waits for the lock to be released or to expire,
+// and gives up with the identity of the holder once the wait timeout is reached.
+func (l *deployLocker) acquire(appName, envName, name string) (*config.DeployLock, error) {
+	lock, err := l.take(appName, envName, name)
+	if err != nil {
+		return nil, err
+	}
+	r := &lockRefresh{
+		stop: make(chan struct{}),
+	}
+	l.refreshes[lock.ID] = r
+	go l.refresh(lock, r)
+	return lock, nil
+}
+
+func (l *deployLocker) take(appName, envName, name string) (*config.DeployLock, error) {
+	lock := &config.DeployLock{
+		App:  appName,
+		Env:  envName,
+		Name: name,
+		ID:   uuid.New().String(),
+		TTL:  deployLockTTL,
+	}
+	deadline := time.Now().Add(l.waitTimeout)
+	waiting := false
+	for {
+		lock.AcquiredAt = time.Now()
+		err := l.store.AcquireDeployLock(lock)
+		if err == nil {
+			if waiting {
+				l.spinner.Stop(log.Ssuccessf("Acquired the deploy lock of %s in environment %s.\n", color.HighlightUserInput(name), color.HighlightUserInput(envName)))
+			}
+			return lock, nil
+		}
+		var errLocked *config.ErrDeployLocked
+		if !errors.As(err, &errLocked) {
+			if waiting {
+				l.spinner.Stop(log.Serrorf("Failed to acquire the deploy lock of %s in environment %s.\n", color.HighlightUserInput(name), color.HighlightUserInput(envName)))
+			}
+			return nil, err
+		}
+		if l.steal {
+			log.Warningf("Taking over the deploy lock of %s in environment %s from %s.\n", color.HighlightUserInput(name), color.HighlightUserInput(envName), errLocked.Lock.Owner)
+			lock.AcquiredAt = time.Now()
+			if err := l.store.StealDeployLock(lock); err != nil {
+				return nil, err
+			}
+			return lock, nil
+		}
+		if !time.Now().Before(deadline) {
+			if waiting {
+				l.spinner.Stop(log.Serrorf("Timed out waiting for the deploy lock of %s in environment %s.\n", color.HighlightUserInput(name), color.HighlightUserInput(envName)))
+			}
+			return nil, fmt.Errorf("%w: retry once the deployment is over or use --%s to take over the lock", err, stealLockFlag)
+		}
+		if !waiting {
+			l.spinner.Start(fmt.Sprintf("Waiting for %s to finish deploying %s to environment %s.", errLocked.Lock.Owner, color.HighlightUserInput(name), color.HighlightUserInput(envName)))
+			waiting = true
+		}
+		time.Sleep(l.pollInterval)
+	}
+}
+
+// refresh pushes back the expiry of the lock at every refresh interval until it's stopped.
+func (l *deployLocker) refresh(lock *config.DeployLock, r *lockRefresh) {
+	ticker := time.NewTicker(l.refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			err := l.store.RefreshDeployLock(lock)
+			if err == nil {
+				continue
+			}
+			var errLocked *config.ErrDeployLocked
+			if errors.As(err, &errLocked) {
+				r.lost = err
+				log.Warningf("Lost the deploy lock of %s in environment %s: %v\n", lock.Name, lock.Env, err)
+				<-r.stop
+				return
+			}
+			log.Warningf("Failed to refresh the deploy lock of %s in environment %s: %v\n", lock.Name, lock.Env, err)
+		}
+	}
+}
+
+// release stops refreshing a deploy lock and releases it. Failures to release the lock are only logged since the lock expires anyway.
+// If the lock was taken over by someone else while it was held, the lock is left to them and an error is returned,
+// since the deployment may have run alongside theirs.
+func (l *deployLocker) release(lock *config.DeployLock) error {
+	if r, ok := l.refreshes[lock.ID]; ok {
+		// Wait for the refresh in progress, if any, so that it doesn't race with the release.
+		r.stop <- struct{}{}
+		delete(l.refreshes, lock.ID)
+		if r.lost != nil {
+			return fmt.Errorf("lost the deploy lock of %s in environment %s during the deployment: %w", lock.Name, lock.Env, r.lost)
+		}
+	}
+	if err := l.store.ReleaseDeployLock(lock); err != nil {
+		log.Warningf("Failed to release the deploy lock of %s in environment %s, it expires in %s: %v\n", lock.Name, lock.Env, lock.TTL, err)
+	}
+	return nil
+}
+
+// releaseOnReturn releases the lock like release, and reports the loss of the lock in err unless the caller already failed.
+// It's meant to be deferred by callers with a named error result.
+func (l *deployLocker) releaseOnReturn(lock *config.DeployLock, err *error) {
+	if releaseErr := l.release(lock); releaseErr != nil && *err == nil {
+		*err = releaseErr
+	}
+}
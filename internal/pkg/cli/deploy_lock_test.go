@@ -0,0 +1,183 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployLocker_acquire(t *testing.T) {
+	heldLock := &config.DeployLock{
+		App:        "phonetool",
+		Env:        "test",
+		Name:       "api",
+		ID:         "1",
+		Owner:      "arn:aws:iam::1234:user/alice",
+		AcquiredAt: time.Date(2020, 12, 1, 10, 0, 0, 0, time.UTC),
+		TTL:        time.Hour,
+	}
+	errLocked := &config.ErrDeployLocked{Lock: heldLock}
+
+	testCases := map[string]struct {
+		inSteal     bool
+		inTimeout   time.Duration
+		setupMocks  func(store *mocks.MockdeployLockStore, spinner *mocks.Mockprogress)
+		wantedError error
+	}{
+		"acquires a lock that isn't held": {
+			setupMocks: func(store *mocks.MockdeployLockStore, spinner *mocks.Mockprogress) {
+				store.EXPECT().AcquireDeployLock(gomock.Any()).DoAndReturn(func(lock *config.DeployLock) error {
+					require.Equal(t, "phonetool", lock.App)
+					require.Equal(t, "test", lock.Env)
+					require.Equal(t, "api", lock.Name)
+					require.NotEmpty(t, lock.ID)
+					require.Equal(t, deployLockTTL, lock.TTL)
+					return nil
+				})
+			},
+		},
+		"returns unexpected errors": {
+			setupMocks: func(store *mocks.MockdeployLockStore, spinner *mocks.Mockprogress) {
+				store.EXPECT().AcquireDeployLock(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"waits for the lock to be released": {
+			inTimeout: time.Minute,
+			setupMocks: func(store *mocks.MockdeployLockStore, spinner *mocks.Mockprogress) {
+				gomock.InOrder(
+					store.EXPECT().AcquireDeployLock(gomock.Any()).Return(errLocked),
+					spinner.EXPECT().Start(gomock.Any()),
+					store.EXPECT().AcquireDeployLock(gomock.Any()).Return(errLocked),
+					store.EXPECT().AcquireDeployLock(gomock.Any()).Return(nil),
+					spinner.EXPECT().Stop(gomock.Any()),
+				)
+			},
+		},
+		"fails with the holder of the lock once the wait times out": {
+			setupMocks: func(store *mocks.MockdeployLockStore, spinner *mocks.Mockprogress) {
+				store.EXPECT().AcquireDeployLock(gomock.Any()).Return(errLocked)
+			},
+			wantedError: errors.New("api in environment test is locked by arn:aws:iam::1234:user/alice since 2020-12-01T10:00:00Z: retry once the deployment is over or use --steal-lock to take over the lock"),
+		},
+		"takes over a lock held by someone else": {
+			inSteal: true,
+			setupMocks: func(store *mocks.MockdeployLockStore, spinner *mocks.Mockprogress) {
+				store.EXPECT().AcquireDeployLock(gomock.Any()).Return(errLocked)
+				store.EXPECT().StealDeployLock(gomock.Any()).Return(nil)
+			},
+		},
+		"returns errors taking over the lock": {
+			inSteal: true,
+			setupMocks: func(store *mocks.MockdeployLockStore, spinner *mocks.Mockprogress) {
+				store.EXPECT().AcquireDeployLock(gomock.Any()).Return(errLocked)
+				store.EXPECT().StealDeployLock(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockdeployLockStore(ctrl)
+			spinner := mocks.NewMockprogress(ctrl)
+			tc.setupMocks(store, spinner)
+			locker := &deployLocker{
+				store:           store,
+				spinner:         spinner,
+				steal:           tc.inSteal,
+				pollInterval:    time.Millisecond,
+				waitTimeout:     tc.inTimeout,
+				refreshInterval: time.Hour,
+				refreshes:       make(map[string]*lockRefresh),
+			}
+
+			// WHEN
+			lock, err := locker.acquire("phonetool", "test", "api")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "api", lock.Name)
+				require.Contains(t, locker.refreshes, lock.ID)
+			}
+		})
+	}
+}
+
+func TestDeployLocker_release(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := mocks.NewMockdeployLockStore(ctrl)
+	refreshed := make(chan struct{})
+	gomock.InOrder(
+		store.EXPECT().AcquireDeployLock(gomock.Any()).Return(nil),
+		store.EXPECT().RefreshDeployLock(gomock.Any()).DoAndReturn(func(lock *config.DeployLock) error {
+			close(refreshed)
+			return nil
+		}),
+		store.EXPECT().RefreshDeployLock(gomock.Any()).Return(nil).AnyTimes(),
+	)
+	locker := &deployLocker{
+		store:           store,
+		spinner:         mocks.NewMockprogress(ctrl),
+		refreshInterval: time.Millisecond,
+		refreshes:       make(map[string]*lockRefresh),
+	}
+	lock, err := locker.acquire("phonetool", "test", "api")
+	require.NoError(t, err)
+	<-refreshed
+	store.EXPECT().ReleaseDeployLock(lock).Return(nil)
+
+	// WHEN
+	err = locker.release(lock)
+
+	// THEN
+	require.NoError(t, err)
+	require.NotContains(t, locker.refreshes, lock.ID)
+}
+
+func TestDeployLocker_release_lostLock(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := mocks.NewMockdeployLockStore(ctrl)
+	lost := make(chan struct{})
+	gomock.InOrder(
+		store.EXPECT().AcquireDeployLock(gomock.Any()).Return(nil),
+		store.EXPECT().RefreshDeployLock(gomock.Any()).DoAndReturn(func(lock *config.DeployLock) error {
+			close(lost)
+			return &config.ErrDeployLocked{Lock: &config.DeployLock{Name: "api", Env: "test", Owner: "arn:aws:iam::1234:user/alice", AcquiredAt: time.Date(2020, 12, 1, 10, 0, 0, 0, time.UTC)}}
+		}),
+	)
+	locker := &deployLocker{
+		store:           store,
+		spinner:         mocks.NewMockprogress(ctrl),
+		refreshInterval: time.Millisecond,
+		refreshes:       make(map[string]*lockRefresh),
+	}
+	lock, err := locker.acquire("phonetool", "test", "api")
+	require.NoError(t, err)
+	<-lost
+
+	// WHEN
+	err = locker.release(lock)
+
+	// THEN
+	require.EqualError(t, err, "lost the deploy lock of api in environment test during the deployment: api in environment test is locked by arn:aws:iam::1234:user/alice since 2020-12-01T10:00:00Z")
+	require.NotContains(t, locker.refreshes, lock.ID)
+}
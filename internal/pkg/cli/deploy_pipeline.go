@@ -0,0 +1,385 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/docker"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/repository"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+)
+
+const (
+	defaultPipelineOutputDir = "infrastructure"
+)
+
+type deployPipelineVars struct {
+	appName      string
+	envName      string
+	imageTag     string
+	outputDir    string
+	resourceTags map[string]string
+}
+
+// localWorkload is a service or job in the workspace along with its manifest.
+type localWorkload struct {
+	name     string
+	typeName string // Either "service" or "job".
+	manifest interface{}
+}
+
+type deployPipelineOpts struct {
+	deployPipelineVars
+
+	store           store
+	deployStore     deployedEnvironmentLister
+	ws              wsWorkloadReader
+	appCFN          appResourcesGetter
+	fs              afero.Fs
+	unmarshal       func([]byte) (interface{}, error)
+	stackSerializer func(mft interface{}, env *config.Environment, app *config.Application, rc stack.RuntimeConfig) (stackSerializer, error)
+	dockerService   repository.ContainerLoginBuildPusher
+
+	// Regional clients, overridden in tests.
+	newImageBuilderPusher func(repoName, region string) (imageBuilderTagPusher, error)
+	newUploader           func(region string) (artifactUploader, error)
+	newAddons             func(name string) (templater, error)
+}
+
+func newDeployPipelineOpts(vars deployPipelineVars) (*deployPipelineOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	provider := sessions.NewProvider()
+	defaultSess, err := provider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("create default session: %w", err)
+	}
+	return &deployPipelineOpts{
+		deployPipelineVars: vars,
+
+		store:           store,
+		deployStore:     deployStore,
+		ws:              ws,
+		appCFN:          cloudformation.New(defaultSess),
+		fs:              &afero.Afero{Fs: afero.NewOsFs()},
+		unmarshal:       manifest.UnmarshalWorkload,
+		stackSerializer: newWorkloadStackSerializer,
+		dockerService:   docker.New(),
+		newImageBuilderPusher: func(repoName, region string) (imageBuilderTagPusher, error) {
+			sess, err := provider.DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create ECR session with region %s: %w", region, err)
+			}
+			return repository.New(repoName, ecr.New(sess))
+		},
+		newUploader: func(region string) (artifactUploader, error) {
+			sess, err := provider.DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create S3 session with region %s: %w", region, err)
+			}
+			return s3.New(sess), nil
+		},
+		newAddons: func(name string) (templater, error) {
+			return addon.New(name)
+		},
+	}, nil
+}
+
+// Validate returns an error if the user inputs are invalid.
+func (o *deployPipelineOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.imageTag == "" {
+		return fmt.Errorf("flag --%s is required when deploying with --%s", imageTagFlag, pipelineFlag)
+	}
+	if o.envName != "" {
+		if _, err := targetEnv(o.store, o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Execute builds and pushes the container images, uploads the addons templates, and writes the
+// CloudFormation templates and configurations of every workload in the workspace for each environment.
+func (o *deployPipelineOpts) Execute() error {
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	envs, err := o.targetEnvs()
+	if err != nil {
+		return err
+	}
+	workloads, err := o.localWorkloads()
+	if err != nil {
+		return err
+	}
+	// The pipeline deploys every workload of the workspace, so the dependencies don't need to be deployed already.
+	endpoints, err := workloadsDependencyEndpoints(workloadsDependencyEndpointsInput{
+		app:         app.Name,
+		envs:        envs,
+		workloads:   workloads,
+		deployStore: o.deployStore,
+		ws:          o.ws,
+		unmarshal:   o.unmarshal,
+	})
+	if err != nil {
+		return err
+	}
+	if err := o.fs.MkdirAll(o.outputDir, 0755); err != nil {
+		return fmt.Errorf("create directory %s: %w", o.outputDir, err)
+	}
+
+	resources := make(map[string]*stack.AppRegionalResources)
+	var regions []string
+	for _, env := range envs {
+		if _, ok := resources[env.Region]; ok {
+			continue
+		}
+		res, err := o.appCFN.GetAppResourcesByRegion(app, env.Region)
+		if err != nil {
+			return fmt.Errorf("get application %s resources from region %s: %w", app.Name, env.Region, err)
+		}
+		resources[env.Region] = res
+		regions = append(regions, env.Region)
+	}
+
+	for _, wl := range workloads {
+		if err := o.packageWorkload(app, envs, regions, resources, endpoints, wl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *deployPipelineOpts) targetEnvs() ([]*config.Environment, error) {
+	if o.envName != "" {
+		env, err := targetEnv(o.store, o.appName, o.envName)
+		if err != nil {
+			return nil, err
+		}
+		return []*config.Environment{env}, nil
+	}
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	return envs, nil
+}
+
+func (o *deployPipelineOpts) localWorkloads() ([]*localWorkload, error) {
+	svcNames, err := o.ws.ServiceNames()
+	if err != nil {
+		return nil, fmt.Errorf("list services in the workspace: %w", err)
+	}
+	jobNames, err := o.ws.JobNames()
+	if err != nil {
+		return nil, fmt.Errorf("list jobs in the workspace: %w", err)
+	}
+	var workloads []*localWorkload
+	for _, name := range svcNames {
+		wl, err := readLocalWorkload(name, "service", o.ws.ReadServiceManifest, o.unmarshal)
+		if err != nil {
+			return nil, err
+		}
+		workloads = append(workloads, wl)
+	}
+	for _, name := range jobNames {
+		wl, err := readLocalWorkload(name, "job", o.ws.ReadJobManifest, o.unmarshal)
+		if err != nil {
+			return nil, err
+		}
+		workloads = append(workloads, wl)
+	}
+	return workloads, nil
+}
+
+func readLocalWorkload(name, typeName string, read func(string) ([]byte, error), unmarshal func([]byte) (interface{}, error)) (*localWorkload, error) {
+	raw, err := read(name)
+	if err != nil {
+		return nil, fmt.Errorf("read %s %s manifest file: %w", typeName, name, err)
+	}
+	mft, err := unmarshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal %s %s manifest: %w", typeName, name, err)
+	}
+	return &localWorkload{
+		name:     name,
+		typeName: typeName,
+		manifest: mft,
+	}, nil
+}
+
+// packageWorkload builds the image of the workload once and pushes it to each region, uploads its addons to each region,
+// and writes the workload's template and configuration for every environment.
+func (o *deployPipelineOpts) packageWorkload(app *config.Application, envs []*config.Environment, regions []string,
+	resources map[string]*stack.AppRegionalResources, endpoints map[deploymentKey]map[string]string, wl *localWorkload) error {
+	log.Infof("Packaging %s %s.\n", wl.typeName, color.HighlightUserInput(wl.name))
+	buildRequired, err := manifest.ServiceDockerfileBuildRequired(wl.manifest)
+	if err != nil {
+		return err
+	}
+	addonsTemplate, err := o.addonsTemplate(wl.name)
+	if err != nil {
+		return err
+	}
+
+	if buildRequired {
+		if err := o.buildAndPush(app, regions, wl); err != nil {
+			return err
+		}
+	}
+
+	addonsURLs := make(map[string]string)
+	for _, env := range envs {
+		region := env.Region
+		if _, ok := addonsURLs[region]; addonsTemplate != "" && !ok {
+			url, err := o.uploadAddons(region, resources[region].S3Bucket, wl.name, addonsTemplate)
+			if err != nil {
+				return err
+			}
+			addonsURLs[region] = url
+		}
+
+		rc := stack.RuntimeConfig{
+			AddonsTemplateURL:   addonsURLs[region],
+			AdditionalTags:      tags.Merge(app.Tags, o.resourceTags),
+			DependencyEndpoints: endpoints[deploymentKey{workload: wl.name, env: env.Name}],
+		}
+		if buildRequired {
+			repoURL, ok := resources[region].RepositoryURLs[wl.name]
+			if !ok {
+				return &errRepoNotFound{
+					svcName:      wl.name,
+					envRegion:    region,
+					appAccountID: app.AccountID,
+				}
+			}
+			rc.Image = &stack.ECRImage{
+				RepoURL:  repoURL,
+				ImageTag: o.imageTag,
+			}
+		}
+		if err := o.writeStack(wl, env, app, rc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *deployPipelineOpts) addonsTemplate(name string) (string, error) {
+	addons, err := o.newAddons(name)
+	if err != nil {
+		return "", fmt.Errorf("initiate addons service: %w", err)
+	}
+	tpl, err := addons.Template()
+	if err != nil {
+		var notExistErr *addon.ErrDirNotExist
+		if errors.As(err, &notExistErr) {
+			return "", nil
+		}
+		return "", fmt.Errorf("retrieve addons template: %w", err)
+	}
+	return tpl, nil
+}
+
+// buildAndPush builds the image of the workload with the repository of the first region, and tags it for the repositories
+// of the other regions so that every region runs the same image.
+func (o *deployPipelineOpts) buildAndPush(app *config.Application, regions []string, wl *localWorkload) error {
+	copilotDir, err := o.ws.CopilotDirPath()
+	if err != nil {
+		return fmt.Errorf("get copilot directory: %w", err)
+	}
+	args, err := buildArgs(wl.name, o.imageTag, copilotDir, wl.manifest)
+	if err != nil {
+		return err
+	}
+	for i, region := range regions {
+		pusher, err := o.newImageBuilderPusher(fmt.Sprintf("%s/%s", app.Name, wl.name), region)
+		if err != nil {
+			return fmt.Errorf("initiate image builder pusher: %w", err)
+		}
+		if i == 0 {
+			// Building sets the URI of the repository in the arguments, the other regions tag the image built for it.
+			err = pusher.BuildAndPush(o.dockerService, args)
+		} else {
+			err = pusher.TagAndPush(o.dockerService, args)
+		}
+		if err != nil {
+			return fmt.Errorf("push image for %s %s to region %s: %w", wl.typeName, wl.name, region, err)
+		}
+	}
+	return nil
+}
+
+func (o *deployPipelineOpts) uploadAddons(region, bucket, name, template string) (string, error) {
+	uploader, err := o.newUploader(region)
+	if err != nil {
+		return "", err
+	}
+	url, err := uploader.PutArtifact(bucket, fmt.Sprintf(config.AddonsCfnTemplateNameFormat, name), strings.NewReader(template))
+	if err != nil {
+		return "", fmt.Errorf("put addons artifact to bucket %s: %w", bucket, err)
+	}
+	return url, nil
+}
+
+func (o *deployPipelineOpts) writeStack(wl *localWorkload, env *config.Environment, app *config.Application, rc stack.RuntimeConfig) error {
+	serializer, err := o.stackSerializer(wl.manifest, env, app, rc)
+	if err != nil {
+		return err
+	}
+	tpl, err := serializer.Template()
+	if err != nil {
+		return fmt.Errorf("generate stack template: %w", err)
+	}
+	params, err := serializer.SerializedParameters()
+	if err != nil {
+		return fmt.Errorf("generate stack template configuration: %w", err)
+	}
+	files := []struct {
+		format  string
+		content string
+	}{
+		{format: config.ServiceCfnTemplateNameFormat, content: tpl},
+		{format: config.ServiceCfnTemplateConfigurationNameFormat, content: params},
+	}
+	for _, f := range files {
+		path := filepath.Join(o.outputDir, fmt.Sprintf(f.format, wl.name, env.Name))
+		if err := afero.WriteFile(o.fs, path, []byte(f.content), 0644); err != nil {
+			return fmt.Errorf("write file %s: %w", path, err)
+		}
+	}
+	log.Successf("Wrote the stack and configuration of %s for environment %s.\n", color.HighlightUserInput(wl.name), color.HighlightUserInput(env.Name))
+	return nil
+}
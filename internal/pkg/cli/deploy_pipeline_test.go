@@ -0,0 +1,334 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployPipelineOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		inEnvName  string
+		inImageTag string
+
+		mockStore func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"no app in workspace": {
+			mockStore:   func(m *mocks.Mockstore) {},
+			wantedError: errNoAppInWorkspace,
+		},
+		"missing image tag": {
+			inAppName:   "phonetool",
+			mockStore:   func(m *mocks.Mockstore) {},
+			wantedError: errors.New("flag --tag is required when deploying with --pipeline"),
+		},
+		"invalid environment": {
+			inAppName:  "phonetool",
+			inEnvName:  "test",
+			inImageTag: "v1",
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get environment test configuration: some error"),
+		},
+		"success": {
+			inAppName:  "phonetool",
+			inImageTag: "v1",
+			mockStore:  func(m *mocks.Mockstore) {},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.mockStore(mockStore)
+			opts := deployPipelineOpts{
+				deployPipelineVars: deployPipelineVars{
+					appName:  tc.inAppName,
+					envName:  tc.inEnvName,
+					imageTag: tc.inImageTag,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type deployPipelineMocks struct {
+	store      *mocks.Mockstore
+	ws         *mocks.MockwsWorkloadReader
+	appCFN     *mocks.MockappResourcesGetter
+	pusher     *mocks.MockimageBuilderTagPusher
+	uploader   *mocks.MockartifactUploader
+	addons     *mocks.Mocktemplater
+	serializer *mocks.MockstackSerializer
+}
+
+func TestDeployPipelineOpts_Execute(t *testing.T) {
+	app := &config.Application{Name: "phonetool", AccountID: "1234"}
+	testEnv := &config.Environment{Name: "test", Region: "us-west-2"}
+	stagingEnv := &config.Environment{Name: "staging", Region: "us-west-2"}
+	prodEnv := &config.Environment{Name: "prod", Region: "us-east-1"}
+	buildMft := manifest.NewBackendService(manifest.BackendServiceProps{
+		WorkloadProps: manifest.WorkloadProps{
+			Name:       "api",
+			Dockerfile: "api/Dockerfile",
+		},
+		Port: 80,
+	})
+	locationMft := manifest.NewScheduledJob(&manifest.ScheduledJobProps{
+		WorkloadProps: &manifest.WorkloadProps{
+			Name:  "report",
+			Image: "nginx",
+		},
+		Schedule: "@daily",
+	})
+	dependentMft := manifest.NewBackendService(manifest.BackendServiceProps{
+		WorkloadProps: manifest.WorkloadProps{
+			Name:  "web",
+			Image: "nginx",
+		},
+	})
+	dependentMft.DependsOn = []string{"api"}
+	mockResources := func(region string) *stack.AppRegionalResources {
+		return &stack.AppRegionalResources{
+			Region:   region,
+			S3Bucket: "bucket-" + region,
+			RepositoryURLs: map[string]string{
+				"api": "url-" + region,
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		inEnvName  string
+		setupMocks func(m deployPipelineMocks)
+
+		wantedFiles []string
+		wantedError error
+	}{
+		"builds each image once and writes templates for every workload and environment": {
+			setupMocks: func(m deployPipelineMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(app, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv, stagingEnv, prodEnv}, nil)
+				m.ws.EXPECT().ServiceNames().Return([]string{"api"}, nil)
+				m.ws.EXPECT().JobNames().Return([]string{"report"}, nil)
+				m.ws.EXPECT().ReadServiceManifest("api").Return([]byte("api"), nil)
+				m.ws.EXPECT().ReadJobManifest("report").Return([]byte("report"), nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(app, "us-west-2").Return(mockResources("us-west-2"), nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(app, "us-east-1").Return(mockResources("us-east-1"), nil)
+
+				// api is built once, pushed to the first region and tagged for the other one, and has addons.
+				m.addons.EXPECT().Template().Return("addons", nil)
+				m.ws.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+				gomock.InOrder(
+					m.pusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return(nil),
+					m.pusher.EXPECT().TagAndPush(gomock.Any(), gomock.Any()).Return(nil),
+				)
+				m.uploader.EXPECT().PutArtifact("bucket-us-west-2", "api.addons.stack.yml", gomock.Any()).Return("url-addons-west", nil)
+				m.uploader.EXPECT().PutArtifact("bucket-us-east-1", "api.addons.stack.yml", gomock.Any()).Return("url-addons-east", nil)
+
+				// report uses an existing image and doesn't have addons.
+				m.addons.EXPECT().Template().Return("", &addon.ErrDirNotExist{})
+
+				m.serializer.EXPECT().Template().Return("template", nil).Times(6)
+				m.serializer.EXPECT().SerializedParameters().Return("params", nil).Times(6)
+			},
+			wantedFiles: []string{
+				"api-test.stack.yml", "api-test.params.json",
+				"api-staging.stack.yml", "api-staging.params.json",
+				"api-prod.stack.yml", "api-prod.params.json",
+				"report-test.stack.yml", "report-test.params.json",
+				"report-staging.stack.yml", "report-staging.params.json",
+				"report-prod.stack.yml", "report-prod.params.json",
+			},
+		},
+		"packages only the selected environment": {
+			inEnvName: "prod",
+			setupMocks: func(m deployPipelineMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(app, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "prod").Return(prodEnv, nil)
+				m.ws.EXPECT().ServiceNames().Return(nil, nil)
+				m.ws.EXPECT().JobNames().Return([]string{"report"}, nil)
+				m.ws.EXPECT().ReadJobManifest("report").Return([]byte("report"), nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(app, "us-east-1").Return(mockResources("us-east-1"), nil)
+				m.addons.EXPECT().Template().Return("", &addon.ErrDirNotExist{})
+				m.serializer.EXPECT().Template().Return("template", nil)
+				m.serializer.EXPECT().SerializedParameters().Return("params", nil)
+			},
+			wantedFiles: []string{"report-prod.stack.yml", "report-prod.params.json"},
+		},
+		"passes the endpoints of the dependencies of a service": {
+			inEnvName: "prod",
+			setupMocks: func(m deployPipelineMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(app, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "prod").Return(prodEnv, nil)
+				m.ws.EXPECT().ServiceNames().Return([]string{"api", "web"}, nil).Times(2)
+				m.ws.EXPECT().JobNames().Return(nil, nil)
+				m.ws.EXPECT().ReadServiceManifest("api").Return([]byte("api"), nil).Times(2)
+				m.ws.EXPECT().ReadServiceManifest("web").Return([]byte("web"), nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(app, "us-east-1").Return(mockResources("us-east-1"), nil)
+				m.addons.EXPECT().Template().Return("", &addon.ErrDirNotExist{}).Times(2)
+				m.ws.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+				m.pusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return(nil)
+				m.serializer.EXPECT().Template().Return("template", nil).Times(2)
+				m.serializer.EXPECT().SerializedParameters().Return("params", nil).Times(2)
+			},
+			wantedFiles: []string{
+				"api-prod.stack.yml", "api-prod.params.json",
+				"web-prod.stack.yml", "web-prod.params.json",
+			},
+		},
+		"returns an error if fails to list jobs": {
+			setupMocks: func(m deployPipelineMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(app, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv}, nil)
+				m.ws.EXPECT().ServiceNames().Return(nil, nil)
+				m.ws.EXPECT().JobNames().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list jobs in the workspace: some error"),
+		},
+		"returns an error if fails to build and push the image": {
+			setupMocks: func(m deployPipelineMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(app, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv}, nil)
+				m.ws.EXPECT().ServiceNames().Return([]string{"api"}, nil)
+				m.ws.EXPECT().JobNames().Return(nil, nil)
+				m.ws.EXPECT().ReadServiceManifest("api").Return([]byte("api"), nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(app, "us-west-2").Return(mockResources("us-west-2"), nil)
+				m.addons.EXPECT().Template().Return("", &addon.ErrDirNotExist{})
+				m.ws.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+				m.pusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("push image for service api to region us-west-2: some error"),
+		},
+		"returns an error if the repository does not exist": {
+			setupMocks: func(m deployPipelineMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(app, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv}, nil)
+				m.ws.EXPECT().ServiceNames().Return([]string{"api"}, nil)
+				m.ws.EXPECT().JobNames().Return(nil, nil)
+				m.ws.EXPECT().ReadServiceManifest("api").Return([]byte("api"), nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(app, "us-west-2").Return(&stack.AppRegionalResources{}, nil)
+				m.addons.EXPECT().Template().Return("", &addon.ErrDirNotExist{})
+				m.ws.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+				m.pusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantedError: &errRepoNotFound{
+				svcName:      "api",
+				envRegion:    "us-west-2",
+				appAccountID: "1234",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := deployPipelineMocks{
+				store:      mocks.NewMockstore(ctrl),
+				ws:         mocks.NewMockwsWorkloadReader(ctrl),
+				appCFN:     mocks.NewMockappResourcesGetter(ctrl),
+				pusher:     mocks.NewMockimageBuilderTagPusher(ctrl),
+				uploader:   mocks.NewMockartifactUploader(ctrl),
+				addons:     mocks.NewMocktemplater(ctrl),
+				serializer: mocks.NewMockstackSerializer(ctrl),
+			}
+			tc.setupMocks(m)
+			fs := afero.NewMemMapFs()
+			opts := deployPipelineOpts{
+				deployPipelineVars: deployPipelineVars{
+					appName:   "phonetool",
+					envName:   tc.inEnvName,
+					imageTag:  "v1",
+					outputDir: "infrastructure",
+				},
+				store:  m.store,
+				ws:     m.ws,
+				appCFN: m.appCFN,
+				fs:     fs,
+				unmarshal: func(in []byte) (interface{}, error) {
+					switch string(in) {
+					case "api":
+						return buildMft, nil
+					case "web":
+						return dependentMft, nil
+					}
+					return locationMft, nil
+				},
+				stackSerializer: func(mft interface{}, _ *config.Environment, _ *config.Application, rc stack.RuntimeConfig) (stackSerializer, error) {
+					if mft == interface{}(dependentMft) {
+						require.Equal(t, map[string]string{"api": "http://api.phonetool.local:80"}, rc.DependencyEndpoints)
+					} else {
+						require.Empty(t, rc.DependencyEndpoints)
+					}
+					return m.serializer, nil
+				},
+				newImageBuilderPusher: func(repoName, region string) (imageBuilderTagPusher, error) {
+					require.Equal(t, "phonetool/api", repoName)
+					return m.pusher, nil
+				},
+				newUploader: func(region string) (artifactUploader, error) {
+					return m.uploader, nil
+				},
+				newAddons: func(name string) (templater, error) {
+					return m.addons, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			files, err := afero.ReadDir(fs, "infrastructure")
+			require.NoError(t, err)
+			var names []string
+			for _, f := range files {
+				names = append(names, f.Name())
+			}
+			require.ElementsMatch(t, tc.wantedFiles, names)
+			for _, name := range tc.wantedFiles {
+				f, err := fs.Open(filepath.Join("infrastructure", name))
+				require.NoError(t, err)
+				content, err := ioutil.ReadAll(f)
+				require.NoError(t, err)
+				require.NotEmpty(t, content, fmt.Sprintf("file %s should not be empty", name))
+			}
+		})
+	}
+}
@@ -0,0 +1,816 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/docker"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/repository"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
+)
+
+const (
+	defaultDeployParallelism = 4
+
+	deployWorkloadsEnvNamePrompt = "Select an environment"
+	deployWorkloadsNamePrompt    = "Select a service or job in your workspace"
+)
+
+// Statuses of a deployment in the combined progress, in addition to the ones of termprogress.
+const (
+	deploymentStatusQueued    termprogress.Status = "Queued"
+	deploymentStatusNoChanges termprogress.Status = "No Changes"
+	deploymentStatusSkipped   termprogress.Status = "Skipped"
+)
+
+type deployWorkloadsVars struct {
+	appName        string
+	names          []string
+	envNames       []string
+	all            bool
+	imageTag       string
+	resourceTags   map[string]string
+	parallelism    int
+	force          bool
+	allowDirty     bool
+	allowAnyBranch bool
+	stealLock      bool
+}
+
+// envDeployClients are the clients to deploy workload stacks to an environment.
+type envDeployClients struct {
+	deployer  workloadDeployStreamer
+	protector wlTerminationProtector
+	ecs       ecsServiceRolloutDescriber
+	analyzer  stackFailureAnalyzer
+
+	// Clients to resume the services that were paused.
+	rg     resourcesByTagsGetter
+	scaler ecsServiceScaler
+	aas    serviceScalingSuspender
+}
+
+// regionDeployClients are the clients to the resources of the application in a region.
+type regionDeployClients struct {
+	artifacts artifactUploader
+	templates objectUploader
+	images    imageDigestGetter
+}
+
+type deployWorkloadsOpts struct {
+	deployWorkloadsVars
+
+	store         store
+	deployStore   deployedEnvironmentLister
+	ws            wsWorkloadReader
+	appCFN        appResourcesGetter
+	unmarshal     func([]byte) (interface{}, error)
+	newStack      func(mft interface{}, env *config.Environment, app *config.Application, rc stack.RuntimeConfig) (cloudformation.StackConfiguration, error)
+	dockerService repository.ContainerLoginBuildPusher
+	cmd           runner
+	sel           wsSelector
+	prompt        prompter
+	spinner       progress
+	w             io.Writer
+
+	// Regional clients, overridden in tests.
+	newImageBuilderPusher func(repoName, region string) (imageBuilderTagPusher, error)
+	newRegionClients      func(region string) (*regionDeployClients, error)
+	newEnvClients         func(env *config.Environment) (*envDeployClients, error)
+	newAddons             func(name string) (templater, error)
+}
+
+func newDeployWorkloadsOpts(vars deployWorkloadsVars) (*deployWorkloadsOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	provider := sessions.NewProvider()
+	defaultSess, err := provider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("create default session: %w", err)
+	}
+	prompter := prompt.New()
+	return &deployWorkloadsOpts{
+		deployWorkloadsVars: vars,
+
+		store:       store,
+		deployStore: deployStore,
+		ws:          ws,
+		appCFN:      cloudformation.New(defaultSess),
+		unmarshal:   manifest.UnmarshalWorkload,
+		newStack: func(mft interface{}, env *config.Environment, app *config.Application, rc stack.RuntimeConfig) (cloudformation.StackConfiguration, error) {
+			return newWorkloadStack(mft, env, app, rc)
+		},
+		dockerService: docker.New(),
+		cmd:           command.New(),
+		sel:           selector.NewWorkspaceSelect(prompter, store, ws),
+		prompt:        prompter,
+		spinner:       termprogress.NewSpinner(),
+		w:             log.OutputWriter,
+		newImageBuilderPusher: func(repoName, region string) (imageBuilderTagPusher, error) {
+			sess, err := provider.DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create ECR session with region %s: %w", region, err)
+			}
+			return repository.New(repoName, ecr.New(sess))
+		},
+		newRegionClients: func(region string) (*regionDeployClients, error) {
+			sess, err := provider.DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create session with region %s: %w", region, err)
+			}
+			s3Client := s3.New(sess)
+			return &regionDeployClients{
+				artifacts: s3Client,
+				templates: s3Client,
+				images:    ecr.New(sess),
+			}, nil
+		},
+		newEnvClients: func(env *config.Environment) (*envDeployClients, error) {
+			sess, err := provider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("get session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			cfn := cloudformation.New(sess)
+			ecsClient := ecs.New(sess)
+			return &envDeployClients{
+				deployer:  cfn,
+				protector: cfn,
+				ecs:       ecsClient,
+				analyzer:  describe.NewStackFailureAnalyzer(sess),
+				rg:        resourcegroups.New(sess),
+				scaler:    ecsClient,
+				aas:       aas.New(sess),
+			}, nil
+		},
+		newAddons: func(name string) (templater, error) {
+			return addon.New(name)
+		},
+	}, nil
+}
+
+// Validate returns an error if the user inputs are invalid.
+func (o *deployWorkloadsOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.all && len(o.names) != 0 {
+		return fmt.Errorf("cannot specify both --%s and --%s flags", allFlag, nameFlag)
+	}
+	if o.parallelism < 1 {
+		return fmt.Errorf("flag --%s must be at least 1", parallelismFlag)
+	}
+	if len(o.names) != 0 {
+		svcNames, err := o.ws.ServiceNames()
+		if err != nil {
+			return fmt.Errorf("list services in the workspace: %w", err)
+		}
+		jobNames, err := o.ws.JobNames()
+		if err != nil {
+			return fmt.Errorf("list jobs in the workspace: %w", err)
+		}
+		for _, name := range o.names {
+			if !contains(name, svcNames) && !contains(name, jobNames) {
+				return fmt.Errorf("service or job %s not found in the workspace", color.HighlightUserInput(name))
+			}
+		}
+	}
+	for _, envName := range o.envNames {
+		if _, err := targetEnv(o.store, o.appName, envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask prompts the user for any required fields that are not provided.
+func (o *deployWorkloadsOpts) Ask() error {
+	if len(o.names) == 0 && !o.all {
+		name, err := o.sel.Workload(deployWorkloadsNamePrompt, "")
+		if err != nil {
+			return fmt.Errorf("select service or job: %w", err)
+		}
+		o.names = []string{name}
+	}
+	if len(o.envNames) == 0 {
+		name, err := o.sel.Environment(deployWorkloadsEnvNamePrompt, "", o.appName)
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		o.envNames = []string{name}
+	}
+	tag, err := askImageTag(o.imageTag, o.prompt, o.cmd)
+	if err != nil {
+		return err
+	}
+	o.imageTag = tag
+	return nil
+}
+
+// workloadDeployment is the deployment of a workload stack to an environment.
+type workloadDeployment struct {
+	workload     *localWorkload
+	env          *config.Environment
+	stack        cloudformation.StackConfiguration
+	dependencies []*workloadDeployment // Deployments of the services the workload depends on in the same environment.
+	done         chan struct{}         // Closed once the deployment is over.
+
+	// Updated while deploying.
+	status termprogress.Status
+	rows   []termprogress.TabRow
+	since  time.Time // When the previous operation on the stack started.
+	err    error
+}
+
+// Execute builds each container image once and pushes it to the repositories of every region of the environments,
+// then deploys the stacks of the workloads to the environments concurrently. A service is deployed to an environment
+// only once the services it depends on are deployed to it.
+func (o *deployWorkloadsOpts) Execute() (err error) {
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	var envs []*config.Environment
+	for _, envName := range o.envNames {
+		env, err := targetEnv(o.store, o.appName, envName)
+		if err != nil {
+			return err
+		}
+		envs = append(envs, env)
+	}
+	workloads, err := o.localWorkloads()
+	if err != nil {
+		return err
+	}
+	workloads, err = orderByDependencies(workloads)
+	if err != nil {
+		return err
+	}
+	if err := o.confirmProdEnvs(envs, workloads); err != nil {
+		return err
+	}
+	locker := newDeployLocker(o.store, o.spinner, o.stealLock)
+	for _, key := range deployLockOrder(envs, workloads) {
+		var lock *config.DeployLock
+		lock, err = locker.acquire(o.appName, key.env, key.workload)
+		if err != nil {
+			return err
+		}
+		defer locker.releaseOnReturn(lock, &err)
+	}
+	endpoints, err := workloadsDependencyEndpoints(workloadsDependencyEndpointsInput{
+		app:         app.Name,
+		envs:        envs,
+		workloads:   workloads,
+		deployStore: o.deployStore,
+		ws:          o.ws,
+		unmarshal:   o.unmarshal,
+	})
+	if err != nil {
+		return err
+	}
+
+	resources := make(map[string]*stack.AppRegionalResources)
+	regionClients := make(map[string]*regionDeployClients)
+	var regions []string
+	for _, env := range envs {
+		if _, ok := resources[env.Region]; ok {
+			continue
+		}
+		res, err := o.appCFN.GetAppResourcesByRegion(app, env.Region)
+		if err != nil {
+			return fmt.Errorf("get application %s resources from region %s: %w", app.Name, env.Region, err)
+		}
+		clients, err := o.newRegionClients(env.Region)
+		if err != nil {
+			return err
+		}
+		resources[env.Region] = res
+		regionClients[env.Region] = clients
+		regions = append(regions, env.Region)
+	}
+	envClients := make(map[string]*envDeployClients)
+	for _, env := range envs {
+		clients, err := o.newEnvClients(env)
+		if err != nil {
+			return err
+		}
+		envClients[env.Name] = clients
+	}
+
+	var deployments []*workloadDeployment
+	for _, wl := range workloads {
+		wlDeployments, err := o.prepareWorkload(app, envs, regions, resources, regionClients, endpoints, wl)
+		if err != nil {
+			return err
+		}
+		deployments = append(deployments, wlDeployments...)
+	}
+	linkDependencies(deployments)
+
+	o.deployAll(deployments, envClients)
+	for _, d := range deployments {
+		if d.err == nil && d.workload.typeName == "service" {
+			o.recordDeployment(app, resources[d.env.Region], regionClients[d.env.Region], d)
+			o.resumePaused(envClients[d.env.Name], d)
+		}
+	}
+	if err := o.writeSummary(deployments); err != nil {
+		return err
+	}
+
+	var failed int
+	for _, d := range deployments {
+		if d.err == nil {
+			continue
+		}
+		failed++
+		log.Errorf("Failed to deploy %s to %s: %v\n", color.HighlightUserInput(d.workload.name), color.HighlightUserInput(d.env.Name), d.err)
+		if d.status == deploymentStatusSkipped {
+			continue
+		}
+		logStackFailure(envClients[d.env.Name].analyzer, d.stack.StackName(), d.since)
+	}
+	if failed != 0 {
+		return fmt.Errorf("%d of %d deployments failed", failed, len(deployments))
+	}
+	return nil
+}
+
+// confirmProdEnvs checks the guardrails of each production environment before anything is deployed.
+func (o *deployWorkloadsOpts) confirmProdEnvs(envs []*config.Environment, workloads []*localWorkload) error {
+	var names []string
+	for _, wl := range workloads {
+		names = append(names, wl.name)
+	}
+	guard := prodGuard{
+		force:          o.force,
+		allowDirty:     o.allowDirty,
+		allowAnyBranch: o.allowAnyBranch,
+		prompt:         o.prompt,
+		runner:         o.cmd,
+	}
+	for _, env := range envs {
+		if err := guard.confirmDeploy(env, fmt.Sprintf("deploy %s to it", english.WordSeries(names, "and"))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *deployWorkloadsOpts) RecommendedActions() []string {
+	return nil
+}
+
+// localWorkloads returns the workloads to deploy in the order they were requested, or every workload in the workspace.
+func (o *deployWorkloadsOpts) localWorkloads() ([]*localWorkload, error) {
+	svcNames, err := o.ws.ServiceNames()
+	if err != nil {
+		return nil, fmt.Errorf("list services in the workspace: %w", err)
+	}
+	jobNames, err := o.ws.JobNames()
+	if err != nil {
+		return nil, fmt.Errorf("list jobs in the workspace: %w", err)
+	}
+	names := o.names
+	if o.all {
+		names = append(append([]string{}, svcNames...), jobNames...)
+	}
+	var workloads []*localWorkload
+	for _, name := range names {
+		typeName, read := "service", o.ws.ReadServiceManifest
+		if !contains(name, svcNames) {
+			typeName, read = "job", o.ws.ReadJobManifest
+		}
+		wl, err := readLocalWorkload(name, typeName, read, o.unmarshal)
+		if err != nil {
+			return nil, err
+		}
+		workloads = append(workloads, wl)
+	}
+	return workloads, nil
+}
+
+// orderByDependencies sorts the workloads so that each service comes after the services it depends on among the workloads.
+// Otherwise, the workloads keep the order they were requested in.
+func orderByDependencies(workloads []*localWorkload) ([]*localWorkload, error) {
+	byName := make(map[string]*localWorkload)
+	for _, wl := range workloads {
+		byName[wl.name] = wl
+	}
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := make(map[string]int)
+	var ordered []*localWorkload
+	var visit func(wl *localWorkload, path []string) error
+	visit = func(wl *localWorkload, path []string) error {
+		path = append(path, wl.name)
+		switch state[wl.name] {
+		case visiting:
+			return fmt.Errorf("circular dependency between services: %s", strings.Join(path, " -> "))
+		case visited:
+			return nil
+		}
+		state[wl.name] = visiting
+		for _, dep := range manifest.ServiceDependencies(wl.manifest) {
+			depWl, ok := byName[dep]
+			if !ok {
+				continue
+			}
+			if err := visit(depWl, path); err != nil {
+				return err
+			}
+		}
+		state[wl.name] = visited
+		ordered = append(ordered, wl)
+		return nil
+	}
+	for _, wl := range workloads {
+		if err := visit(wl, nil); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
+
+// deployLockOrder returns the deploy locks to acquire for the workloads in the environments, sorted by environment then workload.
+// Concurrent deployments acquire the locks they share in the same order, so neither holds a lock that the other waits for.
+func deployLockOrder(envs []*config.Environment, workloads []*localWorkload) []deploymentKey {
+	var keys []deploymentKey
+	for _, env := range envs {
+		for _, wl := range workloads {
+			keys = append(keys, deploymentKey{workload: wl.name, env: env.Name})
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].env != keys[j].env {
+			return keys[i].env < keys[j].env
+		}
+		return keys[i].workload < keys[j].workload
+	})
+	return keys
+}
+
+// linkDependencies sets the dependencies of each deployment to the deployments of the services it depends on in the same environment.
+func linkDependencies(deployments []*workloadDeployment) {
+	byKey := make(map[deploymentKey]*workloadDeployment)
+	for _, d := range deployments {
+		byKey[deploymentKey{workload: d.workload.name, env: d.env.Name}] = d
+	}
+	for _, d := range deployments {
+		for _, dep := range manifest.ServiceDependencies(d.workload.manifest) {
+			if depDeployment, ok := byKey[deploymentKey{workload: dep, env: d.env.Name}]; ok {
+				d.dependencies = append(d.dependencies, depDeployment)
+			}
+		}
+	}
+}
+
+// prepareWorkload builds the image of the workload once and pushes it to each region, uploads its addons to each region,
+// and returns the deployments of the workload's stack to every environment.
+func (o *deployWorkloadsOpts) prepareWorkload(app *config.Application, envs []*config.Environment, regions []string,
+	resources map[string]*stack.AppRegionalResources, regionClients map[string]*regionDeployClients,
+	endpoints map[deploymentKey]map[string]string, wl *localWorkload) ([]*workloadDeployment, error) {
+	buildRequired, err := manifest.ServiceDockerfileBuildRequired(wl.manifest)
+	if err != nil {
+		return nil, err
+	}
+	if buildRequired {
+		if err := o.buildAndPush(app, regions, wl); err != nil {
+			return nil, err
+		}
+	}
+	addonsTemplate, err := o.addonsTemplate(wl.name)
+	if err != nil {
+		return nil, err
+	}
+	addonsURLs := make(map[string]string)
+	if addonsTemplate != "" {
+		for _, region := range regions {
+			bucket := resources[region].S3Bucket
+			url, err := regionClients[region].artifacts.PutArtifact(bucket, fmt.Sprintf(config.AddonsCfnTemplateNameFormat, wl.name), strings.NewReader(addonsTemplate))
+			if err != nil {
+				return nil, fmt.Errorf("put addons artifact to bucket %s: %w", bucket, err)
+			}
+			addonsURLs[region] = url
+		}
+	}
+
+	var deployments []*workloadDeployment
+	for _, env := range envs {
+		rc := stack.RuntimeConfig{
+			AddonsTemplateURL:   addonsURLs[env.Region],
+			AdditionalTags:      tags.Merge(app.Tags, o.resourceTags),
+			DependencyEndpoints: endpoints[deploymentKey{workload: wl.name, env: env.Name}],
+		}
+		if buildRequired {
+			repoURL, ok := resources[env.Region].RepositoryURLs[wl.name]
+			if !ok {
+				return nil, &errRepoNotFound{
+					svcName:      wl.name,
+					envRegion:    env.Region,
+					appAccountID: app.AccountID,
+				}
+			}
+			rc.Image = &stack.ECRImage{
+				RepoURL:  repoURL,
+				ImageTag: o.imageTag,
+			}
+		}
+		conf, err := o.newStack(wl.manifest, env, app, rc)
+		if err != nil {
+			return nil, err
+		}
+		deployments = append(deployments, &workloadDeployment{
+			workload: wl,
+			env:      env,
+			stack:    conf,
+			status:   deploymentStatusQueued,
+			done:     make(chan struct{}),
+		})
+	}
+	return deployments, nil
+}
+
+// buildAndPush builds the image of the workload with the repository of the first region, and tags it for the repositories
+// of the other regions instead of building it again.
+func (o *deployWorkloadsOpts) buildAndPush(app *config.Application, regions []string, wl *localWorkload) error {
+	copilotDir, err := o.ws.CopilotDirPath()
+	if err != nil {
+		return fmt.Errorf("get copilot directory: %w", err)
+	}
+	args, err := buildArgs(wl.name, o.imageTag, copilotDir, wl.manifest)
+	if err != nil {
+		return err
+	}
+	log.Infof("Building the image of %s %s.\n", wl.typeName, color.HighlightUserInput(wl.name))
+	for i, region := range regions {
+		pusher, err := o.newImageBuilderPusher(fmt.Sprintf("%s/%s", app.Name, wl.name), region)
+		if err != nil {
+			return fmt.Errorf("initiate image builder pusher: %w", err)
+		}
+		if i == 0 {
+			// Building sets the URI of the repository in the arguments, the other regions tag the image built for it.
+			err = pusher.BuildAndPush(o.dockerService, args)
+		} else {
+			err = pusher.TagAndPush(o.dockerService, args)
+		}
+		if err != nil {
+			return fmt.Errorf("push image for %s %s to region %s: %w", wl.typeName, wl.name, region, err)
+		}
+	}
+	return nil
+}
+
+func (o *deployWorkloadsOpts) addonsTemplate(name string) (string, error) {
+	addons, err := o.newAddons(name)
+	if err != nil {
+		return "", fmt.Errorf("initiate addons service: %w", err)
+	}
+	tpl, err := addons.Template()
+	if err != nil {
+		var notExistErr *addon.ErrDirNotExist
+		if errors.As(err, &notExistErr) {
+			return "", nil
+		}
+		return "", fmt.Errorf("retrieve addons template: %w", err)
+	}
+	return tpl, nil
+}
+
+// deployAll deploys the stacks with at most parallelism deployments at the same time, and displays their combined progress.
+// The result of each deployment is stored in the deployment.
+// The deployments are ordered so that the dependencies of a deployment are always picked up before it, so waiting
+// for them can't block the workers forever.
+func (o *deployWorkloadsOpts) deployAll(deployments []*workloadDeployment, envClients map[string]*envDeployClients) {
+	view := &deploymentsProgress{
+		prog:        o.spinner,
+		deployments: deployments,
+	}
+	o.spinner.Start(fmt.Sprintf("Deploying %s.", english.Plural(len(deployments), "stack", "")))
+
+	queue := make(chan *workloadDeployment)
+	var wg sync.WaitGroup
+	for i := 0; i < o.parallelism && i < len(deployments); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for d := range queue {
+				if dep := failedDependency(d); dep != nil {
+					d.err = fmt.Errorf("dependency %s failed to deploy", dep.workload.name)
+					view.update(d, deploymentStatusSkipped, nil)
+				} else {
+					o.deploy(d, envClients[d.env.Name], view)
+				}
+				close(d.done)
+			}
+		}()
+	}
+	for _, d := range deployments {
+		queue <- d
+	}
+	close(queue)
+	wg.Wait()
+
+	o.spinner.Stop("\n")
+}
+
+// failedDependency waits for the dependencies of the deployment to be over, and returns the first one that failed if any.
+func failedDependency(d *workloadDeployment) *workloadDeployment {
+	var failed *workloadDeployment
+	for _, dep := range d.dependencies {
+		<-dep.done
+		if dep.err != nil && failed == nil {
+			failed = dep
+		}
+	}
+	return failed
+}
+
+func (o *deployWorkloadsOpts) deploy(d *workloadDeployment, clients *envDeployClients, view *deploymentsProgress) {
+	startedAt := time.Now()
+	d.since = lastStackOperationTime(clients.analyzer, d.stack.StackName())
+	view.update(d, termprogress.StatusInProgress, nil)
+	var rollout *serviceRollout
+	if d.workload.typeName == "service" {
+		rollout = &serviceRollout{
+			ecs:   clients.ecs,
+			since: startedAt,
+		}
+	}
+	events, errs := clients.deployer.StreamServiceDeployment(d.stack, awscloudformation.WithRoleARN(d.env.ExecutionRoleARN))
+	err := followWorkloadDeployment(&deploymentProgress{view: view, deployment: d}, d.stack.StackName(), events, errs, rollout)
+
+	var errNoChanges *awscloudformation.ErrChangeSetEmpty
+	if err == nil || errors.As(err, &errNoChanges) {
+		if protectErr := protectWorkload(clients.protector, o.appName, d.env, d.workload.name); protectErr != nil {
+			err = protectErr
+		}
+	}
+	switch {
+	case err == nil:
+		view.update(d, termprogress.StatusComplete, nil)
+	case errors.As(err, &errNoChanges):
+		view.update(d, deploymentStatusNoChanges, nil)
+	default:
+		d.err = err
+		view.update(d, termprogress.StatusFailed, nil)
+	}
+}
+
+// recordDeployment records the successful deployment of a service so that it can be rolled back to.
+// Failing to record it only prevents rolling back to it, so the error is logged instead of returned.
+func (o *deployWorkloadsOpts) recordDeployment(app *config.Application, resources *stack.AppRegionalResources, clients *regionDeployClients, d *workloadDeployment) {
+	if d.status == deploymentStatusNoChanges {
+		return
+	}
+	var digest string
+	if _, ok := resources.RepositoryURLs[d.workload.name]; ok {
+		var err error
+		digest, err = clients.images.ImageDigest(fmt.Sprintf("%s/%s", app.Name, d.workload.name), o.imageTag)
+		if err != nil {
+			log.Warningf("Failed to record the deployment of %s to %s: get digest of image %s: %v\n", d.workload.name, d.env.Name, o.imageTag, err)
+			return
+		}
+	}
+	err := recordSvcDeployment(o.store, clients.templates, recordSvcDeploymentInput{
+		app:         app.Name,
+		env:         d.env.Name,
+		svc:         d.workload.name,
+		bucket:      resources.S3Bucket,
+		stack:       d.stack,
+		imageDigest: digest,
+		deployedAt:  time.Now(),
+	})
+	if err != nil {
+		log.Warningf("Failed to record the deployment of %s to %s: %v\n", d.workload.name, d.env.Name, err)
+	}
+}
+
+// resumePaused restores a deployed service if it was paused. The deployment itself succeeded,
+// so the error is logged instead of returned.
+func (o *deployWorkloadsOpts) resumePaused(clients *envDeployClients, d *workloadDeployment) {
+	err := resumeRedeployedSvc(resumeRedeployedSvcInput{
+		store: o.store,
+		rg:    clients.rg,
+		ecs:   clients.scaler,
+		aas:   clients.aas,
+		app:   o.appName,
+		env:   d.env.Name,
+		svc:   d.workload.name,
+	})
+	if err != nil {
+		log.Warningf("Failed to resume %s in %s, run %s: %v\n", d.workload.name, d.env.Name,
+			color.HighlightCode(fmt.Sprintf("copilot svc resume -n %s -e %s", d.workload.name, d.env.Name)), err)
+	}
+}
+
+// writeSummary writes a table with the status of every deployment.
+func (o *deployWorkloadsOpts) writeSummary(deployments []*workloadDeployment) error {
+	writer := tabwriter.NewWriter(o.w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(writer, "Name\tType\tEnvironment\tStatus")
+	fmt.Fprintln(writer, "----\t----\t-----------\t------")
+	for _, d := range deployments {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", d.workload.name, d.workload.typeName, d.env.Name, d.status)
+	}
+	return writer.Flush()
+}
+
+// deploymentsProgress displays the progress of concurrent deployments under a single spinner.
+type deploymentsProgress struct {
+	mu          sync.Mutex
+	prog        progress
+	deployments []*workloadDeployment
+}
+
+// update sets the status of a deployment, and the rows of its resources if rows is not nil, then redraws every deployment.
+func (p *deploymentsProgress) update(d *workloadDeployment, status termprogress.Status, rows []termprogress.TabRow) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	d.status = status
+	if rows != nil {
+		d.rows = rows
+	}
+	var all []termprogress.TabRow
+	for _, d := range p.deployments {
+		all = append(all, termprogress.TabRow(fmt.Sprintf("- %s in %s\t%s", d.workload.name, d.env.Name, coloredStatus(d.status))))
+		if d.status != termprogress.StatusInProgress && d.status != termprogress.StatusFailed {
+			// The resources of queued and finished deployments are not interesting anymore.
+			continue
+		}
+		for _, row := range d.rows {
+			all = append(all, "  "+row)
+		}
+	}
+	p.prog.Events(all)
+}
+
+// deploymentProgress is the progress of one of the deployments displayed by deploymentsProgress.
+// The spinner is started and stopped once for all the deployments, so Start and Stop do nothing.
+type deploymentProgress struct {
+	view       *deploymentsProgress
+	deployment *workloadDeployment
+}
+
+func (p *deploymentProgress) Start(label string) {}
+
+func (p *deploymentProgress) Stop(label string) {}
+
+func (p *deploymentProgress) Events(rows []termprogress.TabRow) {
+	p.view.update(p.deployment, termprogress.StatusInProgress, rows)
+}
+
+func coloredStatus(status termprogress.Status) string {
+	s := fmt.Sprintf("[%s]", status)
+	switch status {
+	case termprogress.StatusFailed:
+		return color.Red.Sprint(s)
+	case termprogress.StatusComplete, deploymentStatusNoChanges:
+		return s
+	default:
+		return color.Grey.Sprint(s)
+	}
+}
@@ -0,0 +1,645 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/docker"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployWorkloadsOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inNames       []string
+		inEnvNames    []string
+		inAll         bool
+		inParallelism int
+
+		setupMocks func(store *mocks.Mockstore, ws *mocks.MockwsWorkloadReader)
+
+		wantedError error
+	}{
+		"cannot specify both names and all": {
+			inNames:       []string{"api"},
+			inAll:         true,
+			inParallelism: 4,
+			setupMocks:    func(store *mocks.Mockstore, ws *mocks.MockwsWorkloadReader) {},
+
+			wantedError: errors.New("cannot specify both --all and --name flags"),
+		},
+		"parallelism must be positive": {
+			inAll:         true,
+			inParallelism: 0,
+			setupMocks:    func(store *mocks.Mockstore, ws *mocks.MockwsWorkloadReader) {},
+
+			wantedError: errors.New("flag --parallelism must be at least 1"),
+		},
+		"errors if a workload does not exist": {
+			inNames:       []string{"api", "frontend"},
+			inParallelism: 4,
+			setupMocks: func(store *mocks.Mockstore, ws *mocks.MockwsWorkloadReader) {
+				ws.EXPECT().ServiceNames().Return([]string{"api"}, nil)
+				ws.EXPECT().JobNames().Return([]string{"report"}, nil)
+			},
+
+			wantedError: errors.New("service or job frontend not found in the workspace"),
+		},
+		"errors if an environment does not exist": {
+			inAll:         true,
+			inEnvNames:    []string{"test", "prod"},
+			inParallelism: 4,
+			setupMocks: func(store *mocks.Mockstore, ws *mocks.MockwsWorkloadReader) {
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "prod").Return(nil, mockError)
+			},
+
+			wantedError: errors.New("get environment prod configuration: mock error"),
+		},
+		"success": {
+			inNames:       []string{"api", "report"},
+			inEnvNames:    []string{"test"},
+			inParallelism: 4,
+			setupMocks: func(store *mocks.Mockstore, ws *mocks.MockwsWorkloadReader) {
+				ws.EXPECT().ServiceNames().Return([]string{"api"}, nil)
+				ws.EXPECT().JobNames().Return([]string{"report"}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockWs := mocks.NewMockwsWorkloadReader(ctrl)
+			tc.setupMocks(mockStore, mockWs)
+			opts := deployWorkloadsOpts{
+				deployWorkloadsVars: deployWorkloadsVars{
+					appName:     "phonetool",
+					names:       tc.inNames,
+					envNames:    tc.inEnvNames,
+					all:         tc.inAll,
+					parallelism: tc.inParallelism,
+				},
+				store: mockStore,
+				ws:    mockWs,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// mockWorkloadStack is a stack configuration named after its application, environment and workload.
+type mockWorkloadStack struct {
+	mockStackConfig
+	name string
+}
+
+func (m *mockWorkloadStack) StackName() string { return m.name }
+
+type deployWorkloadsMocks struct {
+	store    *mocks.Mockstore
+	ws       *mocks.MockwsWorkloadReader
+	appCFN   *mocks.MockappResourcesGetter
+	pusher   *mocks.MockimageBuilderTagPusher
+	uploader *mocks.MockartifactUploader
+	objects  *mocks.MockobjectUploader
+	images   *mocks.MockimageDigestGetter
+	addons   *mocks.Mocktemplater
+	deployer *mocks.MockworkloadDeployStreamer
+	analyzer *mocks.MockstackFailureAnalyzer
+	spinner  *mocks.Mockprogress
+	rg       *mocks.MockresourcesByTagsGetter
+	scaler   *mocks.MockecsServiceScaler
+	aas      *mocks.MockserviceScalingSuspender
+}
+
+func TestDeployWorkloadsOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inNames    []string
+		inAll      bool
+		inEnvNames []string
+		setupMocks func(sel *mocks.MockwsSelector)
+
+		wantedNames    []string
+		wantedEnvNames []string
+		wantedError    error
+	}{
+		"prompts for a service or job if no name is provided": {
+			inEnvNames: []string{"test", "prod"},
+			setupMocks: func(sel *mocks.MockwsSelector) {
+				sel.EXPECT().Workload(deployWorkloadsNamePrompt, "").Return("report", nil)
+				sel.EXPECT().Service(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			wantedNames:    []string{"report"},
+			wantedEnvNames: []string{"test", "prod"},
+		},
+		"returns an error if fails to select a service or job": {
+			inEnvNames: []string{"test"},
+			setupMocks: func(sel *mocks.MockwsSelector) {
+				sel.EXPECT().Workload(gomock.Any(), gomock.Any()).Return("", mockError)
+			},
+
+			wantedError: fmt.Errorf("select service or job: %w", mockError),
+		},
+		"doesn't prompt for a workload if every workload is deployed": {
+			inAll: true,
+			setupMocks: func(sel *mocks.MockwsSelector) {
+				sel.EXPECT().Workload(gomock.Any(), gomock.Any()).Times(0)
+				sel.EXPECT().Environment(deployWorkloadsEnvNamePrompt, "", "phonetool").Return("test", nil)
+			},
+
+			wantedEnvNames: []string{"test"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			sel := mocks.NewMockwsSelector(ctrl)
+			tc.setupMocks(sel)
+			opts := deployWorkloadsOpts{
+				deployWorkloadsVars: deployWorkloadsVars{
+					appName:  "phonetool",
+					names:    tc.inNames,
+					all:      tc.inAll,
+					envNames: tc.inEnvNames,
+					imageTag: "v1",
+				},
+				sel: sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNames, opts.names)
+			require.Equal(t, tc.wantedEnvNames, opts.envNames)
+		})
+	}
+}
+
+func TestDeployWorkloadsOpts_Execute(t *testing.T) {
+	app := &config.Application{Name: "phonetool", AccountID: "1234"}
+	testEnv := &config.Environment{App: "phonetool", Name: "test", Region: "us-west-2"}
+	prodEnv := &config.Environment{App: "phonetool", Name: "prod", Region: "us-east-1"}
+	buildMft := manifest.NewBackendService(manifest.BackendServiceProps{
+		WorkloadProps: manifest.WorkloadProps{
+			Name:       "api",
+			Dockerfile: "api/Dockerfile",
+		},
+		Port: 80,
+	})
+	locationMft := manifest.NewScheduledJob(&manifest.ScheduledJobProps{
+		WorkloadProps: &manifest.WorkloadProps{
+			Name:  "report",
+			Image: "nginx",
+		},
+		Schedule: "@daily",
+	})
+	mockResources := func(region string) *stack.AppRegionalResources {
+		return &stack.AppRegionalResources{
+			Region:   region,
+			S3Bucket: "bucket-" + region,
+			RepositoryURLs: map[string]string{
+				"api": "url-" + region,
+			},
+		}
+	}
+	deployResult := func(err error) (<-chan []deploy.ResourceEvent, <-chan error) {
+		events := make(chan []deploy.ResourceEvent)
+		close(events)
+		errs := make(chan error, 1)
+		errs <- err
+		return events, errs
+	}
+	setupCommonMocks := func(m deployWorkloadsMocks) {
+		m.store.EXPECT().GetApplication("phonetool").Return(app, nil)
+		m.store.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+		m.store.EXPECT().GetEnvironment("phonetool", "prod").Return(prodEnv, nil)
+		m.ws.EXPECT().ServiceNames().Return([]string{"api"}, nil)
+		m.ws.EXPECT().JobNames().Return([]string{"report"}, nil)
+		m.ws.EXPECT().ReadServiceManifest("api").Return([]byte("api"), nil)
+		m.ws.EXPECT().ReadJobManifest("report").Return([]byte("report"), nil)
+		m.appCFN.EXPECT().GetAppResourcesByRegion(app, "us-west-2").Return(mockResources("us-west-2"), nil)
+		m.appCFN.EXPECT().GetAppResourcesByRegion(app, "us-east-1").Return(mockResources("us-east-1"), nil)
+		// The locks are acquired by environment then workload, whatever the order of the flags.
+		var acquisitions []*gomock.Call
+		for _, key := range []deploymentKey{
+			{env: "prod", workload: "api"},
+			{env: "prod", workload: "report"},
+			{env: "test", workload: "api"},
+			{env: "test", workload: "report"},
+		} {
+			key := key
+			acquisitions = append(acquisitions, m.store.EXPECT().AcquireDeployLock(gomock.Any()).Do(func(lock *config.DeployLock) {
+				require.Equal(t, key, deploymentKey{env: lock.Env, workload: lock.Name})
+			}).Return(nil))
+		}
+		gomock.InOrder(acquisitions...)
+		m.store.EXPECT().ReleaseDeployLock(gomock.Any()).Return(nil).Times(4)
+	}
+
+	testCases := map[string]struct {
+		setupMocks func(m deployWorkloadsMocks)
+
+		wantedSummary string
+		wantedError   error
+	}{
+		"returns an error if fails to build the image": {
+			setupMocks: func(m deployWorkloadsMocks) {
+				setupCommonMocks(m)
+				m.ws.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+				m.pusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return(mockError)
+			},
+
+			wantedError: errors.New("push image for service api to region us-west-2: mock error"),
+		},
+		"builds each image once and deploys every workload to every environment": {
+			setupMocks: func(m deployWorkloadsMocks) {
+				setupCommonMocks(m)
+
+				// api is built for the first region and tagged for the second one.
+				m.ws.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+				gomock.InOrder(
+					m.pusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, args *docker.BuildArguments) error {
+						require.Equal(t, "v1", args.ImageTag)
+						args.URI = "url-us-west-2"
+						return nil
+					}),
+					m.pusher.EXPECT().TagAndPush(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, args *docker.BuildArguments) error {
+						require.Equal(t, "url-us-west-2", args.URI)
+						return nil
+					}),
+				)
+				m.addons.EXPECT().Template().Return("addons", nil)
+				m.uploader.EXPECT().PutArtifact("bucket-us-west-2", "api.addons.stack.yml", gomock.Any()).Return("url-addons-west", nil)
+				m.uploader.EXPECT().PutArtifact("bucket-us-east-1", "api.addons.stack.yml", gomock.Any()).Return("url-addons-east", nil)
+
+				// report uses an existing image and doesn't have addons.
+				m.addons.EXPECT().Template().Return("", &addon.ErrDirNotExist{})
+
+				m.spinner.EXPECT().Start("Deploying 4 stacks.")
+				m.spinner.EXPECT().Events(gomock.Any()).AnyTimes()
+				m.deployer.EXPECT().StreamServiceDeployment(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(conf cloudformation.StackConfiguration, _ ...awscloudformation.StackOption) (<-chan []deploy.ResourceEvent, <-chan error) {
+						if conf.StackName() == "phonetool-prod-report" {
+							return deployResult(&awscloudformation.ErrChangeSetEmpty{})
+						}
+						return deployResult(nil)
+					}).Times(4)
+				m.analyzer.EXPECT().LastOperationTime(gomock.Any()).Return(time.Time{}, nil).Times(4)
+				m.spinner.EXPECT().Stop("\n")
+
+				// Only the deployments of the service are recorded.
+				m.images.EXPECT().ImageDigest("phonetool/api", "v1").Return("sha256:1234", nil).Times(2)
+				m.objects.EXPECT().PutObject("bucket-us-west-2", gomock.Any(), gomock.Any()).Return(nil)
+				m.objects.EXPECT().PutObject("bucket-us-east-1", gomock.Any(), gomock.Any()).Return(nil)
+				m.store.EXPECT().CreateServiceDeployment(gomock.Any()).Return(nil).Times(2)
+				m.store.EXPECT().ListServiceDeployments("phonetool", gomock.Any(), "api").Return(nil, nil).Times(2)
+
+				// api was paused in the test environment, it's resumed once it's deployed.
+				m.store.EXPECT().GetPausedService("phonetool", "prod", "api").Return(nil, &config.ErrServiceNotPaused{})
+				gomock.InOrder(
+					m.store.EXPECT().GetPausedService("phonetool", "test", "api").Return(&config.PausedService{DesiredCount: 3}, nil),
+					m.rg.EXPECT().GetResourcesByTags(ecsServiceResourceType, map[string]string{
+						deploy.AppTagKey:     "phonetool",
+						deploy.EnvTagKey:     "test",
+						deploy.ServiceTagKey: "api",
+					}).Return([]*resourcegroups.Resource{{ARN: mockScalingServiceARN}}, nil),
+					m.scaler.EXPECT().UpdateServiceDesiredCount(mockScalingCluster, mockScalingService, int64(3)).Return(nil),
+					m.aas.EXPECT().ResumeECSServiceScaling(mockScalingCluster, mockScalingService).Return(nil),
+					m.store.EXPECT().DeletePausedService("phonetool", "test", "api").Return(nil),
+				)
+			},
+
+			wantedSummary: `Name    Type     Environment  Status
+----    ----     -----------  ------
+api     service  test         Complete
+api     service  prod         Complete
+report  job      test         Complete
+report  job      prod         No Changes
+`,
+		},
+		"keeps deploying the other stacks if one fails": {
+			setupMocks: func(m deployWorkloadsMocks) {
+				setupCommonMocks(m)
+				m.ws.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+				m.pusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return(nil)
+				m.pusher.EXPECT().TagAndPush(gomock.Any(), gomock.Any()).Return(nil)
+				m.addons.EXPECT().Template().Return("", &addon.ErrDirNotExist{}).Times(2)
+
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.spinner.EXPECT().Events(gomock.Any()).AnyTimes()
+				m.deployer.EXPECT().StreamServiceDeployment(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(conf cloudformation.StackConfiguration, _ ...awscloudformation.StackOption) (<-chan []deploy.ResourceEvent, <-chan error) {
+						if conf.StackName() == "phonetool-prod-api" {
+							return deployResult(mockError)
+						}
+						return deployResult(nil)
+					}).Times(4)
+				m.analyzer.EXPECT().LastOperationTime(gomock.Any()).Return(time.Time{}, nil).Times(4)
+				m.spinner.EXPECT().Stop("\n")
+
+				m.images.EXPECT().ImageDigest("phonetool/api", "v1").Return("", mockError)
+				m.store.EXPECT().GetPausedService("phonetool", "test", "api").Return(nil, &config.ErrServiceNotPaused{})
+				m.analyzer.EXPECT().Analyze("phonetool-prod-api", time.Time{}).Return(nil, nil)
+			},
+
+			wantedSummary: `Name    Type     Environment  Status
+----    ----     -----------  ------
+api     service  test         Complete
+api     service  prod         Failed
+report  job      test         Complete
+report  job      prod         Complete
+`,
+			wantedError: errors.New("1 of 4 deployments failed"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := deployWorkloadsMocks{
+				store:    mocks.NewMockstore(ctrl),
+				ws:       mocks.NewMockwsWorkloadReader(ctrl),
+				appCFN:   mocks.NewMockappResourcesGetter(ctrl),
+				pusher:   mocks.NewMockimageBuilderTagPusher(ctrl),
+				uploader: mocks.NewMockartifactUploader(ctrl),
+				objects:  mocks.NewMockobjectUploader(ctrl),
+				images:   mocks.NewMockimageDigestGetter(ctrl),
+				addons:   mocks.NewMocktemplater(ctrl),
+				deployer: mocks.NewMockworkloadDeployStreamer(ctrl),
+				analyzer: mocks.NewMockstackFailureAnalyzer(ctrl),
+				spinner:  mocks.NewMockprogress(ctrl),
+				rg:       mocks.NewMockresourcesByTagsGetter(ctrl),
+				scaler:   mocks.NewMockecsServiceScaler(ctrl),
+				aas:      mocks.NewMockserviceScalingSuspender(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+			opts := deployWorkloadsOpts{
+				deployWorkloadsVars: deployWorkloadsVars{
+					appName:     "phonetool",
+					names:       []string{"api", "report"},
+					envNames:    []string{"test", "prod"},
+					imageTag:    "v1",
+					parallelism: 2,
+				},
+				store:  m.store,
+				ws:     m.ws,
+				appCFN: m.appCFN,
+				unmarshal: func(in []byte) (interface{}, error) {
+					if string(in) == "api" {
+						return buildMft, nil
+					}
+					return locationMft, nil
+				},
+				newStack: func(mft interface{}, env *config.Environment, app *config.Application, rc stack.RuntimeConfig) (cloudformation.StackConfiguration, error) {
+					name := "report"
+					if _, ok := mft.(*manifest.BackendService); ok {
+						name = "api"
+						require.Equal(t, "url-"+env.Region, rc.Image.RepoURL)
+					}
+					return &mockWorkloadStack{
+						name: fmt.Sprintf("%s-%s-%s", app.Name, env.Name, name),
+					}, nil
+				},
+				spinner: m.spinner,
+				w:       b,
+				newImageBuilderPusher: func(repoName, region string) (imageBuilderTagPusher, error) {
+					require.Equal(t, "phonetool/api", repoName)
+					return m.pusher, nil
+				},
+				newRegionClients: func(region string) (*regionDeployClients, error) {
+					return &regionDeployClients{
+						artifacts: m.uploader,
+						templates: m.objects,
+						images:    m.images,
+					}, nil
+				},
+				newEnvClients: func(env *config.Environment) (*envDeployClients, error) {
+					return &envDeployClients{
+						deployer: m.deployer,
+						analyzer: m.analyzer,
+						rg:       m.rg,
+						scaler:   m.scaler,
+						aas:      m.aas,
+					}, nil
+				},
+				newAddons: func(name string) (templater, error) {
+					return m.addons, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedSummary, b.String())
+		})
+	}
+}
+
+func TestDeployLockOrder(t *testing.T) {
+	// GIVEN
+	envs := []*config.Environment{{Name: "test"}, {Name: "prod"}}
+	workloads := []*localWorkload{{name: "frontend"}, {name: "api"}}
+
+	// WHEN
+	keys := deployLockOrder(envs, workloads)
+
+	// THEN
+	require.Equal(t, []deploymentKey{
+		{env: "prod", workload: "api"},
+		{env: "prod", workload: "frontend"},
+		{env: "test", workload: "api"},
+		{env: "test", workload: "frontend"},
+	}, keys)
+}
+
+func TestOrderByDependencies(t *testing.T) {
+	svc := func(name string, deps ...string) *localWorkload {
+		mft := manifest.NewBackendService(manifest.BackendServiceProps{
+			WorkloadProps: manifest.WorkloadProps{
+				Name:  name,
+				Image: "nginx",
+			},
+		})
+		mft.DependsOn = deps
+		return &localWorkload{
+			name:     name,
+			typeName: "service",
+			manifest: mft,
+		}
+	}
+	testCases := map[string]struct {
+		inWorkloads []*localWorkload
+
+		wantedOrder []string
+		wantedError error
+	}{
+		"keeps the requested order without dependencies": {
+			inWorkloads: []*localWorkload{svc("frontend"), svc("api")},
+
+			wantedOrder: []string{"frontend", "api"},
+		},
+		"moves dependencies before their dependents": {
+			inWorkloads: []*localWorkload{svc("frontend", "api"), svc("api", "db"), svc("db"), svc("worker")},
+
+			wantedOrder: []string{"db", "api", "frontend", "worker"},
+		},
+		"ignores dependencies that aren't deployed along with the workloads": {
+			inWorkloads: []*localWorkload{svc("frontend", "api")},
+
+			wantedOrder: []string{"frontend"},
+		},
+		"errors on circular dependencies": {
+			inWorkloads: []*localWorkload{svc("frontend", "api"), svc("api", "db"), svc("db", "frontend")},
+
+			wantedError: errors.New("circular dependency between services: frontend -> api -> db -> frontend"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			ordered, err := orderByDependencies(tc.inWorkloads)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			var names []string
+			for _, wl := range ordered {
+				names = append(names, wl.name)
+			}
+			require.Equal(t, tc.wantedOrder, names)
+		})
+	}
+}
+
+func TestDeployWorkloadsOpts_deployAll(t *testing.T) {
+	testEnv := &config.Environment{App: "phonetool", Name: "test"}
+	deployResult := func(err error) (<-chan []deploy.ResourceEvent, <-chan error) {
+		events := make(chan []deploy.ResourceEvent)
+		close(events)
+		errs := make(chan error, 1)
+		errs <- err
+		return events, errs
+	}
+	testCases := map[string]struct {
+		dbErr error
+
+		wantedAPIStatus termprogress.Status
+		wantedAPIError  error
+	}{
+		"deploys a service once its dependency is deployed": {
+			wantedAPIStatus: termprogress.StatusComplete,
+		},
+		"skips a service if its dependency fails to deploy": {
+			dbErr: mockError,
+
+			wantedAPIStatus: deploymentStatusSkipped,
+			wantedAPIError:  errors.New("dependency db failed to deploy"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			deployer := mocks.NewMockworkloadDeployStreamer(ctrl)
+			analyzer := mocks.NewMockstackFailureAnalyzer(ctrl)
+			spinner := mocks.NewMockprogress(ctrl)
+			db := &workloadDeployment{
+				workload: &localWorkload{name: "db", typeName: "job"},
+				env:      testEnv,
+				stack:    &mockWorkloadStack{name: "phonetool-test-db"},
+				done:     make(chan struct{}),
+			}
+			api := &workloadDeployment{
+				workload:     &localWorkload{name: "api", typeName: "job"},
+				env:          testEnv,
+				stack:        &mockWorkloadStack{name: "phonetool-test-api"},
+				dependencies: []*workloadDeployment{db},
+				done:         make(chan struct{}),
+			}
+			spinner.EXPECT().Start("Deploying 2 stacks.")
+			spinner.EXPECT().Events(gomock.Any()).AnyTimes()
+			spinner.EXPECT().Stop("\n")
+			calls := []*gomock.Call{
+				analyzer.EXPECT().LastOperationTime("phonetool-test-db").Return(time.Time{}, nil),
+				deployer.EXPECT().StreamServiceDeployment(db.stack, gomock.Any()).Return(deployResult(tc.dbErr)),
+			}
+			if tc.dbErr == nil {
+				calls = append(calls,
+					analyzer.EXPECT().LastOperationTime("phonetool-test-api").Return(time.Time{}, nil),
+					deployer.EXPECT().StreamServiceDeployment(api.stack, gomock.Any()).Return(deployResult(nil)))
+			}
+			gomock.InOrder(calls...)
+			opts := deployWorkloadsOpts{
+				deployWorkloadsVars: deployWorkloadsVars{
+					parallelism: 2,
+				},
+				spinner: spinner,
+			}
+
+			// WHEN
+			opts.deployAll([]*workloadDeployment{db, api}, map[string]*envDeployClients{
+				"test": {deployer: deployer, analyzer: analyzer},
+			})
+
+			// THEN
+			require.Equal(t, tc.wantedAPIStatus, api.status)
+			if tc.wantedAPIError != nil {
+				require.EqualError(t, api.err, tc.wantedAPIError.Error())
+			} else {
+				require.NoError(t, api.err)
+			}
+		})
+	}
+}
@@ -46,6 +46,7 @@ type deleteEnvVars struct {
 	appName          string
 	name             string
 	skipConfirmation bool
+	force            bool
 }
 
 type deleteEnvOpts struct {
@@ -63,6 +64,9 @@ type deleteEnvOpts struct {
 	// cached data to avoid fetching the same information multiple times.
 	envConfig *config.Environment
 
+	// prodConfirmed is true if a production environment was already confirmed by the caller, such as app delete.
+	prodConfirmed bool
+
 	// initRuntimeClients is overriden in tests.
 	initRuntimeClients func(*deleteEnvOpts) error
 }
@@ -115,6 +119,18 @@ func (o *deleteEnvOpts) Ask() error {
 		return err
 	}
 
+	env, err := o.getEnvConfig()
+	if err != nil {
+		return err
+	}
+	if env.Prod && !o.prodConfirmed {
+		// Production environments are confirmed by typing their name even if the confirmation is skipped.
+		guard := prodGuard{
+			force:  o.force,
+			prompt: o.prompt,
+		}
+		return guard.confirm(env, "delete it")
+	}
 	if o.skipConfirmation {
 		return nil
 	}
@@ -281,6 +297,14 @@ func (o *deleteEnvOpts) deleteStack() error {
 	if err != nil {
 		return err
 	}
+	if env.Prod {
+		if err := o.deployer.SetEnvironmentTerminationProtection(o.appName, o.name, false); err != nil {
+			var stackDoesNotExist *awscfn.ErrStackNotFound
+			if !errors.As(err, &stackDoesNotExist) {
+				return fmt.Errorf("disable termination protection on environment %s stack: %w", o.name, err)
+			}
+		}
+	}
 	if err := o.deployer.DeleteEnvironment(o.appName, o.name, env.ExecutionRoleARN); err != nil {
 		return fmt.Errorf("delete environment %s stack: %w", o.name, err)
 	}
@@ -332,7 +356,10 @@ func buildEnvDeleteCmd() *cobra.Command {
   /code $ copilot env delete --name test
 
   Delete the "test" environment without prompting.
-  /code $ copilot env delete --name test --yes`,
+  /code $ copilot env delete --name test --yes
+
+  Delete the "prod" production environment without typing its name to confirm.
+  /code $ copilot env delete --name prod --force`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newDeleteEnvOpts(vars)
 			if err != nil {
@@ -350,5 +377,6 @@ func buildEnvDeleteCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&vars.force, forceFlag, false, forceFlagDescription)
 	return cmd
 }
@@ -87,6 +87,8 @@ func TestDeleteEnvOpts_Ask(t *testing.T) {
 	testCases := map[string]struct {
 		inEnvName          string
 		inSkipConfirmation bool
+		inForce            bool
+		inProd             bool
 
 		mockDependencies func(ctrl *gomock.Controller, o *deleteEnvOpts)
 
@@ -120,6 +122,39 @@ func TestDeleteEnvOpts_Ask(t *testing.T) {
 
 			wantedError: errors.New("confirm to delete environment test: some error"),
 		},
+		"requires typing the name of a production environment even if the confirmation is skipped": {
+			inSkipConfirmation: true,
+			inEnvName:          testEnv,
+			inProd:             true,
+			mockDependencies: func(ctrl *gomock.Controller, o *deleteEnvOpts) {
+				mockPrompter := mocks.NewMockprompter(ctrl)
+				mockPrompter.EXPECT().Get(gomock.Any(), prodConfirmHelp, nil).Return(testEnv, nil)
+				mockPrompter.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+
+				o.prompt = mockPrompter
+			},
+			wantedEnvName: testEnv,
+		},
+		"returns an error if the name typed does not match the production environment": {
+			inEnvName: testEnv,
+			inProd:    true,
+			mockDependencies: func(ctrl *gomock.Controller, o *deleteEnvOpts) {
+				mockPrompter := mocks.NewMockprompter(ctrl)
+				mockPrompter.EXPECT().Get(gomock.Any(), prodConfirmHelp, nil).Return("prod", nil)
+
+				o.prompt = mockPrompter
+			},
+			wantedError: errProdConfirmationMismatch,
+		},
+		"skips the production environment guardrails if forced": {
+			inEnvName: testEnv,
+			inProd:    true,
+			inForce:   true,
+			mockDependencies: func(ctrl *gomock.Controller, o *deleteEnvOpts) {
+				o.prompt = mocks.NewMockprompter(ctrl)
+			},
+			wantedEnvName: testEnv,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -127,12 +162,16 @@ func TestDeleteEnvOpts_Ask(t *testing.T) {
 			// GIVEN
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
+			mockStore := mocks.NewMockenvironmentStore(ctrl)
+			mockStore.EXPECT().GetEnvironment(testApp, testEnv).Return(&config.Environment{Name: testEnv, Prod: tc.inProd}, nil)
 			opts := &deleteEnvOpts{
 				deleteEnvVars: deleteEnvVars{
 					name:             tc.inEnvName,
 					appName:          testApp,
 					skipConfirmation: tc.inSkipConfirmation,
+					force:            tc.inForce,
 				},
+				store: mockStore,
 			}
 			tc.mockDependencies(ctrl, opts)
 
@@ -324,6 +363,46 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 			},
 			wantedError: errors.New("delete role managerRoleARN: some error"),
 		},
+		"disables termination protection before deleting the stack of a production environment": {
+			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
+				rg := mocks.NewMockresourceGetter(ctrl)
+				rg.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+					ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{}}, nil)
+
+				prog := mocks.NewMockprogress(ctrl)
+				prog.EXPECT().Start(gomock.Any())
+
+				deployer := mocks.NewMockenvironmentDeployer(ctrl)
+				deployer.EXPECT().EnvironmentTemplate(gomock.Any(), gomock.Any()).Return(`
+  CloudformationExecutionRole:
+    DeletionPolicy: Retain
+  EnvironmentManagerRole:
+    DeletionPolicy: Retain`, nil)
+				gomock.InOrder(
+					deployer.EXPECT().SetEnvironmentTerminationProtection("phonetool", "prod", false).Return(nil),
+					deployer.EXPECT().DeleteEnvironment("phonetool", "prod", "execARN").Return(errors.New("some error")),
+				)
+
+				prog.EXPECT().Stop(log.Serror("Failed to delete environment prod from application phonetool."))
+
+				return &deleteEnvOpts{
+					deleteEnvVars: deleteEnvVars{
+						appName: "phonetool",
+						name:    "prod",
+					},
+					rg:       rg,
+					deployer: deployer,
+					prog:     prog,
+					envConfig: &config.Environment{
+						Prod:             true,
+						ExecutionRoleARN: "execARN",
+					},
+					initRuntimeClients: noopInitRuntimeClients,
+				}
+			},
+
+			wantedError: errors.New("delete environment prod stack: some error"),
+		},
 		"deletes the stack, then the roles, then SSM by default": {
 			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
 				rg := mocks.NewMockresourceGetter(ctrl)
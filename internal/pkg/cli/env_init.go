@@ -20,6 +20,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -117,6 +118,7 @@ type initEnvVars struct {
 	name          string // Name for the environment.
 	profile       string // The named profile to use for credential retrieval. Mutually exclusive with tempCreds.
 	isProduction  bool   // True means retain resources even after deletion.
+	deployBranch  string // Git branch to deploy from if the environment is a production environment.
 	defaultConfig bool   // True means using default environment configuration.
 
 	importVPC importVPCVars // Existing VPC resources to use instead of creating new ones.
@@ -133,6 +135,7 @@ type initEnvOpts struct {
 	sessProvider sessionProvider
 	store        store
 	envDeployer  deployer
+	envAnalyzer  stackFailureAnalyzer
 	appDeployer  deployer
 	identity     identityService
 	envIdentity  identityService
@@ -190,6 +193,9 @@ func (o *initEnvOpts) Validate() error {
 	if err := o.validateCustomizedResources(); err != nil {
 		return err
 	}
+	if o.deployBranch != "" && !o.isProduction {
+		return fmt.Errorf("cannot specify --%s for an environment that is not a production environment", deployBranchFlag)
+	}
 	return o.validateCredentials()
 }
 
@@ -216,6 +222,9 @@ func (o *initEnvOpts) Execute() error {
 	if o.envDeployer == nil {
 		o.envDeployer = deploycfn.New(o.sess)
 	}
+	if o.envAnalyzer == nil {
+		o.envAnalyzer = describe.NewStackFailureAnalyzer(o.sess)
+	}
 
 	app, err := o.store.GetApplication(o.appName)
 	if err != nil {
@@ -232,6 +241,12 @@ func (o *initEnvOpts) Execute() error {
 	if err := o.deployEnv(app); err != nil {
 		return err
 	}
+	if o.isProduction {
+		// Protect the stack of a production environment from being deleted by accident.
+		if err := o.envDeployer.SetEnvironmentTerminationProtection(o.appName, o.name, true); err != nil {
+			return fmt.Errorf("enable termination protection on environment %s: %w", o.name, err)
+		}
+	}
 
 	// 2. Get the environment
 	env, err := o.envDeployer.GetEnvironment(o.appName, o.name)
@@ -239,6 +254,7 @@ func (o *initEnvOpts) Execute() error {
 		return fmt.Errorf("get environment struct for %s: %w", o.name, err)
 	}
 	env.Prod = o.isProduction
+	env.DeployBranch = o.deployBranch
 	env.CustomConfig = config.NewCustomizeEnv(o.importVPCConfig(), o.adjustVPCConfig())
 
 	// 3. Add the stack set instance to the app stackset.
@@ -480,6 +496,7 @@ func (o *initEnvOpts) deployEnv(app *config.Application) error {
 	}
 
 	o.prog.Start(fmt.Sprintf(fmtDeployEnvStart, color.HighlightUserInput(o.name)))
+	since := lastStackOperationTime(o.envAnalyzer, stack.NameForEnv(o.appName, o.name))
 	if err := o.envDeployer.DeployEnvironment(deployEnvInput); err != nil {
 		var existsErr *cloudformation.ErrStackAlreadyExists
 		if errors.As(err, &existsErr) {
@@ -501,6 +518,7 @@ func (o *initEnvOpts) deployEnv(app *config.Application) error {
 	resp := <-responses
 	if resp.Err != nil {
 		o.prog.Stop(log.Serrorf(fmtStreamEnvFailed, color.HighlightUserInput(o.name)))
+		logStackFailure(o.envAnalyzer, stack.NameForEnv(o.appName, o.name), since)
 		return resp.Err
 	}
 	o.prog.Stop(log.Ssuccessf(fmtStreamEnvComplete, color.HighlightUserInput(o.name)))
@@ -605,6 +623,9 @@ func buildEnvInitCmd() *cobra.Command {
   Creates a prod-iad environment using your "prod-admin" AWS profile.
   /code $ copilot env init --name prod-iad --profile prod-admin --prod
 
+  Creates a prod-iad environment that can only be deployed to from the "release" git branch.
+  /code $ copilot env init --name prod-iad --profile prod-admin --prod --deploy-branch release
+
   Creates an environment with imported VPC resources.
   /code $ copilot env init --import-vpc-id vpc-099c32d2b98cdcf47 \
   /code --import-public-subnets subnet-013e8b691862966cf,subnet -014661ebb7ab8681a \
@@ -637,6 +658,7 @@ func buildEnvInitCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.region, regionFlag, "", envRegionTokenFlagDescription)
 
 	cmd.Flags().BoolVar(&vars.isProduction, prodEnvFlag, false, prodEnvFlagDescription)
+	cmd.Flags().StringVar(&vars.deployBranch, deployBranchFlag, "", deployBranchFlagDescription)
 
 	cmd.Flags().StringVar(&vars.importVPC.ID, vpcIDFlag, "", vpcIDFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.importVPC.PublicSubnetIDs, publicSubnetsFlag, nil, publicSubnetsFlagDescription)
@@ -658,6 +680,7 @@ func buildEnvInitCmd() *cobra.Command {
 	flags.AddFlag(cmd.Flags().Lookup(regionFlag))
 	flags.AddFlag(cmd.Flags().Lookup(defaultConfigFlag))
 	flags.AddFlag(cmd.Flags().Lookup(prodEnvFlag))
+	flags.AddFlag(cmd.Flags().Lookup(deployBranchFlag))
 
 	resourcesImportFlag := pflag.NewFlagSet("Import Existing Resources", pflag.ContinueOnError)
 	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(vpcIDFlag))
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -493,6 +494,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 		expectDeployer func(m *mocks.Mockdeployer)
 		expectIdentity func(m *mocks.MockidentityService)
 		expectProgress func(m *mocks.Mockprogress)
+		expectAnalyzer func(m *mocks.MockstackFailureAnalyzer)
 
 		wantedErrorS string
 	}{
@@ -532,6 +534,9 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				m.EXPECT().Start(fmt.Sprintf(fmtDeployEnvStart, "test"))
 				m.EXPECT().Stop(log.Serrorf(fmtDeployEnvFailed, "test"))
 			},
+			expectAnalyzer: func(m *mocks.MockstackFailureAnalyzer) {
+				m.EXPECT().LastOperationTime("phonetool-test").Return(time.Time{}, nil)
+			},
 			expectDeployer: func(m *mocks.Mockdeployer) {
 				m.EXPECT().DeployEnvironment(gomock.Any()).Return(errors.New("some deploy error"))
 			},
@@ -583,6 +588,10 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				close(events)
 				close(responses)
 			},
+			expectAnalyzer: func(m *mocks.MockstackFailureAnalyzer) {
+				m.EXPECT().LastOperationTime("phonetool-test").Return(time.Time{}, nil)
+				m.EXPECT().Analyze("phonetool-test", time.Time{}).Return(nil, nil)
+			},
 			wantedErrorS: "some stream error",
 		},
 		"failed to get environment stack": {
@@ -601,6 +610,9 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				m.EXPECT().Start(fmt.Sprintf(fmtStreamEnvStart, "test"))
 				m.EXPECT().Stop(log.Ssuccessf(fmtStreamEnvComplete, "test"))
 			},
+			expectAnalyzer: func(m *mocks.MockstackFailureAnalyzer) {
+				m.EXPECT().LastOperationTime("phonetool-test").Return(time.Time{}, nil)
+			},
 			expectDeployer: func(m *mocks.Mockdeployer) {
 				m.EXPECT().DeployEnvironment(gomock.Any()).Return(nil)
 				events := make(chan []deploy.ResourceEvent, 1)
@@ -640,6 +652,9 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "1234", "mars-1", "phonetool"))
 				m.EXPECT().Stop(log.Serrorf(fmtAddEnvToAppFailed, "1234", "mars-1", "phonetool"))
 			},
+			expectAnalyzer: func(m *mocks.MockstackFailureAnalyzer) {
+				m.EXPECT().LastOperationTime("phonetool-test").Return(time.Time{}, nil)
+			},
 			expectDeployer: func(m *mocks.Mockdeployer) {
 				m.EXPECT().DeployEnvironment(gomock.Any()).Return(nil)
 				events := make(chan []deploy.ResourceEvent, 1)
@@ -692,6 +707,9 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "1234", "mars-1", "phonetool"))
 				m.EXPECT().Stop(log.Ssuccessf(fmtAddEnvToAppComplete, "1234", "mars-1", "phonetool"))
 			},
+			expectAnalyzer: func(m *mocks.MockstackFailureAnalyzer) {
+				m.EXPECT().LastOperationTime("phonetool-test").Return(time.Time{}, nil)
+			},
 			expectDeployer: func(m *mocks.Mockdeployer) {
 				m.EXPECT().DeployEnvironment(gomock.Any()).Return(nil)
 				events := make(chan []deploy.ResourceEvent, 1)
@@ -743,6 +761,9 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "1234", "mars-1", "phonetool"))
 				m.EXPECT().Stop(log.Ssuccessf(fmtAddEnvToAppComplete, "1234", "mars-1", "phonetool"))
 			},
+			expectAnalyzer: func(m *mocks.MockstackFailureAnalyzer) {
+				m.EXPECT().LastOperationTime("phonetool-test").Return(time.Time{}, nil)
+			},
 			expectDeployer: func(m *mocks.Mockdeployer) {
 				m.EXPECT().DeployEnvironment(gomock.Any()).Return(nil)
 				events := make(chan []deploy.ResourceEvent, 1)
@@ -767,6 +788,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 					Prod:      false,
 					App:       "phonetool",
 				}, nil)
+				m.EXPECT().SetEnvironmentTerminationProtection("phonetool", "test", true).Return(nil)
 				m.EXPECT().AddEnvToApp(gomock.Any(), gomock.Any()).Return(nil)
 			},
 		},
@@ -792,6 +814,9 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "1234", "mars-1", "phonetool"))
 				m.EXPECT().Stop(log.Ssuccessf(fmtAddEnvToAppComplete, "1234", "mars-1", "phonetool"))
 			},
+			expectAnalyzer: func(m *mocks.MockstackFailureAnalyzer) {
+				m.EXPECT().LastOperationTime("phonetool-test").Return(time.Time{}, nil)
+			},
 			expectDeployer: func(m *mocks.Mockdeployer) {
 				m.EXPECT().DeployEnvironment(&deploy.CreateEnvironmentInput{
 					Name:                     "test",
@@ -851,6 +876,9 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "1234", "mars-1", "phonetool"))
 				m.EXPECT().Stop(log.Ssuccessf(fmtAddEnvToAppComplete, "1234", "mars-1", "phonetool"))
 			},
+			expectAnalyzer: func(m *mocks.MockstackFailureAnalyzer) {
+				m.EXPECT().LastOperationTime("phonetool-test").Return(time.Time{}, nil)
+			},
 			expectDeployer: func(m *mocks.Mockdeployer) {
 				m.EXPECT().DelegateDNSPermissions(gomock.Any(), "4567").Return(nil)
 				m.EXPECT().DeployEnvironment(gomock.Any()).Return(nil)
@@ -889,6 +917,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 			mockDeployer := mocks.NewMockdeployer(ctrl)
 			mockIdentity := mocks.NewMockidentityService(ctrl)
 			mockProgress := mocks.NewMockprogress(ctrl)
+			mockAnalyzer := mocks.NewMockstackFailureAnalyzer(ctrl)
 			if tc.expectstore != nil {
 				tc.expectstore(mockstore)
 			}
@@ -901,6 +930,9 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 			if tc.expectProgress != nil {
 				tc.expectProgress(mockProgress)
 			}
+			if tc.expectAnalyzer != nil {
+				tc.expectAnalyzer(mockAnalyzer)
+			}
 
 			opts := &initEnvOpts{
 				initEnvVars: initEnvVars{
@@ -910,6 +942,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				},
 				store:       mockstore,
 				envDeployer: mockDeployer,
+				envAnalyzer: mockAnalyzer,
 				appDeployer: mockDeployer,
 				identity:    mockIdentity,
 				envIdentity: mockIdentity,
@@ -7,8 +7,10 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -30,6 +32,7 @@ type envUpgradeVars struct {
 	appName string // Required. Name of the application.
 	name    string // Required. Name of the environment.
 	all     bool   // True means all environments should be upgraded.
+	force   bool   // True means the guardrails of production environments are skipped.
 }
 
 // envUpgradeOpts represents the env upgrade command and holds the necessary data
@@ -37,12 +40,14 @@ type envUpgradeVars struct {
 type envUpgradeOpts struct {
 	envUpgradeVars
 
-	store environmentStore
-	sel   appEnvSelector
+	store  environmentStore
+	sel    appEnvSelector
+	prompt prompter
 
 	// Constructors for clients that can be initialized only at runtime.
 	// These functions are overriden in tests to provide mocks.
 	newEnvVersionGetter func(app, env string) (versionGetter, error)
+	newEnvProtector     func(env *config.Environment) (envTerminationProtector, error)
 }
 
 func newEnvUpgradeOpts(vars envUpgradeVars) (*envUpgradeOpts, error) {
@@ -50,11 +55,13 @@ func newEnvUpgradeOpts(vars envUpgradeVars) (*envUpgradeOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("connect to config store: %v", err)
 	}
+	prompter := prompt.New()
 	return &envUpgradeOpts{
 		envUpgradeVars: vars,
 
-		store: store,
-		sel:   selector.NewSelect(prompt.New(), store),
+		store:  store,
+		sel:    selector.NewSelect(prompter, store),
+		prompt: prompter,
 
 		newEnvVersionGetter: func(app, env string) (versionGetter, error) {
 			d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
@@ -67,6 +74,13 @@ func newEnvUpgradeOpts(vars envUpgradeVars) (*envUpgradeOpts, error) {
 			}
 			return d, nil
 		},
+		newEnvProtector: func(env *config.Environment) (envTerminationProtector, error) {
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from environment manager role %s in region %s: %v", env.ManagerRoleARN, env.Region, err)
+			}
+			return cloudformation.New(sess), nil
+		},
 	}, nil
 }
 
@@ -121,34 +135,57 @@ func (o *envUpgradeOpts) Execute() error {
 	return nil
 }
 
-func (o *envUpgradeOpts) listEnvsToUpgrade() ([]string, error) {
+func (o *envUpgradeOpts) listEnvsToUpgrade() ([]*config.Environment, error) {
 	if !o.all {
-		return []string{o.name}, nil
+		env, err := o.store.GetEnvironment(o.appName, o.name)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s configuration from application %s: %v", o.name, o.appName, err)
+		}
+		return []*config.Environment{env}, nil
 	}
 
 	envs, err := o.store.ListEnvironments(o.appName)
 	if err != nil {
 		return nil, fmt.Errorf("list environments in app %s: %v", o.appName, err)
 	}
-	var names []string
-	for _, env := range envs {
-		names = append(names, env.Name)
-	}
-	return names, nil
+	return envs, nil
 }
 
-func (o *envUpgradeOpts) upgrade(env string) error {
-	yes, err := o.shouldUpgrade(env)
+func (o *envUpgradeOpts) upgrade(env *config.Environment) error {
+	yes, err := o.shouldUpgrade(env.Name)
 	if err != nil {
 		return err
 	}
 	if !yes {
 		return nil
 	}
+	guard := prodGuard{
+		force:  o.force,
+		prompt: o.prompt,
+	}
+	if err := guard.confirm(env, "upgrade it"); err != nil {
+		return err
+	}
 	// If the environment's version is a legacy version,
 	// and the template was generated by customizing the VPC,
 	// and the customization configuration is not stored in SSM (see #1433),
 	// then we cannot upgrade the environment without re-asking for the VPC configuration.
+	return o.protect(env)
+}
+
+// protect enables termination protection on the stack of a production environment,
+// including environments that were created before stacks were protected.
+func (o *envUpgradeOpts) protect(env *config.Environment) error {
+	if !env.Prod {
+		return nil
+	}
+	protector, err := o.newEnvProtector(env)
+	if err != nil {
+		return err
+	}
+	if err := protector.SetEnvironmentTerminationProtection(o.appName, env.Name, true); err != nil {
+		return fmt.Errorf("enable termination protection on environment %s: %v", env.Name, err)
+	}
 	return nil
 }
 
@@ -202,5 +239,6 @@ func buildEnvUpgradeCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.all, allFlag, false, upgradeAllEnvsDescription)
+	cmd.Flags().BoolVar(&vars.force, forceFlag, false, forceFlagDescription)
 	return cmd
 }
@@ -214,6 +214,62 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 				}
 			},
 		},
+		"should confirm and protect the stack of a production environment": {
+			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
+				mockStore := mocks.NewMockenvironmentStore(ctrl)
+				mockStore.EXPECT().GetEnvironment("phonetool", "prod").Return(&config.Environment{
+					Name: "prod",
+					Prod: true,
+				}, nil)
+				mockEnvTpl := mocks.NewMockversionGetter(ctrl)
+				mockEnvTpl.EXPECT().Version().Return("v0.0.0", nil)
+				mockPrompt := mocks.NewMockprompter(ctrl)
+				mockPrompt.EXPECT().Get(gomock.Any(), prodConfirmHelp, nil).Return("prod", nil)
+				mockProtector := mocks.NewMockenvTerminationProtector(ctrl)
+				mockProtector.EXPECT().SetEnvironmentTerminationProtection("phonetool", "prod", true).Return(nil)
+
+				return &envUpgradeOpts{
+					envUpgradeVars: envUpgradeVars{
+						appName: "phonetool",
+						name:    "prod",
+					},
+					store:  mockStore,
+					prompt: mockPrompt,
+					newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
+						return mockEnvTpl, nil
+					},
+					newEnvProtector: func(_ *config.Environment) (envTerminationProtector, error) {
+						return mockProtector, nil
+					},
+				}
+			},
+		},
+		"should not upgrade a production environment if the name typed does not match": {
+			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
+				mockStore := mocks.NewMockenvironmentStore(ctrl)
+				mockStore.EXPECT().GetEnvironment("phonetool", "prod").Return(&config.Environment{
+					Name: "prod",
+					Prod: true,
+				}, nil)
+				mockEnvTpl := mocks.NewMockversionGetter(ctrl)
+				mockEnvTpl.EXPECT().Version().Return("v0.0.0", nil)
+				mockPrompt := mocks.NewMockprompter(ctrl)
+				mockPrompt.EXPECT().Get(gomock.Any(), prodConfirmHelp, nil).Return("test", nil)
+
+				return &envUpgradeOpts{
+					envUpgradeVars: envUpgradeVars{
+						appName: "phonetool",
+						name:    "prod",
+					},
+					store:  mockStore,
+					prompt: mockPrompt,
+					newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
+						return mockEnvTpl, nil
+					},
+				}
+			},
+			wantedErr: errProdConfirmationMismatch,
+		},
 	}
 
 	for name, tc := range testCases {
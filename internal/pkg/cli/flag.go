@@ -39,14 +39,20 @@ const (
 	prodEnvFlag           = "prod"
 	deployFlag            = "deploy"
 	resourcesFlag         = "resources"
+	repoURLFlag           = "url"
 	githubURLFlag         = "github-url"
 	githubAccessTokenFlag = "github-access-token"
 	gitBranchFlag         = "git-branch"
+	connectionARNFlag     = "connection-arn"
 	envsFlag              = "environments"
 	domainNameFlag        = "domain"
 	localFlag             = "local"
 	deleteSecretFlag      = "delete-secret"
 	svcPortFlag           = "port"
+	stageFlag             = "stage"
+	actionFlag            = "action"
+	buildIDFlag           = "build-id"
+	watchFlag             = "watch"
 
 	storageTypeFlag         = "storage-type"
 	storagePartitionKeyFlag = "partition-key"
@@ -67,6 +73,8 @@ const (
 	envVarsFlag        = "env-vars"
 	commandFlag        = "command"
 	taskDefaultFlag    = "default"
+	taskIDFlag         = "task-id"
+	containerFlag      = "container"
 
 	vpcIDFlag          = "import-vpc-id"
 	publicSubnetsFlag  = "import-public-subnets"
@@ -86,6 +94,25 @@ const (
 	retriesFlag  = "retries"
 	timeoutFlag  = "timeout"
 	scheduleFlag = "schedule"
+	timezoneFlag = "timezone"
+
+	pipelineFlag = "pipeline"
+
+	rollbackToFlag = "to"
+
+	dryRunFlag = "dry-run"
+
+	parallelismFlag = "parallelism"
+
+	promoteFromFlag = "from"
+	promoteToFlag   = "to"
+
+	forceFlag          = "force"
+	deployBranchFlag   = "deploy-branch"
+	allowDirtyFlag     = "allow-dirty"
+	allowAnyBranchFlag = "allow-any-branch"
+
+	stealLockFlag = "steal-lock"
 )
 
 // Short flag names.
@@ -100,7 +127,7 @@ const (
 
 	dockerFileFlagShort        = "d"
 	imageFlagShort             = "i"
-	githubURLFlagShort         = "u"
+	repoURLFlagShort           = "u"
 	githubAccessTokenFlagShort = "t"
 	gitBranchFlagShort         = "b"
 	envsFlagShort              = "e"
@@ -131,6 +158,12 @@ Cannot be specified with '%s', '%s' or '%s'.`, appFlag, envFlag, subnetsFlag)
 Cannot be specified with '%s', '%s' or '%s'`, taskDefaultFlag, subnetsFlag, securityGroupsFlag)
 	taskAppFlagDescription = fmt.Sprintf(`Optional. Name of the application.
 Cannot be specified with '%s', '%s' or '%s'`, taskDefaultFlag, subnetsFlag, securityGroupsFlag)
+	taskExecDefaultFlagDescription = fmt.Sprintf(`Optional. Execute commands in running tasks in default cluster.
+Cannot be specified with '%s' or '%s'.`, appFlag, envFlag)
+	taskExecAppFlagDescription = fmt.Sprintf(`Optional. Name of the application.
+Cannot be specified with '%s'.`, taskDefaultFlag)
+	taskExecEnvFlagDescription = fmt.Sprintf(`Optional. Name of the environment.
+Cannot be specified with '%s'.`, taskDefaultFlag)
 )
 
 const (
@@ -148,6 +181,8 @@ const (
 Allows you to categorize resources.`
 	stackOutputDirFlagDescription = "Optional. Writes the stack template and template configuration to a directory."
 	prodEnvFlagDescription        = "If the environment contains production services."
+	deployPipelineFlagDescription = `Optional. Package every service and job for the environments of a pipeline.
+Builds and pushes the images, uploads the addons, and writes the templates to the output directory.`
 
 	limitFlagDescription = `Optional. The maximum number of log events returned. Default is 10
 unless any time filtering flags are set.`
@@ -161,9 +196,11 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	tasksLogsFlagDescription = "Optional. Only return logs from specific task IDs."
 
 	deployTestFlagDescription        = `Deploy your service to a "test" environment.`
-	githubURLFlagDescription         = "GitHub repository URL for your service."
+	repoURLFlagDescription           = "The repository URL to trigger your pipeline."
+	githubURLFlagDescription         = "(Deprecated) Use --url instead. GitHub repository URL for your service."
 	githubAccessTokenFlagDescription = "GitHub personal access token for your repository."
 	gitBranchFlagDescription         = "Branch used to trigger your pipeline."
+	connectionARNFlagDescription     = "Optional. ARN of an existing AWS CodeStar connection to your repository."
 	pipelineEnvsFlagDescription      = "Environments to add to the pipeline."
 	domainNameFlagDescription        = "Optional. Your existing custom domain name."
 	envResourcesFlagDescription      = "Optional. Show the resources in your environment."
@@ -171,6 +208,11 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	pipelineResourcesFlagDescription = "Optional. Show the resources in your pipeline."
 	localSvcFlagDescription          = "Only show services in the workspace."
 	localJobFlagDescription          = "Only show jobs in the workspace."
+	localPipelineFlagDescription     = "Only show pipelines in the workspace."
+	stageFlagDescription             = "Name of the pipeline stage to retry."
+	watchPipelineFlagDescription     = "Optional. Refreshes the status until the pipeline execution finishes."
+	actionFlagDescription            = "Optional. Only return logs from the pipeline action with this name."
+	buildIDFlagDescription           = "Optional. Return logs from the CodeBuild build with this ID instead of the latest builds."
 	deleteSecretFlagDescription      = "Deletes AWS Secrets Manager secret associated with a pipeline source repository."
 	svcPortFlagDescription           = "Optional. The port on which your service listens."
 
@@ -197,6 +239,35 @@ Tasks with the same group name share the same set of resources.
 (default directory name)`
 	taskImageTagFlagDescription = `Optional. The container image tag in addition to "latest".`
 
+	execTaskIDFlagDescription    = "Optional. ID of the task to execute the command in, a prefix is enough. Defaults to any running task."
+	execContainerFlagDescription = "Optional. Name of the container to execute the command in. Defaults to the main container."
+	execCommandFlagDescription   = "Optional. The command that is run in the container."
+
+	rollbackToFlagDescription = "Optional. ID of the deployment to roll back to. Defaults to the previous deployment."
+
+	dryRunFlagDescription = `Optional. Show the changes that the deployment would make to the stack without deploying.
+The container image is not built nor pushed.`
+
+	deployNamesFlagDescription       = "Names of the services or jobs to deploy. Can be specified multiple times."
+	deployEnvsFlagDescription        = "Names of the environments to deploy to. Can be specified multiple times."
+	deployAllFlagDescription         = "Optional. Deploy every service and job in the workspace."
+	deployParallelismFlagDescription = "Optional. Maximum number of stacks to deploy at the same time."
+
+	promoteFromFlagDescription = "Name of the environment running the image to promote."
+	promoteToFlagDescription   = "Name of the environment to deploy the image to."
+
+	forceFlagDescription = `Optional. Skip the guardrails of production environments:
+the confirmation prompt and, when deploying, the checks of the git working tree.`
+	deployBranchFlagDescription = `Optional. Git branch that must be checked out to deploy to a production environment.
+Defaults to the default branch of the origin remote, or to main if it's unknown.`
+	allowDirtyFlagDescription = `Optional. Deploy to a production environment even if
+the git working tree has uncommitted changes.`
+	allowAnyBranchFlagDescription = `Optional. Deploy to a production environment from
+any git branch, or from a detached HEAD.`
+
+	stealLockFlagDescription = `Optional. Take over the deploy lock of the workload in the environment
+instead of waiting for someone else's deployment to finish.`
+
 	vpcIDFlagDescription          = "Optional. Use an existing VPC ID."
 	publicSubnetsFlagDescription  = "Optional. Use existing public subnet IDs."
 	privateSubnetsFlagDescription = "Optional. Use existing private subnet IDs."
@@ -220,6 +291,8 @@ Accepts cron expressions of the format (M H DoM M DoW) and schedule definition s
 For example: "0 * * * *", "@daily", "@weekly", "@every 1h30m".
 AWS Schedule Expressions of the form "rate(10 minutes)" or "cron(0 12 L * ? 2021)"
 are also accepted.`
+	timezoneFlagDescription = `Optional. The IANA time zone in which cron schedules are evaluated.
+For example: "Europe/Berlin", "America/New_York". Defaults to UTC.`
 
 	upgradeAllEnvsDescription = "Optional. Upgrade all environments."
 )
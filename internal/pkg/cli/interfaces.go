@@ -6,17 +6,23 @@ package cli
 import (
 	"encoding"
 	"io"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/docker"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
 	"github.com/aws/copilot-cli/internal/pkg/ecslogging"
+	"github.com/aws/copilot-cli/internal/pkg/pipelinelogging"
 	"github.com/aws/copilot-cli/internal/pkg/repository"
 	"github.com/aws/copilot-cli/internal/pkg/task"
 	"github.com/aws/copilot-cli/internal/pkg/term/command"
@@ -49,6 +55,25 @@ type serviceStore interface {
 	DeleteService(appName, svcName string) error
 }
 
+type pausedServiceStore interface {
+	PauseService(paused *config.PausedService) error
+	GetPausedService(appName, envName, svcName string) (*config.PausedService, error)
+	DeletePausedService(appName, envName, svcName string) error
+}
+
+type svcDeploymentStore interface {
+	CreateServiceDeployment(deployment *config.ServiceDeployment) error
+	ListServiceDeployments(appName, envName, svcName string) ([]*config.ServiceDeployment, error)
+	DeleteServiceDeployment(appName, envName, svcName, id string) error
+}
+
+type deployLockStore interface {
+	AcquireDeployLock(lock *config.DeployLock) error
+	StealDeployLock(lock *config.DeployLock) error
+	RefreshDeployLock(lock *config.DeployLock) error
+	ReleaseDeployLock(lock *config.DeployLock) error
+}
+
 type jobStore interface {
 	CreateJob(job *config.Workload) error
 	GetJob(appName, jobName string) (*config.Workload, error)
@@ -110,6 +135,9 @@ type store interface {
 	applicationStore
 	environmentStore
 	serviceStore
+	pausedServiceStore
+	svcDeploymentStore
+	deployLockStore
 	jobStore
 }
 
@@ -138,6 +166,16 @@ type imageBuilderPusher interface {
 	BuildAndPush(docker repository.ContainerLoginBuildPusher, args *docker.BuildArguments) error
 }
 
+type imageBuilderTagPusher interface {
+	imageBuilderPusher
+	TagAndPush(docker repository.ContainerLoginBuildPusher, args *docker.BuildArguments) error
+}
+
+type imagePullPusher interface {
+	Pull(docker repository.ContainerLoginPullPusher, digest string) (string, error)
+	PushImage(docker repository.ContainerLoginPullPusher, image, imageTag string) error
+}
+
 type repositoryURIGetter interface {
 	URI() string
 }
@@ -240,12 +278,16 @@ type copilotDirGetter interface {
 }
 
 type wsPipelineManifestReader interface {
-	ReadPipelineManifest() ([]byte, error)
+	ReadPipelineManifest(path string) ([]byte, error)
+}
+
+type wsPipelineLister interface {
+	ListPipelines() ([]workspace.PipelineManifest, error)
 }
 
 type wsPipelineWriter interface {
-	WritePipelineBuildspec(marshaler encoding.BinaryMarshaler) (string, error)
-	WritePipelineManifest(marshaler encoding.BinaryMarshaler) (string, error)
+	WritePipelineBuildspec(marshaler encoding.BinaryMarshaler, name string) (string, error)
+	WritePipelineManifest(marshaler encoding.BinaryMarshaler, name string) (string, error)
 }
 
 type wsServiceLister interface {
@@ -276,9 +318,29 @@ type wsJobDirReader interface {
 	CopilotDirPath() (string, error)
 }
 
+type wsWorkloadReader interface {
+	wsServiceLister
+	wsJobLister
+	svcManifestReader
+	jobManifestReader
+	copilotDirGetter
+}
+
+type wsPipelineUpgrader interface {
+	wsServiceLister
+	wsJobLister
+	wsPipelineLister
+	wsPipelineManifestReader
+	OverwritePipelineManifest(path string, data []byte) error
+}
+
 type wsPipelineReader interface {
 	wsServiceLister
+	wsJobLister
+	wsPipelineLister
 	wsPipelineManifestReader
+	PipelineBuildspecPath(manifestPath string) (string, error)
+	LoadBalancedWebServiceNames() ([]string, error)
 }
 
 type wsAppManager interface {
@@ -295,6 +357,19 @@ type artifactUploader interface {
 	PutArtifact(bucket, fileName string, data io.Reader) (string, error)
 }
 
+type objectUploader interface {
+	PutObject(bucket, key string, data io.Reader) error
+}
+
+type objectStore interface {
+	objectUploader
+	GetObject(bucket, key string) (string, error)
+}
+
+type imageDigestGetter interface {
+	ImageDigest(repoName, tag string) (string, error)
+}
+
 type bucketEmptier interface {
 	EmptyBucket(bucket string) error
 }
@@ -307,9 +382,19 @@ type environmentDeployer interface {
 	GetEnvironment(appName, envName string) (*config.Environment, error)
 	EnvironmentTemplate(appName, envName string) (string, error)
 	UpdateEnvironmentTemplate(appName, envName, templateBody, cfnExecRoleARN string) error
+	envTerminationProtector
+}
+
+type envTerminationProtector interface {
+	SetEnvironmentTerminationProtection(appName, envName string, enabled bool) error
+}
+
+type wlTerminationProtector interface {
+	SetWorkloadTerminationProtection(appName, envName, name string, enabled bool) error
 }
 
 type wlDeleter interface {
+	wlTerminationProtector
 	DeleteWorkload(in deploy.DeleteWorkloadInput) error
 }
 
@@ -353,6 +438,38 @@ type taskDeployer interface {
 	DeployTask(input *deploy.CreateTaskResourcesInput, opts ...cloudformation.StackOption) error
 }
 
+type serviceDeployer interface {
+	DeployService(conf deploycfn.StackConfiguration, opts ...cloudformation.StackOption) error
+}
+
+type stackFailureAnalyzer interface {
+	LastOperationTime(stackName string) (time.Time, error)
+	Analyze(stackName string, since time.Time) (*describe.StackFailure, error)
+}
+
+type workloadDeployStreamer interface {
+	StreamServiceDeployment(conf deploycfn.StackConfiguration, opts ...cloudformation.StackOption) (<-chan []deploy.ResourceEvent, <-chan error)
+}
+
+type workloadDeployCanceller interface {
+	WorkloadStack(appName, envName, name string) (*cloudformation.StackDescription, error)
+	CancelWorkloadDeployment(appName, envName, name string) (<-chan []deploy.ResourceEvent, <-chan error)
+	ContinueWorkloadRollback(appName, envName, name string) (<-chan []deploy.ResourceEvent, <-chan error)
+	StreamWorkloadRollback(appName, envName, name string) (<-chan []deploy.ResourceEvent, <-chan error)
+}
+
+type deployedWorkloadGetter interface {
+	WorkloadTemplate(appName, envName, name string) (string, error)
+	WorkloadStack(appName, envName, name string) (*cloudformation.StackDescription, error)
+}
+
+type workloadPreviewer interface {
+	PreviewService(conf deploycfn.StackConfiguration, opts ...cloudformation.StackOption) ([]cloudformation.ResourceChange, error)
+	WorkloadTemplate(appName, envName, name string) (string, error)
+	WorkloadAddonsTemplate(appName, envName, name string) (string, error)
+	WorkloadStack(appName, envName, name string) (*cloudformation.StackDescription, error)
+}
+
 type taskRunner interface {
 	Run() ([]*task.Task, error)
 }
@@ -361,6 +478,50 @@ type defaultClusterGetter interface {
 	HasDefaultCluster() (bool, error)
 }
 
+type resourcesByTagsGetter interface {
+	GetResourcesByTags(resourceType string, tags map[string]string) ([]*resourcegroups.Resource, error)
+}
+
+type ecsCommandExecutor interface {
+	ExecuteCommand(in ecs.ExecuteCommandInput) (*ecs.Session, error)
+}
+
+type ecsServiceCommandExecutor interface {
+	ecsCommandExecutor
+	ServiceTasks(clusterName, serviceName string) ([]*ecs.Task, error)
+}
+
+type ecsTaskCommandExecutor interface {
+	ecsCommandExecutor
+	DefaultCluster() (string, error)
+	RunningTasksInFamily(clusterName, family string) ([]*ecs.Task, error)
+}
+
+type ecsServiceScaler interface {
+	Service(clusterName, serviceName string) (*ecs.Service, error)
+	UpdateServiceDesiredCount(clusterName, serviceName string, desiredCount int64) error
+}
+
+type ecsServiceTasksDescriber interface {
+	Service(clusterName, serviceName string) (*ecs.Service, error)
+	ServiceTasks(clusterName, serviceName string) ([]*ecs.Task, error)
+}
+
+type ecsServiceRolloutDescriber interface {
+	Service(clusterName, serviceName string) (*ecs.Service, error)
+	StoppedServiceTasks(clusterName, serviceName string) ([]*ecs.Task, error)
+}
+
+type serviceScalingSuspender interface {
+	SuspendECSServiceScaling(cluster, service string) error
+	ResumeECSServiceScaling(cluster, service string) error
+}
+
+type ssmSessionStarter interface {
+	ValidateInstalled() error
+	StartSession(sess *ecs.Session) error
+}
+
 type deployer interface {
 	environmentDeployer
 	appDeployer
@@ -393,6 +554,38 @@ type pipelineGetter interface {
 	ListPipelineNamesByTags(tags map[string]string) ([]string, error)
 }
 
+type pipelineStateGetter interface {
+	GetPipelineState(pipelineName string) (*codepipeline.PipelineState, error)
+}
+
+type pipelineExecutionGetter interface {
+	LatestExecutionID(pipelineName string) (string, error)
+	ExecutionStatus(pipelineName, executionID string) (string, error)
+}
+
+type pipelineExecutor interface {
+	StartExecution(pipelineName string) (string, error)
+	RetryStageExecution(pipelineName, stageName, executionID string) error
+}
+
+type buildActionLister interface {
+	BuildActions(pipelineName string) ([]*codepipeline.BuildAction, error)
+}
+
+type buildGetter interface {
+	LatestBuild(projectName string) (*codebuild.Build, error)
+	Builds(ids []string) ([]*codebuild.Build, error)
+}
+
+type buildLogsWriter interface {
+	WriteLogEvents(builds []*codebuild.Build, opts pipelinelogging.WriteLogEventsOpts) error
+}
+
+type cursorMover interface {
+	Up(n int)
+	EraseLine()
+}
+
 type executor interface {
 	Execute() error
 }
@@ -425,15 +618,22 @@ type deploySelector interface {
 	DeployedService(prompt, help string, app string, opts ...selector.GetDeployedServiceOpts) (*selector.DeployedService, error)
 }
 
+type deployEnvSelector interface {
+	deploySelector
+	Environment(prompt, help, app string, additionalOpts ...string) (string, error)
+}
+
 type wsSelector interface {
 	appEnvSelector
 	Service(prompt, help string) (string, error)
 	Job(prompt, help string) (string, error)
+	Workload(prompt, help string) (string, error)
 }
 
 type initJobSelector interface {
 	dockerfileSelector
 	Schedule(scheduleTypePrompt, scheduleTypeHelp string, scheduleValidator, rateValidator prompt.ValidatorFunc) (string, error)
+	Timezone(timezoneValidator prompt.ValidatorFunc) (string, error)
 }
 
 type dockerfileSelector interface {
@@ -51,6 +51,7 @@ type deleteJobVars struct {
 	skipConfirmation bool
 	name             string
 	envName          string
+	force            bool
 }
 
 type deleteJobOpts struct {
@@ -122,6 +123,9 @@ func (o *deleteJobOpts) Ask() error {
 	if err := o.askJobName(); err != nil {
 		return err
 	}
+	if err := o.confirmProdEnvs(); err != nil {
+		return err
+	}
 
 	if o.skipConfirmation {
 		return nil
@@ -227,6 +231,25 @@ func (o *deleteJobOpts) askJobName() error {
 	return nil
 }
 
+// confirmProdEnvs asks the user to type the name of each production environment the job is deleted from.
+// Production environments are confirmed even if the confirmation prompt is skipped.
+func (o *deleteJobOpts) confirmProdEnvs() error {
+	envs, err := o.appEnvironments()
+	if err != nil {
+		return err
+	}
+	guard := prodGuard{
+		force:  o.force,
+		prompt: o.prompt,
+	}
+	for _, env := range envs {
+		if err := guard.confirm(env, fmt.Sprintf("delete job %s from it", o.name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (o *deleteJobOpts) appEnvironments() ([]*config.Environment, error) {
 	var envs []*config.Environment
 	var err error
@@ -253,6 +276,9 @@ func (o *deleteJobOpts) deleteStacks(envs []*config.Environment) error {
 		}
 
 		cfClient := o.getJobCFN(sess)
+		if err := unprotectWorkload(cfClient, o.appName, env, o.name); err != nil {
+			return err
+		}
 		o.spinner.Start(fmt.Sprintf(fmtJobDeleteStart, o.name, env.Name))
 		if err := cfClient.DeleteWorkload(deploy.DeleteWorkloadInput{
 			Name:    o.name,
@@ -377,5 +403,6 @@ func buildJobDeleteCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&vars.force, forceFlag, false, forceFlagDescription)
 	return cmd
 }
@@ -121,9 +121,11 @@ func TestDeleteJobOpts_Ask(t *testing.T) {
 
 	tests := map[string]struct {
 		skipConfirmation bool
+		force            bool
 		inName           string
 		envName          string
 		appName          string
+		inProd           bool
 
 		mockPrompt func(m *mocks.Mockprompter)
 		mockSel    func(m *mocks.MockwsSelector)
@@ -250,6 +252,41 @@ func TestDeleteJobOpts_Ask(t *testing.T) {
 				).Times(1).Return(true, nil)
 			},
 
+			wantedName: testJobName,
+		},
+		"should require typing the name of production environments even if the confirmation is skipped": {
+			appName:          testAppName,
+			inName:           testJobName,
+			skipConfirmation: true,
+			inProd:           true,
+			mockSel:          func(m *mocks.MockwsSelector) {},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Any(), prodConfirmHelp, nil).Return("test", nil)
+			},
+
+			wantedName: testJobName,
+		},
+		"should return error if the name typed does not match the production environment": {
+			appName:          testAppName,
+			inName:           testJobName,
+			skipConfirmation: true,
+			inProd:           true,
+			mockSel:          func(m *mocks.MockwsSelector) {},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Any(), prodConfirmHelp, nil).Return("prod", nil)
+			},
+
+			wantedError: errProdConfirmationMismatch,
+		},
+		"should skip the production environment guardrails if forced": {
+			appName:          testAppName,
+			inName:           testJobName,
+			skipConfirmation: true,
+			force:            true,
+			inProd:           true,
+			mockSel:          func(m *mocks.MockwsSelector) {},
+			mockPrompt:       func(m *mocks.Mockprompter) {},
+
 			wantedName: testJobName,
 		},
 	}
@@ -263,6 +300,10 @@ func TestDeleteJobOpts_Ask(t *testing.T) {
 			mockSel := mocks.NewMockwsSelector(ctrl)
 			test.mockPrompt(mockPrompter)
 			test.mockSel(mockSel)
+			mockStore := mocks.NewMockstore(ctrl)
+			envs := []*config.Environment{{Name: "test", Prod: test.inProd}}
+			mockStore.EXPECT().GetEnvironment(gomock.Any(), "test").Return(envs[0], nil).AnyTimes()
+			mockStore.EXPECT().ListEnvironments(gomock.Any()).Return(envs, nil).AnyTimes()
 
 			opts := deleteJobOpts{
 				deleteJobVars: deleteJobVars{
@@ -270,7 +311,9 @@ func TestDeleteJobOpts_Ask(t *testing.T) {
 					appName:          test.appName,
 					name:             test.inName,
 					envName:          test.envName,
+					force:            test.force,
 				},
+				store:  mockStore,
 				prompt: mockPrompter,
 				sel:    mockSel,
 			}
@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/addon"
@@ -21,6 +22,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/command"
@@ -32,11 +34,16 @@ import (
 )
 
 type deployJobVars struct {
-	appName      string
-	name         string
-	envName      string
-	imageTag     string
-	resourceTags map[string]string
+	appName        string
+	name           string
+	envName        string
+	imageTag       string
+	resourceTags   map[string]string
+	dryRun         bool
+	force          bool
+	allowDirty     bool
+	allowAnyBranch bool
+	stealLock      bool
 }
 
 type deployJobOpts struct {
@@ -52,7 +59,12 @@ type deployJobOpts struct {
 	imageBuilderPusher imageBuilderPusher
 	sessProvider       sessionProvider
 	s3                 artifactUploader
+	previewer          workloadPreviewer
+	deployer           workloadDeployStreamer
+	protector          wlTerminationProtector
+	failureAnalyzer    stackFailureAnalyzer
 
+	w       io.Writer
 	spinner progress
 	sel     wsSelector
 	prompt  prompter
@@ -83,6 +95,7 @@ func newJobDeployOpts(vars deployJobVars) (*deployJobOpts, error) {
 		store:        store,
 		ws:           ws,
 		unmarshal:    manifest.UnmarshalWorkload,
+		w:            log.OutputWriter,
 		spinner:      termprogress.NewSpinner(),
 		sel:          selector.NewWorkspaceSelect(prompter, store, ws),
 		prompt:       prompter,
@@ -125,8 +138,9 @@ func (o *deployJobOpts) Ask() error {
 	return nil
 }
 
-// Execute builds and pushes the container image for the job.
-func (o *deployJobOpts) Execute() error {
+// Execute builds and pushes the container image for the job,
+// and deploys the job stack or, in a dry run, shows the changes the deployment would make.
+func (o *deployJobOpts) Execute() (err error) {
 	env, err := targetEnv(o.store, o.appName, o.envName)
 	if err != nil {
 		return err
@@ -145,6 +159,26 @@ func (o *deployJobOpts) Execute() error {
 	}
 	o.targetJob = job
 
+	if !o.dryRun {
+		guard := prodGuard{
+			force:          o.force,
+			allowDirty:     o.allowDirty,
+			allowAnyBranch: o.allowAnyBranch,
+			prompt:         o.prompt,
+			runner:         o.cmd,
+		}
+		if err := guard.confirmDeploy(env, fmt.Sprintf("deploy job %s to it", o.name)); err != nil {
+			return err
+		}
+		locker := newDeployLocker(o.store, o.spinner, o.stealLock)
+		var lock *config.DeployLock
+		lock, err = locker.acquire(o.appName, env.Name, o.name)
+		if err != nil {
+			return err
+		}
+		defer locker.releaseOnReturn(lock, &err)
+	}
+
 	if err := o.configureClients(); err != nil {
 		return err
 	}
@@ -153,6 +187,14 @@ func (o *deployJobOpts) Execute() error {
 		return err
 	}
 
+	if o.dryRun {
+		addons, addonsURL, err := dryRunAddons(o.addons, o.previewer, o.targetEnvironment, o.name)
+		if err != nil {
+			return err
+		}
+		return o.previewJob(addons, addonsURL)
+	}
+
 	addonsURL, err := o.pushAddonsTemplateToS3Bucket()
 	if err != nil {
 		return err
@@ -210,6 +252,10 @@ func (o *deployJobOpts) configureClients() error {
 
 	// CF client against env account profile AND target environment region
 	o.jobCFN = cloudformation.New(envSession)
+	o.previewer = o.jobCFN
+	o.deployer = o.jobCFN
+	o.protector = o.jobCFN
+	o.failureAnalyzer = describe.NewStackFailureAnalyzer(envSession)
 
 	addonsSvc, err := addon.New(o.name)
 	if err != nil {
@@ -238,6 +284,11 @@ func (o *deployJobOpts) configureContainerImage() error {
 	if !required {
 		return nil
 	}
+	if o.dryRun {
+		// The stack only references the image, so it doesn't need to be pushed to preview the changes.
+		o.buildRequired = true
+		return nil
+	}
 	// If it is built from local Dockerfile, build and push to the ECR repo.
 	buildArg, err := o.dfBuildArgs(job)
 	if err != nil {
@@ -269,15 +320,38 @@ func (o *deployJobOpts) deployJob(addonsURL string) error {
 			color.HighlightUserInput(o.targetEnvironment.Name),
 		),
 	)
-	if err := o.jobCFN.DeployService(conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN)); err != nil {
+	since := lastStackOperationTime(o.failureAnalyzer, conf.StackName())
+	events, errs := o.deployer.StreamServiceDeployment(conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN))
+	// Jobs don't have an ECS service, only the progress of their resources is displayed.
+	if err := followWorkloadDeployment(o.spinner, conf.StackName(), events, errs, nil); err != nil {
 		o.spinner.Stop(log.Serrorf("Failed to deploy job.\n"))
+		logStackFailure(o.failureAnalyzer, conf.StackName(), since)
 		return fmt.Errorf("deploy job: %w", err)
 	}
 	o.spinner.Stop("\n")
+	if err := protectWorkload(o.protector, o.appName, o.targetEnvironment, o.name); err != nil {
+		return err
+	}
 	log.Successf("Deployed %s.\n", color.HighlightUserInput(o.name))
 	return nil
 }
 
+func (o *deployJobOpts) previewJob(addons, addonsURL string) error {
+	conf, err := o.stackConfiguration(addonsURL)
+	if err != nil {
+		return err
+	}
+	return previewWorkload(previewWorkloadInput{
+		w:         o.w,
+		spinner:   o.spinner,
+		previewer: o.previewer,
+		env:       o.targetEnvironment,
+		name:      o.name,
+		stack:     conf,
+		addons:    addons,
+	})
+}
+
 func (o *deployJobOpts) stackConfiguration(addonsURL string) (cloudformation.StackConfiguration, error) {
 	mft, err := o.manifest()
 	if err != nil {
@@ -403,7 +477,11 @@ func buildJobDeployCmd() *cobra.Command {
   Deploys a job named "report-gen" to a "test" environment.
   /code $ copilot job deploy --name report-gen --env test
   Deploys a job with additional resource tags.
-  /code $ copilot job deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot job deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Shows the changes that deploying the "report-gen" job to the "prod" environment would make.
+  /code $ copilot job deploy --name report-gen --env prod --dry-run
+  Deploys the "report-gen" job to the "prod" production environment without the guardrails.
+  /code $ copilot job deploy --name report-gen --env prod --force`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newJobDeployOpts(vars)
 			if err != nil {
@@ -426,6 +504,11 @@ func buildJobDeployCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, dryRunFlagDescription)
+	cmd.Flags().BoolVar(&vars.force, forceFlag, false, forceFlagDescription)
+	cmd.Flags().BoolVar(&vars.allowDirty, allowDirtyFlag, false, allowDirtyFlagDescription)
+	cmd.Flags().BoolVar(&vars.allowAnyBranch, allowAnyBranchFlag, false, allowAnyBranchFlagDescription)
+	cmd.Flags().BoolVar(&vars.stealLock, stealLockFlag, false, stealLockFlagDescription)
 
 	return cmd
 }
@@ -220,6 +220,7 @@ image:
 
 	tests := map[string]struct {
 		inputSvc   string
+		inDryRun   bool
 		setupMocks func(mocks deployJobMocks)
 
 		wantErr error
@@ -253,6 +254,16 @@ image:
 				)
 			},
 		},
+		"should not build and push in a dry run": {
+			inputSvc: "mailer",
+			inDryRun: true,
+			setupMocks: func(m deployJobMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadJobManifest("mailer").Return(mockManifest, nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0),
+				)
+			},
+		},
 		"should return error if fail to build and push": {
 			inputSvc: "mailer",
 			setupMocks: func(m deployJobMocks) {
@@ -319,7 +330,8 @@ image:
 			test.setupMocks(mocks)
 			opts := deployJobOpts{
 				deployJobVars: deployJobVars{
-					name: test.inputSvc,
+					name:   test.inputSvc,
+					dryRun: test.inDryRun,
 				},
 				unmarshal:          manifest.UnmarshalWorkload,
 				imageBuilderPusher: mockimageBuilderPusher,
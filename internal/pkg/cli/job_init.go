@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
@@ -48,6 +49,7 @@ type initJobVars struct {
 	timeout        string
 	retries        int
 	schedule       string
+	timezone       string
 	jobType        string
 }
 
@@ -123,6 +125,11 @@ func (o *initJobOpts) Validate() error {
 			return err
 		}
 	}
+	if o.timezone != "" {
+		if err := validateTimezone(o.timezone); err != nil {
+			return err
+		}
+	}
 	if o.timeout != "" {
 		if err := validateTimeout(o.timeout); err != nil {
 			return err
@@ -234,6 +241,7 @@ func (o *initJobOpts) newJobManifest() (*manifest.ScheduledJob, error) {
 			Image:      o.image,
 		},
 		Schedule: o.schedule,
+		Timezone: o.timezone,
 		Timeout:  o.timeout,
 		Retries:  o.retries,
 	}), nil
@@ -318,6 +326,19 @@ func (o *initJobOpts) askSchedule() error {
 	}
 
 	o.schedule = schedule
+	return o.askTimezone()
+}
+
+func (o *initJobOpts) askTimezone() error {
+	// Rates run at a fixed interval regardless of the time zone.
+	if o.timezone != "" || strings.HasPrefix(o.schedule, "@every ") || strings.HasPrefix(o.schedule, "rate(") {
+		return nil
+	}
+	timezone, err := o.sel.Timezone(validateTimezone)
+	if err != nil {
+		return fmt.Errorf("get time zone: %w", err)
+	}
+	o.timezone = timezone
 	return nil
 }
 
@@ -342,7 +363,10 @@ func buildJobInitCmd() *cobra.Command {
   /code $ copilot job init --name reaper --dockerfile ./frontend/Dockerfile --schedule "every 2 hours"
 
   Create a "report-generator" scheduled task with retries.
-  /code $ copilot job init --name report-generator --schedule "@monthly" --retries 3 --timeout 900s`,
+  /code $ copilot job init --name report-generator --schedule "@monthly" --retries 3 --timeout 900s
+
+  Create a "digest" scheduled task that runs at 9 am Berlin time on weekdays.
+  /code $ copilot job init --name digest --schedule "0 9 * * MON-FRI" --timezone Europe/Berlin`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitJobOpts(vars)
 			if err != nil {
@@ -369,6 +393,7 @@ func buildJobInitCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.jobType, jobTypeFlag, jobTypeFlagShort, "", jobTypeFlagDescription)
 	cmd.Flags().StringVarP(&vars.dockerfilePath, dockerFileFlag, dockerFileFlagShort, "", dockerFileFlagDescription)
 	cmd.Flags().StringVarP(&vars.schedule, scheduleFlag, scheduleFlagShort, "", scheduleFlagDescription)
+	cmd.Flags().StringVar(&vars.timezone, timezoneFlag, "", timezoneFlagDescription)
 	cmd.Flags().StringVar(&vars.timeout, timeoutFlag, "", timeoutFlagDescription)
 	cmd.Flags().IntVar(&vars.retries, retriesFlag, 0, retriesFlagDescription)
 	cmd.Flags().StringVarP(&vars.image, imageFlag, imageFlagShort, "", imageFlagDescription)
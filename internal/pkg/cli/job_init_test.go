@@ -27,6 +27,7 @@ func TestJobInitOpts_Validate(t *testing.T) {
 		inTimeout        string
 		inRetries        int
 		inSchedule       string
+		inTimezone       string
 
 		mockFileSystem func(mockFS afero.Fs)
 		wantedErr      error
@@ -99,6 +100,17 @@ func TestJobInitOpts_Validate(t *testing.T) {
 			inTimeout: "0s",
 			wantedErr: errors.New("timeout value 0s is invalid: duration must be 1s or greater"),
 		},
+		"invalid time zone": {
+			inTimezone: "Berlin",
+			wantedErr:  errors.New("time zone Berlin is invalid: must be an IANA time zone name such as Europe/Berlin"),
+		},
+		"local time zone": {
+			inTimezone: "Local",
+			wantedErr:  errors.New("time zone Local is invalid: must be an IANA time zone name such as Europe/Berlin"),
+		},
+		"valid time zone": {
+			inTimezone: "Europe/Berlin",
+		},
 		"invalid number of times to retry": {
 			inRetries: -3,
 			wantedErr: errors.New("number of retries must be non-negative"),
@@ -120,6 +132,7 @@ func TestJobInitOpts_Validate(t *testing.T) {
 					timeout:        tc.inTimeout,
 					retries:        tc.inRetries,
 					schedule:       tc.inSchedule,
+					timezone:       tc.inTimezone,
 				},
 				fs: &afero.Afero{Fs: afero.NewMemMapFs()},
 			}
@@ -160,6 +173,7 @@ func TestJobInitOpts_Ask(t *testing.T) {
 
 		wantedErr      error
 		wantedSchedule string
+		wantedTimezone string
 	}{
 		"prompt for job name": {
 			inJobType:        wantedJobType,
@@ -303,10 +317,39 @@ func TestJobInitOpts_Ask(t *testing.T) {
 					gomock.Any(),
 					gomock.Any(),
 				).Return(wantedCronSchedule, nil)
+				m.EXPECT().Timezone(gomock.Any()).Return("Europe/Berlin", nil)
 			},
 			mockPrompt:     func(m *mocks.Mockprompter) {},
 			wantedErr:      nil,
 			wantedSchedule: wantedCronSchedule,
+			wantedTimezone: "Europe/Berlin",
+		},
+		"does not ask for time zone for rates": {
+			inJobType:        wantedJobType,
+			inJobName:        wantedJobName,
+			inDockerfilePath: wantedDockerfilePath,
+			inJobSchedule:    "",
+
+			mockFileSystem: func(mockFS afero.Fs) {},
+			mockSel: func(m *mocks.MockinitJobSelector) {
+				m.EXPECT().Schedule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("@every 1h", nil)
+			},
+			mockPrompt:     func(m *mocks.Mockprompter) {},
+			wantedSchedule: "@every 1h",
+		},
+		"error getting time zone": {
+			inJobType:        wantedJobType,
+			inJobName:        wantedJobName,
+			inDockerfilePath: wantedDockerfilePath,
+			inJobSchedule:    "",
+
+			mockFileSystem: func(mockFS afero.Fs) {},
+			mockSel: func(m *mocks.MockinitJobSelector) {
+				m.EXPECT().Schedule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(wantedCronSchedule, nil)
+				m.EXPECT().Timezone(gomock.Any()).Return("", errors.New("some error"))
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			wantedErr:  fmt.Errorf("get time zone: some error"),
 		},
 		"error getting schedule": {
 			inJobType:        wantedJobType,
@@ -370,6 +413,7 @@ func TestJobInitOpts_Ask(t *testing.T) {
 				require.Equal(t, wantedImage, opts.image)
 			}
 			require.Equal(t, tc.wantedSchedule, opts.schedule)
+			require.Equal(t, tc.wantedTimezone, opts.timezone)
 		})
 	}
 }
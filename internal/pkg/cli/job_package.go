@@ -5,10 +5,15 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
 
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/term/command"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
@@ -37,6 +42,10 @@ type packageJobOpts struct {
 	runner runner
 	sel    wsSelector
 	prompt prompter
+
+	// Subcommand that generates the job's templates, jobs are packaged the same way as services.
+	packageCmd    executor
+	newPackageCmd func(*packageJobOpts)
 }
 
 func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
@@ -48,6 +57,10 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("connect to config store: %w", err)
 	}
+	sess, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve default session: %w", err)
+	}
 	prompter := prompt.New()
 	opts := &packageJobOpts{
 		packageJobVars: vars,
@@ -57,6 +70,27 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 		sel:            selector.NewWorkspaceSelect(prompter, store, ws),
 		prompt:         prompter,
 	}
+	opts.newPackageCmd = func(o *packageJobOpts) {
+		o.packageCmd = &packageSvcOpts{
+			packageSvcVars: packageSvcVars{
+				name:      o.name,
+				envName:   o.envName,
+				appName:   o.appName,
+				tag:       o.tag,
+				outputDir: o.outputDir,
+			},
+			initAddonsSvc:   initPackageAddonsSvc,
+			ws:              ws,
+			store:           o.store,
+			appCFN:          cloudformation.New(sess),
+			runner:          o.runner,
+			stackWriter:     os.Stdout,
+			paramsWriter:    ioutil.Discard,
+			addonsWriter:    ioutil.Discard,
+			fs:              &afero.Afero{Fs: afero.NewOsFs()},
+			stackSerializer: newWorkloadStackSerializer,
+		}
+	}
 	return opts, nil
 }
 
@@ -98,9 +132,10 @@ func (o *packageJobOpts) Ask() error {
 	return nil
 }
 
-// Execute prints the CloudFormation template of the application for the environment.
+// Execute prints the CloudFormation template of the job for the environment.
 func (o *packageJobOpts) Execute() error {
-	return nil
+	o.newPackageCmd(o)
+	return o.packageCmd.Execute()
 }
 
 func (o *packageJobOpts) askJobName() error {
@@ -236,3 +236,59 @@ func TestPackageJobOpts_Ask(t *testing.T) {
 		})
 	}
 }
+
+func TestPackageJobOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(*gomock.Controller, *packageJobOpts)
+
+		wantedErr error
+	}{
+		"writes job template by delegating to the package command": {
+			mockDependencies: func(ctrl *gomock.Controller, opts *packageJobOpts) {
+				opts.newPackageCmd = func(o *packageJobOpts) {
+					require.Equal(t, "resizer", o.name)
+					require.Equal(t, "test", o.envName)
+					mockCmd := mocks.NewMockexecutor(ctrl)
+					mockCmd.EXPECT().Execute().Return(nil)
+					o.packageCmd = mockCmd
+				}
+			},
+		},
+		"returns the error from the package command": {
+			mockDependencies: func(ctrl *gomock.Controller, opts *packageJobOpts) {
+				opts.newPackageCmd = func(o *packageJobOpts) {
+					mockCmd := mocks.NewMockexecutor(ctrl)
+					mockCmd.EXPECT().Execute().Return(errors.New("some error"))
+					o.packageCmd = mockCmd
+				}
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			opts := &packageJobOpts{
+				packageJobVars: packageJobVars{
+					name:    "resizer",
+					envName: "test",
+					appName: "phonetool",
+				},
+			}
+			tc.mockDependencies(ctrl, opts)
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
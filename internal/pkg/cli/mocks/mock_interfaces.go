@@ -8,14 +8,19 @@ import (
 	encoding "encoding"
 	session "github.com/aws/aws-sdk-go/aws/session"
 	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	codebuild "github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
 	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	resourcegroups "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
 	deploy "github.com/aws/copilot-cli/internal/pkg/deploy"
+	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	stack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	describe "github.com/aws/copilot-cli/internal/pkg/describe"
 	docker "github.com/aws/copilot-cli/internal/pkg/docker"
 	dockerfile "github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
 	ecslogging "github.com/aws/copilot-cli/internal/pkg/ecslogging"
+	pipelinelogging "github.com/aws/copilot-cli/internal/pkg/pipelinelogging"
 	repository "github.com/aws/copilot-cli/internal/pkg/repository"
 	task "github.com/aws/copilot-cli/internal/pkg/task"
 	command "github.com/aws/copilot-cli/internal/pkg/term/command"
@@ -25,6 +30,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	io "io"
 	reflect "reflect"
+	time "time"
 )
 
 // MockactionCommand is a mock of actionCommand interface
@@ -187,6 +193,217 @@ func (mr *MockserviceStoreMockRecorder) DeleteService(appName, svcName interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteService", reflect.TypeOf((*MockserviceStore)(nil).DeleteService), appName, svcName)
 }
 
+// MockpausedServiceStore is a mock of pausedServiceStore interface
+type MockpausedServiceStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockpausedServiceStoreMockRecorder
+}
+
+// MockpausedServiceStoreMockRecorder is the mock recorder for MockpausedServiceStore
+type MockpausedServiceStoreMockRecorder struct {
+	mock *MockpausedServiceStore
+}
+
+// NewMockpausedServiceStore creates a new mock instance
+func NewMockpausedServiceStore(ctrl *gomock.Controller) *MockpausedServiceStore {
+	mock := &MockpausedServiceStore{ctrl: ctrl}
+	mock.recorder = &MockpausedServiceStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockpausedServiceStore) EXPECT() *MockpausedServiceStoreMockRecorder {
+	return m.recorder
+}
+
+// PauseService mocks base method
+func (m *MockpausedServiceStore) PauseService(paused *config.PausedService) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseService", paused)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseService indicates an expected call of PauseService
+func (mr *MockpausedServiceStoreMockRecorder) PauseService(paused interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseService", reflect.TypeOf((*MockpausedServiceStore)(nil).PauseService), paused)
+}
+
+// GetPausedService mocks base method
+func (m *MockpausedServiceStore) GetPausedService(appName, envName, svcName string) (*config.PausedService, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPausedService", appName, envName, svcName)
+	ret0, _ := ret[0].(*config.PausedService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPausedService indicates an expected call of GetPausedService
+func (mr *MockpausedServiceStoreMockRecorder) GetPausedService(appName, envName, svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPausedService", reflect.TypeOf((*MockpausedServiceStore)(nil).GetPausedService), appName, envName, svcName)
+}
+
+// DeletePausedService mocks base method
+func (m *MockpausedServiceStore) DeletePausedService(appName, envName, svcName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePausedService", appName, envName, svcName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePausedService indicates an expected call of DeletePausedService
+func (mr *MockpausedServiceStoreMockRecorder) DeletePausedService(appName, envName, svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePausedService", reflect.TypeOf((*MockpausedServiceStore)(nil).DeletePausedService), appName, envName, svcName)
+}
+
+// MocksvcDeploymentStore is a mock of svcDeploymentStore interface
+type MocksvcDeploymentStore struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcDeploymentStoreMockRecorder
+}
+
+// MocksvcDeploymentStoreMockRecorder is the mock recorder for MocksvcDeploymentStore
+type MocksvcDeploymentStoreMockRecorder struct {
+	mock *MocksvcDeploymentStore
+}
+
+// NewMocksvcDeploymentStore creates a new mock instance
+func NewMocksvcDeploymentStore(ctrl *gomock.Controller) *MocksvcDeploymentStore {
+	mock := &MocksvcDeploymentStore{ctrl: ctrl}
+	mock.recorder = &MocksvcDeploymentStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcDeploymentStore) EXPECT() *MocksvcDeploymentStoreMockRecorder {
+	return m.recorder
+}
+
+// CreateServiceDeployment mocks base method
+func (m *MocksvcDeploymentStore) CreateServiceDeployment(deployment *config.ServiceDeployment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceDeployment", deployment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateServiceDeployment indicates an expected call of CreateServiceDeployment
+func (mr *MocksvcDeploymentStoreMockRecorder) CreateServiceDeployment(deployment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceDeployment", reflect.TypeOf((*MocksvcDeploymentStore)(nil).CreateServiceDeployment), deployment)
+}
+
+// ListServiceDeployments mocks base method
+func (m *MocksvcDeploymentStore) ListServiceDeployments(appName, envName, svcName string) ([]*config.ServiceDeployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServiceDeployments", appName, envName, svcName)
+	ret0, _ := ret[0].([]*config.ServiceDeployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceDeployments indicates an expected call of ListServiceDeployments
+func (mr *MocksvcDeploymentStoreMockRecorder) ListServiceDeployments(appName, envName, svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceDeployments", reflect.TypeOf((*MocksvcDeploymentStore)(nil).ListServiceDeployments), appName, envName, svcName)
+}
+
+// DeleteServiceDeployment mocks base method
+func (m *MocksvcDeploymentStore) DeleteServiceDeployment(appName, envName, svcName, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServiceDeployment", appName, envName, svcName, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteServiceDeployment indicates an expected call of DeleteServiceDeployment
+func (mr *MocksvcDeploymentStoreMockRecorder) DeleteServiceDeployment(appName, envName, svcName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceDeployment", reflect.TypeOf((*MocksvcDeploymentStore)(nil).DeleteServiceDeployment), appName, envName, svcName, id)
+}
+
+// MockdeployLockStore is a mock of deployLockStore interface
+type MockdeployLockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployLockStoreMockRecorder
+}
+
+// MockdeployLockStoreMockRecorder is the mock recorder for MockdeployLockStore
+type MockdeployLockStoreMockRecorder struct {
+	mock *MockdeployLockStore
+}
+
+// NewMockdeployLockStore creates a new mock instance
+func NewMockdeployLockStore(ctrl *gomock.Controller) *MockdeployLockStore {
+	mock := &MockdeployLockStore{ctrl: ctrl}
+	mock.recorder = &MockdeployLockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockdeployLockStore) EXPECT() *MockdeployLockStoreMockRecorder {
+	return m.recorder
+}
+
+// AcquireDeployLock mocks base method
+func (m *MockdeployLockStore) AcquireDeployLock(lock *config.DeployLock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireDeployLock", lock)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcquireDeployLock indicates an expected call of AcquireDeployLock
+func (mr *MockdeployLockStoreMockRecorder) AcquireDeployLock(lock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireDeployLock", reflect.TypeOf((*MockdeployLockStore)(nil).AcquireDeployLock), lock)
+}
+
+// StealDeployLock mocks base method
+func (m *MockdeployLockStore) StealDeployLock(lock *config.DeployLock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StealDeployLock", lock)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StealDeployLock indicates an expected call of StealDeployLock
+func (mr *MockdeployLockStoreMockRecorder) StealDeployLock(lock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StealDeployLock", reflect.TypeOf((*MockdeployLockStore)(nil).StealDeployLock), lock)
+}
+
+// RefreshDeployLock mocks base method
+func (m *MockdeployLockStore) RefreshDeployLock(lock *config.DeployLock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshDeployLock", lock)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshDeployLock indicates an expected call of RefreshDeployLock
+func (mr *MockdeployLockStoreMockRecorder) RefreshDeployLock(lock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshDeployLock", reflect.TypeOf((*MockdeployLockStore)(nil).RefreshDeployLock), lock)
+}
+
+// ReleaseDeployLock mocks base method
+func (m *MockdeployLockStore) ReleaseDeployLock(lock *config.DeployLock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDeployLock", lock)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseDeployLock indicates an expected call of ReleaseDeployLock
+func (mr *MockdeployLockStoreMockRecorder) ReleaseDeployLock(lock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDeployLock", reflect.TypeOf((*MockdeployLockStore)(nil).ReleaseDeployLock), lock)
+}
+
 // MockjobStore is a mock of jobStore interface
 type MockjobStore struct {
 	ctrl     *gomock.Controller
@@ -964,6 +1181,148 @@ func (mr *MockstoreMockRecorder) DeleteService(appName, svcName interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteService", reflect.TypeOf((*Mockstore)(nil).DeleteService), appName, svcName)
 }
 
+// PauseService mocks base method
+func (m *Mockstore) PauseService(paused *config.PausedService) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseService", paused)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseService indicates an expected call of PauseService
+func (mr *MockstoreMockRecorder) PauseService(paused interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseService", reflect.TypeOf((*Mockstore)(nil).PauseService), paused)
+}
+
+// GetPausedService mocks base method
+func (m *Mockstore) GetPausedService(appName, envName, svcName string) (*config.PausedService, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPausedService", appName, envName, svcName)
+	ret0, _ := ret[0].(*config.PausedService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPausedService indicates an expected call of GetPausedService
+func (mr *MockstoreMockRecorder) GetPausedService(appName, envName, svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPausedService", reflect.TypeOf((*Mockstore)(nil).GetPausedService), appName, envName, svcName)
+}
+
+// DeletePausedService mocks base method
+func (m *Mockstore) DeletePausedService(appName, envName, svcName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePausedService", appName, envName, svcName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePausedService indicates an expected call of DeletePausedService
+func (mr *MockstoreMockRecorder) DeletePausedService(appName, envName, svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePausedService", reflect.TypeOf((*Mockstore)(nil).DeletePausedService), appName, envName, svcName)
+}
+
+// CreateServiceDeployment mocks base method
+func (m *Mockstore) CreateServiceDeployment(deployment *config.ServiceDeployment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceDeployment", deployment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateServiceDeployment indicates an expected call of CreateServiceDeployment
+func (mr *MockstoreMockRecorder) CreateServiceDeployment(deployment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceDeployment", reflect.TypeOf((*Mockstore)(nil).CreateServiceDeployment), deployment)
+}
+
+// ListServiceDeployments mocks base method
+func (m *Mockstore) ListServiceDeployments(appName, envName, svcName string) ([]*config.ServiceDeployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServiceDeployments", appName, envName, svcName)
+	ret0, _ := ret[0].([]*config.ServiceDeployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceDeployments indicates an expected call of ListServiceDeployments
+func (mr *MockstoreMockRecorder) ListServiceDeployments(appName, envName, svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceDeployments", reflect.TypeOf((*Mockstore)(nil).ListServiceDeployments), appName, envName, svcName)
+}
+
+// DeleteServiceDeployment mocks base method
+func (m *Mockstore) DeleteServiceDeployment(appName, envName, svcName, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServiceDeployment", appName, envName, svcName, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteServiceDeployment indicates an expected call of DeleteServiceDeployment
+func (mr *MockstoreMockRecorder) DeleteServiceDeployment(appName, envName, svcName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceDeployment", reflect.TypeOf((*Mockstore)(nil).DeleteServiceDeployment), appName, envName, svcName, id)
+}
+
+// AcquireDeployLock mocks base method
+func (m *Mockstore) AcquireDeployLock(lock *config.DeployLock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireDeployLock", lock)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcquireDeployLock indicates an expected call of AcquireDeployLock
+func (mr *MockstoreMockRecorder) AcquireDeployLock(lock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireDeployLock", reflect.TypeOf((*Mockstore)(nil).AcquireDeployLock), lock)
+}
+
+// StealDeployLock mocks base method
+func (m *Mockstore) StealDeployLock(lock *config.DeployLock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StealDeployLock", lock)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StealDeployLock indicates an expected call of StealDeployLock
+func (mr *MockstoreMockRecorder) StealDeployLock(lock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StealDeployLock", reflect.TypeOf((*Mockstore)(nil).StealDeployLock), lock)
+}
+
+// RefreshDeployLock mocks base method
+func (m *Mockstore) RefreshDeployLock(lock *config.DeployLock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshDeployLock", lock)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshDeployLock indicates an expected call of RefreshDeployLock
+func (mr *MockstoreMockRecorder) RefreshDeployLock(lock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshDeployLock", reflect.TypeOf((*Mockstore)(nil).RefreshDeployLock), lock)
+}
+
+// ReleaseDeployLock mocks base method
+func (m *Mockstore) ReleaseDeployLock(lock *config.DeployLock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDeployLock", lock)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseDeployLock indicates an expected call of ReleaseDeployLock
+func (mr *MockstoreMockRecorder) ReleaseDeployLock(lock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDeployLock", reflect.TypeOf((*Mockstore)(nil).ReleaseDeployLock), lock)
+}
+
 // CreateJob mocks base method
 func (m *Mockstore) CreateJob(job *config.Workload) error {
 	m.ctrl.T.Helper()
@@ -1254,6 +1613,109 @@ func (mr *MockimageBuilderPusherMockRecorder) BuildAndPush(docker, args interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAndPush", reflect.TypeOf((*MockimageBuilderPusher)(nil).BuildAndPush), docker, args)
 }
 
+// MockimageBuilderTagPusher is a mock of imageBuilderTagPusher interface
+type MockimageBuilderTagPusher struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageBuilderTagPusherMockRecorder
+}
+
+// MockimageBuilderTagPusherMockRecorder is the mock recorder for MockimageBuilderTagPusher
+type MockimageBuilderTagPusherMockRecorder struct {
+	mock *MockimageBuilderTagPusher
+}
+
+// NewMockimageBuilderTagPusher creates a new mock instance
+func NewMockimageBuilderTagPusher(ctrl *gomock.Controller) *MockimageBuilderTagPusher {
+	mock := &MockimageBuilderTagPusher{ctrl: ctrl}
+	mock.recorder = &MockimageBuilderTagPusherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockimageBuilderTagPusher) EXPECT() *MockimageBuilderTagPusherMockRecorder {
+	return m.recorder
+}
+
+// BuildAndPush mocks base method
+func (m *MockimageBuilderTagPusher) BuildAndPush(docker repository.ContainerLoginBuildPusher, args *docker.BuildArguments) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildAndPush", docker, args)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BuildAndPush indicates an expected call of BuildAndPush
+func (mr *MockimageBuilderTagPusherMockRecorder) BuildAndPush(docker, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAndPush", reflect.TypeOf((*MockimageBuilderTagPusher)(nil).BuildAndPush), docker, args)
+}
+
+// TagAndPush mocks base method
+func (m *MockimageBuilderTagPusher) TagAndPush(docker repository.ContainerLoginBuildPusher, args *docker.BuildArguments) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagAndPush", docker, args)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TagAndPush indicates an expected call of TagAndPush
+func (mr *MockimageBuilderTagPusherMockRecorder) TagAndPush(docker, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagAndPush", reflect.TypeOf((*MockimageBuilderTagPusher)(nil).TagAndPush), docker, args)
+}
+
+// MockimagePullPusher is a mock of imagePullPusher interface
+type MockimagePullPusher struct {
+	ctrl     *gomock.Controller
+	recorder *MockimagePullPusherMockRecorder
+}
+
+// MockimagePullPusherMockRecorder is the mock recorder for MockimagePullPusher
+type MockimagePullPusherMockRecorder struct {
+	mock *MockimagePullPusher
+}
+
+// NewMockimagePullPusher creates a new mock instance
+func NewMockimagePullPusher(ctrl *gomock.Controller) *MockimagePullPusher {
+	mock := &MockimagePullPusher{ctrl: ctrl}
+	mock.recorder = &MockimagePullPusherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockimagePullPusher) EXPECT() *MockimagePullPusherMockRecorder {
+	return m.recorder
+}
+
+// Pull mocks base method
+func (m *MockimagePullPusher) Pull(docker repository.ContainerLoginPullPusher, digest string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pull", docker, digest)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pull indicates an expected call of Pull
+func (mr *MockimagePullPusherMockRecorder) Pull(docker, digest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pull", reflect.TypeOf((*MockimagePullPusher)(nil).Pull), docker, digest)
+}
+
+// PushImage mocks base method
+func (m *MockimagePullPusher) PushImage(docker repository.ContainerLoginPullPusher, image, imageTag string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PushImage", docker, image, imageTag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PushImage indicates an expected call of PushImage
+func (mr *MockimagePullPusherMockRecorder) PushImage(docker, image, imageTag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushImage", reflect.TypeOf((*MockimagePullPusher)(nil).PushImage), docker, image, imageTag)
+}
+
 // MockrepositoryURIGetter is a mock of repositoryURIGetter interface
 type MockrepositoryURIGetter struct {
 	ctrl     *gomock.Controller
@@ -2300,18 +2762,56 @@ func (m *MockwsPipelineManifestReader) EXPECT() *MockwsPipelineManifestReaderMoc
 }
 
 // ReadPipelineManifest mocks base method
-func (m *MockwsPipelineManifestReader) ReadPipelineManifest() ([]byte, error) {
+func (m *MockwsPipelineManifestReader) ReadPipelineManifest(path string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadPipelineManifest")
+	ret := m.ctrl.Call(m, "ReadPipelineManifest", path)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReadPipelineManifest indicates an expected call of ReadPipelineManifest
-func (mr *MockwsPipelineManifestReaderMockRecorder) ReadPipelineManifest() *gomock.Call {
+func (mr *MockwsPipelineManifestReaderMockRecorder) ReadPipelineManifest(path interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineManifestReader)(nil).ReadPipelineManifest))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineManifestReader)(nil).ReadPipelineManifest), path)
+}
+
+// MockwsPipelineLister is a mock of wsPipelineLister interface
+type MockwsPipelineLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsPipelineListerMockRecorder
+}
+
+// MockwsPipelineListerMockRecorder is the mock recorder for MockwsPipelineLister
+type MockwsPipelineListerMockRecorder struct {
+	mock *MockwsPipelineLister
+}
+
+// NewMockwsPipelineLister creates a new mock instance
+func NewMockwsPipelineLister(ctrl *gomock.Controller) *MockwsPipelineLister {
+	mock := &MockwsPipelineLister{ctrl: ctrl}
+	mock.recorder = &MockwsPipelineListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsPipelineLister) EXPECT() *MockwsPipelineListerMockRecorder {
+	return m.recorder
+}
+
+// ListPipelines mocks base method
+func (m *MockwsPipelineLister) ListPipelines() ([]workspace.PipelineManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPipelines")
+	ret0, _ := ret[0].([]workspace.PipelineManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPipelines indicates an expected call of ListPipelines
+func (mr *MockwsPipelineListerMockRecorder) ListPipelines() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelines", reflect.TypeOf((*MockwsPipelineLister)(nil).ListPipelines))
 }
 
 // MockwsPipelineWriter is a mock of wsPipelineWriter interface
@@ -2338,33 +2838,33 @@ func (m *MockwsPipelineWriter) EXPECT() *MockwsPipelineWriterMockRecorder {
 }
 
 // WritePipelineBuildspec mocks base method
-func (m *MockwsPipelineWriter) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler) (string, error) {
+func (m *MockwsPipelineWriter) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler, name string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WritePipelineBuildspec", marshaler)
+	ret := m.ctrl.Call(m, "WritePipelineBuildspec", marshaler, name)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // WritePipelineBuildspec indicates an expected call of WritePipelineBuildspec
-func (mr *MockwsPipelineWriterMockRecorder) WritePipelineBuildspec(marshaler interface{}) *gomock.Call {
+func (mr *MockwsPipelineWriterMockRecorder) WritePipelineBuildspec(marshaler, name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineBuildspec", reflect.TypeOf((*MockwsPipelineWriter)(nil).WritePipelineBuildspec), marshaler)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineBuildspec", reflect.TypeOf((*MockwsPipelineWriter)(nil).WritePipelineBuildspec), marshaler, name)
 }
 
 // WritePipelineManifest mocks base method
-func (m *MockwsPipelineWriter) WritePipelineManifest(marshaler encoding.BinaryMarshaler) (string, error) {
+func (m *MockwsPipelineWriter) WritePipelineManifest(marshaler encoding.BinaryMarshaler, name string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WritePipelineManifest", marshaler)
+	ret := m.ctrl.Call(m, "WritePipelineManifest", marshaler, name)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // WritePipelineManifest indicates an expected call of WritePipelineManifest
-func (mr *MockwsPipelineWriterMockRecorder) WritePipelineManifest(marshaler interface{}) *gomock.Call {
+func (mr *MockwsPipelineWriterMockRecorder) WritePipelineManifest(marshaler, name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineManifest", reflect.TypeOf((*MockwsPipelineWriter)(nil).WritePipelineManifest), marshaler)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineManifest", reflect.TypeOf((*MockwsPipelineWriter)(nil).WritePipelineManifest), marshaler, name)
 }
 
 // MockwsServiceLister is a mock of wsServiceLister interface
@@ -2685,6 +3185,201 @@ func (mr *MockwsJobDirReaderMockRecorder) CopilotDirPath() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopilotDirPath", reflect.TypeOf((*MockwsJobDirReader)(nil).CopilotDirPath))
 }
 
+// MockwsWorkloadReader is a mock of wsWorkloadReader interface
+type MockwsWorkloadReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsWorkloadReaderMockRecorder
+}
+
+// MockwsWorkloadReaderMockRecorder is the mock recorder for MockwsWorkloadReader
+type MockwsWorkloadReaderMockRecorder struct {
+	mock *MockwsWorkloadReader
+}
+
+// NewMockwsWorkloadReader creates a new mock instance
+func NewMockwsWorkloadReader(ctrl *gomock.Controller) *MockwsWorkloadReader {
+	mock := &MockwsWorkloadReader{ctrl: ctrl}
+	mock.recorder = &MockwsWorkloadReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsWorkloadReader) EXPECT() *MockwsWorkloadReaderMockRecorder {
+	return m.recorder
+}
+
+// ServiceNames mocks base method
+func (m *MockwsWorkloadReader) ServiceNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceNames indicates an expected call of ServiceNames
+func (mr *MockwsWorkloadReaderMockRecorder) ServiceNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceNames", reflect.TypeOf((*MockwsWorkloadReader)(nil).ServiceNames))
+}
+
+// JobNames mocks base method
+func (m *MockwsWorkloadReader) JobNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JobNames indicates an expected call of JobNames
+func (mr *MockwsWorkloadReaderMockRecorder) JobNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobNames", reflect.TypeOf((*MockwsWorkloadReader)(nil).JobNames))
+}
+
+// ReadServiceManifest mocks base method
+func (m *MockwsWorkloadReader) ReadServiceManifest(svcName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadServiceManifest", svcName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadServiceManifest indicates an expected call of ReadServiceManifest
+func (mr *MockwsWorkloadReaderMockRecorder) ReadServiceManifest(svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MockwsWorkloadReader)(nil).ReadServiceManifest), svcName)
+}
+
+// ReadJobManifest mocks base method
+func (m *MockwsWorkloadReader) ReadJobManifest(jobName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadJobManifest", jobName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadJobManifest indicates an expected call of ReadJobManifest
+func (mr *MockwsWorkloadReaderMockRecorder) ReadJobManifest(jobName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadJobManifest", reflect.TypeOf((*MockwsWorkloadReader)(nil).ReadJobManifest), jobName)
+}
+
+// CopilotDirPath mocks base method
+func (m *MockwsWorkloadReader) CopilotDirPath() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopilotDirPath")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopilotDirPath indicates an expected call of CopilotDirPath
+func (mr *MockwsWorkloadReaderMockRecorder) CopilotDirPath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopilotDirPath", reflect.TypeOf((*MockwsWorkloadReader)(nil).CopilotDirPath))
+}
+
+// MockwsPipelineUpgrader is a mock of wsPipelineUpgrader interface
+type MockwsPipelineUpgrader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsPipelineUpgraderMockRecorder
+}
+
+// MockwsPipelineUpgraderMockRecorder is the mock recorder for MockwsPipelineUpgrader
+type MockwsPipelineUpgraderMockRecorder struct {
+	mock *MockwsPipelineUpgrader
+}
+
+// NewMockwsPipelineUpgrader creates a new mock instance
+func NewMockwsPipelineUpgrader(ctrl *gomock.Controller) *MockwsPipelineUpgrader {
+	mock := &MockwsPipelineUpgrader{ctrl: ctrl}
+	mock.recorder = &MockwsPipelineUpgraderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsPipelineUpgrader) EXPECT() *MockwsPipelineUpgraderMockRecorder {
+	return m.recorder
+}
+
+// ServiceNames mocks base method
+func (m *MockwsPipelineUpgrader) ServiceNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceNames indicates an expected call of ServiceNames
+func (mr *MockwsPipelineUpgraderMockRecorder) ServiceNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceNames", reflect.TypeOf((*MockwsPipelineUpgrader)(nil).ServiceNames))
+}
+
+// JobNames mocks base method
+func (m *MockwsPipelineUpgrader) JobNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JobNames indicates an expected call of JobNames
+func (mr *MockwsPipelineUpgraderMockRecorder) JobNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobNames", reflect.TypeOf((*MockwsPipelineUpgrader)(nil).JobNames))
+}
+
+// ListPipelines mocks base method
+func (m *MockwsPipelineUpgrader) ListPipelines() ([]workspace.PipelineManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPipelines")
+	ret0, _ := ret[0].([]workspace.PipelineManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPipelines indicates an expected call of ListPipelines
+func (mr *MockwsPipelineUpgraderMockRecorder) ListPipelines() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelines", reflect.TypeOf((*MockwsPipelineUpgrader)(nil).ListPipelines))
+}
+
+// ReadPipelineManifest mocks base method
+func (m *MockwsPipelineUpgrader) ReadPipelineManifest(path string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadPipelineManifest", path)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadPipelineManifest indicates an expected call of ReadPipelineManifest
+func (mr *MockwsPipelineUpgraderMockRecorder) ReadPipelineManifest(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineUpgrader)(nil).ReadPipelineManifest), path)
+}
+
+// OverwritePipelineManifest mocks base method
+func (m *MockwsPipelineUpgrader) OverwritePipelineManifest(path string, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OverwritePipelineManifest", path, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OverwritePipelineManifest indicates an expected call of OverwritePipelineManifest
+func (mr *MockwsPipelineUpgraderMockRecorder) OverwritePipelineManifest(path, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OverwritePipelineManifest", reflect.TypeOf((*MockwsPipelineUpgrader)(nil).OverwritePipelineManifest), path, data)
+}
+
 // MockwsPipelineReader is a mock of wsPipelineReader interface
 type MockwsPipelineReader struct {
 	ctrl     *gomock.Controller
@@ -2723,19 +3418,79 @@ func (mr *MockwsPipelineReaderMockRecorder) ServiceNames() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceNames", reflect.TypeOf((*MockwsPipelineReader)(nil).ServiceNames))
 }
 
+// JobNames mocks base method
+func (m *MockwsPipelineReader) JobNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JobNames indicates an expected call of JobNames
+func (mr *MockwsPipelineReaderMockRecorder) JobNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobNames", reflect.TypeOf((*MockwsPipelineReader)(nil).JobNames))
+}
+
+// ListPipelines mocks base method
+func (m *MockwsPipelineReader) ListPipelines() ([]workspace.PipelineManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPipelines")
+	ret0, _ := ret[0].([]workspace.PipelineManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPipelines indicates an expected call of ListPipelines
+func (mr *MockwsPipelineReaderMockRecorder) ListPipelines() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelines", reflect.TypeOf((*MockwsPipelineReader)(nil).ListPipelines))
+}
+
 // ReadPipelineManifest mocks base method
-func (m *MockwsPipelineReader) ReadPipelineManifest() ([]byte, error) {
+func (m *MockwsPipelineReader) ReadPipelineManifest(path string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadPipelineManifest")
+	ret := m.ctrl.Call(m, "ReadPipelineManifest", path)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReadPipelineManifest indicates an expected call of ReadPipelineManifest
-func (mr *MockwsPipelineReaderMockRecorder) ReadPipelineManifest() *gomock.Call {
+func (mr *MockwsPipelineReaderMockRecorder) ReadPipelineManifest(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadPipelineManifest), path)
+}
+
+// PipelineBuildspecPath mocks base method
+func (m *MockwsPipelineReader) PipelineBuildspecPath(manifestPath string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PipelineBuildspecPath", manifestPath)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PipelineBuildspecPath indicates an expected call of PipelineBuildspecPath
+func (mr *MockwsPipelineReaderMockRecorder) PipelineBuildspecPath(manifestPath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineBuildspecPath", reflect.TypeOf((*MockwsPipelineReader)(nil).PipelineBuildspecPath), manifestPath)
+}
+
+// LoadBalancedWebServiceNames mocks base method
+func (m *MockwsPipelineReader) LoadBalancedWebServiceNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadBalancedWebServiceNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadBalancedWebServiceNames indicates an expected call of LoadBalancedWebServiceNames
+func (mr *MockwsPipelineReaderMockRecorder) LoadBalancedWebServiceNames() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadPipelineManifest))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadBalancedWebServiceNames", reflect.TypeOf((*MockwsPipelineReader)(nil).LoadBalancedWebServiceNames))
 }
 
 // MockwsAppManager is a mock of wsAppManager interface
@@ -2896,116 +3651,243 @@ func (mr *MockartifactUploaderMockRecorder) PutArtifact(bucket, fileName, data i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutArtifact", reflect.TypeOf((*MockartifactUploader)(nil).PutArtifact), bucket, fileName, data)
 }
 
-// MockbucketEmptier is a mock of bucketEmptier interface
-type MockbucketEmptier struct {
+// MockobjectUploader is a mock of objectUploader interface
+type MockobjectUploader struct {
 	ctrl     *gomock.Controller
-	recorder *MockbucketEmptierMockRecorder
+	recorder *MockobjectUploaderMockRecorder
 }
 
-// MockbucketEmptierMockRecorder is the mock recorder for MockbucketEmptier
-type MockbucketEmptierMockRecorder struct {
-	mock *MockbucketEmptier
+// MockobjectUploaderMockRecorder is the mock recorder for MockobjectUploader
+type MockobjectUploaderMockRecorder struct {
+	mock *MockobjectUploader
 }
 
-// NewMockbucketEmptier creates a new mock instance
-func NewMockbucketEmptier(ctrl *gomock.Controller) *MockbucketEmptier {
-	mock := &MockbucketEmptier{ctrl: ctrl}
-	mock.recorder = &MockbucketEmptierMockRecorder{mock}
+// NewMockobjectUploader creates a new mock instance
+func NewMockobjectUploader(ctrl *gomock.Controller) *MockobjectUploader {
+	mock := &MockobjectUploader{ctrl: ctrl}
+	mock.recorder = &MockobjectUploaderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockbucketEmptier) EXPECT() *MockbucketEmptierMockRecorder {
+func (m *MockobjectUploader) EXPECT() *MockobjectUploaderMockRecorder {
 	return m.recorder
 }
 
-// EmptyBucket mocks base method
-func (m *MockbucketEmptier) EmptyBucket(bucket string) error {
+// PutObject mocks base method
+func (m *MockobjectUploader) PutObject(bucket, key string, data io.Reader) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EmptyBucket", bucket)
+	ret := m.ctrl.Call(m, "PutObject", bucket, key, data)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// EmptyBucket indicates an expected call of EmptyBucket
-func (mr *MockbucketEmptierMockRecorder) EmptyBucket(bucket interface{}) *gomock.Call {
+// PutObject indicates an expected call of PutObject
+func (mr *MockobjectUploaderMockRecorder) PutObject(bucket, key, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmptyBucket", reflect.TypeOf((*MockbucketEmptier)(nil).EmptyBucket), bucket)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutObject", reflect.TypeOf((*MockobjectUploader)(nil).PutObject), bucket, key, data)
 }
 
-// MockenvironmentDeployer is a mock of environmentDeployer interface
-type MockenvironmentDeployer struct {
+// MockobjectStore is a mock of objectStore interface
+type MockobjectStore struct {
 	ctrl     *gomock.Controller
-	recorder *MockenvironmentDeployerMockRecorder
+	recorder *MockobjectStoreMockRecorder
 }
 
-// MockenvironmentDeployerMockRecorder is the mock recorder for MockenvironmentDeployer
-type MockenvironmentDeployerMockRecorder struct {
-	mock *MockenvironmentDeployer
+// MockobjectStoreMockRecorder is the mock recorder for MockobjectStore
+type MockobjectStoreMockRecorder struct {
+	mock *MockobjectStore
 }
 
-// NewMockenvironmentDeployer creates a new mock instance
-func NewMockenvironmentDeployer(ctrl *gomock.Controller) *MockenvironmentDeployer {
-	mock := &MockenvironmentDeployer{ctrl: ctrl}
-	mock.recorder = &MockenvironmentDeployerMockRecorder{mock}
+// NewMockobjectStore creates a new mock instance
+func NewMockobjectStore(ctrl *gomock.Controller) *MockobjectStore {
+	mock := &MockobjectStore{ctrl: ctrl}
+	mock.recorder = &MockobjectStoreMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockenvironmentDeployer) EXPECT() *MockenvironmentDeployerMockRecorder {
+func (m *MockobjectStore) EXPECT() *MockobjectStoreMockRecorder {
 	return m.recorder
 }
 
-// DeployEnvironment mocks base method
-func (m *MockenvironmentDeployer) DeployEnvironment(env *deploy.CreateEnvironmentInput) error {
+// PutObject mocks base method
+func (m *MockobjectStore) PutObject(bucket, key string, data io.Reader) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployEnvironment", env)
+	ret := m.ctrl.Call(m, "PutObject", bucket, key, data)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeployEnvironment indicates an expected call of DeployEnvironment
-func (mr *MockenvironmentDeployerMockRecorder) DeployEnvironment(env interface{}) *gomock.Call {
+// PutObject indicates an expected call of PutObject
+func (mr *MockobjectStoreMockRecorder) PutObject(bucket, key, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).DeployEnvironment), env)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutObject", reflect.TypeOf((*MockobjectStore)(nil).PutObject), bucket, key, data)
 }
 
-// StreamEnvironmentCreation mocks base method
-func (m *MockenvironmentDeployer) StreamEnvironmentCreation(env *deploy.CreateEnvironmentInput) (<-chan []deploy.ResourceEvent, <-chan deploy.CreateEnvironmentResponse) {
+// GetObject mocks base method
+func (m *MockobjectStore) GetObject(bucket, key string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StreamEnvironmentCreation", env)
-	ret0, _ := ret[0].(<-chan []deploy.ResourceEvent)
-	ret1, _ := ret[1].(<-chan deploy.CreateEnvironmentResponse)
+	ret := m.ctrl.Call(m, "GetObject", bucket, key)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// StreamEnvironmentCreation indicates an expected call of StreamEnvironmentCreation
-func (mr *MockenvironmentDeployerMockRecorder) StreamEnvironmentCreation(env interface{}) *gomock.Call {
+// GetObject indicates an expected call of GetObject
+func (mr *MockobjectStoreMockRecorder) GetObject(bucket, key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamEnvironmentCreation", reflect.TypeOf((*MockenvironmentDeployer)(nil).StreamEnvironmentCreation), env)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObject", reflect.TypeOf((*MockobjectStore)(nil).GetObject), bucket, key)
 }
 
-// DeleteEnvironment mocks base method
-func (m *MockenvironmentDeployer) DeleteEnvironment(appName, envName, cfnExecRoleARN string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteEnvironment", appName, envName, cfnExecRoleARN)
-	ret0, _ := ret[0].(error)
-	return ret0
+// MockimageDigestGetter is a mock of imageDigestGetter interface
+type MockimageDigestGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageDigestGetterMockRecorder
 }
 
-// DeleteEnvironment indicates an expected call of DeleteEnvironment
-func (mr *MockenvironmentDeployerMockRecorder) DeleteEnvironment(appName, envName, cfnExecRoleARN interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).DeleteEnvironment), appName, envName, cfnExecRoleARN)
+// MockimageDigestGetterMockRecorder is the mock recorder for MockimageDigestGetter
+type MockimageDigestGetterMockRecorder struct {
+	mock *MockimageDigestGetter
 }
 
-// GetEnvironment mocks base method
-func (m *MockenvironmentDeployer) GetEnvironment(appName, envName string) (*config.Environment, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEnvironment", appName, envName)
-	ret0, _ := ret[0].(*config.Environment)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// NewMockimageDigestGetter creates a new mock instance
+func NewMockimageDigestGetter(ctrl *gomock.Controller) *MockimageDigestGetter {
+	mock := &MockimageDigestGetter{ctrl: ctrl}
+	mock.recorder = &MockimageDigestGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockimageDigestGetter) EXPECT() *MockimageDigestGetterMockRecorder {
+	return m.recorder
+}
+
+// ImageDigest mocks base method
+func (m *MockimageDigestGetter) ImageDigest(repoName, tag string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageDigest", repoName, tag)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageDigest indicates an expected call of ImageDigest
+func (mr *MockimageDigestGetterMockRecorder) ImageDigest(repoName, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageDigest", reflect.TypeOf((*MockimageDigestGetter)(nil).ImageDigest), repoName, tag)
+}
+
+// MockbucketEmptier is a mock of bucketEmptier interface
+type MockbucketEmptier struct {
+	ctrl     *gomock.Controller
+	recorder *MockbucketEmptierMockRecorder
+}
+
+// MockbucketEmptierMockRecorder is the mock recorder for MockbucketEmptier
+type MockbucketEmptierMockRecorder struct {
+	mock *MockbucketEmptier
+}
+
+// NewMockbucketEmptier creates a new mock instance
+func NewMockbucketEmptier(ctrl *gomock.Controller) *MockbucketEmptier {
+	mock := &MockbucketEmptier{ctrl: ctrl}
+	mock.recorder = &MockbucketEmptierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockbucketEmptier) EXPECT() *MockbucketEmptierMockRecorder {
+	return m.recorder
+}
+
+// EmptyBucket mocks base method
+func (m *MockbucketEmptier) EmptyBucket(bucket string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EmptyBucket", bucket)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EmptyBucket indicates an expected call of EmptyBucket
+func (mr *MockbucketEmptierMockRecorder) EmptyBucket(bucket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmptyBucket", reflect.TypeOf((*MockbucketEmptier)(nil).EmptyBucket), bucket)
+}
+
+// MockenvironmentDeployer is a mock of environmentDeployer interface
+type MockenvironmentDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvironmentDeployerMockRecorder
+}
+
+// MockenvironmentDeployerMockRecorder is the mock recorder for MockenvironmentDeployer
+type MockenvironmentDeployerMockRecorder struct {
+	mock *MockenvironmentDeployer
+}
+
+// NewMockenvironmentDeployer creates a new mock instance
+func NewMockenvironmentDeployer(ctrl *gomock.Controller) *MockenvironmentDeployer {
+	mock := &MockenvironmentDeployer{ctrl: ctrl}
+	mock.recorder = &MockenvironmentDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockenvironmentDeployer) EXPECT() *MockenvironmentDeployerMockRecorder {
+	return m.recorder
+}
+
+// DeployEnvironment mocks base method
+func (m *MockenvironmentDeployer) DeployEnvironment(env *deploy.CreateEnvironmentInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployEnvironment indicates an expected call of DeployEnvironment
+func (mr *MockenvironmentDeployerMockRecorder) DeployEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).DeployEnvironment), env)
+}
+
+// StreamEnvironmentCreation mocks base method
+func (m *MockenvironmentDeployer) StreamEnvironmentCreation(env *deploy.CreateEnvironmentInput) (<-chan []deploy.ResourceEvent, <-chan deploy.CreateEnvironmentResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamEnvironmentCreation", env)
+	ret0, _ := ret[0].(<-chan []deploy.ResourceEvent)
+	ret1, _ := ret[1].(<-chan deploy.CreateEnvironmentResponse)
+	return ret0, ret1
+}
+
+// StreamEnvironmentCreation indicates an expected call of StreamEnvironmentCreation
+func (mr *MockenvironmentDeployerMockRecorder) StreamEnvironmentCreation(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamEnvironmentCreation", reflect.TypeOf((*MockenvironmentDeployer)(nil).StreamEnvironmentCreation), env)
+}
+
+// DeleteEnvironment mocks base method
+func (m *MockenvironmentDeployer) DeleteEnvironment(appName, envName, cfnExecRoleARN string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEnvironment", appName, envName, cfnExecRoleARN)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEnvironment indicates an expected call of DeleteEnvironment
+func (mr *MockenvironmentDeployerMockRecorder) DeleteEnvironment(appName, envName, cfnExecRoleARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).DeleteEnvironment), appName, envName, cfnExecRoleARN)
+}
+
+// GetEnvironment mocks base method
+func (m *MockenvironmentDeployer) GetEnvironment(appName, envName string) (*config.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnvironment", appName, envName)
+	ret0, _ := ret[0].(*config.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // GetEnvironment indicates an expected call of GetEnvironment
@@ -3043,6 +3925,94 @@ func (mr *MockenvironmentDeployerMockRecorder) UpdateEnvironmentTemplate(appName
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironmentTemplate", reflect.TypeOf((*MockenvironmentDeployer)(nil).UpdateEnvironmentTemplate), appName, envName, templateBody, cfnExecRoleARN)
 }
 
+// SetEnvironmentTerminationProtection mocks base method
+func (m *MockenvironmentDeployer) SetEnvironmentTerminationProtection(appName, envName string, enabled bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEnvironmentTerminationProtection", appName, envName, enabled)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEnvironmentTerminationProtection indicates an expected call of SetEnvironmentTerminationProtection
+func (mr *MockenvironmentDeployerMockRecorder) SetEnvironmentTerminationProtection(appName, envName, enabled interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEnvironmentTerminationProtection", reflect.TypeOf((*MockenvironmentDeployer)(nil).SetEnvironmentTerminationProtection), appName, envName, enabled)
+}
+
+// MockenvTerminationProtector is a mock of envTerminationProtector interface
+type MockenvTerminationProtector struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvTerminationProtectorMockRecorder
+}
+
+// MockenvTerminationProtectorMockRecorder is the mock recorder for MockenvTerminationProtector
+type MockenvTerminationProtectorMockRecorder struct {
+	mock *MockenvTerminationProtector
+}
+
+// NewMockenvTerminationProtector creates a new mock instance
+func NewMockenvTerminationProtector(ctrl *gomock.Controller) *MockenvTerminationProtector {
+	mock := &MockenvTerminationProtector{ctrl: ctrl}
+	mock.recorder = &MockenvTerminationProtectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockenvTerminationProtector) EXPECT() *MockenvTerminationProtectorMockRecorder {
+	return m.recorder
+}
+
+// SetEnvironmentTerminationProtection mocks base method
+func (m *MockenvTerminationProtector) SetEnvironmentTerminationProtection(appName, envName string, enabled bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEnvironmentTerminationProtection", appName, envName, enabled)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEnvironmentTerminationProtection indicates an expected call of SetEnvironmentTerminationProtection
+func (mr *MockenvTerminationProtectorMockRecorder) SetEnvironmentTerminationProtection(appName, envName, enabled interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEnvironmentTerminationProtection", reflect.TypeOf((*MockenvTerminationProtector)(nil).SetEnvironmentTerminationProtection), appName, envName, enabled)
+}
+
+// MockwlTerminationProtector is a mock of wlTerminationProtector interface
+type MockwlTerminationProtector struct {
+	ctrl     *gomock.Controller
+	recorder *MockwlTerminationProtectorMockRecorder
+}
+
+// MockwlTerminationProtectorMockRecorder is the mock recorder for MockwlTerminationProtector
+type MockwlTerminationProtectorMockRecorder struct {
+	mock *MockwlTerminationProtector
+}
+
+// NewMockwlTerminationProtector creates a new mock instance
+func NewMockwlTerminationProtector(ctrl *gomock.Controller) *MockwlTerminationProtector {
+	mock := &MockwlTerminationProtector{ctrl: ctrl}
+	mock.recorder = &MockwlTerminationProtectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwlTerminationProtector) EXPECT() *MockwlTerminationProtectorMockRecorder {
+	return m.recorder
+}
+
+// SetWorkloadTerminationProtection mocks base method
+func (m *MockwlTerminationProtector) SetWorkloadTerminationProtection(appName, envName, name string, enabled bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWorkloadTerminationProtection", appName, envName, name, enabled)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetWorkloadTerminationProtection indicates an expected call of SetWorkloadTerminationProtection
+func (mr *MockwlTerminationProtectorMockRecorder) SetWorkloadTerminationProtection(appName, envName, name, enabled interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWorkloadTerminationProtection", reflect.TypeOf((*MockwlTerminationProtector)(nil).SetWorkloadTerminationProtection), appName, envName, name, enabled)
+}
+
 // MockwlDeleter is a mock of wlDeleter interface
 type MockwlDeleter struct {
 	ctrl     *gomock.Controller
@@ -3066,6 +4036,20 @@ func (m *MockwlDeleter) EXPECT() *MockwlDeleterMockRecorder {
 	return m.recorder
 }
 
+// SetWorkloadTerminationProtection mocks base method
+func (m *MockwlDeleter) SetWorkloadTerminationProtection(appName, envName, name string, enabled bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWorkloadTerminationProtection", appName, envName, name, enabled)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetWorkloadTerminationProtection indicates an expected call of SetWorkloadTerminationProtection
+func (mr *MockwlDeleterMockRecorder) SetWorkloadTerminationProtection(appName, envName, name, enabled interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWorkloadTerminationProtection", reflect.TypeOf((*MockwlDeleter)(nil).SetWorkloadTerminationProtection), appName, envName, name, enabled)
+}
+
 // DeleteWorkload mocks base method
 func (m *MockwlDeleter) DeleteWorkload(in deploy.DeleteWorkloadInput) error {
 	m.ctrl.T.Helper()
@@ -3517,117 +4501,936 @@ func (mr *MocktaskDeployerMockRecorder) DeployTask(input interface{}, opts ...in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployTask", reflect.TypeOf((*MocktaskDeployer)(nil).DeployTask), varargs...)
 }
 
-// MocktaskRunner is a mock of taskRunner interface
-type MocktaskRunner struct {
+// MockserviceDeployer is a mock of serviceDeployer interface
+type MockserviceDeployer struct {
 	ctrl     *gomock.Controller
-	recorder *MocktaskRunnerMockRecorder
+	recorder *MockserviceDeployerMockRecorder
 }
 
-// MocktaskRunnerMockRecorder is the mock recorder for MocktaskRunner
-type MocktaskRunnerMockRecorder struct {
-	mock *MocktaskRunner
+// MockserviceDeployerMockRecorder is the mock recorder for MockserviceDeployer
+type MockserviceDeployerMockRecorder struct {
+	mock *MockserviceDeployer
 }
 
-// NewMocktaskRunner creates a new mock instance
-func NewMocktaskRunner(ctrl *gomock.Controller) *MocktaskRunner {
-	mock := &MocktaskRunner{ctrl: ctrl}
-	mock.recorder = &MocktaskRunnerMockRecorder{mock}
+// NewMockserviceDeployer creates a new mock instance
+func NewMockserviceDeployer(ctrl *gomock.Controller) *MockserviceDeployer {
+	mock := &MockserviceDeployer{ctrl: ctrl}
+	mock.recorder = &MockserviceDeployerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MocktaskRunner) EXPECT() *MocktaskRunnerMockRecorder {
+func (m *MockserviceDeployer) EXPECT() *MockserviceDeployerMockRecorder {
 	return m.recorder
 }
 
-// Run mocks base method
-func (m *MocktaskRunner) Run() ([]*task.Task, error) {
+// DeployService mocks base method
+func (m *MockserviceDeployer) DeployService(conf cloudformation0.StackConfiguration, opts ...cloudformation.StackOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Run")
-	ret0, _ := ret[0].([]*task.Task)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []interface{}{conf}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployService", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Run indicates an expected call of Run
-func (mr *MocktaskRunnerMockRecorder) Run() *gomock.Call {
+// DeployService indicates an expected call of DeployService
+func (mr *MockserviceDeployerMockRecorder) DeployService(conf interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MocktaskRunner)(nil).Run))
+	varargs := append([]interface{}{conf}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployService", reflect.TypeOf((*MockserviceDeployer)(nil).DeployService), varargs...)
 }
 
-// MockdefaultClusterGetter is a mock of defaultClusterGetter interface
-type MockdefaultClusterGetter struct {
+// MockstackFailureAnalyzer is a mock of stackFailureAnalyzer interface
+type MockstackFailureAnalyzer struct {
 	ctrl     *gomock.Controller
-	recorder *MockdefaultClusterGetterMockRecorder
+	recorder *MockstackFailureAnalyzerMockRecorder
 }
 
-// MockdefaultClusterGetterMockRecorder is the mock recorder for MockdefaultClusterGetter
-type MockdefaultClusterGetterMockRecorder struct {
-	mock *MockdefaultClusterGetter
+// MockstackFailureAnalyzerMockRecorder is the mock recorder for MockstackFailureAnalyzer
+type MockstackFailureAnalyzerMockRecorder struct {
+	mock *MockstackFailureAnalyzer
 }
 
-// NewMockdefaultClusterGetter creates a new mock instance
-func NewMockdefaultClusterGetter(ctrl *gomock.Controller) *MockdefaultClusterGetter {
-	mock := &MockdefaultClusterGetter{ctrl: ctrl}
-	mock.recorder = &MockdefaultClusterGetterMockRecorder{mock}
+// NewMockstackFailureAnalyzer creates a new mock instance
+func NewMockstackFailureAnalyzer(ctrl *gomock.Controller) *MockstackFailureAnalyzer {
+	mock := &MockstackFailureAnalyzer{ctrl: ctrl}
+	mock.recorder = &MockstackFailureAnalyzerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockdefaultClusterGetter) EXPECT() *MockdefaultClusterGetterMockRecorder {
+func (m *MockstackFailureAnalyzer) EXPECT() *MockstackFailureAnalyzerMockRecorder {
 	return m.recorder
 }
 
-// HasDefaultCluster mocks base method
-func (m *MockdefaultClusterGetter) HasDefaultCluster() (bool, error) {
+// LastOperationTime mocks base method
+func (m *MockstackFailureAnalyzer) LastOperationTime(stackName string) (time.Time, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HasDefaultCluster")
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "LastOperationTime", stackName)
+	ret0, _ := ret[0].(time.Time)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// HasDefaultCluster indicates an expected call of HasDefaultCluster
-func (mr *MockdefaultClusterGetterMockRecorder) HasDefaultCluster() *gomock.Call {
+// LastOperationTime indicates an expected call of LastOperationTime
+func (mr *MockstackFailureAnalyzerMockRecorder) LastOperationTime(stackName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDefaultCluster", reflect.TypeOf((*MockdefaultClusterGetter)(nil).HasDefaultCluster))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastOperationTime", reflect.TypeOf((*MockstackFailureAnalyzer)(nil).LastOperationTime), stackName)
 }
 
-// Mockdeployer is a mock of deployer interface
-type Mockdeployer struct {
+// Analyze mocks base method
+func (m *MockstackFailureAnalyzer) Analyze(stackName string, since time.Time) (*describe.StackFailure, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Analyze", stackName, since)
+	ret0, _ := ret[0].(*describe.StackFailure)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Analyze indicates an expected call of Analyze
+func (mr *MockstackFailureAnalyzerMockRecorder) Analyze(stackName, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Analyze", reflect.TypeOf((*MockstackFailureAnalyzer)(nil).Analyze), stackName, since)
+}
+
+// MockworkloadDeployStreamer is a mock of workloadDeployStreamer interface
+type MockworkloadDeployStreamer struct {
 	ctrl     *gomock.Controller
-	recorder *MockdeployerMockRecorder
+	recorder *MockworkloadDeployStreamerMockRecorder
 }
 
-// MockdeployerMockRecorder is the mock recorder for Mockdeployer
-type MockdeployerMockRecorder struct {
-	mock *Mockdeployer
+// MockworkloadDeployStreamerMockRecorder is the mock recorder for MockworkloadDeployStreamer
+type MockworkloadDeployStreamerMockRecorder struct {
+	mock *MockworkloadDeployStreamer
 }
 
-// NewMockdeployer creates a new mock instance
-func NewMockdeployer(ctrl *gomock.Controller) *Mockdeployer {
-	mock := &Mockdeployer{ctrl: ctrl}
-	mock.recorder = &MockdeployerMockRecorder{mock}
+// NewMockworkloadDeployStreamer creates a new mock instance
+func NewMockworkloadDeployStreamer(ctrl *gomock.Controller) *MockworkloadDeployStreamer {
+	mock := &MockworkloadDeployStreamer{ctrl: ctrl}
+	mock.recorder = &MockworkloadDeployStreamerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *Mockdeployer) EXPECT() *MockdeployerMockRecorder {
+func (m *MockworkloadDeployStreamer) EXPECT() *MockworkloadDeployStreamerMockRecorder {
 	return m.recorder
 }
 
-// DeployEnvironment mocks base method
-func (m *Mockdeployer) DeployEnvironment(env *deploy.CreateEnvironmentInput) error {
+// StreamServiceDeployment mocks base method
+func (m *MockworkloadDeployStreamer) StreamServiceDeployment(conf cloudformation0.StackConfiguration, opts ...cloudformation.StackOption) (<-chan []deploy.ResourceEvent, <-chan error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployEnvironment", env)
-	ret0, _ := ret[0].(error)
-	return ret0
+	varargs := []interface{}{conf}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamServiceDeployment", varargs...)
+	ret0, _ := ret[0].(<-chan []deploy.ResourceEvent)
+	ret1, _ := ret[1].(<-chan error)
+	return ret0, ret1
 }
 
-// DeployEnvironment indicates an expected call of DeployEnvironment
-func (mr *MockdeployerMockRecorder) DeployEnvironment(env interface{}) *gomock.Call {
+// StreamServiceDeployment indicates an expected call of StreamServiceDeployment
+func (mr *MockworkloadDeployStreamerMockRecorder) StreamServiceDeployment(conf interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployEnvironment", reflect.TypeOf((*Mockdeployer)(nil).DeployEnvironment), env)
+	varargs := append([]interface{}{conf}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamServiceDeployment", reflect.TypeOf((*MockworkloadDeployStreamer)(nil).StreamServiceDeployment), varargs...)
+}
+
+// MockworkloadDeployCanceller is a mock of workloadDeployCanceller interface
+type MockworkloadDeployCanceller struct {
+	ctrl     *gomock.Controller
+	recorder *MockworkloadDeployCancellerMockRecorder
+}
+
+// MockworkloadDeployCancellerMockRecorder is the mock recorder for MockworkloadDeployCanceller
+type MockworkloadDeployCancellerMockRecorder struct {
+	mock *MockworkloadDeployCanceller
+}
+
+// NewMockworkloadDeployCanceller creates a new mock instance
+func NewMockworkloadDeployCanceller(ctrl *gomock.Controller) *MockworkloadDeployCanceller {
+	mock := &MockworkloadDeployCanceller{ctrl: ctrl}
+	mock.recorder = &MockworkloadDeployCancellerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockworkloadDeployCanceller) EXPECT() *MockworkloadDeployCancellerMockRecorder {
+	return m.recorder
+}
+
+// WorkloadStack mocks base method
+func (m *MockworkloadDeployCanceller) WorkloadStack(appName, envName, name string) (*cloudformation.StackDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadStack", appName, envName, name)
+	ret0, _ := ret[0].(*cloudformation.StackDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WorkloadStack indicates an expected call of WorkloadStack
+func (mr *MockworkloadDeployCancellerMockRecorder) WorkloadStack(appName, envName, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadStack", reflect.TypeOf((*MockworkloadDeployCanceller)(nil).WorkloadStack), appName, envName, name)
+}
+
+// CancelWorkloadDeployment mocks base method
+func (m *MockworkloadDeployCanceller) CancelWorkloadDeployment(appName, envName, name string) (<-chan []deploy.ResourceEvent, <-chan error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelWorkloadDeployment", appName, envName, name)
+	ret0, _ := ret[0].(<-chan []deploy.ResourceEvent)
+	ret1, _ := ret[1].(<-chan error)
+	return ret0, ret1
+}
+
+// CancelWorkloadDeployment indicates an expected call of CancelWorkloadDeployment
+func (mr *MockworkloadDeployCancellerMockRecorder) CancelWorkloadDeployment(appName, envName, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelWorkloadDeployment", reflect.TypeOf((*MockworkloadDeployCanceller)(nil).CancelWorkloadDeployment), appName, envName, name)
+}
+
+// ContinueWorkloadRollback mocks base method
+func (m *MockworkloadDeployCanceller) ContinueWorkloadRollback(appName, envName, name string) (<-chan []deploy.ResourceEvent, <-chan error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContinueWorkloadRollback", appName, envName, name)
+	ret0, _ := ret[0].(<-chan []deploy.ResourceEvent)
+	ret1, _ := ret[1].(<-chan error)
+	return ret0, ret1
+}
+
+// ContinueWorkloadRollback indicates an expected call of ContinueWorkloadRollback
+func (mr *MockworkloadDeployCancellerMockRecorder) ContinueWorkloadRollback(appName, envName, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContinueWorkloadRollback", reflect.TypeOf((*MockworkloadDeployCanceller)(nil).ContinueWorkloadRollback), appName, envName, name)
+}
+
+// StreamWorkloadRollback mocks base method
+func (m *MockworkloadDeployCanceller) StreamWorkloadRollback(appName, envName, name string) (<-chan []deploy.ResourceEvent, <-chan error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamWorkloadRollback", appName, envName, name)
+	ret0, _ := ret[0].(<-chan []deploy.ResourceEvent)
+	ret1, _ := ret[1].(<-chan error)
+	return ret0, ret1
+}
+
+// StreamWorkloadRollback indicates an expected call of StreamWorkloadRollback
+func (mr *MockworkloadDeployCancellerMockRecorder) StreamWorkloadRollback(appName, envName, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkloadRollback", reflect.TypeOf((*MockworkloadDeployCanceller)(nil).StreamWorkloadRollback), appName, envName, name)
+}
+
+// MockdeployedWorkloadGetter is a mock of deployedWorkloadGetter interface
+type MockdeployedWorkloadGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployedWorkloadGetterMockRecorder
+}
+
+// MockdeployedWorkloadGetterMockRecorder is the mock recorder for MockdeployedWorkloadGetter
+type MockdeployedWorkloadGetterMockRecorder struct {
+	mock *MockdeployedWorkloadGetter
+}
+
+// NewMockdeployedWorkloadGetter creates a new mock instance
+func NewMockdeployedWorkloadGetter(ctrl *gomock.Controller) *MockdeployedWorkloadGetter {
+	mock := &MockdeployedWorkloadGetter{ctrl: ctrl}
+	mock.recorder = &MockdeployedWorkloadGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockdeployedWorkloadGetter) EXPECT() *MockdeployedWorkloadGetterMockRecorder {
+	return m.recorder
+}
+
+// WorkloadTemplate mocks base method
+func (m *MockdeployedWorkloadGetter) WorkloadTemplate(appName, envName, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadTemplate", appName, envName, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WorkloadTemplate indicates an expected call of WorkloadTemplate
+func (mr *MockdeployedWorkloadGetterMockRecorder) WorkloadTemplate(appName, envName, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadTemplate", reflect.TypeOf((*MockdeployedWorkloadGetter)(nil).WorkloadTemplate), appName, envName, name)
+}
+
+// WorkloadStack mocks base method
+func (m *MockdeployedWorkloadGetter) WorkloadStack(appName, envName, name string) (*cloudformation.StackDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadStack", appName, envName, name)
+	ret0, _ := ret[0].(*cloudformation.StackDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WorkloadStack indicates an expected call of WorkloadStack
+func (mr *MockdeployedWorkloadGetterMockRecorder) WorkloadStack(appName, envName, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadStack", reflect.TypeOf((*MockdeployedWorkloadGetter)(nil).WorkloadStack), appName, envName, name)
+}
+
+// MockworkloadPreviewer is a mock of workloadPreviewer interface
+type MockworkloadPreviewer struct {
+	ctrl     *gomock.Controller
+	recorder *MockworkloadPreviewerMockRecorder
+}
+
+// MockworkloadPreviewerMockRecorder is the mock recorder for MockworkloadPreviewer
+type MockworkloadPreviewerMockRecorder struct {
+	mock *MockworkloadPreviewer
+}
+
+// NewMockworkloadPreviewer creates a new mock instance
+func NewMockworkloadPreviewer(ctrl *gomock.Controller) *MockworkloadPreviewer {
+	mock := &MockworkloadPreviewer{ctrl: ctrl}
+	mock.recorder = &MockworkloadPreviewerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockworkloadPreviewer) EXPECT() *MockworkloadPreviewerMockRecorder {
+	return m.recorder
+}
+
+// PreviewService mocks base method
+func (m *MockworkloadPreviewer) PreviewService(conf cloudformation0.StackConfiguration, opts ...cloudformation.StackOption) ([]cloudformation.ResourceChange, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{conf}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PreviewService", varargs...)
+	ret0, _ := ret[0].([]cloudformation.ResourceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewService indicates an expected call of PreviewService
+func (mr *MockworkloadPreviewerMockRecorder) PreviewService(conf interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{conf}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewService", reflect.TypeOf((*MockworkloadPreviewer)(nil).PreviewService), varargs...)
+}
+
+// WorkloadTemplate mocks base method
+func (m *MockworkloadPreviewer) WorkloadTemplate(appName, envName, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadTemplate", appName, envName, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WorkloadTemplate indicates an expected call of WorkloadTemplate
+func (mr *MockworkloadPreviewerMockRecorder) WorkloadTemplate(appName, envName, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadTemplate", reflect.TypeOf((*MockworkloadPreviewer)(nil).WorkloadTemplate), appName, envName, name)
+}
+
+// WorkloadAddonsTemplate mocks base method
+func (m *MockworkloadPreviewer) WorkloadAddonsTemplate(appName, envName, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadAddonsTemplate", appName, envName, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WorkloadAddonsTemplate indicates an expected call of WorkloadAddonsTemplate
+func (mr *MockworkloadPreviewerMockRecorder) WorkloadAddonsTemplate(appName, envName, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadAddonsTemplate", reflect.TypeOf((*MockworkloadPreviewer)(nil).WorkloadAddonsTemplate), appName, envName, name)
+}
+
+// WorkloadStack mocks base method
+func (m *MockworkloadPreviewer) WorkloadStack(appName, envName, name string) (*cloudformation.StackDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadStack", appName, envName, name)
+	ret0, _ := ret[0].(*cloudformation.StackDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WorkloadStack indicates an expected call of WorkloadStack
+func (mr *MockworkloadPreviewerMockRecorder) WorkloadStack(appName, envName, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadStack", reflect.TypeOf((*MockworkloadPreviewer)(nil).WorkloadStack), appName, envName, name)
+}
+
+// MocktaskRunner is a mock of taskRunner interface
+type MocktaskRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskRunnerMockRecorder
+}
+
+// MocktaskRunnerMockRecorder is the mock recorder for MocktaskRunner
+type MocktaskRunnerMockRecorder struct {
+	mock *MocktaskRunner
+}
+
+// NewMocktaskRunner creates a new mock instance
+func NewMocktaskRunner(ctrl *gomock.Controller) *MocktaskRunner {
+	mock := &MocktaskRunner{ctrl: ctrl}
+	mock.recorder = &MocktaskRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocktaskRunner) EXPECT() *MocktaskRunnerMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method
+func (m *MocktaskRunner) Run() ([]*task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run")
+	ret0, _ := ret[0].([]*task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run
+func (mr *MocktaskRunnerMockRecorder) Run() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MocktaskRunner)(nil).Run))
+}
+
+// MockdefaultClusterGetter is a mock of defaultClusterGetter interface
+type MockdefaultClusterGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockdefaultClusterGetterMockRecorder
+}
+
+// MockdefaultClusterGetterMockRecorder is the mock recorder for MockdefaultClusterGetter
+type MockdefaultClusterGetterMockRecorder struct {
+	mock *MockdefaultClusterGetter
+}
+
+// NewMockdefaultClusterGetter creates a new mock instance
+func NewMockdefaultClusterGetter(ctrl *gomock.Controller) *MockdefaultClusterGetter {
+	mock := &MockdefaultClusterGetter{ctrl: ctrl}
+	mock.recorder = &MockdefaultClusterGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockdefaultClusterGetter) EXPECT() *MockdefaultClusterGetterMockRecorder {
+	return m.recorder
+}
+
+// HasDefaultCluster mocks base method
+func (m *MockdefaultClusterGetter) HasDefaultCluster() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasDefaultCluster")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasDefaultCluster indicates an expected call of HasDefaultCluster
+func (mr *MockdefaultClusterGetterMockRecorder) HasDefaultCluster() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDefaultCluster", reflect.TypeOf((*MockdefaultClusterGetter)(nil).HasDefaultCluster))
+}
+
+// MockresourcesByTagsGetter is a mock of resourcesByTagsGetter interface
+type MockresourcesByTagsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockresourcesByTagsGetterMockRecorder
+}
+
+// MockresourcesByTagsGetterMockRecorder is the mock recorder for MockresourcesByTagsGetter
+type MockresourcesByTagsGetterMockRecorder struct {
+	mock *MockresourcesByTagsGetter
+}
+
+// NewMockresourcesByTagsGetter creates a new mock instance
+func NewMockresourcesByTagsGetter(ctrl *gomock.Controller) *MockresourcesByTagsGetter {
+	mock := &MockresourcesByTagsGetter{ctrl: ctrl}
+	mock.recorder = &MockresourcesByTagsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockresourcesByTagsGetter) EXPECT() *MockresourcesByTagsGetterMockRecorder {
+	return m.recorder
+}
+
+// GetResourcesByTags mocks base method
+func (m *MockresourcesByTagsGetter) GetResourcesByTags(resourceType string, tags map[string]string) ([]*resourcegroups.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourcesByTags", resourceType, tags)
+	ret0, _ := ret[0].([]*resourcegroups.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourcesByTags indicates an expected call of GetResourcesByTags
+func (mr *MockresourcesByTagsGetterMockRecorder) GetResourcesByTags(resourceType, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourcesByTags", reflect.TypeOf((*MockresourcesByTagsGetter)(nil).GetResourcesByTags), resourceType, tags)
+}
+
+// MockecsCommandExecutor is a mock of ecsCommandExecutor interface
+type MockecsCommandExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsCommandExecutorMockRecorder
+}
+
+// MockecsCommandExecutorMockRecorder is the mock recorder for MockecsCommandExecutor
+type MockecsCommandExecutorMockRecorder struct {
+	mock *MockecsCommandExecutor
+}
+
+// NewMockecsCommandExecutor creates a new mock instance
+func NewMockecsCommandExecutor(ctrl *gomock.Controller) *MockecsCommandExecutor {
+	mock := &MockecsCommandExecutor{ctrl: ctrl}
+	mock.recorder = &MockecsCommandExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockecsCommandExecutor) EXPECT() *MockecsCommandExecutorMockRecorder {
+	return m.recorder
+}
+
+// ExecuteCommand mocks base method
+func (m *MockecsCommandExecutor) ExecuteCommand(in ecs.ExecuteCommandInput) (*ecs.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteCommand", in)
+	ret0, _ := ret[0].(*ecs.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteCommand indicates an expected call of ExecuteCommand
+func (mr *MockecsCommandExecutorMockRecorder) ExecuteCommand(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommand", reflect.TypeOf((*MockecsCommandExecutor)(nil).ExecuteCommand), in)
+}
+
+// MockecsServiceCommandExecutor is a mock of ecsServiceCommandExecutor interface
+type MockecsServiceCommandExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsServiceCommandExecutorMockRecorder
+}
+
+// MockecsServiceCommandExecutorMockRecorder is the mock recorder for MockecsServiceCommandExecutor
+type MockecsServiceCommandExecutorMockRecorder struct {
+	mock *MockecsServiceCommandExecutor
+}
+
+// NewMockecsServiceCommandExecutor creates a new mock instance
+func NewMockecsServiceCommandExecutor(ctrl *gomock.Controller) *MockecsServiceCommandExecutor {
+	mock := &MockecsServiceCommandExecutor{ctrl: ctrl}
+	mock.recorder = &MockecsServiceCommandExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockecsServiceCommandExecutor) EXPECT() *MockecsServiceCommandExecutorMockRecorder {
+	return m.recorder
+}
+
+// ExecuteCommand mocks base method
+func (m *MockecsServiceCommandExecutor) ExecuteCommand(in ecs.ExecuteCommandInput) (*ecs.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteCommand", in)
+	ret0, _ := ret[0].(*ecs.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteCommand indicates an expected call of ExecuteCommand
+func (mr *MockecsServiceCommandExecutorMockRecorder) ExecuteCommand(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommand", reflect.TypeOf((*MockecsServiceCommandExecutor)(nil).ExecuteCommand), in)
+}
+
+// ServiceTasks mocks base method
+func (m *MockecsServiceCommandExecutor) ServiceTasks(clusterName, serviceName string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceTasks", clusterName, serviceName)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceTasks indicates an expected call of ServiceTasks
+func (mr *MockecsServiceCommandExecutorMockRecorder) ServiceTasks(clusterName, serviceName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceTasks", reflect.TypeOf((*MockecsServiceCommandExecutor)(nil).ServiceTasks), clusterName, serviceName)
+}
+
+// MockecsTaskCommandExecutor is a mock of ecsTaskCommandExecutor interface
+type MockecsTaskCommandExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsTaskCommandExecutorMockRecorder
+}
+
+// MockecsTaskCommandExecutorMockRecorder is the mock recorder for MockecsTaskCommandExecutor
+type MockecsTaskCommandExecutorMockRecorder struct {
+	mock *MockecsTaskCommandExecutor
+}
+
+// NewMockecsTaskCommandExecutor creates a new mock instance
+func NewMockecsTaskCommandExecutor(ctrl *gomock.Controller) *MockecsTaskCommandExecutor {
+	mock := &MockecsTaskCommandExecutor{ctrl: ctrl}
+	mock.recorder = &MockecsTaskCommandExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockecsTaskCommandExecutor) EXPECT() *MockecsTaskCommandExecutorMockRecorder {
+	return m.recorder
+}
+
+// ExecuteCommand mocks base method
+func (m *MockecsTaskCommandExecutor) ExecuteCommand(in ecs.ExecuteCommandInput) (*ecs.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteCommand", in)
+	ret0, _ := ret[0].(*ecs.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteCommand indicates an expected call of ExecuteCommand
+func (mr *MockecsTaskCommandExecutorMockRecorder) ExecuteCommand(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommand", reflect.TypeOf((*MockecsTaskCommandExecutor)(nil).ExecuteCommand), in)
+}
+
+// DefaultCluster mocks base method
+func (m *MockecsTaskCommandExecutor) DefaultCluster() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DefaultCluster")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DefaultCluster indicates an expected call of DefaultCluster
+func (mr *MockecsTaskCommandExecutorMockRecorder) DefaultCluster() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultCluster", reflect.TypeOf((*MockecsTaskCommandExecutor)(nil).DefaultCluster))
+}
+
+// RunningTasksInFamily mocks base method
+func (m *MockecsTaskCommandExecutor) RunningTasksInFamily(clusterName, family string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunningTasksInFamily", clusterName, family)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunningTasksInFamily indicates an expected call of RunningTasksInFamily
+func (mr *MockecsTaskCommandExecutorMockRecorder) RunningTasksInFamily(clusterName, family interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningTasksInFamily", reflect.TypeOf((*MockecsTaskCommandExecutor)(nil).RunningTasksInFamily), clusterName, family)
+}
+
+// MockecsServiceScaler is a mock of ecsServiceScaler interface
+type MockecsServiceScaler struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsServiceScalerMockRecorder
+}
+
+// MockecsServiceScalerMockRecorder is the mock recorder for MockecsServiceScaler
+type MockecsServiceScalerMockRecorder struct {
+	mock *MockecsServiceScaler
+}
+
+// NewMockecsServiceScaler creates a new mock instance
+func NewMockecsServiceScaler(ctrl *gomock.Controller) *MockecsServiceScaler {
+	mock := &MockecsServiceScaler{ctrl: ctrl}
+	mock.recorder = &MockecsServiceScalerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockecsServiceScaler) EXPECT() *MockecsServiceScalerMockRecorder {
+	return m.recorder
+}
+
+// Service mocks base method
+func (m *MockecsServiceScaler) Service(clusterName, serviceName string) (*ecs.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", clusterName, serviceName)
+	ret0, _ := ret[0].(*ecs.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service
+func (mr *MockecsServiceScalerMockRecorder) Service(clusterName, serviceName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockecsServiceScaler)(nil).Service), clusterName, serviceName)
+}
+
+// UpdateServiceDesiredCount mocks base method
+func (m *MockecsServiceScaler) UpdateServiceDesiredCount(clusterName, serviceName string, desiredCount int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServiceDesiredCount", clusterName, serviceName, desiredCount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServiceDesiredCount indicates an expected call of UpdateServiceDesiredCount
+func (mr *MockecsServiceScalerMockRecorder) UpdateServiceDesiredCount(clusterName, serviceName, desiredCount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceDesiredCount", reflect.TypeOf((*MockecsServiceScaler)(nil).UpdateServiceDesiredCount), clusterName, serviceName, desiredCount)
+}
+
+// MockecsServiceTasksDescriber is a mock of ecsServiceTasksDescriber interface
+type MockecsServiceTasksDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsServiceTasksDescriberMockRecorder
+}
+
+// MockecsServiceTasksDescriberMockRecorder is the mock recorder for MockecsServiceTasksDescriber
+type MockecsServiceTasksDescriberMockRecorder struct {
+	mock *MockecsServiceTasksDescriber
+}
+
+// NewMockecsServiceTasksDescriber creates a new mock instance
+func NewMockecsServiceTasksDescriber(ctrl *gomock.Controller) *MockecsServiceTasksDescriber {
+	mock := &MockecsServiceTasksDescriber{ctrl: ctrl}
+	mock.recorder = &MockecsServiceTasksDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockecsServiceTasksDescriber) EXPECT() *MockecsServiceTasksDescriberMockRecorder {
+	return m.recorder
+}
+
+// Service mocks base method
+func (m *MockecsServiceTasksDescriber) Service(clusterName, serviceName string) (*ecs.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", clusterName, serviceName)
+	ret0, _ := ret[0].(*ecs.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service
+func (mr *MockecsServiceTasksDescriberMockRecorder) Service(clusterName, serviceName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockecsServiceTasksDescriber)(nil).Service), clusterName, serviceName)
+}
+
+// ServiceTasks mocks base method
+func (m *MockecsServiceTasksDescriber) ServiceTasks(clusterName, serviceName string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceTasks", clusterName, serviceName)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceTasks indicates an expected call of ServiceTasks
+func (mr *MockecsServiceTasksDescriberMockRecorder) ServiceTasks(clusterName, serviceName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceTasks", reflect.TypeOf((*MockecsServiceTasksDescriber)(nil).ServiceTasks), clusterName, serviceName)
+}
+
+// MockecsServiceRolloutDescriber is a mock of ecsServiceRolloutDescriber interface
+type MockecsServiceRolloutDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsServiceRolloutDescriberMockRecorder
+}
+
+// MockecsServiceRolloutDescriberMockRecorder is the mock recorder for MockecsServiceRolloutDescriber
+type MockecsServiceRolloutDescriberMockRecorder struct {
+	mock *MockecsServiceRolloutDescriber
+}
+
+// NewMockecsServiceRolloutDescriber creates a new mock instance
+func NewMockecsServiceRolloutDescriber(ctrl *gomock.Controller) *MockecsServiceRolloutDescriber {
+	mock := &MockecsServiceRolloutDescriber{ctrl: ctrl}
+	mock.recorder = &MockecsServiceRolloutDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockecsServiceRolloutDescriber) EXPECT() *MockecsServiceRolloutDescriberMockRecorder {
+	return m.recorder
+}
+
+// Service mocks base method
+func (m *MockecsServiceRolloutDescriber) Service(clusterName, serviceName string) (*ecs.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", clusterName, serviceName)
+	ret0, _ := ret[0].(*ecs.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service
+func (mr *MockecsServiceRolloutDescriberMockRecorder) Service(clusterName, serviceName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockecsServiceRolloutDescriber)(nil).Service), clusterName, serviceName)
+}
+
+// StoppedServiceTasks mocks base method
+func (m *MockecsServiceRolloutDescriber) StoppedServiceTasks(clusterName, serviceName string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoppedServiceTasks", clusterName, serviceName)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoppedServiceTasks indicates an expected call of StoppedServiceTasks
+func (mr *MockecsServiceRolloutDescriberMockRecorder) StoppedServiceTasks(clusterName, serviceName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoppedServiceTasks", reflect.TypeOf((*MockecsServiceRolloutDescriber)(nil).StoppedServiceTasks), clusterName, serviceName)
+}
+
+// MockserviceScalingSuspender is a mock of serviceScalingSuspender interface
+type MockserviceScalingSuspender struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceScalingSuspenderMockRecorder
+}
+
+// MockserviceScalingSuspenderMockRecorder is the mock recorder for MockserviceScalingSuspender
+type MockserviceScalingSuspenderMockRecorder struct {
+	mock *MockserviceScalingSuspender
+}
+
+// NewMockserviceScalingSuspender creates a new mock instance
+func NewMockserviceScalingSuspender(ctrl *gomock.Controller) *MockserviceScalingSuspender {
+	mock := &MockserviceScalingSuspender{ctrl: ctrl}
+	mock.recorder = &MockserviceScalingSuspenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockserviceScalingSuspender) EXPECT() *MockserviceScalingSuspenderMockRecorder {
+	return m.recorder
+}
+
+// SuspendECSServiceScaling mocks base method
+func (m *MockserviceScalingSuspender) SuspendECSServiceScaling(cluster, service string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendECSServiceScaling", cluster, service)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SuspendECSServiceScaling indicates an expected call of SuspendECSServiceScaling
+func (mr *MockserviceScalingSuspenderMockRecorder) SuspendECSServiceScaling(cluster, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendECSServiceScaling", reflect.TypeOf((*MockserviceScalingSuspender)(nil).SuspendECSServiceScaling), cluster, service)
+}
+
+// ResumeECSServiceScaling mocks base method
+func (m *MockserviceScalingSuspender) ResumeECSServiceScaling(cluster, service string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeECSServiceScaling", cluster, service)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeECSServiceScaling indicates an expected call of ResumeECSServiceScaling
+func (mr *MockserviceScalingSuspenderMockRecorder) ResumeECSServiceScaling(cluster, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeECSServiceScaling", reflect.TypeOf((*MockserviceScalingSuspender)(nil).ResumeECSServiceScaling), cluster, service)
+}
+
+// MockssmSessionStarter is a mock of ssmSessionStarter interface
+type MockssmSessionStarter struct {
+	ctrl     *gomock.Controller
+	recorder *MockssmSessionStarterMockRecorder
+}
+
+// MockssmSessionStarterMockRecorder is the mock recorder for MockssmSessionStarter
+type MockssmSessionStarterMockRecorder struct {
+	mock *MockssmSessionStarter
+}
+
+// NewMockssmSessionStarter creates a new mock instance
+func NewMockssmSessionStarter(ctrl *gomock.Controller) *MockssmSessionStarter {
+	mock := &MockssmSessionStarter{ctrl: ctrl}
+	mock.recorder = &MockssmSessionStarterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockssmSessionStarter) EXPECT() *MockssmSessionStarterMockRecorder {
+	return m.recorder
+}
+
+// ValidateInstalled mocks base method
+func (m *MockssmSessionStarter) ValidateInstalled() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateInstalled")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateInstalled indicates an expected call of ValidateInstalled
+func (mr *MockssmSessionStarterMockRecorder) ValidateInstalled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateInstalled", reflect.TypeOf((*MockssmSessionStarter)(nil).ValidateInstalled))
+}
+
+// StartSession mocks base method
+func (m *MockssmSessionStarter) StartSession(sess *ecs.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSession", sess)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartSession indicates an expected call of StartSession
+func (mr *MockssmSessionStarterMockRecorder) StartSession(sess interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSession", reflect.TypeOf((*MockssmSessionStarter)(nil).StartSession), sess)
+}
+
+// Mockdeployer is a mock of deployer interface
+type Mockdeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployerMockRecorder
+}
+
+// MockdeployerMockRecorder is the mock recorder for Mockdeployer
+type MockdeployerMockRecorder struct {
+	mock *Mockdeployer
+}
+
+// NewMockdeployer creates a new mock instance
+func NewMockdeployer(ctrl *gomock.Controller) *Mockdeployer {
+	mock := &Mockdeployer{ctrl: ctrl}
+	mock.recorder = &MockdeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *Mockdeployer) EXPECT() *MockdeployerMockRecorder {
+	return m.recorder
+}
+
+// DeployEnvironment mocks base method
+func (m *Mockdeployer) DeployEnvironment(env *deploy.CreateEnvironmentInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployEnvironment indicates an expected call of DeployEnvironment
+func (mr *MockdeployerMockRecorder) DeployEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployEnvironment", reflect.TypeOf((*Mockdeployer)(nil).DeployEnvironment), env)
 }
 
 // StreamEnvironmentCreation mocks base method
@@ -3700,7 +5503,21 @@ func (m *Mockdeployer) UpdateEnvironmentTemplate(appName, envName, templateBody,
 // UpdateEnvironmentTemplate indicates an expected call of UpdateEnvironmentTemplate
 func (mr *MockdeployerMockRecorder) UpdateEnvironmentTemplate(appName, envName, templateBody, cfnExecRoleARN interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironmentTemplate", reflect.TypeOf((*Mockdeployer)(nil).UpdateEnvironmentTemplate), appName, envName, templateBody, cfnExecRoleARN)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironmentTemplate", reflect.TypeOf((*Mockdeployer)(nil).UpdateEnvironmentTemplate), appName, envName, templateBody, cfnExecRoleARN)
+}
+
+// SetEnvironmentTerminationProtection mocks base method
+func (m *Mockdeployer) SetEnvironmentTerminationProtection(appName, envName string, enabled bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEnvironmentTerminationProtection", appName, envName, enabled)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEnvironmentTerminationProtection indicates an expected call of SetEnvironmentTerminationProtection
+func (mr *MockdeployerMockRecorder) SetEnvironmentTerminationProtection(appName, envName, enabled interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEnvironmentTerminationProtection", reflect.TypeOf((*Mockdeployer)(nil).SetEnvironmentTerminationProtection), appName, envName, enabled)
 }
 
 // DeployApp mocks base method
@@ -4146,6 +5963,324 @@ func (mr *MockpipelineGetterMockRecorder) ListPipelineNamesByTags(tags interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelineNamesByTags", reflect.TypeOf((*MockpipelineGetter)(nil).ListPipelineNamesByTags), tags)
 }
 
+// MockpipelineStateGetter is a mock of pipelineStateGetter interface
+type MockpipelineStateGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineStateGetterMockRecorder
+}
+
+// MockpipelineStateGetterMockRecorder is the mock recorder for MockpipelineStateGetter
+type MockpipelineStateGetterMockRecorder struct {
+	mock *MockpipelineStateGetter
+}
+
+// NewMockpipelineStateGetter creates a new mock instance
+func NewMockpipelineStateGetter(ctrl *gomock.Controller) *MockpipelineStateGetter {
+	mock := &MockpipelineStateGetter{ctrl: ctrl}
+	mock.recorder = &MockpipelineStateGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockpipelineStateGetter) EXPECT() *MockpipelineStateGetterMockRecorder {
+	return m.recorder
+}
+
+// GetPipelineState mocks base method
+func (m *MockpipelineStateGetter) GetPipelineState(pipelineName string) (*codepipeline.PipelineState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipelineState", pipelineName)
+	ret0, _ := ret[0].(*codepipeline.PipelineState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipelineState indicates an expected call of GetPipelineState
+func (mr *MockpipelineStateGetterMockRecorder) GetPipelineState(pipelineName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineState", reflect.TypeOf((*MockpipelineStateGetter)(nil).GetPipelineState), pipelineName)
+}
+
+// MockpipelineExecutionGetter is a mock of pipelineExecutionGetter interface
+type MockpipelineExecutionGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineExecutionGetterMockRecorder
+}
+
+// MockpipelineExecutionGetterMockRecorder is the mock recorder for MockpipelineExecutionGetter
+type MockpipelineExecutionGetterMockRecorder struct {
+	mock *MockpipelineExecutionGetter
+}
+
+// NewMockpipelineExecutionGetter creates a new mock instance
+func NewMockpipelineExecutionGetter(ctrl *gomock.Controller) *MockpipelineExecutionGetter {
+	mock := &MockpipelineExecutionGetter{ctrl: ctrl}
+	mock.recorder = &MockpipelineExecutionGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockpipelineExecutionGetter) EXPECT() *MockpipelineExecutionGetterMockRecorder {
+	return m.recorder
+}
+
+// LatestExecutionID mocks base method
+func (m *MockpipelineExecutionGetter) LatestExecutionID(pipelineName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestExecutionID", pipelineName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestExecutionID indicates an expected call of LatestExecutionID
+func (mr *MockpipelineExecutionGetterMockRecorder) LatestExecutionID(pipelineName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestExecutionID", reflect.TypeOf((*MockpipelineExecutionGetter)(nil).LatestExecutionID), pipelineName)
+}
+
+// ExecutionStatus mocks base method
+func (m *MockpipelineExecutionGetter) ExecutionStatus(pipelineName, executionID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecutionStatus", pipelineName, executionID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecutionStatus indicates an expected call of ExecutionStatus
+func (mr *MockpipelineExecutionGetterMockRecorder) ExecutionStatus(pipelineName, executionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecutionStatus", reflect.TypeOf((*MockpipelineExecutionGetter)(nil).ExecutionStatus), pipelineName, executionID)
+}
+
+// MockpipelineExecutor is a mock of pipelineExecutor interface
+type MockpipelineExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineExecutorMockRecorder
+}
+
+// MockpipelineExecutorMockRecorder is the mock recorder for MockpipelineExecutor
+type MockpipelineExecutorMockRecorder struct {
+	mock *MockpipelineExecutor
+}
+
+// NewMockpipelineExecutor creates a new mock instance
+func NewMockpipelineExecutor(ctrl *gomock.Controller) *MockpipelineExecutor {
+	mock := &MockpipelineExecutor{ctrl: ctrl}
+	mock.recorder = &MockpipelineExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockpipelineExecutor) EXPECT() *MockpipelineExecutorMockRecorder {
+	return m.recorder
+}
+
+// StartExecution mocks base method
+func (m *MockpipelineExecutor) StartExecution(pipelineName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartExecution", pipelineName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartExecution indicates an expected call of StartExecution
+func (mr *MockpipelineExecutorMockRecorder) StartExecution(pipelineName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartExecution", reflect.TypeOf((*MockpipelineExecutor)(nil).StartExecution), pipelineName)
+}
+
+// RetryStageExecution mocks base method
+func (m *MockpipelineExecutor) RetryStageExecution(pipelineName, stageName, executionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryStageExecution", pipelineName, stageName, executionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryStageExecution indicates an expected call of RetryStageExecution
+func (mr *MockpipelineExecutorMockRecorder) RetryStageExecution(pipelineName, stageName, executionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryStageExecution", reflect.TypeOf((*MockpipelineExecutor)(nil).RetryStageExecution), pipelineName, stageName, executionID)
+}
+
+// MockbuildActionLister is a mock of buildActionLister interface
+type MockbuildActionLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockbuildActionListerMockRecorder
+}
+
+// MockbuildActionListerMockRecorder is the mock recorder for MockbuildActionLister
+type MockbuildActionListerMockRecorder struct {
+	mock *MockbuildActionLister
+}
+
+// NewMockbuildActionLister creates a new mock instance
+func NewMockbuildActionLister(ctrl *gomock.Controller) *MockbuildActionLister {
+	mock := &MockbuildActionLister{ctrl: ctrl}
+	mock.recorder = &MockbuildActionListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockbuildActionLister) EXPECT() *MockbuildActionListerMockRecorder {
+	return m.recorder
+}
+
+// BuildActions mocks base method
+func (m *MockbuildActionLister) BuildActions(pipelineName string) ([]*codepipeline.BuildAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildActions", pipelineName)
+	ret0, _ := ret[0].([]*codepipeline.BuildAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildActions indicates an expected call of BuildActions
+func (mr *MockbuildActionListerMockRecorder) BuildActions(pipelineName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildActions", reflect.TypeOf((*MockbuildActionLister)(nil).BuildActions), pipelineName)
+}
+
+// MockbuildGetter is a mock of buildGetter interface
+type MockbuildGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockbuildGetterMockRecorder
+}
+
+// MockbuildGetterMockRecorder is the mock recorder for MockbuildGetter
+type MockbuildGetterMockRecorder struct {
+	mock *MockbuildGetter
+}
+
+// NewMockbuildGetter creates a new mock instance
+func NewMockbuildGetter(ctrl *gomock.Controller) *MockbuildGetter {
+	mock := &MockbuildGetter{ctrl: ctrl}
+	mock.recorder = &MockbuildGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockbuildGetter) EXPECT() *MockbuildGetterMockRecorder {
+	return m.recorder
+}
+
+// LatestBuild mocks base method
+func (m *MockbuildGetter) LatestBuild(projectName string) (*codebuild.Build, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestBuild", projectName)
+	ret0, _ := ret[0].(*codebuild.Build)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestBuild indicates an expected call of LatestBuild
+func (mr *MockbuildGetterMockRecorder) LatestBuild(projectName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestBuild", reflect.TypeOf((*MockbuildGetter)(nil).LatestBuild), projectName)
+}
+
+// Builds mocks base method
+func (m *MockbuildGetter) Builds(ids []string) ([]*codebuild.Build, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Builds", ids)
+	ret0, _ := ret[0].([]*codebuild.Build)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Builds indicates an expected call of Builds
+func (mr *MockbuildGetterMockRecorder) Builds(ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Builds", reflect.TypeOf((*MockbuildGetter)(nil).Builds), ids)
+}
+
+// MockbuildLogsWriter is a mock of buildLogsWriter interface
+type MockbuildLogsWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockbuildLogsWriterMockRecorder
+}
+
+// MockbuildLogsWriterMockRecorder is the mock recorder for MockbuildLogsWriter
+type MockbuildLogsWriterMockRecorder struct {
+	mock *MockbuildLogsWriter
+}
+
+// NewMockbuildLogsWriter creates a new mock instance
+func NewMockbuildLogsWriter(ctrl *gomock.Controller) *MockbuildLogsWriter {
+	mock := &MockbuildLogsWriter{ctrl: ctrl}
+	mock.recorder = &MockbuildLogsWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockbuildLogsWriter) EXPECT() *MockbuildLogsWriterMockRecorder {
+	return m.recorder
+}
+
+// WriteLogEvents mocks base method
+func (m *MockbuildLogsWriter) WriteLogEvents(builds []*codebuild.Build, opts pipelinelogging.WriteLogEventsOpts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteLogEvents", builds, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteLogEvents indicates an expected call of WriteLogEvents
+func (mr *MockbuildLogsWriterMockRecorder) WriteLogEvents(builds, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteLogEvents", reflect.TypeOf((*MockbuildLogsWriter)(nil).WriteLogEvents), builds, opts)
+}
+
+// MockcursorMover is a mock of cursorMover interface
+type MockcursorMover struct {
+	ctrl     *gomock.Controller
+	recorder *MockcursorMoverMockRecorder
+}
+
+// MockcursorMoverMockRecorder is the mock recorder for MockcursorMover
+type MockcursorMoverMockRecorder struct {
+	mock *MockcursorMover
+}
+
+// NewMockcursorMover creates a new mock instance
+func NewMockcursorMover(ctrl *gomock.Controller) *MockcursorMover {
+	mock := &MockcursorMover{ctrl: ctrl}
+	mock.recorder = &MockcursorMoverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockcursorMover) EXPECT() *MockcursorMoverMockRecorder {
+	return m.recorder
+}
+
+// Up mocks base method
+func (m *MockcursorMover) Up(n int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Up", n)
+}
+
+// Up indicates an expected call of Up
+func (mr *MockcursorMoverMockRecorder) Up(n interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Up", reflect.TypeOf((*MockcursorMover)(nil).Up), n)
+}
+
+// EraseLine mocks base method
+func (m *MockcursorMover) EraseLine() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "EraseLine")
+}
+
+// EraseLine indicates an expected call of EraseLine
+func (mr *MockcursorMoverMockRecorder) EraseLine() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseLine", reflect.TypeOf((*MockcursorMover)(nil).EraseLine))
+}
+
 // Mockexecutor is a mock of executor interface
 type Mockexecutor struct {
 	ctrl     *gomock.Controller
@@ -4518,6 +6653,89 @@ func (mr *MockdeploySelectorMockRecorder) DeployedService(prompt, help, app inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedService", reflect.TypeOf((*MockdeploySelector)(nil).DeployedService), varargs...)
 }
 
+// MockdeployEnvSelector is a mock of deployEnvSelector interface
+type MockdeployEnvSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployEnvSelectorMockRecorder
+}
+
+// MockdeployEnvSelectorMockRecorder is the mock recorder for MockdeployEnvSelector
+type MockdeployEnvSelectorMockRecorder struct {
+	mock *MockdeployEnvSelector
+}
+
+// NewMockdeployEnvSelector creates a new mock instance
+func NewMockdeployEnvSelector(ctrl *gomock.Controller) *MockdeployEnvSelector {
+	mock := &MockdeployEnvSelector{ctrl: ctrl}
+	mock.recorder = &MockdeployEnvSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockdeployEnvSelector) EXPECT() *MockdeployEnvSelectorMockRecorder {
+	return m.recorder
+}
+
+// Application mocks base method
+func (m *MockdeployEnvSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Application", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Application indicates an expected call of Application
+func (mr *MockdeployEnvSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockdeployEnvSelector)(nil).Application), varargs...)
+}
+
+// DeployedService mocks base method
+func (m *MockdeployEnvSelector) DeployedService(prompt, help, app string, opts ...selector.GetDeployedServiceOpts) (*selector.DeployedService, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help, app}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployedService", varargs...)
+	ret0, _ := ret[0].(*selector.DeployedService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployedService indicates an expected call of DeployedService
+func (mr *MockdeployEnvSelectorMockRecorder) DeployedService(prompt, help, app interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help, app}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedService", reflect.TypeOf((*MockdeployEnvSelector)(nil).DeployedService), varargs...)
+}
+
+// Environment mocks base method
+func (m *MockdeployEnvSelector) Environment(prompt, help, app string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help, app}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Environment", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Environment indicates an expected call of Environment
+func (mr *MockdeployEnvSelectorMockRecorder) Environment(prompt, help, app interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help, app}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environment", reflect.TypeOf((*MockdeployEnvSelector)(nil).Environment), varargs...)
+}
+
 // MockwsSelector is a mock of wsSelector interface
 type MockwsSelector struct {
 	ctrl     *gomock.Controller
@@ -4611,6 +6829,21 @@ func (mr *MockwsSelectorMockRecorder) Job(prompt, help interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockwsSelector)(nil).Job), prompt, help)
 }
 
+// Workload mocks base method
+func (m *MockwsSelector) Workload(prompt, help string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Workload", prompt, help)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Workload indicates an expected call of Workload
+func (mr *MockwsSelectorMockRecorder) Workload(prompt, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Workload", reflect.TypeOf((*MockwsSelector)(nil).Workload), prompt, help)
+}
+
 // MockinitJobSelector is a mock of initJobSelector interface
 type MockinitJobSelector struct {
 	ctrl     *gomock.Controller
@@ -4664,6 +6897,21 @@ func (mr *MockinitJobSelectorMockRecorder) Schedule(scheduleTypePrompt, schedule
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockinitJobSelector)(nil).Schedule), scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator)
 }
 
+// Timezone mocks base method
+func (m *MockinitJobSelector) Timezone(timezoneValidator prompt.ValidatorFunc) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Timezone", timezoneValidator)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Timezone indicates an expected call of Timezone
+func (mr *MockinitJobSelectorMockRecorder) Timezone(timezoneValidator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Timezone", reflect.TypeOf((*MockinitJobSelector)(nil).Timezone), timezoneValidator)
+}
+
 // MockdockerfileSelector is a mock of dockerfileSelector interface
 type MockdockerfileSelector struct {
 	ctrl     *gomock.Controller
@@ -4,11 +4,23 @@
 package cli
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/aws/copilot-cli/cmd/copilot/template"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/cobra"
 )
 
+const (
+	pipelineSelectManifestPrompt     = "Which pipeline in your workspace would you like to use?"
+	pipelineSelectManifestHelpPrompt = "A workspace can contain several pipelines, each defined by a manifest under copilot/pipelines/."
+)
+
 // BuildPipelineCmd is the top level command for pipelines
 func BuildPipelineCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -24,6 +36,11 @@ Continuous delivery pipelines to release services.`,
 	cmd.AddCommand(buildPipelineDeleteCmd())
 	cmd.AddCommand(buildPipelineShowCmd())
 	cmd.AddCommand(buildPipelineStatusCmd())
+	cmd.AddCommand(buildPipelineListCmd())
+	cmd.AddCommand(buildPipelineRunCmd())
+	cmd.AddCommand(buildPipelineRetryCmd())
+	cmd.AddCommand(buildPipelineLogsCmd())
+	cmd.AddCommand(buildPipelineUpgradeManifestCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -32,3 +49,72 @@ Continuous delivery pipelines to release services.`,
 
 	return cmd
 }
+
+// selectWorkspacePipeline returns the pipeline manifest named name in the workspace.
+// If name is empty, it returns the only pipeline of the workspace or prompts the user to select one.
+func selectWorkspacePipeline(ws wsPipelineLister, prompt prompter, name string) (*workspace.PipelineManifest, error) {
+	pipelines, err := ws.ListPipelines()
+	if err != nil {
+		return nil, fmt.Errorf("list pipelines in the workspace: %w", err)
+	}
+	if len(pipelines) == 0 {
+		return nil, workspace.ErrNoPipelineInWorkspace
+	}
+	if name != "" {
+		for i := range pipelines {
+			if pipelines[i].Name == name {
+				return &pipelines[i], nil
+			}
+		}
+		return nil, fmt.Errorf("pipeline %s does not exist in the workspace", name)
+	}
+	if len(pipelines) == 1 {
+		return &pipelines[0], nil
+	}
+
+	var names []string
+	for _, pipeline := range pipelines {
+		names = append(names, pipeline.Name)
+	}
+	selected, err := prompt.SelectOne(pipelineSelectManifestPrompt, pipelineSelectManifestHelpPrompt, names)
+	if err != nil {
+		return nil, fmt.Errorf("select pipeline: %w", err)
+	}
+	for i := range pipelines {
+		if pipelines[i].Name == selected {
+			return &pipelines[i], nil
+		}
+	}
+	return nil, fmt.Errorf("pipeline %s does not exist in the workspace", selected)
+}
+
+// selectDeployedPipeline returns the name of the pipeline in the workspace if there is one,
+// otherwise it returns the only deployed pipeline of the application or prompts the user to select one.
+func selectDeployedPipeline(ws wsPipelineLister, prompt prompter, pipelineSvc pipelineGetter, appName, msg, help string) (string, error) {
+	pipeline, err := selectWorkspacePipeline(ws, prompt, "")
+	if err == nil {
+		return pipeline.Name, nil
+	}
+	if errors.Is(err, workspace.ErrNoPipelineInWorkspace) {
+		log.Infof("No pipeline manifest in workspace for application %s, looking for deployed pipelines.\n", color.HighlightUserInput(appName))
+	}
+
+	names, err := pipelineSvc.ListPipelineNamesByTags(map[string]string{
+		deploy.AppTagKey: appName,
+	})
+	if err != nil {
+		return "", fmt.Errorf("list pipelines: %w", err)
+	}
+	switch len(names) {
+	case 0:
+		return "", fmt.Errorf("no pipelines found for application %s", appName)
+	case 1:
+		log.Infof("Found pipeline: %s\n", color.HighlightUserInput(names[0]))
+		return names[0], nil
+	}
+	name, err := prompt.SelectOne(msg, help, names)
+	if err != nil {
+		return "", fmt.Errorf("select pipeline for application %s: %w", appName, err)
+	}
+	return name, nil
+}
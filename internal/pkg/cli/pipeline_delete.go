@@ -36,6 +36,7 @@ var (
 
 type deletePipelineVars struct {
 	appName            string
+	name               string
 	skipConfirmation   bool
 	shouldDeleteSecret bool
 }
@@ -130,7 +131,11 @@ func (o *deletePipelineOpts) Execute() error {
 }
 
 func (o *deletePipelineOpts) readPipelineManifest() error {
-	data, err := o.ws.ReadPipelineManifest()
+	pipelineMft, err := selectWorkspacePipeline(o.ws, o.prompt, o.name)
+	if err != nil {
+		return err
+	}
+	data, err := o.ws.ReadPipelineManifest(pipelineMft.Path)
 	if err != nil {
 		if err == workspace.ErrNoPipelineInWorkspace {
 			return err
@@ -214,7 +219,10 @@ func buildPipelineDeleteCmd() *cobra.Command {
 		Short: "Deletes the pipeline associated with your workspace.",
 		Example: `
   Delete the pipeline associated with your workspace.
-  /code $ copilot pipeline delete`,
+  /code $ copilot pipeline delete
+
+  Delete the pipeline named "release" from a workspace with several pipelines.
+  /code $ copilot pipeline delete --name release`,
 
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newDeletePipelineOpts(vars)
@@ -225,6 +233,7 @@ func buildPipelineDeleteCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldDeleteSecret, deleteSecretFlag, false, deleteSecretFlagDescription)
 	return cmd
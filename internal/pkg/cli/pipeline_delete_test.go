@@ -50,15 +50,32 @@ stages:
 `
 
 	testCases := map[string]struct {
-		inAppName string
-		callMocks func(m deletePipelineMocks)
+		inAppName      string
+		inPipelineName string
+		callMocks      func(m deletePipelineMocks)
 
 		wantedError error
 	}{
 		"happy path": {
 			inAppName: testAppName,
 			callMocks: func(m deletePipelineMocks) {
-				m.ws.EXPECT().ReadPipelineManifest().Return([]byte(pipelineData), nil)
+				m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{
+					{Name: "pipeline-badgoose-honker-repo", Path: "/copilot/pipelines/pipeline-badgoose-honker-repo/manifest.yml"},
+				}, nil)
+				m.ws.EXPECT().ReadPipelineManifest("/copilot/pipelines/pipeline-badgoose-honker-repo/manifest.yml").Return([]byte(pipelineData), nil)
+			},
+			wantedError: nil,
+		},
+
+		"selects the pipeline by name": {
+			inAppName:      testAppName,
+			inPipelineName: "pipeline-badgoose-honker-repo",
+			callMocks: func(m deletePipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{
+					{Name: "pipeline-badgoose-honker-repo", Path: "/copilot/pipelines/pipeline-badgoose-honker-repo/manifest.yml"},
+					{Name: "pipeline-badgoose-honker-release", Path: "/copilot/pipelines/pipeline-badgoose-honker-release/manifest.yml"},
+				}, nil)
+				m.ws.EXPECT().ReadPipelineManifest("/copilot/pipelines/pipeline-badgoose-honker-repo/manifest.yml").Return([]byte(pipelineData), nil)
 			},
 			wantedError: nil,
 		},
@@ -66,12 +83,24 @@ stages:
 		"pipeline manifest does not exist": {
 			inAppName: testAppName,
 			callMocks: func(m deletePipelineMocks) {
-				m.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace)
+				m.ws.EXPECT().ListPipelines().Return(nil, nil)
 			},
 
 			wantedError: workspace.ErrNoPipelineInWorkspace,
 		},
 
+		"pipeline name does not exist in the workspace": {
+			inAppName:      testAppName,
+			inPipelineName: "badpipeline",
+			callMocks: func(m deletePipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{
+					{Name: "pipeline-badgoose-honker-repo", Path: "/copilot/pipelines/pipeline-badgoose-honker-repo/manifest.yml"},
+				}, nil)
+			},
+
+			wantedError: errors.New("pipeline badpipeline does not exist in the workspace"),
+		},
+
 		"application does not exist": {
 			inAppName: "",
 			callMocks: func(m deletePipelineMocks) {},
@@ -96,6 +125,7 @@ stages:
 			opts := &deletePipelineOpts{
 				deletePipelineVars: deletePipelineVars{
 					appName: tc.inAppName,
+					name:    tc.inPipelineName,
 				},
 				ws: mockWorkspace,
 			}
@@ -10,6 +10,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -35,18 +36,42 @@ const (
 
 	pipelineSelectEnvPrompt = "Which environment would you like to add to your pipeline?"
 
-	pipelineSelectGitHubURLPrompt     = "Which GitHub repository would you like to use for your service?"
-	pipelineSelectGitHubURLHelpPrompt = `The GitHub repository linked to your workspace.
+	pipelineSelectURLPrompt     = "Which repository would you like to use for your pipeline?"
+	pipelineSelectURLHelpPrompt = `The repository linked to your workspace.
 Pushing to this repository will trigger your pipeline build stage.
-Please enter full repository URL, e.g. "https://github.com/myCompany/myRepo", or the owner/rep, e.g. "myCompany/myRepo"`
+Please enter full repository URL, e.g. "https://github.com/myCompany/myRepo", "https://bitbucket.org/myCompany/myRepo",
+or "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/myRepo".`
+
+	pipelineSelectGitHubAuthPrompt     = "How would you like your pipeline to access your GitHub repository?"
+	pipelineSelectGitHubAuthHelpPrompt = `A GitHub App connection is managed by AWS CodeStar and must be authorized in the AWS console once the pipeline is created.
+A personal access token is stored in AWS Secrets Manager.`
+
+	fmtPipelineConfirmGHEPrompt  = "Is %s a GitHub Enterprise Server?"
+	pipelineConfirmGHEHelpPrompt = `Pipelines can pull from repositories hosted on GitHub, GitHub Enterprise Server, Bitbucket or AWS CodeCommit.
+A GitHub Enterprise Server is accessed through an AWS CodeStar connection to its host.`
+
+	pipelineConnectionARNPrompt     = "What is the ARN of the AWS CodeStar connection to your repository?"
+	pipelineConnectionARNHelpPrompt = `The connection to your GitHub Enterprise Server must be created from the AWS console.
+For more information, please refer to: https://docs.aws.amazon.com/dtconsole/latest/userguide/connections-create-gheserver.html.`
+)
+
+const (
+	pipelineGitHubAppAuth   = "GitHub App (AWS CodeStar connection)"
+	pipelineGitHubTokenAuth = "Personal access token"
 )
 
 const (
 	buildspecTemplatePath = "cicd/buildspec.yml"
 	githubURL             = "github.com"
+	bitbucketURL          = "bitbucket.org"
 	defaultBranch         = "main"
 )
 
+var codecommitHostExp = regexp.MustCompile(`^git-codecommit\.([a-z0-9-]+)\.amazonaws\.com(\.cn)?$`)
+
+// CodePipeline can only pull from CodeCommit repositories in the region of the pipeline.
+const fmtErrCodeCommitRegion = "CodeCommit repository %s is in region %s, but the pipeline is deployed in region %s: the repository must be in the same region as the pipeline"
+
 var (
 	// Filled in via the -ldflags flag at compile time to support pipeline buildspec CLI pulling.
 	binaryS3BucketPath string
@@ -56,14 +81,31 @@ var errNoEnvsInApp = errors.New("there were no more environments found that can
 
 type initPipelineVars struct {
 	appName           string
+	name              string
 	environments      []string
-	githubOwner       string
-	githubRepo        string
-	githubURL         string
+	repoURL           string
 	githubAccessToken string
+	connectionARN     string
 	gitBranch         string
 }
 
+// sourceRepository is the repository that triggers the pipeline.
+type sourceRepository struct {
+	provider string // The name of the manifest source provider, such as "GitHub" or "CodeCommit".
+	host     string
+	owner    string // Empty for CodeCommit repositories.
+	name     string
+	region   string // Only set for CodeCommit repositories.
+}
+
+// url returns the HTTPS URL of the repository.
+func (r *sourceRepository) url() string {
+	if r.provider == manifest.CodeCommitProviderName {
+		return fmt.Sprintf("https://%s/v1/repos/%s", r.host, r.name)
+	}
+	return fmt.Sprintf("https://%s/%s/%s", r.host, r.owner, r.name)
+}
+
 type initPipelineOpts struct {
 	initPipelineVars
 	// Interfaces to interact with dependencies.
@@ -74,8 +116,10 @@ type initPipelineOpts struct {
 	cfnClient      appResourcesGetter
 	store          store
 	prompt         prompter
+	region         string // Region of the pipeline.
 
 	// Outputs stored on successful actions.
+	repo       *sourceRepository
 	secretName string
 
 	// Caches variables
@@ -143,6 +187,7 @@ func newInitPipelineOpts(vars initPipelineVars) (*initPipelineOpts, error) {
 		return nil, err
 	}
 	opts.cfnClient = cloudformation.New(defaultSession)
+	opts.region = aws.StringValue(defaultSession.Config.Region)
 
 	opts.prompt = prompt.New()
 	return opts, nil
@@ -154,6 +199,14 @@ func (o *initPipelineOpts) Validate() error {
 	if o.appName == "" {
 		return errNoAppInWorkspace
 	}
+	if o.name != "" {
+		if err := validatePipelineName(o.name); err != nil {
+			return err
+		}
+	}
+	if o.githubAccessToken != "" && o.connectionARN != "" {
+		return fmt.Errorf("cannot specify both --%s and --%s", githubAccessTokenFlag, connectionARNFlag)
+	}
 
 	return nil
 }
@@ -167,49 +220,62 @@ func (o *initPipelineOpts) Ask() error {
 		}
 	}
 
-	if o.githubURL == "" {
-		if err = o.selectGitHubURL(); err != nil {
+	if o.repoURL == "" {
+		if err = o.selectURL(); err != nil {
 			return err
 		}
 	}
-	if o.githubOwner, o.githubRepo, err = o.parseOwnerRepoName(o.githubURL); err != nil {
+	if o.repo, err = parseRepoURL(o.repoURL); err != nil {
 		return err
 	}
 
-	if o.githubAccessToken == "" {
-		if err = o.getGitHubAccessToken(); err != nil {
-			return err
+	switch o.repo.provider {
+	case manifest.GithubProviderName:
+		if o.githubAccessToken == "" && o.connectionARN == "" {
+			if err = o.selectGitHubAuth(); err != nil {
+				return err
+			}
+		}
+	case manifest.GitHubEnterpriseProviderName:
+		// Any host that isn't a known provider is assumed to be a GitHub Enterprise Server.
+		// Passing a connection ARN confirms it, otherwise ask the user before asking for the connection.
+		if o.connectionARN == "" {
+			if err = o.confirmGitHubEnterprise(); err != nil {
+				return err
+			}
+			if err = o.getConnectionARN(); err != nil {
+				return err
+			}
+		}
+	case manifest.CodeCommitProviderName:
+		if o.repo.region != o.region {
+			return fmt.Errorf(fmtErrCodeCommitRegion, o.repo.url(), o.repo.region, o.region)
 		}
 	}
 
 	if o.gitBranch == "" {
 		o.gitBranch = defaultBranch
 	}
+	if o.name == "" {
+		o.name = o.createPipelineName()
+	}
 	return nil
 }
 
 // Execute writes the pipeline manifest file.
 func (o *initPipelineOpts) Execute() error {
-	secretName := o.createSecretName()
-	_, err := o.secretsmanager.CreateSecret(secretName, o.githubAccessToken)
-
-	if err != nil {
-		var existsErr *secretsmanager.ErrSecretAlreadyExists
-		if !errors.As(err, &existsErr) {
+	if o.githubAccessToken != "" {
+		if err := o.storeGitHubAccessToken(); err != nil {
 			return err
 		}
-		log.Successf("Secret already exists for %s! Do nothing.\n", color.HighlightUserInput(o.githubRepo))
-	} else {
-		log.Successf("Created the secret %s for pipeline source stage!\n", color.HighlightUserInput(secretName))
 	}
-	o.secretName = secretName
 
 	// write pipeline.yml file, populate with:
-	//   - github repo as source
+	//   - repository as source
 	//   - stage names (environments)
 	//   - enable/disable transition to prod envs
 
-	err = o.createPipelineManifest()
+	err := o.createPipelineManifest()
 	if err != nil {
 		return err
 	}
@@ -224,27 +290,87 @@ func (o *initPipelineOpts) Execute() error {
 
 // RecommendedActions returns follow-up actions the user can take after successfully executing the command.
 func (o *initPipelineOpts) RecommendedActions() []string {
-	return []string{
+	actions := []string{
 		"Commit and push the generated buildspec and manifest file.",
 		fmt.Sprintf("Update the %s phase of your buildspec to unit test your services before pushing the images.", color.HighlightResource("build")),
 		"Update your pipeline manifest to add additional stages.",
-		fmt.Sprintf("Run %s to deploy your pipeline for the repository.", color.HighlightCode("copilot pipeline update")),
+		fmt.Sprintf("Run %s to deploy your pipeline for the repository.", color.HighlightCode(fmt.Sprintf("copilot pipeline update --name %s", o.name))),
+	}
+	if o.usesNewConnection() {
+		actions = append(actions, fmt.Sprintf("Complete the pending connection to your repository at %s once the pipeline is deployed.", color.HighlightResource(connectionsConsoleURL)))
+	}
+	return actions
+}
+
+func (o *initPipelineOpts) storeGitHubAccessToken() error {
+	secretName := o.createSecretName()
+	_, err := o.secretsmanager.CreateSecret(secretName, o.githubAccessToken)
+	if err != nil {
+		var existsErr *secretsmanager.ErrSecretAlreadyExists
+		if !errors.As(err, &existsErr) {
+			return err
+		}
+		log.Successf("Secret already exists for %s! Do nothing.\n", color.HighlightUserInput(o.repo.name))
+	} else {
+		log.Successf("Created the secret %s for pipeline source stage!\n", color.HighlightUserInput(secretName))
+	}
+	o.secretName = secretName
+	return nil
+}
+
+// usesNewConnection returns true if the pipeline stack creates a CodeStar connection that the user needs to complete.
+func (o *initPipelineOpts) usesNewConnection() bool {
+	if o.repo == nil || o.connectionARN != "" {
+		return false
+	}
+	switch o.repo.provider {
+	case manifest.BitbucketProviderName:
+		return true
+	case manifest.GithubProviderName:
+		return o.githubAccessToken == ""
+	default:
+		return false
 	}
 }
 
 func (o *initPipelineOpts) createSecretName() string {
-	return fmt.Sprintf("github-token-%s-%s", o.appName, o.githubRepo)
+	return fmt.Sprintf("github-token-%s-%s", o.appName, o.repo.name)
 }
 
 func (o *initPipelineOpts) createPipelineName() string {
-	return fmt.Sprintf("pipeline-%s-%s-%s", o.appName, o.githubOwner, o.githubRepo)
+	if o.repo.owner == "" {
+		return fmt.Sprintf("pipeline-%s-%s", o.appName, o.repo.name)
+	}
+	return fmt.Sprintf("pipeline-%s-%s-%s", o.appName, o.repo.owner, o.repo.name)
 }
 
 func (o *initPipelineOpts) createPipelineProvider() (manifest.Provider, error) {
-	config := &manifest.GitHubProperties{
-		OwnerAndRepository:    "https://" + githubURL + "/" + o.githubOwner + "/" + o.githubRepo,
-		Branch:                o.gitBranch,
-		GithubSecretIdKeyName: o.secretName,
+	var config interface{}
+	switch o.repo.provider {
+	case manifest.GithubProviderName:
+		config = &manifest.GitHubProperties{
+			OwnerAndRepository:    o.repo.url(),
+			Branch:                o.gitBranch,
+			GithubSecretIdKeyName: o.secretName,
+			ConnectionARN:         o.connectionARN,
+		}
+	case manifest.GitHubEnterpriseProviderName:
+		config = &manifest.GitHubEnterpriseProperties{
+			Repository:    o.repo.url(),
+			Branch:        o.gitBranch,
+			ConnectionARN: o.connectionARN,
+		}
+	case manifest.BitbucketProviderName:
+		config = &manifest.BitbucketProperties{
+			Repository:    o.repo.url(),
+			Branch:        o.gitBranch,
+			ConnectionARN: o.connectionARN,
+		}
+	case manifest.CodeCommitProviderName:
+		config = &manifest.CodeCommitProperties{
+			Repository: o.repo.url(),
+			Branch:     o.gitBranch,
+		}
 	}
 	return manifest.NewProvider(config)
 }
@@ -259,7 +385,6 @@ func (o *initPipelineOpts) getEnvConfig(environmentName string) (*config.Environ
 }
 
 func (o *initPipelineOpts) createPipelineManifest() error {
-	pipelineName := o.createPipelineName()
 	provider, err := o.createPipelineProvider()
 	if err != nil {
 		return fmt.Errorf("create pipeline provider: %w", err)
@@ -272,19 +397,21 @@ func (o *initPipelineOpts) createPipelineManifest() error {
 			return err
 		}
 		stage := manifest.PipelineStage{
-			Name:             env.Name,
-			RequiresApproval: env.Prod,
+			Name: env.Name,
+		}
+		if env.Prod {
+			stage.Approval = &manifest.PipelineApproval{Required: true}
 		}
 		stages = append(stages, stage)
 	}
 
-	manifest, err := manifest.NewPipelineManifest(pipelineName, provider, stages)
+	manifest, err := manifest.NewPipelineManifest(o.name, provider, stages)
 	if err != nil {
 		return fmt.Errorf("generate a pipeline manifest: %w", err)
 	}
 
 	var manifestExists bool
-	manifestPath, err := o.workspace.WritePipelineManifest(manifest)
+	manifestPath, err := o.workspace.WritePipelineManifest(manifest, o.name)
 	if err != nil {
 		e, ok := err.(*workspace.ErrFileExists)
 		if !ok {
@@ -303,7 +430,7 @@ func (o *initPipelineOpts) createPipelineManifest() error {
 	if manifestExists {
 		manifestMsgFmt = "Pipeline manifest file for %s already exists at %s, skipping writing it.\n"
 	}
-	log.Successf(manifestMsgFmt, color.HighlightUserInput(o.githubRepo), color.HighlightResource(manifestPath))
+	log.Successf(manifestMsgFmt, color.HighlightUserInput(o.name), color.HighlightResource(manifestPath))
 	log.Infoln("The manifest contains configurations for your CodePipeline resources, such as your pipeline stages and build steps.")
 	return nil
 }
@@ -325,7 +452,7 @@ func (o *initPipelineOpts) createBuildspec() error {
 	if err != nil {
 		return err
 	}
-	buildspecPath, err := o.workspace.WritePipelineBuildspec(content)
+	buildspecPath, err := o.workspace.WritePipelineBuildspec(content, o.name)
 	var buildspecExists bool
 	if err != nil {
 		e, ok := err.(*workspace.ErrFileExists)
@@ -448,29 +575,80 @@ func (o *initPipelineOpts) selectEnvironment() error {
 	return nil
 }
 
-func (o *initPipelineOpts) selectGitHubURL() error {
+func (o *initPipelineOpts) selectURL() error {
 	url, err := o.prompt.SelectOne(
-		pipelineSelectGitHubURLPrompt,
-		pipelineSelectGitHubURLHelpPrompt,
+		pipelineSelectURLPrompt,
+		pipelineSelectURLHelpPrompt,
 		o.repoURLs,
 	)
 	if err != nil {
-		return fmt.Errorf("select GitHub URL: %w", err)
+		return fmt.Errorf("select repository URL: %w", err)
 	}
-	o.githubURL = url
+	o.repoURL = url
 
 	return nil
 }
 
-func (o *initPipelineOpts) parseOwnerRepoName(url string) (string, string, error) {
-	regexPattern := regexp.MustCompile(`.*(github.com)(:|\/)`)
-	parsedURL := strings.TrimPrefix(url, regexPattern.FindString(url))
-	parsedURL = strings.TrimSuffix(parsedURL, ".git")
-	ownerRepo := strings.Split(parsedURL, "/")
-	if len(ownerRepo) != 2 {
-		return "", "", fmt.Errorf("unable to parse the GitHub repository owner and name from %s: please pass the repository URL with the format `--github-url https://github.com/{owner}/{repositoryName}`", url)
+// parseRepoURL detects the source provider, owner and name of the repository from its URL.
+// examples:
+// git@github.com:efekarakus/grit.git
+// https://huanjani@bitbucket.org/huanjani/sample.git
+// https://git-codecommit.us-west-2.amazonaws.com/v1/repos/grit
+// koke/grit
+func parseRepoURL(url string) (*sourceRepository, error) {
+	path := strings.TrimSuffix(strings.TrimSpace(url), ".git")
+	if i := strings.Index(path, "://"); i != -1 {
+		path = path[i+len("://"):]
+	}
+	if i := strings.Index(path, "@"); i != -1 {
+		path = path[i+1:]
+	}
+	// The scp-like syntax of SSH URLs separates the host from the path with a colon.
+	path = strings.Replace(path, ":", "/", 1)
+
+	parts := strings.Split(path, "/")
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("unable to parse the repository from %s: please pass the repository URL with the format `--%s https://{host}/{owner}/{repositoryName}`", url, repoURLFlag)
+		}
+	}
+	switch {
+	case codecommitHostExp.MatchString(parts[0]):
+		if len(parts) != 4 || parts[1] != "v1" || parts[2] != "repos" {
+			return nil, fmt.Errorf("unable to parse the CodeCommit repository from %s: please pass the repository URL with the format `--%s https://git-codecommit.{region}.amazonaws.com/v1/repos/{repositoryName}`", url, repoURLFlag)
+		}
+		return &sourceRepository{
+			provider: manifest.CodeCommitProviderName,
+			host:     parts[0],
+			name:     parts[3],
+			region:   codecommitHostExp.FindStringSubmatch(parts[0])[1],
+		}, nil
+	case len(parts) == 2:
+		// The owner/repo short form is only supported for GitHub.
+		return &sourceRepository{
+			provider: manifest.GithubProviderName,
+			host:     githubURL,
+			owner:    parts[0],
+			name:     parts[1],
+		}, nil
+	case len(parts) == 3:
+		repo := &sourceRepository{
+			host:  parts[0],
+			owner: parts[1],
+			name:  parts[2],
+		}
+		switch repo.host {
+		case githubURL:
+			repo.provider = manifest.GithubProviderName
+		case bitbucketURL:
+			repo.provider = manifest.BitbucketProviderName
+		default:
+			repo.provider = manifest.GitHubEnterpriseProviderName
+		}
+		return repo, nil
+	default:
+		return nil, fmt.Errorf("unable to parse the repository from %s: please pass the repository URL with the format `--%s https://{host}/{owner}/{repositoryName}`", url, repoURLFlag)
 	}
-	return ownerRepo[0], ownerRepo[1], nil
 }
 
 // examples:
@@ -478,15 +656,16 @@ func (o *initPipelineOpts) parseOwnerRepoName(url string) (string, string, error
 // efekarakus	https://github.com/karakuse/grit.git (fetch)
 // origin	    https://github.com/koke/grit (fetch)
 // koke         git://github.com/koke/grit.git (push)
+// codecommit   https://git-codecommit.us-west-2.amazonaws.com/v1/repos/grit (fetch)
 func (o *initPipelineOpts) parseGitRemoteResult(s string) ([]string, error) {
 	var urls []string
 	urlSet := make(map[string]bool)
 	items := strings.Split(s, "\n")
 	for _, item := range items {
-		if !strings.Contains(item, githubURL) {
+		cols := strings.Split(item, "\t")
+		if len(cols) < 2 {
 			continue
 		}
-		cols := strings.Split(item, "\t")
 		url := strings.TrimSpace(strings.TrimSuffix(strings.Split(cols[1], " ")[0], ".git"))
 		urlSet[url] = true
 	}
@@ -496,9 +675,24 @@ func (o *initPipelineOpts) parseGitRemoteResult(s string) ([]string, error) {
 	return urls, nil
 }
 
+func (o *initPipelineOpts) selectGitHubAuth() error {
+	auth, err := o.prompt.SelectOne(
+		pipelineSelectGitHubAuthPrompt,
+		pipelineSelectGitHubAuthHelpPrompt,
+		[]string{pipelineGitHubAppAuth, pipelineGitHubTokenAuth},
+	)
+	if err != nil {
+		return fmt.Errorf("select GitHub authentication: %w", err)
+	}
+	if auth == pipelineGitHubTokenAuth {
+		return o.getGitHubAccessToken()
+	}
+	return nil
+}
+
 func (o *initPipelineOpts) getGitHubAccessToken() error {
 	token, err := o.prompt.GetSecret(
-		fmt.Sprintf("Please enter your GitHub Personal Access Token for your repository %s:", color.HighlightUserInput(o.githubRepo)),
+		fmt.Sprintf("Please enter your GitHub Personal Access Token for your repository %s:", color.HighlightUserInput(o.repo.name)),
 		`The personal access token for the GitHub repository linked to your workspace. 
 For more information, please refer to: https://git.io/JfDFD.`,
 	)
@@ -510,6 +704,30 @@ For more information, please refer to: https://git.io/JfDFD.`,
 	return nil
 }
 
+func (o *initPipelineOpts) confirmGitHubEnterprise() error {
+	confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtPipelineConfirmGHEPrompt, color.HighlightUserInput(o.repo.host)), pipelineConfirmGHEHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("confirm GitHub Enterprise Server: %w", err)
+	}
+	if !confirmed {
+		return fmt.Errorf("repository host %s is not supported: the repository must be hosted on GitHub, GitHub Enterprise Server, Bitbucket or AWS CodeCommit", o.repo.host)
+	}
+	return nil
+}
+
+func (o *initPipelineOpts) getConnectionARN() error {
+	arn, err := o.prompt.Get(
+		pipelineConnectionARNPrompt,
+		pipelineConnectionARNHelpPrompt,
+		validateConnectionARN,
+	)
+	if err != nil {
+		return fmt.Errorf("get connection ARN: %w", err)
+	}
+	o.connectionARN = arn
+	return nil
+}
+
 func (o *initPipelineOpts) getEnvs() ([]*config.Environment, error) {
 	envs, err := o.store.ListEnvironments(o.appName)
 	if err != nil {
@@ -531,8 +749,19 @@ func buildPipelineInitCmd() *cobra.Command {
 		Example: `
   Create a pipeline for the services in your workspace.
   /code $ copilot pipeline init \
-  /code  --github-url https://github.com/gitHubUserName/myFrontendApp.git \
+  /code  --url https://github.com/gitHubUserName/myFrontendApp.git \
   /code  --github-access-token file://myGitHubToken \
+  /code  --environments "stage,prod"
+
+  Create a second pipeline named "release" that deploys the "release" branch to production.
+  /code $ copilot pipeline init --name release \
+  /code  --url https://github.com/gitHubUserName/myFrontendApp.git \
+  /code  --git-branch release --environments "prod"
+
+  Create a pipeline triggered by a Bitbucket repository through an existing AWS CodeStar connection.
+  /code $ copilot pipeline init \
+  /code  --url https://bitbucket.org/myCompany/myFrontendApp \
+  /code  --connection-arn arn:aws:codestar-connections:us-west-2:123456789012:connection/abcd \
   /code  --environments "stage,prod"`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitPipelineOpts(vars)
@@ -557,8 +786,12 @@ func buildPipelineInitCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
-	cmd.Flags().StringVarP(&vars.githubURL, githubURLFlag, githubURLFlagShort, "", githubURLFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().StringVarP(&vars.repoURL, repoURLFlag, repoURLFlagShort, "", repoURLFlagDescription)
+	cmd.Flags().StringVar(&vars.repoURL, githubURLFlag, "", githubURLFlagDescription)
+	_ = cmd.Flags().MarkHidden(githubURLFlag) // Deprecated in favor of --url.
 	cmd.Flags().StringVarP(&vars.githubAccessToken, githubAccessTokenFlag, githubAccessTokenFlagShort, "", githubAccessTokenFlagDescription)
+	cmd.Flags().StringVar(&vars.connectionARN, connectionARNFlag, "", connectionARNFlagDescription)
 	cmd.Flags().StringVarP(&vars.gitBranch, gitBranchFlag, gitBranchFlagShort, "", gitBranchFlagDescription)
 	cmd.Flags().StringSliceVarP(&vars.environments, envsFlag, envsFlagShort, []string{}, pipelineEnvsFlagDescription)
 
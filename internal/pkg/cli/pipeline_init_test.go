@@ -30,24 +30,22 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 	githubToken := "hunter2"
 	testCases := map[string]struct {
 		inEnvironments      []string
-		inGitHubOwner       string
-		inGitHubRepo        string
+		inRepoURL           string
 		inGitHubAccessToken string
+		inConnectionARN     string
 		inAppEnvs           []*config.Environment
 		inURLs              []string
 
 		mockPrompt func(m *mocks.Mockprompter)
 
-		expectedGitHubOwner       string
-		expectedGitHubRepo        string
+		expectedRepo              *sourceRepository
 		expectedGitHubAccessToken string
+		expectedConnectionARN     string
 		expectedEnvironments      []string
 		expectedError             error
 	}{
 		"prompts for all input": {
 			inEnvironments:      []string{},
-			inGitHubOwner:       "",
-			inGitHubRepo:        "",
 			inGitHubAccessToken: "",
 			inAppEnvs: []*config.Environment{
 				{
@@ -65,20 +63,23 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				m.EXPECT().SelectOne(pipelineSelectEnvPrompt, gomock.Any(), []string{"test", "prod"}).Return("test", nil).Times(1)
 				m.EXPECT().SelectOne(pipelineSelectEnvPrompt, gomock.Any(), []string{"prod"}).Return("prod", nil).Times(1)
 
-				m.EXPECT().SelectOne(pipelineSelectGitHubURLPrompt, gomock.Any(), []string{githubURL, githubBadURL}).Return(githubURL, nil).Times(1)
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), []string{githubURL, githubBadURL}).Return(githubURL, nil).Times(1)
+				m.EXPECT().SelectOne(pipelineSelectGitHubAuthPrompt, gomock.Any(), []string{pipelineGitHubAppAuth, pipelineGitHubTokenAuth}).Return(pipelineGitHubTokenAuth, nil).Times(1)
 				m.EXPECT().GetSecret(gomock.Eq("Please enter your GitHub Personal Access Token for your repository chaOS:"), gomock.Any()).Return(githubToken, nil).Times(1)
 			},
 
-			expectedGitHubOwner:       githubOwner,
-			expectedGitHubRepo:        githubRepoName,
+			expectedRepo: &sourceRepository{
+				provider: "GitHub",
+				host:     "github.com",
+				owner:    githubOwner,
+				name:     githubRepoName,
+			},
 			expectedGitHubAccessToken: githubToken,
 			expectedEnvironments:      []string{"test", "prod"},
 			expectedError:             nil,
 		},
 		"returns error if fail to confirm adding environment": {
 			inEnvironments:      []string{},
-			inGitHubOwner:       "",
-			inGitHubRepo:        "",
 			inGitHubAccessToken: "",
 			inAppEnvs: []*config.Environment{
 				{
@@ -93,16 +94,12 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				m.EXPECT().Confirm(pipelineInitAddEnvPrompt, gomock.Any()).Return(false, errors.New("some error")).Times(1)
 			},
 
-			expectedGitHubOwner:       githubOwner,
-			expectedGitHubRepo:        "",
 			expectedGitHubAccessToken: "",
 			expectedEnvironments:      []string{},
 			expectedError:             fmt.Errorf("confirm adding an environment: some error"),
 		},
 		"returns error if fail to add an environment": {
 			inEnvironments:      []string{},
-			inGitHubOwner:       "",
-			inGitHubRepo:        "",
 			inGitHubAccessToken: "",
 			inAppEnvs: []*config.Environment{
 				{
@@ -118,15 +115,12 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				m.EXPECT().SelectOne(pipelineSelectEnvPrompt, gomock.Any(), []string{"test", "prod"}).Return("", errors.New("some error")).Times(1)
 			},
 
-			expectedGitHubOwner:       "",
-			expectedGitHubRepo:        "",
 			expectedGitHubAccessToken: "",
 			expectedEnvironments:      []string{},
 			expectedError:             fmt.Errorf("add environment: some error"),
 		},
-		"returns error if fail to select GitHub URL": {
+		"returns error if fail to select repository URL": {
 			inEnvironments:      []string{},
-			inGitHubRepo:        "",
 			inGitHubAccessToken: "",
 			inAppEnvs: []*config.Environment{
 				{
@@ -144,18 +138,15 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				m.EXPECT().SelectOne(pipelineSelectEnvPrompt, gomock.Any(), []string{"test", "prod"}).Return("test", nil).Times(1)
 				m.EXPECT().SelectOne(pipelineSelectEnvPrompt, gomock.Any(), []string{"prod"}).Return("prod", nil).Times(1)
 
-				m.EXPECT().SelectOne(pipelineSelectGitHubURLPrompt, gomock.Any(), []string{githubURL, githubBadURL}).Return("", errors.New("some error")).Times(1)
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), []string{githubURL, githubBadURL}).Return("", errors.New("some error")).Times(1)
 			},
 
-			expectedGitHubOwner:       "",
-			expectedGitHubRepo:        "",
 			expectedGitHubAccessToken: "",
 			expectedEnvironments:      []string{},
-			expectedError:             fmt.Errorf("select GitHub URL: some error"),
+			expectedError:             fmt.Errorf("select repository URL: some error"),
 		},
-		"returns error if fail to parse GitHub URL": {
+		"returns error if fail to parse repository URL": {
 			inEnvironments:      []string{},
-			inGitHubRepo:        "",
 			inGitHubAccessToken: "",
 			inAppEnvs: []*config.Environment{
 				{
@@ -173,18 +164,15 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				m.EXPECT().SelectOne(pipelineSelectEnvPrompt, gomock.Any(), []string{"test", "prod"}).Return("test", nil).Times(1)
 				m.EXPECT().SelectOne(pipelineSelectEnvPrompt, gomock.Any(), []string{"prod"}).Return("prod", nil).Times(1)
 
-				m.EXPECT().SelectOne(pipelineSelectGitHubURLPrompt, gomock.Any(), []string{githubReallyBadURL}).Return(githubReallyBadURL, nil).Times(1)
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), []string{githubReallyBadURL}).Return(githubReallyBadURL, nil).Times(1)
 			},
 
-			expectedGitHubOwner:       "",
-			expectedGitHubRepo:        "",
 			expectedGitHubAccessToken: "",
 			expectedEnvironments:      []string{},
-			expectedError:             fmt.Errorf("unable to parse the GitHub repository owner and name from reallybadGoose//notEvenAURL: please pass the repository URL with the format `--github-url https://github.com/{owner}/{repositoryName}`"),
+			expectedError:             fmt.Errorf("unable to parse the repository from reallybadGoose//notEvenAURL: please pass the repository URL with the format `--url https://{host}/{owner}/{repositoryName}`"),
 		},
 		"returns error if fail to get GitHub access token": {
 			inEnvironments:      []string{},
-			inGitHubRepo:        "",
 			inGitHubAccessToken: "",
 			inAppEnvs: []*config.Environment{
 				{
@@ -202,16 +190,142 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				m.EXPECT().SelectOne(pipelineSelectEnvPrompt, gomock.Any(), []string{"test", "prod"}).Return("test", nil).Times(1)
 				m.EXPECT().SelectOne(pipelineSelectEnvPrompt, gomock.Any(), []string{"prod"}).Return("prod", nil).Times(1)
 
-				m.EXPECT().SelectOne(pipelineSelectGitHubURLPrompt, gomock.Any(), []string{githubURL, githubBadURL}).Return(githubURL, nil).Times(1)
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), []string{githubURL, githubBadURL}).Return(githubURL, nil).Times(1)
+				m.EXPECT().SelectOne(pipelineSelectGitHubAuthPrompt, gomock.Any(), []string{pipelineGitHubAppAuth, pipelineGitHubTokenAuth}).Return(pipelineGitHubTokenAuth, nil).Times(1)
 				m.EXPECT().GetSecret(gomock.Eq("Please enter your GitHub Personal Access Token for your repository chaOS:"), gomock.Any()).Return("", errors.New("some error")).Times(1)
 			},
 
-			expectedGitHubOwner:       "",
-			expectedGitHubRepo:        "",
 			expectedGitHubAccessToken: "",
 			expectedEnvironments:      []string{},
 			expectedError:             fmt.Errorf("get GitHub access token: some error"),
 		},
+		"uses a GitHub App connection": {
+			inEnvironments: []string{"test"},
+			inRepoURL:      githubURL,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(pipelineSelectGitHubAuthPrompt, gomock.Any(), []string{pipelineGitHubAppAuth, pipelineGitHubTokenAuth}).Return(pipelineGitHubAppAuth, nil).Times(1)
+			},
+
+			expectedRepo: &sourceRepository{
+				provider: "GitHub",
+				host:     "github.com",
+				owner:    githubOwner,
+				name:     githubRepoName,
+			},
+			expectedEnvironments: []string{"test"},
+		},
+		"returns error if fail to select GitHub authentication": {
+			inEnvironments: []string{"test"},
+			inRepoURL:      githubURL,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(pipelineSelectGitHubAuthPrompt, gomock.Any(), gomock.Any()).Return("", errors.New("some error")).Times(1)
+			},
+
+			expectedError: fmt.Errorf("select GitHub authentication: some error"),
+		},
+		"does not prompt for credentials for a Bitbucket repository": {
+			inEnvironments: []string{"test"},
+			inRepoURL:      "https://huanjani@bitbucket.org/huanjani/sample.git",
+
+			mockPrompt: func(m *mocks.Mockprompter) {},
+
+			expectedRepo: &sourceRepository{
+				provider: "Bitbucket",
+				host:     "bitbucket.org",
+				owner:    "huanjani",
+				name:     "sample",
+			},
+			expectedEnvironments: []string{"test"},
+		},
+		"does not prompt for credentials for a CodeCommit repository": {
+			inEnvironments: []string{"test"},
+			inRepoURL:      "ssh://git-codecommit.us-west-2.amazonaws.com/v1/repos/chaOS",
+
+			mockPrompt: func(m *mocks.Mockprompter) {},
+
+			expectedRepo: &sourceRepository{
+				provider: "CodeCommit",
+				host:     "git-codecommit.us-west-2.amazonaws.com",
+				name:     "chaOS",
+				region:   "us-west-2",
+			},
+			expectedEnvironments: []string{"test"},
+		},
+		"returns error if the CodeCommit repository is in another region": {
+			inEnvironments: []string{"test"},
+			inRepoURL:      "https://git-codecommit.us-east-1.amazonaws.com/v1/repos/chaOS",
+
+			mockPrompt: func(m *mocks.Mockprompter) {},
+
+			expectedError: fmt.Errorf("CodeCommit repository https://git-codecommit.us-east-1.amazonaws.com/v1/repos/chaOS is in region us-east-1, but the pipeline is deployed in region us-west-2: the repository must be in the same region as the pipeline"),
+		},
+		"prompts for the connection ARN of a GitHub Enterprise Server repository": {
+			inEnvironments: []string{"test"},
+			inRepoURL:      "git@github.mycompany.com:badGoose/chaOS.git",
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(fmt.Sprintf(fmtPipelineConfirmGHEPrompt, "github.mycompany.com"), gomock.Any()).Return(true, nil)
+				m.EXPECT().Get(pipelineConnectionARNPrompt, gomock.Any(), gomock.Any()).Return("arn:aws:codestar-connections:us-west-2:1234:connection/abcd", nil).Times(1)
+			},
+
+			expectedRepo: &sourceRepository{
+				provider: "GitHubEnterpriseServer",
+				host:     "github.mycompany.com",
+				owner:    githubOwner,
+				name:     githubRepoName,
+			},
+			expectedConnectionARN: "arn:aws:codestar-connections:us-west-2:1234:connection/abcd",
+			expectedEnvironments:  []string{"test"},
+		},
+		"returns error if fail to get the connection ARN": {
+			inEnvironments: []string{"test"},
+			inRepoURL:      "https://github.mycompany.com/badGoose/chaOS",
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil)
+				m.EXPECT().Get(pipelineConnectionARNPrompt, gomock.Any(), gomock.Any()).Return("", errors.New("some error")).Times(1)
+			},
+
+			expectedError: fmt.Errorf("get connection ARN: some error"),
+		},
+		"does not confirm the GitHub Enterprise Server if a connection ARN is passed": {
+			inEnvironments:  []string{"test"},
+			inRepoURL:       "https://github.mycompany.com/badGoose/chaOS",
+			inConnectionARN: "arn:aws:codestar-connections:us-west-2:1234:connection/abcd",
+
+			mockPrompt: func(m *mocks.Mockprompter) {},
+
+			expectedRepo: &sourceRepository{
+				provider: "GitHubEnterpriseServer",
+				host:     "github.mycompany.com",
+				owner:    githubOwner,
+				name:     githubRepoName,
+			},
+			expectedConnectionARN: "arn:aws:codestar-connections:us-west-2:1234:connection/abcd",
+			expectedEnvironments:  []string{"test"},
+		},
+		"returns error if the host is not a GitHub Enterprise Server": {
+			inEnvironments: []string{"test"},
+			inRepoURL:      "https://gitlab.com/badGoose/chaOS",
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(fmt.Sprintf(fmtPipelineConfirmGHEPrompt, "gitlab.com"), gomock.Any()).Return(false, nil)
+			},
+
+			expectedError: fmt.Errorf("repository host gitlab.com is not supported: the repository must be hosted on GitHub, GitHub Enterprise Server, Bitbucket or AWS CodeCommit"),
+		},
+		"returns error if fail to confirm the GitHub Enterprise Server": {
+			inEnvironments: []string{"test"},
+			inRepoURL:      "https://github.mycompany.com/badGoose/chaOS",
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(false, errors.New("some error"))
+			},
+
+			expectedError: fmt.Errorf("confirm GitHub Enterprise Server: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -225,13 +339,14 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 			opts := &initPipelineOpts{
 				initPipelineVars: initPipelineVars{
 					environments:      tc.inEnvironments,
-					githubOwner:       tc.inGitHubOwner,
-					githubRepo:        tc.inGitHubRepo,
+					repoURL:           tc.inRepoURL,
 					githubAccessToken: tc.inGitHubAccessToken,
+					connectionARN:     tc.inConnectionARN,
 				},
 				envs:     tc.inAppEnvs,
 				repoURLs: tc.inURLs,
 				prompt:   mockPrompt,
+				region:   "us-west-2",
 			}
 
 			tc.mockPrompt(mockPrompt)
@@ -244,9 +359,9 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				require.EqualError(t, err, tc.expectedError.Error())
 			} else {
 				require.NoError(t, err)
-				require.Equal(t, tc.expectedGitHubOwner, opts.githubOwner)
-				require.Equal(t, tc.expectedGitHubRepo, opts.githubRepo)
+				require.Equal(t, tc.expectedRepo, opts.repo)
 				require.Equal(t, tc.expectedGitHubAccessToken, opts.githubAccessToken)
+				require.Equal(t, tc.expectedConnectionARN, opts.connectionARN)
 				require.ElementsMatch(t, tc.expectedEnvironments, opts.environments)
 			}
 		})
@@ -255,7 +370,9 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 
 func TestInitPipelineOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inAppName string
+		inAppName           string
+		inGitHubAccessToken string
+		inConnectionARN     string
 
 		expectedError error
 	}{
@@ -263,6 +380,12 @@ func TestInitPipelineOpts_Validate(t *testing.T) {
 			inAppName:     "",
 			expectedError: errNoAppInWorkspace,
 		},
+		"cannot use both an access token and a connection": {
+			inAppName:           "badgoose",
+			inGitHubAccessToken: "hunter2",
+			inConnectionARN:     "arn:aws:codestar-connections:us-west-2:1234:connection/abcd",
+			expectedError:       errors.New("cannot specify both --github-access-token and --connection-arn"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -273,7 +396,9 @@ func TestInitPipelineOpts_Validate(t *testing.T) {
 
 			opts := &initPipelineOpts{
 				initPipelineVars: initPipelineVars{
-					appName: tc.inAppName,
+					appName:           tc.inAppName,
+					githubAccessToken: tc.inGitHubAccessToken,
+					connectionARN:     tc.inConnectionARN,
 				},
 			}
 
@@ -293,10 +418,16 @@ func TestInitPipelineOpts_Validate(t *testing.T) {
 func TestInitPipelineOpts_Execute(t *testing.T) {
 	buildspecExistsErr := &workspace.ErrFileExists{FileName: "/buildspec.yml"}
 	manifestExistsErr := &workspace.ErrFileExists{FileName: "/pipeline.yml"}
+	githubRepo := &sourceRepository{
+		provider: "GitHub",
+		host:     "github.com",
+		owner:    "badgoose",
+		name:     "goose",
+	}
 	testCases := map[string]struct {
 		inEnvironments []string
 		inGitHubToken  string
-		inGitHubRepo   string
+		inRepo         *sourceRepository
 		inGitBranch    string
 		inAppName      string
 		inAppEnvs      []*config.Environment
@@ -313,7 +444,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 		"creates secret and writes manifest and buildspecs": {
 			inEnvironments: []string{"test"},
 			inGitHubToken:  "hunter2",
-			inGitHubRepo:   "goose",
+			inRepo:         githubRepo,
 			inGitBranch:    "dev",
 			inAppName:      "badgoose",
 			inAppEnvs: []*config.Environment{
@@ -329,8 +460,52 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
 			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("/buildspec.yml", nil)
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "pipeline-badgoose-goose").Return("/pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), "pipeline-badgoose-goose").Return("/buildspec.yml", nil)
+			},
+			mockParser: func(m *templatemocks.MockParser) {
+				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
+					Buffer: bytes.NewBufferString("hello"),
+				}, nil)
+			},
+			mockStoreSvc: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("badgoose").Return(&config.Application{
+					Name: "badgoose",
+				}, nil)
+			},
+			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetRegionalAppResources(&config.Application{
+					Name: "badgoose",
+				}).Return([]*stack.AppRegionalResources{
+					{
+						Region:   "us-west-2",
+						S3Bucket: "gooseBucket",
+					},
+				}, nil)
+			},
+			expectedError: nil,
+		},
+		"writes manifest and buildspecs without a secret for a CodeCommit repository": {
+			inEnvironments: []string{"test"},
+			inRepo: &sourceRepository{
+				provider: "CodeCommit",
+				host:     "git-codecommit.us-west-2.amazonaws.com",
+				name:     "goose",
+			},
+			inGitBranch: "dev",
+			inAppName:   "badgoose",
+			inAppEnvs: []*config.Environment{
+				{
+					Name: "test",
+				},
+			},
+
+			mockSecretsManager: func(m *mocks.MocksecretsManager) {
+				m.EXPECT().CreateSecret(gomock.Any(), gomock.Any()).Times(0)
+			},
+			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "pipeline-badgoose-goose").Return("/pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), "pipeline-badgoose-goose").Return("/buildspec.yml", nil)
 			},
 			mockParser: func(m *templatemocks.MockParser) {
 				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
@@ -357,7 +532,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 		"does not return an error if secret already exists": {
 			inEnvironments: []string{"test"},
 			inGitHubToken:  "hunter2",
-			inGitHubRepo:   "goose",
+			inRepo:         githubRepo,
 			inGitBranch:    "dev",
 			inAppName:      "badgoose",
 			inAppEnvs: []*config.Environment{
@@ -374,8 +549,8 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("", existsErr)
 			},
 			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("/buildspec.yml", nil)
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "pipeline-badgoose-goose").Return("/pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), "pipeline-badgoose-goose").Return("/buildspec.yml", nil)
 			},
 			mockParser: func(m *templatemocks.MockParser) {
 				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
@@ -403,7 +578,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 		"returns an error if can't write manifest": {
 			inEnvironments: []string{"test"},
 			inGitHubToken:  "hunter2",
-			inGitHubRepo:   "goose",
+			inRepo:         githubRepo,
 			inGitBranch:    "dev",
 			inAppName:      "badgoose",
 			inAppEnvs: []*config.Environment{
@@ -419,7 +594,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
 			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("", errors.New("some error"))
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "pipeline-badgoose-goose").Return("", errors.New("some error"))
 			},
 			mockParser:                  func(m *templatemocks.MockParser) {},
 			mockStoreSvc:                func(m *mocks.Mockstore) {},
@@ -429,7 +604,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 		"returns an error if application cannot be retrieved": {
 			inEnvironments: []string{"test"},
 			inGitHubToken:  "hunter2",
-			inGitHubRepo:   "goose",
+			inRepo:         githubRepo,
 			inGitBranch:    "dev",
 			inAppName:      "badgoose",
 			inAppEnvs: []*config.Environment{
@@ -445,7 +620,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
 			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "pipeline-badgoose-goose").Return("/pipeline.yml", nil)
 			},
 			mockParser: func(m *templatemocks.MockParser) {},
 			mockStoreSvc: func(m *mocks.Mockstore) {
@@ -457,7 +632,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 		"returns an error if can't get regional application resources": {
 			inEnvironments: []string{"test"},
 			inGitHubToken:  "hunter2",
-			inGitHubRepo:   "goose",
+			inRepo:         githubRepo,
 			inGitBranch:    "dev",
 			inAppName:      "badgoose",
 			inAppEnvs: []*config.Environment{
@@ -473,7 +648,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
 			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "pipeline-badgoose-goose").Return("/pipeline.yml", nil)
 			},
 			mockParser: func(m *templatemocks.MockParser) {},
 			mockStoreSvc: func(m *mocks.Mockstore) {
@@ -491,7 +666,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 		"returns an error if buildspec cannot be parsed": {
 			inEnvironments: []string{"test"},
 			inGitHubToken:  "hunter2",
-			inGitHubRepo:   "goose",
+			inRepo:         githubRepo,
 			inGitBranch:    "dev",
 			inAppName:      "badgoose",
 			inAppEnvs: []*config.Environment{
@@ -507,8 +682,8 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
 			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Times(0)
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "pipeline-badgoose-goose").Return("/pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), "pipeline-badgoose-goose").Times(0)
 			},
 			mockParser: func(m *templatemocks.MockParser) {
 				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(nil, errors.New("some error"))
@@ -533,7 +708,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 		"does not return an error if buildspec and manifest already exists": {
 			inEnvironments: []string{"test"},
 			inGitHubToken:  "hunter2",
-			inGitHubRepo:   "goose",
+			inRepo:         githubRepo,
 			inGitBranch:    "dev",
 			inAppName:      "badgoose",
 			inAppEnvs: []*config.Environment{
@@ -549,8 +724,8 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
 			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("", manifestExistsErr)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("", buildspecExistsErr)
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "pipeline-badgoose-goose").Return("", manifestExistsErr)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), "pipeline-badgoose-goose").Return("", buildspecExistsErr)
 			},
 			mockParser: func(m *templatemocks.MockParser) {
 				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
@@ -577,7 +752,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 		"returns an error if can't write buildspec": {
 			inEnvironments: []string{"test"},
 			inGitHubToken:  "hunter2",
-			inGitHubRepo:   "goose",
+			inRepo:         githubRepo,
 			inGitBranch:    "dev",
 			inAppName:      "badgoose",
 			inAppEnvs: []*config.Environment{
@@ -593,8 +768,8 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
 			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("", errors.New("some error"))
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "pipeline-badgoose-goose").Return("/pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), "pipeline-badgoose-goose").Return("", errors.New("some error"))
 			},
 			mockParser: func(m *templatemocks.MockParser) {
 				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
@@ -641,13 +816,14 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 
 			opts := &initPipelineOpts{
 				initPipelineVars: initPipelineVars{
+					name:              "pipeline-badgoose-goose",
 					environments:      tc.inEnvironments,
-					githubRepo:        tc.inGitHubRepo,
 					githubAccessToken: tc.inGitHubToken,
 					gitBranch:         tc.inGitBranch,
 					appName:           tc.inAppName,
 				},
 
+				repo:           tc.inRepo,
 				secretsmanager: mockSecretsManager,
 				cfnClient:      mockRegionalResourcesGetter,
 				store:          mockstore,
@@ -672,19 +848,32 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 
 func TestInitPipelineOpts_createPipelineName(t *testing.T) {
 	testCases := map[string]struct {
-		inGitHubRepo string
-		inAppName    string
-		inAppOwner   string
+		inRepo    *sourceRepository
+		inAppName string
 
 		expected string
 	}{
 		"matches repo name": {
-			inGitHubRepo: "goose",
-			inAppName:    "badgoose",
-			inAppOwner:   "david",
+			inRepo: &sourceRepository{
+				provider: "GitHub",
+				host:     "github.com",
+				owner:    "david",
+				name:     "goose",
+			},
+			inAppName: "badgoose",
 
 			expected: "pipeline-badgoose-david-goose",
 		},
+		"omits the owner of a CodeCommit repository": {
+			inRepo: &sourceRepository{
+				provider: "CodeCommit",
+				host:     "git-codecommit.us-west-2.amazonaws.com",
+				name:     "goose",
+			},
+			inAppName: "badgoose",
+
+			expected: "pipeline-badgoose-goose",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -692,10 +881,9 @@ func TestInitPipelineOpts_createPipelineName(t *testing.T) {
 			// GIVEN
 			opts := &initPipelineOpts{
 				initPipelineVars: initPipelineVars{
-					githubRepo:  tc.inGitHubRepo,
-					appName:     tc.inAppName,
-					githubOwner: tc.inAppOwner,
+					appName: tc.inAppName,
 				},
+				repo: tc.inRepo,
 			}
 
 			// WHEN
@@ -723,10 +911,11 @@ koke	git://github.com/koke/grit.git (push)`,
 			expectedURLs:  []string{"git@github.com:badgoose/grit", "https://github.com/badgoose/cli", "https://github.com/koke/grit", "git://github.com/koke/grit"},
 			expectedError: nil,
 		},
-		"don't add to URL list if it is not a github URL": {
-			inRemoteResult: `badgoose	verybad@gitlab.com/whatever (fetch)`,
+		"adds the URLs of other providers": {
+			inRemoteResult: `codecommit	https://git-codecommit.us-west-2.amazonaws.com/v1/repos/grit (fetch)
+bitbucket	git@bitbucket.org:badgoose/grit.git (push)`,
 
-			expectedURLs:  []string{},
+			expectedURLs:  []string{"https://git-codecommit.us-west-2.amazonaws.com/v1/repos/grit", "git@bitbucket.org:badgoose/grit"},
 			expectedError: nil,
 		},
 	}
@@ -748,56 +937,135 @@ koke	git://github.com/koke/grit.git (push)`,
 	}
 }
 
-func TestInitPipelineOpts_parseOwnerRepoName(t *testing.T) {
+func TestParseRepoURL(t *testing.T) {
 	testCases := map[string]struct {
-		inGitHubURL string
+		inURL string
 
-		expectedOwner string
-		expectedRepo  string
+		expectedRepo  *sourceRepository
 		expectedError error
 	}{
 		"matches repo name without .git suffix": {
-			inGitHubURL: "https://github.com/badgoose/cli",
+			inURL: "https://github.com/badgoose/cli",
 
-			expectedOwner: "badgoose",
-			expectedRepo:  "cli",
-			expectedError: nil,
+			expectedRepo: &sourceRepository{
+				provider: "GitHub",
+				host:     "github.com",
+				owner:    "badgoose",
+				name:     "cli",
+			},
 		},
 		"matches repo name with .git suffix": {
-			inGitHubURL: "https://github.com/koke/grit.git",
+			inURL: "https://github.com/koke/grit.git",
 
-			expectedOwner: "koke",
-			expectedRepo:  "grit",
-			expectedError: nil,
+			expectedRepo: &sourceRepository{
+				provider: "GitHub",
+				host:     "github.com",
+				owner:    "koke",
+				name:     "grit",
+			},
 		},
-		"returns an error if it is not a github URL": {
-			inGitHubURL: "https://git-codecommit.us-east-1.amazonaws.com/v1/repos/whatever",
+		"matches the owner/repo short form": {
+			inURL: "koke/grit",
+
+			expectedRepo: &sourceRepository{
+				provider: "GitHub",
+				host:     "github.com",
+				owner:    "koke",
+				name:     "grit",
+			},
+		},
+		"matches a bitbucket SSH URL": {
+			inURL: "git@bitbucket.org:huanjani/sample.git",
+
+			expectedRepo: &sourceRepository{
+				provider: "Bitbucket",
+				host:     "bitbucket.org",
+				owner:    "huanjani",
+				name:     "sample",
+			},
+		},
+		"matches a github enterprise URL": {
+			inURL: "https://github.mycompany.com/koke/grit",
+
+			expectedRepo: &sourceRepository{
+				provider: "GitHubEnterpriseServer",
+				host:     "github.mycompany.com",
+				owner:    "koke",
+				name:     "grit",
+			},
+		},
+		"matches a codecommit URL": {
+			inURL: "https://git-codecommit.us-east-1.amazonaws.com/v1/repos/whatever",
+
+			expectedRepo: &sourceRepository{
+				provider: "CodeCommit",
+				host:     "git-codecommit.us-east-1.amazonaws.com",
+				name:     "whatever",
+				region:   "us-east-1",
+			},
+		},
+		"returns an error if the codecommit URL is malformed": {
+			inURL: "https://git-codecommit.us-east-1.amazonaws.com/whatever",
+
+			expectedError: fmt.Errorf("unable to parse the CodeCommit repository from https://git-codecommit.us-east-1.amazonaws.com/whatever: please pass the repository URL with the format `--url https://git-codecommit.{region}.amazonaws.com/v1/repos/{repositoryName}`"),
+		},
+		"returns an error if the URL has too many segments": {
+			inURL: "https://github.com/koke/grit/tree/main",
 
-			expectedOwner: "",
-			expectedRepo:  "",
-			expectedError: fmt.Errorf("unable to parse the GitHub repository owner and name from https://git-codecommit.us-east-1.amazonaws.com/v1/repos/whatever: please pass the repository URL with the format `--github-url https://github.com/{owner}/{repositoryName}`"),
+			expectedError: fmt.Errorf("unable to parse the repository from https://github.com/koke/grit/tree/main: please pass the repository URL with the format `--url https://{host}/{owner}/{repositoryName}`"),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			// GIVEN
-			opts := &initPipelineOpts{}
-
 			// WHEN
-			owner, repo, err := opts.parseOwnerRepoName(tc.inGitHubURL)
+			repo, err := parseRepoURL(tc.inURL)
 
 			// THEN
 			if tc.expectedError != nil {
 				require.EqualError(t, err, tc.expectedError.Error())
 			} else {
-				require.Equal(t, tc.expectedOwner, owner)
+				require.NoError(t, err)
 				require.Equal(t, tc.expectedRepo, repo)
 			}
 		})
 	}
 }
 
+func TestSourceRepository_url(t *testing.T) {
+	testCases := map[string]struct {
+		inRepo *sourceRepository
+
+		expected string
+	}{
+		"bitbucket": {
+			inRepo: &sourceRepository{
+				provider: "Bitbucket",
+				host:     "bitbucket.org",
+				owner:    "huanjani",
+				name:     "sample",
+			},
+
+			expected: "https://bitbucket.org/huanjani/sample",
+		},
+		"codecommit": {
+			inRepo: &sourceRepository{
+				provider: "CodeCommit",
+				host:     "git-codecommit.us-east-1.amazonaws.com",
+				name:     "whatever",
+			},
+
+			expected: "https://git-codecommit.us-east-1.amazonaws.com/v1/repos/whatever",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.inRepo.url())
+		})
+	}
+}
+
 func TestInitPipelineOpts_getEnvConfig(t *testing.T) {
 	testCases := map[string]struct {
 		inAppName         string
@@ -0,0 +1,202 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	pipelineListAppNamePrompt = "Which application's pipelines would you like to list?"
+	pipelineListAppNameHelper = "An application is a collection of related services."
+)
+
+type listPipelineVars struct {
+	appName                  string
+	shouldOutputJSON         bool
+	shouldShowLocalPipelines bool
+}
+
+type listPipelineOpts struct {
+	listPipelineVars
+
+	// Interfaces to dependencies.
+	pipelineSvc pipelineGetter
+	ws          wsPipelineLister
+	store       applicationGetter
+	sel         appSelector
+	w           io.Writer
+}
+
+// pipelineSummary is the serialized output of a pipeline in the list.
+type pipelineSummary struct {
+	Name         string `json:"name"`
+	ManifestPath string `json:"manifestPath,omitempty"`
+}
+
+func newListPipelinesOpts(vars listPipelineVars) (*listPipelineOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store client: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace client: %w", err)
+	}
+	defaultSession, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	return &listPipelineOpts{
+		listPipelineVars: vars,
+		pipelineSvc:      codepipeline.New(defaultSession),
+		ws:               ws,
+		store:            store,
+		sel:              selector.NewSelect(prompt.New(), store),
+		w:                os.Stdout,
+	}, nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *listPipelineOpts) Ask() error {
+	if o.appName != "" || o.shouldShowLocalPipelines {
+		return nil
+	}
+	name, err := o.sel.Application(pipelineListAppNamePrompt, pipelineListAppNameHelper)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = name
+	return nil
+}
+
+// Execute writes the pipelines deployed in the application, or the pipelines in the workspace with --local.
+func (o *listPipelineOpts) Execute() error {
+	var pipelines []pipelineSummary
+	var err error
+	if o.shouldShowLocalPipelines {
+		pipelines, err = o.localPipelines()
+	} else {
+		pipelines, err = o.deployedPipelines()
+	}
+	if err != nil {
+		return err
+	}
+
+	var out string
+	if o.shouldOutputJSON {
+		data, err := o.jsonOutput(pipelines)
+		if err != nil {
+			return err
+		}
+		out = data
+	} else {
+		out = o.humanOutput(pipelines)
+	}
+	fmt.Fprint(o.w, out)
+	return nil
+}
+
+func (o *listPipelineOpts) deployedPipelines() ([]pipelineSummary, error) {
+	// Ensure the application actually exists before we try to list its pipelines.
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return nil, fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	names, err := o.pipelineSvc.ListPipelineNamesByTags(map[string]string{
+		deploy.AppTagKey: o.appName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list pipelines: %w", err)
+	}
+	var pipelines []pipelineSummary
+	for _, name := range names {
+		pipelines = append(pipelines, pipelineSummary{Name: name})
+	}
+	return pipelines, nil
+}
+
+func (o *listPipelineOpts) localPipelines() ([]pipelineSummary, error) {
+	manifests, err := o.ws.ListPipelines()
+	if err != nil {
+		return nil, fmt.Errorf("list pipelines in the workspace: %w", err)
+	}
+	var pipelines []pipelineSummary
+	for _, mft := range manifests {
+		path, err := relPath(mft.Path)
+		if err != nil {
+			return nil, err
+		}
+		pipelines = append(pipelines, pipelineSummary{
+			Name:         mft.Name,
+			ManifestPath: path,
+		})
+	}
+	return pipelines, nil
+}
+
+func (o *listPipelineOpts) humanOutput(pipelines []pipelineSummary) string {
+	b := &strings.Builder{}
+	for _, pipeline := range pipelines {
+		if pipeline.ManifestPath != "" {
+			fmt.Fprintf(b, "%s\t%s\n", pipeline.Name, pipeline.ManifestPath)
+			continue
+		}
+		fmt.Fprintln(b, pipeline.Name)
+	}
+	return b.String()
+}
+
+func (o *listPipelineOpts) jsonOutput(pipelines []pipelineSummary) (string, error) {
+	type serializedPipelines struct {
+		Pipelines []pipelineSummary `json:"pipelines"`
+	}
+	b, err := json.Marshal(serializedPipelines{Pipelines: pipelines})
+	if err != nil {
+		return "", fmt.Errorf("marshal pipelines: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// buildPipelineListCmd builds the command for listing the pipelines of an application.
+func buildPipelineListCmd() *cobra.Command {
+	vars := listPipelineVars{}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists the pipelines of an application.",
+		Long:  "Lists the pipelines deployed in an application, or the pipeline manifests in your workspace.",
+		Example: `
+  Lists the pipelines deployed in the "myapp" application.
+  /code $ copilot pipeline ls --app myapp
+
+  Lists the pipeline manifests in your workspace.
+  /code $ copilot pipeline ls --local`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newListPipelinesOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldShowLocalPipelines, localFlag, false, localPipelineFlagDescription)
+	return cmd
+}
@@ -0,0 +1,218 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type listPipelineMocks struct {
+	pipelineSvc *mocks.MockpipelineGetter
+	ws          *mocks.MockwsPipelineLister
+	store       *mocks.MockapplicationGetter
+	sel         *mocks.MockappSelector
+}
+
+func TestPipelineList_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		inLocal    bool
+		setupMocks func(m listPipelineMocks)
+
+		wantedApp string
+		wantedErr error
+	}{
+		"with no flags set": {
+			setupMocks: func(m listPipelineMocks) {
+				m.sel.EXPECT().Application(pipelineListAppNamePrompt, pipelineListAppNameHelper).Return("my-app", nil)
+			},
+			wantedApp: "my-app",
+		},
+		"with app flag set": {
+			inAppName:  "my-app",
+			setupMocks: func(m listPipelineMocks) {},
+			wantedApp:  "my-app",
+		},
+		"does not prompt for app with local flag": {
+			inLocal:    true,
+			setupMocks: func(m listPipelineMocks) {},
+		},
+		"error if fail to select app": {
+			setupMocks: func(m listPipelineMocks) {
+				m.sel.EXPECT().Application(pipelineListAppNamePrompt, pipelineListAppNameHelper).Return("", errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("select application: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := listPipelineMocks{
+				sel: mocks.NewMockappSelector(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &listPipelineOpts{
+				listPipelineVars: listPipelineVars{
+					appName:                  tc.inAppName,
+					shouldShowLocalPipelines: tc.inLocal,
+				},
+				sel: m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, opts.appName, "expected app names to match")
+			}
+		})
+	}
+}
+
+func TestPipelineList_Execute(t *testing.T) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	mockTags := map[string]string{
+		"copilot-application": "coolapp",
+	}
+	mockManifests := []workspace.PipelineManifest{
+		{
+			Name: "pipeline-coolapp-repo",
+			Path: filepath.Join(wd, "copilot", "pipeline.yml"),
+		},
+		{
+			Name: "release",
+			Path: filepath.Join(wd, "copilot", "pipelines", "release", "manifest.yml"),
+		},
+	}
+
+	testCases := map[string]struct {
+		inJSON     bool
+		inLocal    bool
+		setupMocks func(m listPipelineMocks)
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"with deployed pipelines": {
+			setupMocks: func(m listPipelineMocks) {
+				m.store.EXPECT().GetApplication("coolapp").Return(&config.Application{}, nil)
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(mockTags).Return([]string{"pipeline-coolapp-repo", "release"}, nil)
+			},
+			wantedContent: "pipeline-coolapp-repo\nrelease\n",
+		},
+		"with deployed pipelines in json": {
+			inJSON: true,
+			setupMocks: func(m listPipelineMocks) {
+				m.store.EXPECT().GetApplication("coolapp").Return(&config.Application{}, nil)
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(mockTags).Return([]string{"pipeline-coolapp-repo", "release"}, nil)
+			},
+			wantedContent: "{\"pipelines\":[{\"name\":\"pipeline-coolapp-repo\"},{\"name\":\"release\"}]}\n",
+		},
+		"with local pipelines": {
+			inLocal: true,
+			setupMocks: func(m listPipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(mockManifests, nil)
+			},
+			wantedContent: fmt.Sprintf("pipeline-coolapp-repo\t%s\nrelease\t%s\n",
+				filepath.Join("copilot", "pipeline.yml"), filepath.Join("copilot", "pipelines", "release", "manifest.yml")),
+		},
+		"with local pipelines in json": {
+			inJSON:  true,
+			inLocal: true,
+			setupMocks: func(m listPipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(mockManifests[1:], nil)
+			},
+			wantedContent: fmt.Sprintf("{\"pipelines\":[{\"name\":\"release\",\"manifestPath\":\"%s\"}]}\n",
+				filepath.Join("copilot", "pipelines", "release", "manifest.yml")),
+		},
+		"with no pipelines in json": {
+			inJSON: true,
+			setupMocks: func(m listPipelineMocks) {
+				m.store.EXPECT().GetApplication("coolapp").Return(&config.Application{}, nil)
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(mockTags).Return(nil, nil)
+			},
+			wantedContent: "{\"pipelines\":null}\n",
+		},
+		"with invalid app name": {
+			setupMocks: func(m listPipelineMocks) {
+				m.store.EXPECT().GetApplication("coolapp").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get application coolapp: some error"),
+		},
+		"with failure to list deployed pipelines": {
+			setupMocks: func(m listPipelineMocks) {
+				m.store.EXPECT().GetApplication("coolapp").Return(&config.Application{}, nil)
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(mockTags).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list pipelines: some error"),
+		},
+		"with failure to list local pipelines": {
+			inLocal: true,
+			setupMocks: func(m listPipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list pipelines in the workspace: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := listPipelineMocks{
+				pipelineSvc: mocks.NewMockpipelineGetter(ctrl),
+				ws:          mocks.NewMockwsPipelineLister(ctrl),
+				store:       mocks.NewMockapplicationGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+
+			opts := &listPipelineOpts{
+				listPipelineVars: listPipelineVars{
+					appName:                  "coolapp",
+					shouldOutputJSON:         tc.inJSON,
+					shouldShowLocalPipelines: tc.inLocal,
+				},
+				pipelineSvc: m.pipelineSvc,
+				ws:          m.ws,
+				store:       m.store,
+				w:           b,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
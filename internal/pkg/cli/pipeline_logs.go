@@ -0,0 +1,214 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecslogging"
+	"github.com/aws/copilot-cli/internal/pkg/pipelinelogging"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	pipelineLogsAppNamePrompt          = "Which application's pipeline logs would you like to show?"
+	pipelineLogsAppNameHelpPrompt      = "An application is a collection of related services."
+	fmtPipelineLogsPipelineNamePrompt  = "Which pipeline of %s would you like to show the logs of?"
+	pipelineLogsPipelineNameHelpPrompt = "The logs of the build and test actions of the pipeline will be shown."
+)
+
+type pipelineLogsVars struct {
+	appName          string
+	pipelineName     string
+	actionName       string
+	buildID          string
+	follow           bool
+	shouldOutputJSON bool
+}
+
+type pipelineLogsOpts struct {
+	pipelineLogsVars
+
+	ws           wsPipelineLister
+	store        applicationGetter
+	pipelineSvc  pipelineGetter
+	actionLister buildActionLister
+	buildGetter  buildGetter
+	logsSvc      buildLogsWriter
+	sel          appSelector
+	prompt       prompter
+}
+
+func newPipelineLogsOpts(vars pipelineLogsVars) (*pipelineLogsOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store client: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace client: %w", err)
+	}
+	sess, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	cp := codepipeline.New(sess)
+	prompter := prompt.New()
+	return &pipelineLogsOpts{
+		pipelineLogsVars: vars,
+		ws:               ws,
+		store:            store,
+		pipelineSvc:      cp,
+		actionLister:     cp,
+		buildGetter:      codebuild.New(sess),
+		logsSvc:          pipelinelogging.NewBuildClient(sess),
+		sel:              selector.NewSelect(prompter, store),
+		prompt:           prompter,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *pipelineLogsOpts) Validate() error {
+	if o.actionName != "" && o.buildID != "" {
+		return fmt.Errorf("--%s and --%s cannot be specified together", actionFlag, buildIDFlag)
+	}
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.pipelineName != "" {
+		if _, err := o.pipelineSvc.GetPipeline(o.pipelineName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (o *pipelineLogsOpts) Ask() error {
+	if o.buildID != "" {
+		// The build ID is enough to find the logs.
+		return nil
+	}
+	if o.appName == "" {
+		name, err := o.sel.Application(pipelineLogsAppNamePrompt, pipelineLogsAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = name
+	}
+	if o.pipelineName != "" {
+		return nil
+	}
+	name, err := selectDeployedPipeline(o.ws, o.prompt, o.pipelineSvc, o.appName,
+		fmt.Sprintf(fmtPipelineLogsPipelineNamePrompt, color.HighlightUserInput(o.appName)), pipelineLogsPipelineNameHelpPrompt)
+	if err != nil {
+		return err
+	}
+	o.pipelineName = name
+	return nil
+}
+
+// Execute writes the logs of the latest build of each CodeBuild action in the pipeline.
+func (o *pipelineLogsOpts) Execute() error {
+	builds, err := o.builds()
+	if err != nil {
+		return err
+	}
+	if len(builds) == 0 {
+		log.Infof("No builds found for pipeline %s.\n", color.HighlightUserInput(o.pipelineName))
+		return nil
+	}
+	eventsWriter := ecslogging.WriteHumanLogs
+	if o.shouldOutputJSON {
+		eventsWriter = ecslogging.WriteJSONLogs
+	}
+	err = o.logsSvc.WriteLogEvents(builds, pipelinelogging.WriteLogEventsOpts{
+		Follow:   o.follow,
+		OnEvents: eventsWriter,
+	})
+	if err != nil {
+		return fmt.Errorf("write log events for pipeline %s: %w", o.pipelineName, err)
+	}
+	return nil
+}
+
+func (o *pipelineLogsOpts) builds() ([]*codebuild.Build, error) {
+	if o.buildID != "" {
+		return o.buildGetter.Builds([]string{o.buildID})
+	}
+	actions, err := o.actionLister.BuildActions(o.pipelineName)
+	if err != nil {
+		return nil, err
+	}
+	var builds []*codebuild.Build
+	var found bool
+	for _, action := range actions {
+		if o.actionName != "" && action.Name != o.actionName {
+			continue
+		}
+		found = true
+		build, err := o.buildGetter.LatestBuild(action.ProjectName)
+		if err != nil {
+			return nil, err
+		}
+		if build == nil {
+			// The action never ran.
+			continue
+		}
+		builds = append(builds, build)
+	}
+	if o.actionName != "" && !found {
+		return nil, fmt.Errorf("action %s does not exist in pipeline %s", o.actionName, o.pipelineName)
+	}
+	return builds, nil
+}
+
+// buildPipelineLogsCmd builds the command for displaying the logs of the CodeBuild actions of a pipeline.
+func buildPipelineLogsCmd() *cobra.Command {
+	vars := pipelineLogsVars{}
+	cmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Displays logs of the build and test actions of a pipeline.",
+		Long:  "Displays the CodeBuild logs of the latest build of each build and test action of a pipeline.",
+
+		Example: `
+  Displays the logs of the pipeline "pipeline-myapp-myrepo".
+  /code $ copilot pipeline logs -n pipeline-myapp-myrepo
+  Displays the logs of the "TestCommands" action in real time.
+  /code $ copilot pipeline logs -n pipeline-myapp-myrepo --action TestCommands --follow
+  Displays the logs of a specific build.
+  /code $ copilot pipeline logs --build-id BuildProject-4ZTBK1cgOvlg:f4a2cc2a-8a4c-4e11-9cb6-2a9b7a1b35d5`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPipelineLogsOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.pipelineName, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.actionName, actionFlag, "", actionFlagDescription)
+	cmd.Flags().StringVar(&vars.buildID, buildIDFlag, "", buildIDFlagDescription)
+	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
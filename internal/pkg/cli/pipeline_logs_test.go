@@ -0,0 +1,224 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/pipelinelogging"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type pipelineLogsMocks struct {
+	store        *mocks.MockapplicationGetter
+	pipelineSvc  *mocks.MockpipelineGetter
+	actionLister *mocks.MockbuildActionLister
+	buildGetter  *mocks.MockbuildGetter
+	logsSvc      *mocks.MockbuildLogsWriter
+}
+
+func TestPipelineLogsOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName      string
+		inPipelineName string
+		inAction       string
+		inBuildID      string
+		setupMocks     func(m pipelineLogsMocks)
+
+		wantedErr error
+	}{
+		"errors if both action and build id are set": {
+			inAction:   "TestCommands",
+			inBuildID:  "BuildProject:1",
+			setupMocks: func(m pipelineLogsMocks) {},
+			wantedErr:  errors.New("--action and --build-id cannot be specified together"),
+		},
+		"errors if app name is invalid": {
+			inAppName: "bad-app",
+			setupMocks: func(m pipelineLogsMocks) {
+				m.store.EXPECT().GetApplication("bad-app").Return(nil, mockError)
+			},
+			wantedErr: mockError,
+		},
+		"errors if pipeline name is invalid": {
+			inAppName:      mockAppName,
+			inPipelineName: "bad-pipeline",
+			setupMocks: func(m pipelineLogsMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{}, nil)
+				m.pipelineSvc.EXPECT().GetPipeline("bad-pipeline").Return(nil, mockError)
+			},
+			wantedErr: mockError,
+		},
+		"success": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			setupMocks: func(m pipelineLogsMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{}, nil)
+				m.pipelineSvc.EXPECT().GetPipeline(mockPipelineName).Return(nil, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineLogsMocks{
+				store:       mocks.NewMockapplicationGetter(ctrl),
+				pipelineSvc: mocks.NewMockpipelineGetter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &pipelineLogsOpts{
+				pipelineLogsVars: pipelineLogsVars{
+					appName:      tc.inAppName,
+					pipelineName: tc.inPipelineName,
+					actionName:   tc.inAction,
+					buildID:      tc.inBuildID,
+				},
+				store:       m.store,
+				pipelineSvc: m.pipelineSvc,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPipelineLogsOpts_Execute(t *testing.T) {
+	mockActions := []*codepipeline.BuildAction{
+		{StageName: "Build", Name: "Build", ProjectName: "BuildProject"},
+		{StageName: "DeployTo-test", Name: "TestCommands", ProjectName: "BuildTestCommands"},
+		{StageName: "DeployTo-prod", Name: "TestCommands-prod", ProjectName: "BuildTestCommandsprod"},
+	}
+	buildBuild := &codebuild.Build{ID: "BuildProject:1"}
+	testBuild := &codebuild.Build{ID: "BuildTestCommands:1"}
+
+	testCases := map[string]struct {
+		inAction   string
+		inBuildID  string
+		inFollow   bool
+		setupMocks func(m pipelineLogsMocks)
+
+		wantedErr error
+	}{
+		"writes the logs of the latest build of every action that ran": {
+			inFollow: true,
+			setupMocks: func(m pipelineLogsMocks) {
+				m.actionLister.EXPECT().BuildActions(mockPipelineName).Return(mockActions, nil)
+				m.buildGetter.EXPECT().LatestBuild("BuildProject").Return(buildBuild, nil)
+				m.buildGetter.EXPECT().LatestBuild("BuildTestCommands").Return(testBuild, nil)
+				m.buildGetter.EXPECT().LatestBuild("BuildTestCommandsprod").Return(nil, nil)
+				m.logsSvc.EXPECT().WriteLogEvents([]*codebuild.Build{buildBuild, testBuild}, gomock.Any()).
+					Do(func(_ []*codebuild.Build, opts pipelinelogging.WriteLogEventsOpts) {
+						require.True(t, opts.Follow)
+					}).Return(nil)
+			},
+		},
+		"only writes the logs of the given action": {
+			inAction: "TestCommands",
+			setupMocks: func(m pipelineLogsMocks) {
+				m.actionLister.EXPECT().BuildActions(mockPipelineName).Return(mockActions, nil)
+				m.buildGetter.EXPECT().LatestBuild("BuildTestCommands").Return(testBuild, nil)
+				m.logsSvc.EXPECT().WriteLogEvents([]*codebuild.Build{testBuild}, gomock.Any()).Return(nil)
+			},
+		},
+		"writes the logs of the given build": {
+			inBuildID: "BuildProject:1",
+			setupMocks: func(m pipelineLogsMocks) {
+				m.buildGetter.EXPECT().Builds([]string{"BuildProject:1"}).Return([]*codebuild.Build{buildBuild}, nil)
+				m.logsSvc.EXPECT().WriteLogEvents([]*codebuild.Build{buildBuild}, gomock.Any()).Return(nil)
+			},
+		},
+		"does not write logs if no action ran": {
+			inAction: "TestCommands-prod",
+			setupMocks: func(m pipelineLogsMocks) {
+				m.actionLister.EXPECT().BuildActions(mockPipelineName).Return(mockActions, nil)
+				m.buildGetter.EXPECT().LatestBuild("BuildTestCommandsprod").Return(nil, nil)
+			},
+		},
+		"errors if the action does not exist": {
+			inAction: "IntegrationTests",
+			setupMocks: func(m pipelineLogsMocks) {
+				m.actionLister.EXPECT().BuildActions(mockPipelineName).Return(mockActions, nil)
+			},
+			wantedErr: fmt.Errorf("action IntegrationTests does not exist in pipeline %s", mockPipelineName),
+		},
+		"errors if fail to list the actions": {
+			setupMocks: func(m pipelineLogsMocks) {
+				m.actionLister.EXPECT().BuildActions(mockPipelineName).Return(nil, mockError)
+			},
+			wantedErr: mockError,
+		},
+		"errors if fail to get the latest build": {
+			setupMocks: func(m pipelineLogsMocks) {
+				m.actionLister.EXPECT().BuildActions(mockPipelineName).Return(mockActions, nil)
+				m.buildGetter.EXPECT().LatestBuild("BuildProject").Return(nil, mockError)
+			},
+			wantedErr: mockError,
+		},
+		"errors if fail to write the logs": {
+			inBuildID: "BuildProject:1",
+			setupMocks: func(m pipelineLogsMocks) {
+				m.buildGetter.EXPECT().Builds([]string{"BuildProject:1"}).Return([]*codebuild.Build{buildBuild}, nil)
+				m.logsSvc.EXPECT().WriteLogEvents(gomock.Any(), gomock.Any()).Return(mockError)
+			},
+			wantedErr: fmt.Errorf("write log events for pipeline %s: %w", mockPipelineName, mockError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineLogsMocks{
+				actionLister: mocks.NewMockbuildActionLister(ctrl),
+				buildGetter:  mocks.NewMockbuildGetter(ctrl),
+				logsSvc:      mocks.NewMockbuildLogsWriter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &pipelineLogsOpts{
+				pipelineLogsVars: pipelineLogsVars{
+					pipelineName: mockPipelineName,
+					actionName:   tc.inAction,
+					buildID:      tc.inBuildID,
+					follow:       tc.inFollow,
+				},
+				actionLister: m.actionLister,
+				buildGetter:  m.buildGetter,
+				logsSvc:      m.logsSvc,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
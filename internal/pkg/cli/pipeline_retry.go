@@ -0,0 +1,208 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	pipelineRetryAppNamePrompt          = "Which application's pipeline would you like to retry?"
+	pipelineRetryAppNameHelpPrompt      = "An application is a collection of related services."
+	fmtPipelineRetryPipelineNamePrompt  = "Which pipeline of %s would you like to retry?"
+	pipelineRetryPipelineNameHelpPrompt = "The failed actions of a stage of the pipeline will be retried."
+	fmtPipelineRetryStagePrompt         = "Which failed stage of %s would you like to retry?"
+	pipelineRetryStageHelpPrompt        = "Only the failed actions of the stage are retried, in the same pipeline execution."
+)
+
+type retryPipelineVars struct {
+	appName      string
+	pipelineName string
+	stageName    string
+}
+
+type retryPipelineOpts struct {
+	retryPipelineVars
+
+	ws          wsPipelineLister
+	store       applicationGetter
+	pipelineSvc pipelineGetter
+	stateGetter pipelineStateGetter
+	executor    pipelineExecutor
+	sel         appSelector
+	prompt      prompter
+}
+
+func newRetryPipelineOpts(vars retryPipelineVars) (*retryPipelineOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store client: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace client: %w", err)
+	}
+	sess, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	cp := codepipeline.New(sess)
+	prompter := prompt.New()
+	return &retryPipelineOpts{
+		retryPipelineVars: vars,
+		ws:                ws,
+		store:             store,
+		pipelineSvc:       cp,
+		stateGetter:       cp,
+		executor:          cp,
+		sel:               selector.NewSelect(prompter, store),
+		prompt:            prompter,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *retryPipelineOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.pipelineName != "" {
+		if _, err := o.pipelineSvc.GetPipeline(o.pipelineName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (o *retryPipelineOpts) Ask() error {
+	if o.appName == "" {
+		name, err := o.sel.Application(pipelineRetryAppNamePrompt, pipelineRetryAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = name
+	}
+	if o.pipelineName == "" {
+		name, err := selectDeployedPipeline(o.ws, o.prompt, o.pipelineSvc, o.appName,
+			fmt.Sprintf(fmtPipelineRetryPipelineNamePrompt, color.HighlightUserInput(o.appName)), pipelineRetryPipelineNameHelpPrompt)
+		if err != nil {
+			return err
+		}
+		o.pipelineName = name
+	}
+	return o.askStageName()
+}
+
+// Execute retries the failed actions of the stage.
+func (o *retryPipelineOpts) Execute() error {
+	state, err := o.stateGetter.GetPipelineState(o.pipelineName)
+	if err != nil {
+		return err
+	}
+	var stage *codepipeline.StageState
+	for _, s := range state.StageStates {
+		if s.StageName == o.stageName {
+			stage = s
+			break
+		}
+	}
+	if stage == nil {
+		return fmt.Errorf("stage %s does not exist in pipeline %s", o.stageName, o.pipelineName)
+	}
+	if stage.AggregateStatus() != "Failed" {
+		return fmt.Errorf("stage %s of pipeline %s has no failed actions to retry", o.stageName, o.pipelineName)
+	}
+	if err := o.executor.RetryStageExecution(o.pipelineName, o.stageName, stage.ExecutionID); err != nil {
+		return err
+	}
+	log.Successf("Retrying the failed actions of stage %s in pipeline %s.\n", color.HighlightUserInput(o.stageName), color.HighlightUserInput(o.pipelineName))
+	return nil
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *retryPipelineOpts) RecommendedActions() []string {
+	return []string{
+		fmt.Sprintf("Run %s to follow the execution.", color.HighlightCode(fmt.Sprintf("copilot pipeline status -n %s --watch", o.pipelineName))),
+	}
+}
+
+func (o *retryPipelineOpts) askStageName() error {
+	if o.stageName != "" {
+		return nil
+	}
+	state, err := o.stateGetter.GetPipelineState(o.pipelineName)
+	if err != nil {
+		return err
+	}
+	var failed []string
+	for _, stage := range state.StageStates {
+		if stage.AggregateStatus() == "Failed" {
+			failed = append(failed, stage.StageName)
+		}
+	}
+	switch len(failed) {
+	case 0:
+		return fmt.Errorf("no failed stages to retry in pipeline %s", o.pipelineName)
+	case 1:
+		log.Infof("Found failed stage: %s\n", color.HighlightUserInput(failed[0]))
+		o.stageName = failed[0]
+		return nil
+	}
+	name, err := o.prompt.SelectOne(fmt.Sprintf(fmtPipelineRetryStagePrompt, color.HighlightUserInput(o.pipelineName)), pipelineRetryStageHelpPrompt, failed)
+	if err != nil {
+		return fmt.Errorf("select stage of pipeline %s: %w", o.pipelineName, err)
+	}
+	o.stageName = name
+	return nil
+}
+
+// buildPipelineRetryCmd builds the command for retrying the failed actions of a pipeline stage.
+func buildPipelineRetryCmd() *cobra.Command {
+	vars := retryPipelineVars{}
+	cmd := &cobra.Command{
+		Use:   "retry",
+		Short: "Retries the failed actions of a pipeline stage.",
+		Long:  "Retries the failed actions of a stage in the latest execution of a pipeline.",
+
+		Example: `
+  Retries the failed actions of the "DeployTo-test" stage.
+  /code $ copilot pipeline retry -n pipeline-myapp-myrepo --stage DeployTo-test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRetryPipelineOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			log.Infoln("Recommended follow-up actions:")
+			for _, followup := range opts.RecommendedActions() {
+				log.Infof("- %s\n", followup)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.pipelineName, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.stageName, stageFlag, "", stageFlagDescription)
+	return cmd
+}
@@ -0,0 +1,209 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type retryPipelineMocks struct {
+	stateGetter *mocks.MockpipelineStateGetter
+	executor    *mocks.MockpipelineExecutor
+	prompt      *mocks.Mockprompter
+}
+
+func TestRetryPipelineOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inStageName string
+		setupMocks  func(m retryPipelineMocks)
+
+		wantedStage string
+		wantedErr   error
+	}{
+		"does not prompt if stage is set": {
+			inStageName: "DeployTo-test",
+			setupMocks:  func(m retryPipelineMocks) {},
+			wantedStage: "DeployTo-test",
+		},
+		"uses the only failed stage": {
+			setupMocks: func(m retryPipelineMocks) {
+				m.stateGetter.EXPECT().GetPipelineState(mockPipelineName).Return(&codepipeline.PipelineState{
+					StageStates: []*codepipeline.StageState{
+						{StageName: "Source", Actions: []codepipeline.StageAction{{Status: "Succeeded"}}},
+						{StageName: "DeployTo-test", Actions: []codepipeline.StageAction{{Status: "Failed"}}},
+					},
+				}, nil)
+			},
+			wantedStage: "DeployTo-test",
+		},
+		"prompts for one of the failed stages": {
+			setupMocks: func(m retryPipelineMocks) {
+				m.stateGetter.EXPECT().GetPipelineState(mockPipelineName).Return(&codepipeline.PipelineState{
+					StageStates: []*codepipeline.StageState{
+						{StageName: "DeployTo-test", Actions: []codepipeline.StageAction{{Status: "Failed"}}},
+						{StageName: "DeployTo-prod", Actions: []codepipeline.StageAction{{Status: "Abandoned"}}},
+					},
+				}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), pipelineRetryStageHelpPrompt, []string{"DeployTo-test", "DeployTo-prod"}).Return("DeployTo-prod", nil)
+			},
+			wantedStage: "DeployTo-prod",
+		},
+		"errors if there are no failed stages": {
+			setupMocks: func(m retryPipelineMocks) {
+				m.stateGetter.EXPECT().GetPipelineState(mockPipelineName).Return(&codepipeline.PipelineState{
+					StageStates: []*codepipeline.StageState{
+						{StageName: "DeployTo-test", Actions: []codepipeline.StageAction{{Status: "Succeeded"}}},
+					},
+				}, nil)
+			},
+			wantedErr: fmt.Errorf("no failed stages to retry in pipeline %s", mockPipelineName),
+		},
+		"errors if fail to get the pipeline state": {
+			setupMocks: func(m retryPipelineMocks) {
+				m.stateGetter.EXPECT().GetPipelineState(mockPipelineName).Return(nil, mockError)
+			},
+			wantedErr: mockError,
+		},
+		"errors if fail to select a stage": {
+			setupMocks: func(m retryPipelineMocks) {
+				m.stateGetter.EXPECT().GetPipelineState(mockPipelineName).Return(&codepipeline.PipelineState{
+					StageStates: []*codepipeline.StageState{
+						{StageName: "DeployTo-test", Actions: []codepipeline.StageAction{{Status: "Failed"}}},
+						{StageName: "DeployTo-prod", Actions: []codepipeline.StageAction{{Status: "Failed"}}},
+					},
+				}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).Return("", mockError)
+			},
+			wantedErr: fmt.Errorf("select stage of pipeline %s: %w", mockPipelineName, mockError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := retryPipelineMocks{
+				stateGetter: mocks.NewMockpipelineStateGetter(ctrl),
+				prompt:      mocks.NewMockprompter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &retryPipelineOpts{
+				retryPipelineVars: retryPipelineVars{
+					appName:      mockAppName,
+					pipelineName: mockPipelineName,
+					stageName:    tc.inStageName,
+				},
+				stateGetter: m.stateGetter,
+				prompt:      m.prompt,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedStage, opts.stageName)
+			}
+		})
+	}
+}
+
+func TestRetryPipelineOpts_Execute(t *testing.T) {
+	mockState := &codepipeline.PipelineState{
+		StageStates: []*codepipeline.StageState{
+			{
+				StageName:   "Source",
+				Actions:     []codepipeline.StageAction{{Status: "Succeeded"}},
+				ExecutionID: "1234",
+			},
+			{
+				StageName:   "DeployTo-test",
+				Actions:     []codepipeline.StageAction{{Status: "Succeeded"}, {Status: "Failed"}},
+				ExecutionID: "1234",
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inStageName string
+		setupMocks  func(m retryPipelineMocks)
+
+		wantedErr error
+	}{
+		"retries the failed actions of the stage": {
+			inStageName: "DeployTo-test",
+			setupMocks: func(m retryPipelineMocks) {
+				m.stateGetter.EXPECT().GetPipelineState(mockPipelineName).Return(mockState, nil)
+				m.executor.EXPECT().RetryStageExecution(mockPipelineName, "DeployTo-test", "1234").Return(nil)
+			},
+		},
+		"errors if the stage does not exist": {
+			inStageName: "DeployTo-prod",
+			setupMocks: func(m retryPipelineMocks) {
+				m.stateGetter.EXPECT().GetPipelineState(mockPipelineName).Return(mockState, nil)
+			},
+			wantedErr: fmt.Errorf("stage DeployTo-prod does not exist in pipeline %s", mockPipelineName),
+		},
+		"errors if the stage did not fail": {
+			inStageName: "Source",
+			setupMocks: func(m retryPipelineMocks) {
+				m.stateGetter.EXPECT().GetPipelineState(mockPipelineName).Return(mockState, nil)
+			},
+			wantedErr: fmt.Errorf("stage Source of pipeline %s has no failed actions to retry", mockPipelineName),
+		},
+		"errors if fail to retry the stage": {
+			inStageName: "DeployTo-test",
+			setupMocks: func(m retryPipelineMocks) {
+				m.stateGetter.EXPECT().GetPipelineState(mockPipelineName).Return(mockState, nil)
+				m.executor.EXPECT().RetryStageExecution(mockPipelineName, "DeployTo-test", "1234").Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := retryPipelineMocks{
+				stateGetter: mocks.NewMockpipelineStateGetter(ctrl),
+				executor:    mocks.NewMockpipelineExecutor(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &retryPipelineOpts{
+				retryPipelineVars: retryPipelineVars{
+					pipelineName: mockPipelineName,
+					stageName:    tc.inStageName,
+				},
+				stateGetter: m.stateGetter,
+				executor:    m.executor,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	pipelineRunAppNamePrompt          = "Which application's pipeline would you like to run?"
+	pipelineRunAppNameHelpPrompt      = "An application is a collection of related services."
+	fmtPipelineRunPipelineNamePrompt  = "Which pipeline of %s would you like to run?"
+	pipelineRunPipelineNameHelpPrompt = "A new execution of the pipeline will be started with the latest commit of its source."
+)
+
+type runPipelineVars struct {
+	appName      string
+	pipelineName string
+}
+
+type runPipelineOpts struct {
+	runPipelineVars
+
+	ws          wsPipelineLister
+	store       applicationGetter
+	pipelineSvc pipelineGetter
+	executor    pipelineExecutor
+	sel         appSelector
+	prompt      prompter
+}
+
+func newRunPipelineOpts(vars runPipelineVars) (*runPipelineOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store client: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace client: %w", err)
+	}
+	sess, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	cp := codepipeline.New(sess)
+	prompter := prompt.New()
+	return &runPipelineOpts{
+		runPipelineVars: vars,
+		ws:              ws,
+		store:           store,
+		pipelineSvc:     cp,
+		executor:        cp,
+		sel:             selector.NewSelect(prompter, store),
+		prompt:          prompter,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *runPipelineOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.pipelineName != "" {
+		if _, err := o.pipelineSvc.GetPipeline(o.pipelineName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (o *runPipelineOpts) Ask() error {
+	if o.appName == "" {
+		name, err := o.sel.Application(pipelineRunAppNamePrompt, pipelineRunAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = name
+	}
+	if o.pipelineName != "" {
+		return nil
+	}
+	name, err := selectDeployedPipeline(o.ws, o.prompt, o.pipelineSvc, o.appName,
+		fmt.Sprintf(fmtPipelineRunPipelineNamePrompt, color.HighlightUserInput(o.appName)), pipelineRunPipelineNameHelpPrompt)
+	if err != nil {
+		return err
+	}
+	o.pipelineName = name
+	return nil
+}
+
+// Execute starts a new execution of the pipeline.
+func (o *runPipelineOpts) Execute() error {
+	id, err := o.executor.StartExecution(o.pipelineName)
+	if err != nil {
+		return err
+	}
+	log.Successf("Started execution %s of pipeline %s.\n", color.HighlightResource(id), color.HighlightUserInput(o.pipelineName))
+	return nil
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *runPipelineOpts) RecommendedActions() []string {
+	return []string{
+		fmt.Sprintf("Run %s to follow the execution.", color.HighlightCode(fmt.Sprintf("copilot pipeline status -n %s --watch", o.pipelineName))),
+	}
+}
+
+// buildPipelineRunCmd builds the command for starting a new execution of a deployed pipeline.
+func buildPipelineRunCmd() *cobra.Command {
+	vars := runPipelineVars{}
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Starts a new execution of a pipeline.",
+		Long:  "Starts a new execution of a pipeline with the latest commit of its source.",
+
+		Example: `
+  Runs the pipeline "pipeline-myapp-myrepo".
+  /code $ copilot pipeline run -n pipeline-myapp-myrepo`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRunPipelineOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			log.Infoln("Recommended follow-up actions:")
+			for _, followup := range opts.RecommendedActions() {
+				log.Infof("- %s\n", followup)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.pipelineName, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	return cmd
+}
@@ -0,0 +1,254 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type runPipelineMocks struct {
+	ws          *mocks.MockwsPipelineLister
+	store       *mocks.MockapplicationGetter
+	pipelineSvc *mocks.MockpipelineGetter
+	executor    *mocks.MockpipelineExecutor
+	sel         *mocks.MockappSelector
+	prompt      *mocks.Mockprompter
+}
+
+func TestRunPipelineOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName      string
+		inPipelineName string
+		setupMocks     func(m runPipelineMocks)
+
+		wantedErr error
+	}{
+		"errors if app name is invalid": {
+			inAppName: "bad-app",
+			setupMocks: func(m runPipelineMocks) {
+				m.store.EXPECT().GetApplication("bad-app").Return(nil, mockError)
+			},
+			wantedErr: mockError,
+		},
+		"errors if pipeline name is invalid": {
+			inAppName:      mockAppName,
+			inPipelineName: "bad-pipeline",
+			setupMocks: func(m runPipelineMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{}, nil)
+				m.pipelineSvc.EXPECT().GetPipeline("bad-pipeline").Return(nil, mockError)
+			},
+			wantedErr: mockError,
+		},
+		"success": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			setupMocks: func(m runPipelineMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{}, nil)
+				m.pipelineSvc.EXPECT().GetPipeline(mockPipelineName).Return(nil, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := runPipelineMocks{
+				store:       mocks.NewMockapplicationGetter(ctrl),
+				pipelineSvc: mocks.NewMockpipelineGetter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &runPipelineOpts{
+				runPipelineVars: runPipelineVars{
+					appName:      tc.inAppName,
+					pipelineName: tc.inPipelineName,
+				},
+				store:       m.store,
+				pipelineSvc: m.pipelineSvc,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRunPipelineOpts_Ask(t *testing.T) {
+	mockTags := map[string]string{
+		"copilot-application": mockAppName,
+	}
+	testCases := map[string]struct {
+		inAppName      string
+		inPipelineName string
+		setupMocks     func(m runPipelineMocks)
+
+		wantedApp      string
+		wantedPipeline string
+		wantedErr      error
+	}{
+		"errors if fail to select app": {
+			setupMocks: func(m runPipelineMocks) {
+				m.sel.EXPECT().Application(pipelineRunAppNamePrompt, pipelineRunAppNameHelpPrompt).Return("", mockError)
+			},
+			wantedErr: fmt.Errorf("select application: %w", mockError),
+		},
+		"does not prompt if flags are set": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			setupMocks:     func(m runPipelineMocks) {},
+			wantedApp:      mockAppName,
+			wantedPipeline: mockPipelineName,
+		},
+		"uses the pipeline in the workspace": {
+			setupMocks: func(m runPipelineMocks) {
+				m.sel.EXPECT().Application(pipelineRunAppNamePrompt, pipelineRunAppNameHelpPrompt).Return(mockAppName, nil)
+				m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: mockPipelineName}}, nil)
+			},
+			wantedApp:      mockAppName,
+			wantedPipeline: mockPipelineName,
+		},
+		"uses the only deployed pipeline": {
+			inAppName: mockAppName,
+			setupMocks: func(m runPipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(nil, nil)
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(mockTags).Return([]string{mockPipelineName}, nil)
+			},
+			wantedApp:      mockAppName,
+			wantedPipeline: mockPipelineName,
+		},
+		"prompts for one of the deployed pipelines": {
+			inAppName: mockAppName,
+			setupMocks: func(m runPipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(nil, nil)
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(mockTags).Return([]string{mockPipelineName, "release"}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), pipelineRunPipelineNameHelpPrompt, []string{mockPipelineName, "release"}).Return("release", nil)
+			},
+			wantedApp:      mockAppName,
+			wantedPipeline: "release",
+		},
+		"errors if there are no deployed pipelines": {
+			inAppName: mockAppName,
+			setupMocks: func(m runPipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(nil, nil)
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(mockTags).Return(nil, nil)
+			},
+			wantedErr: fmt.Errorf("no pipelines found for application %s", mockAppName),
+		},
+		"errors if fail to list deployed pipelines": {
+			inAppName: mockAppName,
+			setupMocks: func(m runPipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(nil, nil)
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(mockTags).Return(nil, mockError)
+			},
+			wantedErr: fmt.Errorf("list pipelines: %w", mockError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := runPipelineMocks{
+				ws:          mocks.NewMockwsPipelineLister(ctrl),
+				pipelineSvc: mocks.NewMockpipelineGetter(ctrl),
+				sel:         mocks.NewMockappSelector(ctrl),
+				prompt:      mocks.NewMockprompter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &runPipelineOpts{
+				runPipelineVars: runPipelineVars{
+					appName:      tc.inAppName,
+					pipelineName: tc.inPipelineName,
+				},
+				ws:          m.ws,
+				pipelineSvc: m.pipelineSvc,
+				sel:         m.sel,
+				prompt:      m.prompt,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, opts.appName)
+				require.Equal(t, tc.wantedPipeline, opts.pipelineName)
+			}
+		})
+	}
+}
+
+func TestRunPipelineOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m runPipelineMocks)
+
+		wantedErr error
+	}{
+		"starts an execution of the pipeline": {
+			setupMocks: func(m runPipelineMocks) {
+				m.executor.EXPECT().StartExecution(mockPipelineName).Return("1234", nil)
+			},
+		},
+		"errors if fail to start the execution": {
+			setupMocks: func(m runPipelineMocks) {
+				m.executor.EXPECT().StartExecution(mockPipelineName).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := runPipelineMocks{
+				executor: mocks.NewMockpipelineExecutor(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &runPipelineOpts{
+				runPipelineVars: runPipelineVars{
+					pipelineName: mockPipelineName,
+				},
+				executor: m.executor,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
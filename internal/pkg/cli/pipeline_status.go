@@ -7,14 +7,18 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
 
+	sdkcodepipeline "github.com/aws/aws-sdk-go/service/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
-	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/cursor"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
@@ -28,11 +32,14 @@ const (
 	pipelineStatusAppNameHelpPrompt      = "An application is a collection of related services."
 	fmtPipelineStatusPipelineNamePrompt  = "Which pipeline of %s would you like to show the status of?"
 	pipelineStatusPipelineNameHelpPrompt = "The details of a pipeline's status will be shown (e.g., stages, status, transition)."
+
+	pipelineStatusWatchInterval = 5 * time.Second
 )
 
 type pipelineStatusVars struct {
 	appName          string
 	shouldOutputJSON bool
+	shouldWatch      bool
 	pipelineName     string
 }
 
@@ -43,10 +50,14 @@ type pipelineStatusOpts struct {
 	ws            wsPipelineReader
 	store         store
 	pipelineSvc   pipelineGetter
+	stateGetter   pipelineStateGetter
+	executions    pipelineExecutionGetter
 	describer     describer
 	sel           appSelector
 	prompt        prompter
+	cur           cursorMover
 	initDescriber func(opts *pipelineStatusOpts) error
+	watchInterval time.Duration
 }
 
 func newPipelineStatusOpts(vars pipelineStatusVars) (*pipelineStatusOpts, error) {
@@ -66,13 +77,19 @@ func newPipelineStatusOpts(vars pipelineStatusVars) (*pipelineStatusOpts, error)
 	}
 
 	prompter := prompt.New()
+	cp := codepipeline.New(session)
 	return &pipelineStatusOpts{
 		w:                  log.OutputWriter,
 		pipelineStatusVars: vars,
 		ws:                 ws,
 		store:              store,
-		pipelineSvc:        codepipeline.New(session),
+		pipelineSvc:        cp,
+		stateGetter:        cp,
+		executions:         cp,
 		sel:                selector.NewSelect(prompter, store),
+		prompt:             prompter,
+		cur:                cursor.New(),
+		watchInterval:      pipelineStatusWatchInterval,
 		initDescriber: func(o *pipelineStatusOpts) error {
 			d, err := describe.NewPipelineStatusDescriber(o.pipelineName)
 			if err != nil {
@@ -86,6 +103,9 @@ func newPipelineStatusOpts(vars pipelineStatusVars) (*pipelineStatusOpts, error)
 
 // Validate returns an error if the values provided by the user are invalid.
 func (o *pipelineStatusOpts) Validate() error {
+	if o.shouldWatch && o.shouldOutputJSON {
+		return fmt.Errorf("--%s and --%s cannot be specified together", watchFlag, jsonFlag)
+	}
 	if o.appName != "" {
 		if _, err := o.store.GetApplication(o.appName); err != nil {
 			return err
@@ -108,7 +128,11 @@ func (o *pipelineStatusOpts) Ask() error {
 }
 
 // Execute displays the status of the pipeline.
+// With --watch, it refreshes the status until the pipeline execution finishes.
 func (o *pipelineStatusOpts) Execute() error {
+	if o.shouldWatch {
+		return o.watch()
+	}
 	err := o.initDescriber(o)
 	if err != nil {
 		return fmt.Errorf("describe status of pipeline: %w", err)
@@ -131,6 +155,71 @@ func (o *pipelineStatusOpts) Execute() error {
 	return nil
 }
 
+// watch re-renders the status of the pipeline until the execution that was running when the watch started
+// has finished, without following the executions started afterwards.
+func (o *pipelineStatusOpts) watch() error {
+	executionID, err := o.executions.LatestExecutionID(o.pipelineName)
+	if err != nil {
+		return fmt.Errorf("watch status of pipeline: %w", err)
+	}
+	var lines int
+	for {
+		var status string
+		if executionID != "" {
+			if status, err = o.executions.ExecutionStatus(o.pipelineName, executionID); err != nil {
+				return fmt.Errorf("watch status of pipeline: %w", err)
+			}
+		}
+		state, err := o.stateGetter.GetPipelineState(o.pipelineName)
+		if err != nil {
+			return fmt.Errorf("watch status of pipeline: %w", err)
+		}
+		for i := 0; i < lines; i++ {
+			o.cur.Up(1)
+			o.cur.EraseLine()
+		}
+		out := describe.PipelineStatus{PipelineState: *state}.HumanString()
+		fmt.Fprint(o.w, out)
+		lines = strings.Count(out, "\n")
+		if executionID == "" || isPipelineExecutionFinished(status) {
+			o.writeFailedActions(state)
+			return nil
+		}
+		time.Sleep(o.watchInterval)
+	}
+}
+
+// isPipelineExecutionFinished returns true if the execution has reached a final status.
+// A superseded execution is finished too, since a newer execution took over its remaining stages.
+func isPipelineExecutionFinished(status string) bool {
+	switch status {
+	case sdkcodepipeline.PipelineExecutionStatusSucceeded, sdkcodepipeline.PipelineExecutionStatusFailed,
+		sdkcodepipeline.PipelineExecutionStatusStopped, sdkcodepipeline.PipelineExecutionStatusSuperseded:
+		return true
+	}
+	return false
+}
+
+// writeFailedActions writes the links to the execution details, such as the CodeBuild logs, of the failed actions.
+func (o *pipelineStatusOpts) writeFailedActions(state *codepipeline.PipelineState) {
+	var b strings.Builder
+	writer := tabwriter.NewWriter(&b, 0, 4, 2, ' ', 0)
+	for _, stage := range state.StageStates {
+		for _, action := range stage.FailedActions() {
+			if action.ExternalURL == "" {
+				continue
+			}
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", stage.StageName, action.Name, action.ExternalURL)
+		}
+	}
+	writer.Flush()
+	if b.Len() == 0 {
+		return
+	}
+	fmt.Fprint(o.w, color.Bold.Sprint("\nFailed Actions\n\n"))
+	fmt.Fprint(o.w, b.String())
+}
+
 func (o *pipelineStatusOpts) askAppName() error {
 	if o.appName != "" {
 		return nil
@@ -201,16 +290,10 @@ func (o *pipelineStatusOpts) retrieveAllPipelines() ([]string, error) {
 }
 
 func (o *pipelineStatusOpts) getPipelineNameFromManifest() (string, error) {
-	data, err := o.ws.ReadPipelineManifest()
+	pipeline, err := selectWorkspacePipeline(o.ws, o.prompt, "")
 	if err != nil {
 		return "", err
 	}
-
-	pipeline, err := manifest.UnmarshalPipeline(data)
-	if err != nil {
-		return "", fmt.Errorf("unmarshal pipeline manifest: %w", err)
-	}
-
 	return pipeline.Name, nil
 }
 
@@ -224,7 +307,10 @@ func buildPipelineStatusCmd() *cobra.Command {
 
 		Example: `
 Shows status of the pipeline "pipeline-myapp-myrepo".
-/code $ copilot pipeline status -n pipeline-myapp-myrepo`,
+/code $ copilot pipeline status -n pipeline-myapp-myrepo
+
+Follows the status of the pipeline until its execution finishes.
+/code $ copilot pipeline status -n pipeline-myapp-myrepo --watch`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newPipelineStatusOpts(vars)
 			if err != nil {
@@ -242,6 +328,7 @@ Shows status of the pipeline "pipeline-myapp-myrepo".
 	cmd.Flags().StringVarP(&vars.pipelineName, nameFlag, nameFlagShort, "", pipelineFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldWatch, watchFlag, false, watchPipelineFlagDescription)
 
 	return cmd
 }
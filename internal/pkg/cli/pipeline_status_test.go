@@ -7,9 +7,12 @@ import (
 	"bytes"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
@@ -21,6 +24,8 @@ type pipelineStatusMocks struct {
 	ws          *mocks.MockwsPipelineReader
 	prompt      *mocks.Mockprompter
 	pipelineSvc *mocks.MockpipelineGetter
+	stateGetter *mocks.MockpipelineStateGetter
+	executions  *mocks.MockpipelineExecutionGetter
 	describer   *mocks.Mockdescriber
 	sel         *mocks.MockappSelector
 }
@@ -29,10 +34,18 @@ func TestPipelineStatus_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		testAppName      string
 		testPipelineName string
+		shouldWatch      bool
+		shouldOutputJSON bool
 		setupMocks       func(mocks pipelineStatusMocks)
 
 		expectedErr error
 	}{
+		"errors if watching with json output": {
+			shouldWatch:      true,
+			shouldOutputJSON: true,
+			setupMocks:       func(mocks pipelineStatusMocks) {},
+			expectedErr:      fmt.Errorf("--watch and --json cannot be specified together"),
+		},
 		"errors if app name is invalid": {
 			testAppName: "bad-app-le",
 			setupMocks: func(mocks pipelineStatusMocks) {
@@ -88,8 +101,10 @@ func TestPipelineStatus_Validate(t *testing.T) {
 
 			opts := &pipelineStatusOpts{
 				pipelineStatusVars: pipelineStatusVars{
-					appName:      tc.testAppName,
-					pipelineName: tc.testPipelineName,
+					appName:          tc.testAppName,
+					pipelineName:     tc.testPipelineName,
+					shouldWatch:      tc.shouldWatch,
+					shouldOutputJSON: tc.shouldOutputJSON,
 				},
 				store:       mockStoreReader,
 				pipelineSvc: mockPipelineStateGetter,
@@ -114,24 +129,6 @@ func TestPipelineStatus_Ask(t *testing.T) {
 	}
 	mockPipelines := []string{mockPipelineName, "pipeline-the-other-one"}
 	mockTestCommands := []string{"make test", "echo 'honk'"}
-	pipelineData := `
-name: pipeline-dinder-badgoose-repo
-version: 1
-
-source:
-  provider: GitHub
-  properties:
-    repository: badgoose/repo
-    access_token_secret: "github-token-badgoose-repo"
-    branch: main
-
-stages:
-    -
-      name: test
-	  test_commands: [make test, echo 'honk']
-    -
-      name: prod
-`
 
 	testCases := map[string]struct {
 		testAppName      string
@@ -148,7 +145,7 @@ stages:
 			testPipelineName: "",
 			setupMocks: func(mocks pipelineStatusMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace),
+					mocks.ws.EXPECT().ListPipelines().Return(nil, nil),
 					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return([]string{mockPipelineName}, nil),
 				)
 			},
@@ -156,12 +153,11 @@ stages:
 			expectedPipeline: mockPipelineName,
 			expectedErr:      nil,
 		},
-		"reads pipeline name and test commands from manifest": {
+		"reads pipeline name from manifest": {
 			testAppName: mockAppName,
 			setupMocks: func(mocks pipelineStatusMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return([]byte(pipelineData), nil),
-					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return([]string{mockPipelineName}, nil),
+					mocks.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: mockPipelineName, Path: "/copilot/pipelines/" + mockPipelineName + "/manifest.yml"}}, nil),
 				)
 			},
 			expectedApp:          mockAppName,
@@ -173,7 +169,7 @@ stages:
 			testAppName: mockAppName,
 			setupMocks: func(mocks pipelineStatusMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace),
+					mocks.ws.EXPECT().ListPipelines().Return(nil, nil),
 					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return(mockPipelines, nil),
 					mocks.prompt.EXPECT().SelectOne(fmt.Sprintf(fmtPipelineStatusPipelineNamePrompt, color.HighlightUserInput(mockAppName)), pipelineStatusPipelineNameHelpPrompt, mockPipelines).Return(mockPipelineName, nil),
 				)
@@ -187,7 +183,7 @@ stages:
 			testPipelineName: "",
 			setupMocks: func(mocks pipelineStatusMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace),
+					mocks.ws.EXPECT().ListPipelines().Return(nil, nil),
 					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return([]string{}, nil),
 				)
 			},
@@ -209,7 +205,7 @@ stages:
 			testPipelineName: "",
 			setupMocks: func(mocks pipelineStatusMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace),
+					mocks.ws.EXPECT().ListPipelines().Return(nil, nil),
 					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return(nil, mockError),
 				)
 			},
@@ -219,7 +215,7 @@ stages:
 			testAppName: mockAppName,
 			setupMocks: func(mocks pipelineStatusMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace),
+					mocks.ws.EXPECT().ListPipelines().Return(nil, nil),
 					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return(mockPipelines, nil),
 					mocks.prompt.EXPECT().SelectOne(fmt.Sprintf(fmtPipelineStatusPipelineNamePrompt, color.HighlightUserInput(mockAppName)), pipelineStatusPipelineNameHelpPrompt, mockPipelines).Return("", mockError),
 				)
@@ -363,3 +359,152 @@ func TestPipelineStatus_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestPipelineStatus_Watch(t *testing.T) {
+	mockTime := time.Now()
+	inProgress := &codepipeline.PipelineState{
+		PipelineName: mockPipelineName,
+		StageStates: []*codepipeline.StageState{
+			{StageName: "Source", Actions: []codepipeline.StageAction{{Name: "SourceCodeFor-dinder", Status: "Succeeded"}}},
+			{StageName: "DeployTo-test", Actions: []codepipeline.StageAction{{Name: "CreateOrUpdate-api-test", Status: "InProgress"}}},
+		},
+		UpdatedAt: mockTime,
+	}
+	failed := &codepipeline.PipelineState{
+		PipelineName: mockPipelineName,
+		StageStates: []*codepipeline.StageState{
+			{StageName: "Source", Actions: []codepipeline.StageAction{{Name: "SourceCodeFor-dinder", Status: "Succeeded"}}},
+			{StageName: "DeployTo-test", Actions: []codepipeline.StageAction{
+				{Name: "CreateOrUpdate-api-test", Status: "Succeeded"},
+				{Name: "TestCommands", Status: "Failed", ExternalURL: "https://console.aws.amazon.com/codebuild/logs"},
+			}},
+		},
+		UpdatedAt: mockTime,
+	}
+	succeeded := &codepipeline.PipelineState{
+		PipelineName: mockPipelineName,
+		StageStates: []*codepipeline.StageState{
+			{StageName: "Source", Actions: []codepipeline.StageAction{{Name: "SourceCodeFor-dinder", Status: "Succeeded"}}},
+		},
+		UpdatedAt: mockTime,
+	}
+	render := func(state *codepipeline.PipelineState) string {
+		return describe.PipelineStatus{PipelineState: *state}.HumanString()
+	}
+	countLines := func(s string) int {
+		return len(bytes.Split([]byte(s), []byte("\n"))) - 1
+	}
+
+	testCases := map[string]struct {
+		setupMocks func(m pipelineStatusMocks, cur *mocks.MockcursorMover)
+
+		expectedContent string
+		expectedError   error
+	}{
+		"errors if fail to get the latest execution": {
+			setupMocks: func(m pipelineStatusMocks, cur *mocks.MockcursorMover) {
+				m.executions.EXPECT().LatestExecutionID(mockPipelineName).Return("", mockError)
+			},
+			expectedError: fmt.Errorf("watch status of pipeline: %w", mockError),
+		},
+		"errors if fail to get the status of the execution": {
+			setupMocks: func(m pipelineStatusMocks, cur *mocks.MockcursorMover) {
+				m.executions.EXPECT().LatestExecutionID(mockPipelineName).Return("1234", nil)
+				m.executions.EXPECT().ExecutionStatus(mockPipelineName, "1234").Return("", mockError)
+			},
+			expectedError: fmt.Errorf("watch status of pipeline: %w", mockError),
+		},
+		"errors if fail to get the pipeline state": {
+			setupMocks: func(m pipelineStatusMocks, cur *mocks.MockcursorMover) {
+				m.executions.EXPECT().LatestExecutionID(mockPipelineName).Return("1234", nil)
+				m.executions.EXPECT().ExecutionStatus(mockPipelineName, "1234").Return("InProgress", nil)
+				m.stateGetter.EXPECT().GetPipelineState(mockPipelineName).Return(nil, mockError)
+			},
+			expectedError: fmt.Errorf("watch status of pipeline: %w", mockError),
+		},
+		"renders once if the pipeline never ran": {
+			setupMocks: func(m pipelineStatusMocks, cur *mocks.MockcursorMover) {
+				m.executions.EXPECT().LatestExecutionID(mockPipelineName).Return("", nil)
+				m.stateGetter.EXPECT().GetPipelineState(mockPipelineName).Return(succeeded, nil)
+			},
+			expectedContent: render(succeeded),
+		},
+		"renders once if the execution already finished": {
+			setupMocks: func(m pipelineStatusMocks, cur *mocks.MockcursorMover) {
+				m.executions.EXPECT().LatestExecutionID(mockPipelineName).Return("1234", nil)
+				m.executions.EXPECT().ExecutionStatus(mockPipelineName, "1234").Return("Succeeded", nil)
+				m.stateGetter.EXPECT().GetPipelineState(mockPipelineName).Return(succeeded, nil)
+			},
+			expectedContent: render(succeeded),
+		},
+		"re-renders until the execution finishes and writes the logs of failed actions": {
+			setupMocks: func(m pipelineStatusMocks, cur *mocks.MockcursorMover) {
+				m.executions.EXPECT().LatestExecutionID(mockPipelineName).Return("1234", nil)
+				gomock.InOrder(
+					m.executions.EXPECT().ExecutionStatus(mockPipelineName, "1234").Return("InProgress", nil),
+					m.executions.EXPECT().ExecutionStatus(mockPipelineName, "1234").Return("Failed", nil),
+				)
+				gomock.InOrder(
+					m.stateGetter.EXPECT().GetPipelineState(mockPipelineName).Return(inProgress, nil),
+					m.stateGetter.EXPECT().GetPipelineState(mockPipelineName).Return(failed, nil),
+				)
+				cur.EXPECT().Up(1).Times(countLines(render(inProgress)))
+				cur.EXPECT().EraseLine().Times(countLines(render(inProgress)))
+			},
+			expectedContent: render(inProgress) + render(failed) +
+				"\nFailed Actions\n\n" +
+				"  DeployTo-test  TestCommands  https://console.aws.amazon.com/codebuild/logs\n",
+		},
+		"keeps watching the execution while no stage is running": {
+			setupMocks: func(m pipelineStatusMocks, cur *mocks.MockcursorMover) {
+				m.executions.EXPECT().LatestExecutionID(mockPipelineName).Return("1234", nil)
+				gomock.InOrder(
+					m.executions.EXPECT().ExecutionStatus(mockPipelineName, "1234").Return("InProgress", nil),
+					m.executions.EXPECT().ExecutionStatus(mockPipelineName, "1234").Return("Stopped", nil),
+				)
+				m.stateGetter.EXPECT().GetPipelineState(mockPipelineName).Return(succeeded, nil).Times(2)
+				cur.EXPECT().Up(1).Times(countLines(render(succeeded)))
+				cur.EXPECT().EraseLine().Times(countLines(render(succeeded)))
+			},
+			expectedContent: render(succeeded) + render(succeeded),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			cur := mocks.NewMockcursorMover(ctrl)
+			m := pipelineStatusMocks{
+				stateGetter: mocks.NewMockpipelineStateGetter(ctrl),
+				executions:  mocks.NewMockpipelineExecutionGetter(ctrl),
+			}
+			tc.setupMocks(m, cur)
+
+			opts := &pipelineStatusOpts{
+				pipelineStatusVars: pipelineStatusVars{
+					pipelineName: mockPipelineName,
+					shouldWatch:  true,
+				},
+				stateGetter: m.stateGetter,
+				executions:  m.executions,
+				cur:         cur,
+				w:           b,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedContent, b.String(), "expected output content to match")
+			}
+		})
+	}
+}
@@ -6,12 +6,14 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -38,6 +40,9 @@ const (
 	fmtPipelineUpdateProposalComplete = "Successfully updated pipeline: %s"
 
 	fmtPipelineUpdateExistPrompt = "Are you sure you want to update an existing pipeline: %s?"
+
+	fmtPipelineConnectionPending = "%s The connection %s to your repository is pending. Complete it at %s so that your pipeline can pull the source code.\n"
+	connectionsConsoleURL        = "https://console.aws.amazon.com/codesuite/settings/connections"
 )
 
 type updatePipelineVars struct {
@@ -50,12 +55,16 @@ type updatePipelineOpts struct {
 	updatePipelineVars
 
 	pipelineDeployer pipelineDeployer
+	analyzer         stackFailureAnalyzer
 	app              *config.Application
 	prog             progress
 	prompt           prompter
 	region           string
 	envStore         environmentStore
 	ws               wsPipelineReader
+
+	// Cached variables.
+	pipelineMft *workspace.PipelineManifest
 }
 
 func newUpdatePipelineOpts(vars updatePipelineVars) (*updatePipelineOpts, error) {
@@ -82,6 +91,7 @@ func newUpdatePipelineOpts(vars updatePipelineVars) (*updatePipelineOpts, error)
 	return &updatePipelineOpts{
 		app:                app,
 		pipelineDeployer:   deploycfn.New(defaultSession),
+		analyzer:           describe.NewStackFailureAnalyzer(defaultSession),
 		region:             aws.StringValue(defaultSession.Config.Region),
 		updatePipelineVars: vars,
 		envStore:           store,
@@ -96,28 +106,93 @@ func (o *updatePipelineOpts) Validate() error {
 	return nil
 }
 
+// Ask prompts for the pipeline to deploy if the workspace has several pipelines and --name is not provided.
+func (o *updatePipelineOpts) Ask() error {
+	pipelineMft, err := selectWorkspacePipeline(o.ws, o.prompt, o.pipelineName)
+	if err != nil {
+		return err
+	}
+	o.pipelineMft = pipelineMft
+	return nil
+}
+
 func (o *updatePipelineOpts) convertStages(manifestStages []manifest.PipelineStage) ([]deploy.PipelineStage, error) {
 	var stages []deploy.PipelineStage
 	svcNames, err := o.ws.ServiceNames()
 	if err != nil {
 		return nil, fmt.Errorf("service names from workspace: %w", err)
 	}
+	jobNames, err := o.ws.JobNames()
+	if err != nil {
+		return nil, fmt.Errorf("job names from workspace: %w", err)
+	}
+	lbSvcNames, err := o.ws.LoadBalancedWebServiceNames()
+	if err != nil {
+		return nil, fmt.Errorf("load balanced web service names from workspace: %w", err)
+	}
 
 	for _, stage := range manifestStages {
 		env, err := o.envStore.GetEnvironment(o.appName, stage.Name)
 		if err != nil {
 			return nil, fmt.Errorf("get environment %s in application %s: %w", stage.Name, o.appName, err)
 		}
+		stageSvcs, stageJobs, err := selectStageDeployments(stage, svcNames, jobNames)
+		if err != nil {
+			return nil, err
+		}
+		dependsOn, err := deploy.NewPipelineDependencies(stage.Name, stage.Deployments)
+		if err != nil {
+			return nil, err
+		}
+		preDeployments, err := deploy.NewPipelineActions(stage.Name, stage.PreDeployments)
+		if err != nil {
+			return nil, err
+		}
+		postDeployments, err := deploy.NewPipelineActions(stage.Name, stage.PostDeployments)
+		if err != nil {
+			return nil, err
+		}
+		for _, pre := range preDeployments {
+			for _, post := range postDeployments {
+				if pre.Name == post.Name {
+					return nil, fmt.Errorf("action %s is defined more than once in stage %s", pre.Name, stage.Name)
+				}
+			}
+		}
+		var stageLBSvcs []string
+		for _, svc := range stageSvcs {
+			if contains(svc, lbSvcNames) {
+				stageLBSvcs = append(stageLBSvcs, svc)
+			}
+		}
+
+		var approval deploy.PipelineApproval
+		if stage.ApprovalRequired() {
+			var prev *deploy.PipelineStage
+			if len(stages) > 0 {
+				prev = &stages[len(stages)-1]
+			}
+			approval, err = deploy.NewPipelineApproval(stage.Name, stage.Approval, prev)
+			if err != nil {
+				return nil, err
+			}
+		}
 
 		pipelineStage := deploy.PipelineStage{
-			LocalServices: svcNames,
+			LocalServices: stageSvcs,
+			LocalJobs:     stageJobs,
 			AssociatedEnvironment: &deploy.AssociatedEnvironment{
 				Name:      stage.Name,
 				Region:    env.Region,
 				AccountID: env.AccountID,
 			},
-			RequiresApproval: stage.RequiresApproval,
-			TestCommands:     stage.TestCommands,
+			RequiresApproval:     stage.ApprovalRequired(),
+			TestCommands:         stage.TestCommands,
+			LoadBalancedServices: stageLBSvcs,
+			PreDeployments:       preDeployments,
+			PostDeployments:      postDeployments,
+			DependsOn:            dependsOn,
+			Approval:             approval,
 		}
 		stages = append(stages, pipelineStage)
 	}
@@ -125,6 +200,58 @@ func (o *updatePipelineOpts) convertStages(manifestStages []manifest.PipelineSta
 	return stages, nil
 }
 
+// selectStageDeployments returns the services and jobs to deploy in a stage.
+// If the stage doesn't list any deployments, all the local workloads are deployed.
+func selectStageDeployments(stage manifest.PipelineStage, svcNames, jobNames []string) (svcs []string, jobs []string, err error) {
+	if stage.Deployments == nil {
+		svcs, err = selectStageWorkloads("service", stage.Name, stage.Services, svcNames)
+		if err != nil {
+			return nil, nil, err
+		}
+		jobs, err = selectStageWorkloads("job", stage.Name, stage.Jobs, jobNames)
+		if err != nil {
+			return nil, nil, err
+		}
+		return svcs, jobs, nil
+	}
+	var names []string
+	for name := range stage.Deployments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !contains(name, svcNames) && !contains(name, jobNames) {
+			return nil, nil, fmt.Errorf("workload %s in stage %s does not exist in the workspace", name, stage.Name)
+		}
+	}
+	svcs, jobs = []string{}, []string{}
+	for _, name := range svcNames {
+		if _, ok := stage.Deployments[name]; ok {
+			svcs = append(svcs, name)
+		}
+	}
+	for _, name := range jobNames {
+		if _, ok := stage.Deployments[name]; ok {
+			jobs = append(jobs, name)
+		}
+	}
+	return svcs, jobs, nil
+}
+
+// selectStageWorkloads returns the workloads to deploy in a stage.
+// If the stage doesn't select any workloads, all the local workloads are deployed.
+func selectStageWorkloads(workloadType, stageName string, selected, local []string) ([]string, error) {
+	if selected == nil {
+		return local, nil
+	}
+	for _, name := range selected {
+		if !contains(name, local) {
+			return nil, fmt.Errorf("%s %s in stage %s does not exist in the workspace", workloadType, name, stageName)
+		}
+	}
+	return selected, nil
+}
+
 func (o *updatePipelineOpts) getArtifactBuckets() ([]deploy.ArtifactBucket, error) {
 	regionalResources, err := o.pipelineDeployer.GetRegionalAppResources(o.app)
 	if err != nil {
@@ -162,14 +289,24 @@ func (o *updatePipelineOpts) deployPipeline(in *deploy.CreatePipelineInput) erro
 	}
 	if !exist {
 		o.prog.Start(fmt.Sprintf(fmtPipelineUpdateStart, color.HighlightUserInput(o.pipelineName)))
+		since := lastStackOperationTime(o.analyzer, in.Name)
 		if err := o.pipelineDeployer.CreatePipeline(in); err != nil {
 			var alreadyExists *cloudformation.ErrStackAlreadyExists
 			if !errors.As(err, &alreadyExists) {
 				o.prog.Stop(log.Serrorf(fmtPipelineUpdateFailed, color.HighlightUserInput(o.pipelineName)))
+				logStackFailure(o.analyzer, in.Name, since)
 				return fmt.Errorf("create pipeline: %w", err)
 			}
 		}
 		o.prog.Stop(log.Ssuccessf(fmtPipelineUpdateComplete, color.HighlightUserInput(o.pipelineName)))
+		if in.Source.IsCodeStarConnection() && in.Source.ConnectionARN() == "" {
+			// The connection created by the pipeline stack must be completed by the user before the pipeline can run.
+			connName, err := in.Source.ConnectionName()
+			if err != nil {
+				return fmt.Errorf("get connection name: %w", err)
+			}
+			log.Warningf(fmtPipelineConnectionPending, color.Emphasize("ACTION REQUIRED!"), color.HighlightUserInput(connName), color.HighlightResource(connectionsConsoleURL))
+		}
 		return nil
 	}
 
@@ -182,8 +319,10 @@ func (o *updatePipelineOpts) deployPipeline(in *deploy.CreatePipelineInput) erro
 		return nil
 	}
 	o.prog.Start(fmt.Sprintf(fmtPipelineUpdateProposalStart, color.HighlightUserInput(o.pipelineName)))
+	since := lastStackOperationTime(o.analyzer, in.Name)
 	if err := o.pipelineDeployer.UpdatePipeline(in); err != nil {
 		o.prog.Stop(log.Serrorf(fmtPipelineUpdateProposalFailed, color.HighlightUserInput(o.pipelineName)))
+		logStackFailure(o.analyzer, in.Name, since)
 		return fmt.Errorf("update pipeline: %w", err)
 	}
 	o.prog.Stop(log.Ssuccessf(fmtPipelineUpdateProposalComplete, color.HighlightUserInput(o.pipelineName)))
@@ -202,7 +341,7 @@ func (o *updatePipelineOpts) Execute() error {
 	o.prog.Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, color.HighlightUserInput(o.appName)))
 
 	// read pipeline manifest
-	data, err := o.ws.ReadPipelineManifest()
+	data, err := o.ws.ReadPipelineManifest(o.pipelineMft.Path)
 	if err != nil {
 		return fmt.Errorf("read pipeline manifest: %w", err)
 	}
@@ -215,6 +354,15 @@ func (o *updatePipelineOpts) Execute() error {
 		ProviderName: pipeline.Source.ProviderName,
 		Properties:   pipeline.Source.Properties,
 	}
+	if source.IsCodeCommit() {
+		repoRegion, err := source.RepositoryRegion()
+		if err != nil {
+			return fmt.Errorf("get region of the source repository: %w", err)
+		}
+		if repoRegion != o.region {
+			return fmt.Errorf(fmtErrCodeCommitRegion, source.Properties["repository"], repoRegion, o.region)
+		}
+	}
 
 	// convert environments to deployment stages
 	stages, err := o.convertStages(pipeline.Stages)
@@ -222,17 +370,34 @@ func (o *updatePipelineOpts) Execute() error {
 		return fmt.Errorf("convert environments to deployment stage: %w", err)
 	}
 
+	notifications, err := deploy.NewPipelineNotifications(pipeline.Notifications)
+	if err != nil {
+		return fmt.Errorf("convert notifications of pipeline %s: %w", pipeline.Name, err)
+	}
+
 	// get cross-regional resources
 	artifactBuckets, err := o.getArtifactBuckets()
 	if err != nil {
 		return fmt.Errorf("get cross-regional resources: %w", err)
 	}
 
+	build, err := deploy.NewPipelineBuild(pipeline.Build)
+	if err != nil {
+		return fmt.Errorf("convert build of pipeline %s: %w", pipeline.Name, err)
+	}
+	buildspecPath, err := o.buildspecPath(pipeline)
+	if err != nil {
+		return err
+	}
+
 	deployPipelineInput := &deploy.CreatePipelineInput{
 		AppName:         o.appName,
 		Name:            pipeline.Name,
 		Source:          source,
+		BuildspecPath:   buildspecPath,
+		Build:           build,
 		Stages:          stages,
+		Notifications:   notifications,
 		ArtifactBuckets: artifactBuckets,
 		AdditionalTags:  o.app.Tags,
 	}
@@ -244,6 +409,19 @@ func (o *updatePipelineOpts) Execute() error {
 	return nil
 }
 
+// buildspecPath returns the path to the buildspec of the build stage, relative to the root of the source repository.
+// It defaults to the buildspec next to the pipeline manifest unless the manifest overrides it.
+func (o *updatePipelineOpts) buildspecPath(pipeline *manifest.PipelineManifest) (string, error) {
+	if pipeline.Build != nil && pipeline.Build.Buildspec != "" {
+		return pipeline.Build.Buildspec, nil
+	}
+	path, err := o.ws.PipelineBuildspecPath(o.pipelineMft.Path)
+	if err != nil {
+		return "", fmt.Errorf("get buildspec path of pipeline %s: %w", pipeline.Name, err)
+	}
+	return path, nil
+}
+
 // BuildPipelineUpdateCmd build the command for deploying a new pipeline or updating an existing pipeline.
 func buildPipelineUpdateCmd() *cobra.Command {
 	vars := updatePipelineVars{}
@@ -253,7 +431,10 @@ func buildPipelineUpdateCmd() *cobra.Command {
 		Long:  `Deploys a pipeline for the services in your workspace, using the environments associated with the application.`,
 		Example: `
   Deploys an updated pipeline for the services in your workspace.
-  /code $ copilot pipeline update`,
+  /code $ copilot pipeline update
+
+  Deploys the pipeline named "release" in a workspace with several pipelines.
+  /code $ copilot pipeline update --name release`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newUpdatePipelineOpts(vars)
 			if err != nil {
@@ -262,10 +443,14 @@ func buildPipelineUpdateCmd() *cobra.Command {
 			if err := opts.Validate(); err != nil {
 				return err
 			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
 			return opts.Execute()
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.pipelineName, nameFlag, nameFlagShort, "", pipelineFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
 	return cmd
 }
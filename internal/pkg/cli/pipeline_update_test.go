@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -14,6 +15,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -23,9 +25,112 @@ type updatePipelineMocks struct {
 	prompt   *mocks.Mockprompter
 	prog     *mocks.Mockprogress
 	deployer *mocks.MockpipelineDeployer
+	analyzer *mocks.MockstackFailureAnalyzer
 	ws       *mocks.MockwsPipelineReader
 }
 
+func TestUpdatePipelineOpts_Ask(t *testing.T) {
+	pipelines := []workspace.PipelineManifest{
+		{
+			Name: "pipeline-badgoose-honker-repo",
+			Path: "/copilot/pipelines/pipeline-badgoose-honker-repo/manifest.yml",
+		},
+		{
+			Name: "release",
+			Path: "/copilot/pipelines/release/manifest.yml",
+		},
+	}
+	testCases := map[string]struct {
+		inPipelineName string
+		callMocks      func(m updatePipelineMocks)
+
+		wantedPipeline *workspace.PipelineManifest
+		wantedError    error
+	}{
+		"returns an error if fails to list pipelines": {
+			callMocks: func(m updatePipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list pipelines in the workspace: some error"),
+		},
+		"returns an error if there are no pipelines in the workspace": {
+			callMocks: func(m updatePipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(nil, nil)
+			},
+			wantedError: workspace.ErrNoPipelineInWorkspace,
+		},
+		"returns an error if the pipeline name does not exist in the workspace": {
+			inPipelineName: "honk",
+			callMocks: func(m updatePipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(pipelines, nil)
+			},
+			wantedError: errors.New("pipeline honk does not exist in the workspace"),
+		},
+		"uses the pipeline with the name from the flag": {
+			inPipelineName: "release",
+			callMocks: func(m updatePipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(pipelines, nil)
+			},
+			wantedPipeline: &pipelines[1],
+		},
+		"uses the only pipeline in the workspace without prompting": {
+			callMocks: func(m updatePipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(pipelines[:1], nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedPipeline: &pipelines[0],
+		},
+		"prompts for the pipeline if there are multiple in the workspace": {
+			callMocks: func(m updatePipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(pipelines, nil)
+				m.prompt.EXPECT().SelectOne(pipelineSelectManifestPrompt, pipelineSelectManifestHelpPrompt,
+					[]string{"pipeline-badgoose-honker-repo", "release"}).Return("release", nil)
+			},
+			wantedPipeline: &pipelines[1],
+		},
+		"returns an error if fails to select a pipeline": {
+			callMocks: func(m updatePipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return(pipelines, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select pipeline: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := updatePipelineMocks{
+				prompt: mocks.NewMockprompter(ctrl),
+				ws:     mocks.NewMockwsPipelineReader(ctrl),
+			}
+			tc.callMocks(m)
+
+			opts := &updatePipelineOpts{
+				updatePipelineVars: updatePipelineVars{
+					pipelineName: tc.inPipelineName,
+				},
+				ws:     m.ws,
+				prompt: m.prompt,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedPipeline, opts.pipelineMft)
+			}
+		})
+	}
+}
+
 func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 	testCases := map[string]struct {
 		stages    []manifest.PipelineStage
@@ -53,6 +158,8 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 				}
 				gomock.InOrder(
 					m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return([]string{"report"}, nil).Times(1),
+					m.ws.EXPECT().LoadBalancedWebServiceNames().Return([]string{"frontend"}, nil).Times(1),
 					m.envStore.EXPECT().GetEnvironment("badgoose", "test").Return(mockEnv, nil).Times(1),
 				)
 			},
@@ -64,9 +171,11 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 						Region:    "us-west-2",
 						AccountID: "123456789012",
 					},
-					LocalServices:    []string{"frontend", "backend"},
-					RequiresApproval: false,
-					TestCommands:     []string{"make test", "echo \"made test\""},
+					LocalServices:        []string{"frontend", "backend"},
+					LocalJobs:            []string{"report"},
+					LoadBalancedServices: []string{"frontend"},
+					RequiresApproval:     false,
+					TestCommands:         []string{"make test", "echo \"made test\""},
 				},
 			},
 			expectedError: nil,
@@ -88,6 +197,8 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 				}
 				gomock.InOrder(
 					m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return([]string{"report"}, nil).Times(1),
+					m.ws.EXPECT().LoadBalancedWebServiceNames().Return([]string{"frontend"}, nil).Times(1),
 					m.envStore.EXPECT().GetEnvironment("badgoose", "test").Return(mockEnv, nil).Times(1),
 				)
 			},
@@ -99,9 +210,11 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 						Region:    "us-west-2",
 						AccountID: "123456789012",
 					},
-					LocalServices:    []string{"frontend", "backend"},
-					RequiresApproval: false,
-					TestCommands:     []string(nil),
+					LocalServices:        []string{"frontend", "backend"},
+					LocalJobs:            []string{"report"},
+					LoadBalancedServices: []string{"frontend"},
+					RequiresApproval:     false,
+					TestCommands:         []string(nil),
 				},
 			},
 			expectedError: nil,
@@ -124,6 +237,8 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 				}
 				gomock.InOrder(
 					m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return([]string{"report"}, nil).Times(1),
+					m.ws.EXPECT().LoadBalancedWebServiceNames().Return([]string{"frontend"}, nil).Times(1),
 					m.envStore.EXPECT().GetEnvironment("badgoose", "test").Return(mockEnv, nil).Times(1),
 				)
 			},
@@ -135,13 +250,347 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 						Region:    "us-west-2",
 						AccountID: "123456789012",
 					},
-					LocalServices:    []string{"frontend", "backend"},
-					RequiresApproval: true,
-					TestCommands:     []string(nil),
+					LocalServices:        []string{"frontend", "backend"},
+					LocalJobs:            []string{"report"},
+					LoadBalancedServices: []string{"frontend"},
+					RequiresApproval:     true,
+					TestCommands:         []string(nil),
 				},
 			},
 			expectedError: nil,
 		},
+		"converts stages with selected services and jobs": {
+			stages: []manifest.PipelineStage{
+				{
+					Name:     "test",
+					Services: []string{"backend"},
+					Jobs:     []string{},
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m updatePipelineMocks) {
+				mockEnv := &config.Environment{
+					Name:      "test",
+					App:       "badgoose",
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+				}
+				gomock.InOrder(
+					m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return([]string{"report"}, nil).Times(1),
+					m.ws.EXPECT().LoadBalancedWebServiceNames().Return([]string{"frontend"}, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment("badgoose", "test").Return(mockEnv, nil).Times(1),
+				)
+			},
+
+			expectedStages: []deploy.PipelineStage{
+				{
+					AssociatedEnvironment: &deploy.AssociatedEnvironment{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+					},
+					LocalServices: []string{"backend"},
+					LocalJobs:     []string{},
+				},
+			},
+		},
+		"returns an error if a selected job is not in the workspace": {
+			stages: []manifest.PipelineStage{
+				{
+					Name: "test",
+					Jobs: []string{"cleanup"},
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m updatePipelineMocks) {
+				gomock.InOrder(
+					m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return([]string{"report"}, nil).Times(1),
+					m.ws.EXPECT().LoadBalancedWebServiceNames().Return([]string{"frontend"}, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment("badgoose", "test").Return(&config.Environment{}, nil).Times(1),
+				)
+			},
+
+			expectedError: errors.New("job cleanup in stage test does not exist in the workspace"),
+		},
+		"converts stages with approval and deployments": {
+			stages: []manifest.PipelineStage{
+				{
+					Name:     "test",
+					Approval: &manifest.PipelineApproval{Required: true},
+					Deployments: map[string]*manifest.PipelineDeployment{
+						"report": nil,
+						"frontend": {
+							DependsOn: []string{"report"},
+						},
+					},
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m updatePipelineMocks) {
+				mockEnv := &config.Environment{
+					Name:      "test",
+					App:       "badgoose",
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+				}
+				gomock.InOrder(
+					m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return([]string{"report"}, nil).Times(1),
+					m.ws.EXPECT().LoadBalancedWebServiceNames().Return([]string{"frontend"}, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment("badgoose", "test").Return(mockEnv, nil).Times(1),
+				)
+			},
+
+			expectedStages: []deploy.PipelineStage{
+				{
+					AssociatedEnvironment: &deploy.AssociatedEnvironment{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+					},
+					LocalServices:        []string{"frontend"},
+					LocalJobs:            []string{"report"},
+					LoadBalancedServices: []string{"frontend"},
+					RequiresApproval:     true,
+					DependsOn: map[string][]string{
+						"frontend": {"report"},
+					},
+				},
+			},
+		},
+		"converts approvals with the URLs of the services deployed in the previous stage": {
+			stages: []manifest.PipelineStage{
+				{
+					Name: "test",
+				},
+				{
+					Name: "prod",
+					Approval: &manifest.PipelineApproval{
+						Required: true,
+						Message:  "Check the dashboards.",
+					},
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m updatePipelineMocks) {
+				gomock.InOrder(
+					m.ws.EXPECT().ServiceNames().Return([]string{"frontend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return(nil, nil).Times(1),
+					m.ws.EXPECT().LoadBalancedWebServiceNames().Return([]string{"frontend"}, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment("badgoose", "test").Return(&config.Environment{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+					}, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment("badgoose", "prod").Return(&config.Environment{
+						Name:      "prod",
+						Region:    "us-east-1",
+						AccountID: "123456789012",
+						Prod:      true,
+					}, nil).Times(1),
+				)
+			},
+
+			expectedStages: []deploy.PipelineStage{
+				{
+					AssociatedEnvironment: &deploy.AssociatedEnvironment{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+					},
+					LocalServices:        []string{"frontend"},
+					LoadBalancedServices: []string{"frontend"},
+				},
+				{
+					AssociatedEnvironment: &deploy.AssociatedEnvironment{
+						Name:      "prod",
+						Region:    "us-east-1",
+						AccountID: "123456789012",
+					},
+					LocalServices:        []string{"frontend"},
+					LoadBalancedServices: []string{"frontend"},
+					RequiresApproval:     true,
+					Approval: deploy.PipelineApproval{
+						Message:   "Check the dashboards. Services deployed to test: frontend: #{test-frontend.ServiceURL}.",
+						ReviewURL: "#{test-frontend.ServiceURL}",
+					},
+				},
+			},
+		},
+		"returns an error if a deployment is not in the workspace": {
+			stages: []manifest.PipelineStage{
+				{
+					Name: "test",
+					Deployments: map[string]*manifest.PipelineDeployment{
+						"cleanup": nil,
+					},
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m updatePipelineMocks) {
+				gomock.InOrder(
+					m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return([]string{"report"}, nil).Times(1),
+					m.ws.EXPECT().LoadBalancedWebServiceNames().Return([]string{"frontend"}, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment("badgoose", "test").Return(&config.Environment{}, nil).Times(1),
+				)
+			},
+
+			expectedError: errors.New("workload cleanup in stage test does not exist in the workspace"),
+		},
+		"returns an error if the deployments have a circular dependency": {
+			stages: []manifest.PipelineStage{
+				{
+					Name: "test",
+					Deployments: map[string]*manifest.PipelineDeployment{
+						"frontend": {
+							DependsOn: []string{"backend"},
+						},
+						"backend": {
+							DependsOn: []string{"frontend"},
+						},
+					},
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m updatePipelineMocks) {
+				gomock.InOrder(
+					m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return([]string{"report"}, nil).Times(1),
+					m.ws.EXPECT().LoadBalancedWebServiceNames().Return([]string{"frontend"}, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment("badgoose", "test").Return(&config.Environment{}, nil).Times(1),
+				)
+			},
+
+			expectedError: errors.New("deployments in stage test have a circular dependency: backend -> frontend -> backend"),
+		},
+		"converts stages with pre and post deployment actions": {
+			stages: []manifest.PipelineStage{
+				{
+					Name: "test",
+					PreDeployments: []manifest.PipelineAction{
+						{
+							Name:      "db-migration",
+							Buildspec: "copilot/pipelines/release/migrate.yml",
+						},
+					},
+					PostDeployments: []manifest.PipelineAction{
+						{
+							Name:        "integration-tests",
+							Buildspec:   "copilot/pipelines/release/integration.yml",
+							ComputeSize: "large",
+						},
+					},
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m updatePipelineMocks) {
+				mockEnv := &config.Environment{
+					Name:      "test",
+					App:       "badgoose",
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+				}
+				gomock.InOrder(
+					m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return([]string{"report"}, nil).Times(1),
+					m.ws.EXPECT().LoadBalancedWebServiceNames().Return([]string{"frontend"}, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment("badgoose", "test").Return(mockEnv, nil).Times(1),
+				)
+			},
+
+			expectedStages: []deploy.PipelineStage{
+				{
+					AssociatedEnvironment: &deploy.AssociatedEnvironment{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+					},
+					LocalServices:        []string{"frontend", "backend"},
+					LocalJobs:            []string{"report"},
+					LoadBalancedServices: []string{"frontend"},
+					PreDeployments: []deploy.PipelineAction{
+						{
+							Name:          "db-migration",
+							BuildspecPath: "copilot/pipelines/release/migrate.yml",
+							ComputeType:   "BUILD_GENERAL1_SMALL",
+						},
+					},
+					PostDeployments: []deploy.PipelineAction{
+						{
+							Name:          "integration-tests",
+							BuildspecPath: "copilot/pipelines/release/integration.yml",
+							ComputeType:   "BUILD_GENERAL1_LARGE",
+						},
+					},
+				},
+			},
+		},
+		"returns an error if a pre and post deployment action have the same name": {
+			stages: []manifest.PipelineStage{
+				{
+					Name: "test",
+					PreDeployments: []manifest.PipelineAction{
+						{
+							Name:      "smoke",
+							Buildspec: "copilot/pipelines/release/smoke.yml",
+						},
+					},
+					PostDeployments: []manifest.PipelineAction{
+						{
+							Name:      "smoke",
+							Buildspec: "copilot/pipelines/release/smoke.yml",
+						},
+					},
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m updatePipelineMocks) {
+				gomock.InOrder(
+					m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return([]string{"report"}, nil).Times(1),
+					m.ws.EXPECT().LoadBalancedWebServiceNames().Return([]string{"frontend"}, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment("badgoose", "test").Return(&config.Environment{}, nil).Times(1),
+				)
+			},
+
+			expectedError: errors.New("action smoke is defined more than once in stage test"),
+		},
+		"returns an error if fails to list load balanced web services": {
+			stages: []manifest.PipelineStage{
+				{
+					Name: "test",
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m updatePipelineMocks) {
+				gomock.InOrder(
+					m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return([]string{"report"}, nil).Times(1),
+					m.ws.EXPECT().LoadBalancedWebServiceNames().Return(nil, errors.New("some error")).Times(1),
+				)
+			},
+
+			expectedError: errors.New("load balanced web service names from workspace: some error"),
+		},
+		"returns an error if fails to list jobs": {
+			stages: []manifest.PipelineStage{
+				{
+					Name: "test",
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m updatePipelineMocks) {
+				gomock.InOrder(
+					m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return(nil, errors.New("some error")).Times(1),
+				)
+			},
+
+			expectedError: errors.New("job names from workspace: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -172,7 +621,7 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 
 			// THEN
 			if tc.expectedError != nil {
-				require.Equal(t, tc.expectedError, err)
+				require.EqualError(t, err, tc.expectedError.Error())
 			} else {
 				require.NoError(t, err)
 				require.ElementsMatch(t, tc.expectedStages, actualStages)
@@ -236,11 +685,12 @@ func TestUpdatePipelineOpts_getArtifactBuckets(t *testing.T) {
 
 func TestUpdatePipelineOpts_Execute(t *testing.T) {
 	const (
-		appName      = "badgoose"
-		region       = "us-west-2"
-		accountID    = "123456789012"
-		pipelineName = "pipepiper"
-		content      = `
+		appName              = "badgoose"
+		region               = "us-west-2"
+		accountID            = "123456789012"
+		pipelineName         = "pipepiper"
+		pipelineManifestPath = "/copilot/pipelines/pipepiper/manifest.yml"
+		content              = `
 name: pipepiper
 version: 1
 
@@ -304,8 +754,10 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return([]byte(content), nil),
 					m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return([]string{"report"}, nil).Times(1),
+					m.ws.EXPECT().LoadBalancedWebServiceNames().Return([]string{"frontend"}, nil).Times(1),
 
 					// convertStages
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
@@ -313,10 +765,12 @@ stages:
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().PipelineBuildspecPath(pipelineManifestPath).Return("copilot/pipelines/pipepiper/buildspec.yml", nil),
 
 					// deployPipeline
 					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(false, nil),
 					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineUpdateStart, pipelineName)).Times(1),
+					m.analyzer.EXPECT().LastOperationTime(pipelineName).Return(time.Time{}, nil),
 					m.deployer.EXPECT().CreatePipeline(gomock.Any()).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateComplete, pipelineName)).Times(1),
 				)
@@ -333,8 +787,10 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return([]byte(content), nil),
 					m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return([]string{"report"}, nil).Times(1),
+					m.ws.EXPECT().LoadBalancedWebServiceNames().Return([]string{"frontend"}, nil).Times(1),
 
 					// convertStages
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
@@ -342,11 +798,13 @@ stages:
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().PipelineBuildspecPath(pipelineManifestPath).Return("copilot/pipelines/pipepiper/buildspec.yml", nil),
 
 					// deployPipeline
 					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(true, nil),
 					m.prompt.EXPECT().Confirm(fmt.Sprintf(fmtPipelineUpdateExistPrompt, pipelineName), "").Return(true, nil),
 					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineUpdateProposalStart, pipelineName)).Times(1),
+					m.analyzer.EXPECT().LastOperationTime(pipelineName).Return(time.Time{}, nil),
 					m.deployer.EXPECT().UpdatePipeline(gomock.Any()).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateProposalComplete, pipelineName)).Times(1),
 				)
@@ -363,8 +821,10 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return([]byte(content), nil),
 					m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return([]string{"report"}, nil).Times(1),
+					m.ws.EXPECT().LoadBalancedWebServiceNames().Return([]string{"frontend"}, nil).Times(1),
 
 					// convertStages
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
@@ -372,6 +832,7 @@ stages:
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().PipelineBuildspecPath(pipelineManifestPath).Return("copilot/pipelines/pipepiper/buildspec.yml", nil),
 
 					// deployPipeline
 					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(true, nil),
@@ -380,6 +841,110 @@ stages:
 			},
 			expectedError: nil,
 		},
+		"deploys the pipeline with the build environment of the manifest": {
+			inApp:     &app,
+			inAppName: appName,
+			inRegion:  region,
+			callMocks: func(m updatePipelineMocks) {
+				content := `
+name: pipepiper
+version: 2
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    access_token_secret: "github-token-badgoose-backend"
+    branch: main
+
+stages:
+    -
+      name: chicken
+
+build:
+  compute_size: large
+  buildspec: ci/buildspec.yml
+  variables:
+    GOFLAGS: -mod=vendor
+`
+				gomock.InOrder(
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineUpdateResourcesStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
+
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return([]byte(content), nil),
+					m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return([]string{"report"}, nil).Times(1),
+					m.ws.EXPECT().LoadBalancedWebServiceNames().Return([]string{"frontend"}, nil).Times(1),
+
+					// convertStages
+					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
+
+					// getArtifactBuckets
+					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+
+					// deployPipeline
+					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(false, nil),
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineUpdateStart, pipelineName)).Times(1),
+					m.analyzer.EXPECT().LastOperationTime(pipelineName).Return(time.Time{}, nil),
+					m.deployer.EXPECT().CreatePipeline(gomock.Any()).Do(func(in *deploy.CreatePipelineInput) {
+						require.Equal(t, "ci/buildspec.yml", in.BuildspecPath)
+						require.Equal(t, &deploy.PipelineBuild{
+							Image:          "aws/codebuild/amazonlinux2-x86_64-standard:1.0",
+							ComputeType:    "BUILD_GENERAL1_LARGE",
+							PrivilegedMode: true,
+							EnvironmentVariables: map[string]string{
+								"GOFLAGS": "-mod=vendor",
+							},
+							TimeoutInMinutes: 60,
+						}, in.Build)
+					}).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateComplete, pipelineName)).Times(1),
+				)
+			},
+		},
+		"returns an error if the build environment of the manifest is invalid": {
+			inApp:     &app,
+			inAppName: appName,
+			inRegion:  region,
+			callMocks: func(m updatePipelineMocks) {
+				content := `
+name: pipepiper
+version: 2
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    access_token_secret: "github-token-badgoose-backend"
+    branch: main
+
+stages:
+    -
+      name: chicken
+
+build:
+  compute_size: huge
+`
+				gomock.InOrder(
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineUpdateResourcesStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
+
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return([]byte(content), nil),
+					m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return([]string{"report"}, nil).Times(1),
+					m.ws.EXPECT().LoadBalancedWebServiceNames().Return([]string{"frontend"}, nil).Times(1),
+
+					// convertStages
+					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
+
+					// getArtifactBuckets
+					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+				)
+			},
+			expectedError: fmt.Errorf(`convert build of pipeline pipepiper: build compute size "huge" must be one of small, medium, large or 2xlarge`),
+		},
 		"returns an error if fails to prompt for pipeline update": {
 			inApp:     &app,
 			inAppName: appName,
@@ -390,8 +955,10 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return([]byte(content), nil),
 					m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return([]string{"report"}, nil).Times(1),
+					m.ws.EXPECT().LoadBalancedWebServiceNames().Return([]string{"frontend"}, nil).Times(1),
 
 					// convertStages
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
@@ -399,6 +966,7 @@ stages:
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().PipelineBuildspecPath(pipelineManifestPath).Return("copilot/pipelines/pipepiper/buildspec.yml", nil),
 
 					// deployPipeline
 					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(true, nil),
@@ -430,7 +998,7 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), errors.New("some error")),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return([]byte(content), errors.New("some error")),
 				)
 			},
 			expectedError: fmt.Errorf("read pipeline manifest: some error"),
@@ -446,11 +1014,40 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return([]byte(content), nil),
 				)
 			},
 			expectedError: fmt.Errorf("unmarshal pipeline manifest: pipeline.yml contains invalid schema version: 0"),
 		},
+		"returns an error if the CodeCommit repository is in another region": {
+			inApp:     &app,
+			inRegion:  region,
+			inAppName: appName,
+			callMocks: func(m updatePipelineMocks) {
+				content := `
+name: pipepiper
+version: 2
+
+source:
+  provider: CodeCommit
+  properties:
+    repository: https://git-codecommit.us-east-1.amazonaws.com/v1/repos/somethingCool
+    branch: main
+
+stages:
+    -
+      name: chicken
+`
+				gomock.InOrder(
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineUpdateResourcesStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
+
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return([]byte(content), nil),
+				)
+			},
+			expectedError: fmt.Errorf("CodeCommit repository https://git-codecommit.us-east-1.amazonaws.com/v1/repos/somethingCool is in region us-east-1, but the pipeline is deployed in region us-west-2: the repository must be in the same region as the pipeline"),
+		},
 		"returns an error if unable to convert environments to deployment stage": {
 			inApp:     &app,
 			inRegion:  region,
@@ -461,7 +1058,7 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return([]byte(content), nil),
 					m.ws.EXPECT().ServiceNames().Return(nil, errors.New("some error")).Times(1),
 				)
 			},
@@ -477,8 +1074,10 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return([]byte(content), nil),
 					m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return([]string{"report"}, nil).Times(1),
+					m.ws.EXPECT().LoadBalancedWebServiceNames().Return([]string{"frontend"}, nil).Times(1),
 
 					// convertStages
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
@@ -500,8 +1099,10 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return([]byte(content), nil),
 					m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return([]string{"report"}, nil).Times(1),
+					m.ws.EXPECT().LoadBalancedWebServiceNames().Return([]string{"frontend"}, nil).Times(1),
 
 					// convertStages
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
@@ -509,6 +1110,7 @@ stages:
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().PipelineBuildspecPath(pipelineManifestPath).Return("copilot/pipelines/pipepiper/buildspec.yml", nil),
 
 					// deployPipeline
 					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(false, errors.New("some error")),
@@ -516,6 +1118,32 @@ stages:
 			},
 			expectedError: fmt.Errorf("check if pipeline exists: some error"),
 		},
+		"returns an error if fails to get the buildspec path": {
+			inApp:     &app,
+			inRegion:  region,
+			inAppName: appName,
+			callMocks: func(m updatePipelineMocks) {
+				gomock.InOrder(
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineUpdateResourcesStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
+
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return([]byte(content), nil),
+					m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return([]string{"report"}, nil).Times(1),
+					m.ws.EXPECT().LoadBalancedWebServiceNames().Return([]string{"frontend"}, nil).Times(1),
+
+					// convertStages
+					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+
+					// getArtifactBuckets
+					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().PipelineBuildspecPath(pipelineManifestPath).Return("", errors.New("some error")),
+				)
+			},
+			expectedError: fmt.Errorf("get buildspec path of pipeline pipepiper: some error"),
+		},
 		"returns an error if fails to create pipeline": {
 			inApp:     &app,
 			inRegion:  region,
@@ -526,8 +1154,10 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return([]byte(content), nil),
 					m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return([]string{"report"}, nil).Times(1),
+					m.ws.EXPECT().LoadBalancedWebServiceNames().Return([]string{"frontend"}, nil).Times(1),
 
 					// convertStages
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
@@ -535,12 +1165,15 @@ stages:
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().PipelineBuildspecPath(pipelineManifestPath).Return("copilot/pipelines/pipepiper/buildspec.yml", nil),
 
 					// deployPipeline
 					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(false, nil),
 					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineUpdateStart, pipelineName)).Times(1),
+					m.analyzer.EXPECT().LastOperationTime(pipelineName).Return(time.Time{}, nil),
 					m.deployer.EXPECT().CreatePipeline(gomock.Any()).Return(errors.New("some error")),
 					m.prog.EXPECT().Stop(log.Serrorf(fmtPipelineUpdateFailed, pipelineName)).Times(1),
+					m.analyzer.EXPECT().Analyze(pipelineName, time.Time{}).Return(nil, nil),
 				)
 			},
 			expectedError: fmt.Errorf("create pipeline: some error"),
@@ -555,8 +1188,10 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return([]byte(content), nil),
 					m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.ws.EXPECT().JobNames().Return([]string{"report"}, nil).Times(1),
+					m.ws.EXPECT().LoadBalancedWebServiceNames().Return([]string{"frontend"}, nil).Times(1),
 
 					// convertStages
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
@@ -564,13 +1199,16 @@ stages:
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.ws.EXPECT().PipelineBuildspecPath(pipelineManifestPath).Return("copilot/pipelines/pipepiper/buildspec.yml", nil),
 
 					// deployPipeline
 					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(true, nil),
 					m.prompt.EXPECT().Confirm(fmt.Sprintf(fmtPipelineUpdateExistPrompt, pipelineName), "").Return(true, nil),
 					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineUpdateProposalStart, pipelineName)).Times(1),
+					m.analyzer.EXPECT().LastOperationTime(pipelineName).Return(time.Date(2020, 12, 3, 10, 15, 30, 0, time.UTC), nil),
 					m.deployer.EXPECT().UpdatePipeline(gomock.Any()).Return(errors.New("some error")),
 					m.prog.EXPECT().Stop(log.Serrorf(fmtPipelineUpdateProposalFailed, pipelineName)).Times(1),
+					m.analyzer.EXPECT().Analyze(pipelineName, time.Date(2020, 12, 3, 10, 15, 30, 0, time.UTC)).Return(nil, nil),
 				)
 			},
 
@@ -584,6 +1222,7 @@ stages:
 			defer ctrl.Finish()
 
 			mockPipelineDeployer := mocks.NewMockpipelineDeployer(ctrl)
+			mockAnalyzer := mocks.NewMockstackFailureAnalyzer(ctrl)
 			mockEnvStore := mocks.NewMockenvironmentStore(ctrl)
 			mockWorkspace := mocks.NewMockwsPipelineReader(ctrl)
 			mockProgress := mocks.NewMockprogress(ctrl)
@@ -594,6 +1233,7 @@ stages:
 				prompt:   mockPrompt,
 				prog:     mockProgress,
 				deployer: mockPipelineDeployer,
+				analyzer: mockAnalyzer,
 				ws:       mockWorkspace,
 			}
 
@@ -605,12 +1245,17 @@ stages:
 					appName:      tc.inAppName,
 				},
 				pipelineDeployer: mockPipelineDeployer,
+				analyzer:         mockAnalyzer,
 				ws:               mockWorkspace,
-				app:              tc.inApp,
-				region:           tc.inRegion,
-				envStore:         mockEnvStore,
-				prog:             mockProgress,
-				prompt:           mockPrompt,
+				pipelineMft: &workspace.PipelineManifest{
+					Name: pipelineName,
+					Path: pipelineManifestPath,
+				},
+				app:      tc.inApp,
+				region:   tc.inRegion,
+				envStore: mockEnvStore,
+				prog:     mockProgress,
+				prompt:   mockPrompt,
 			}
 
 			// WHEN
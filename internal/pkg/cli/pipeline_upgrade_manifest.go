@@ -0,0 +1,142 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+type upgradePipelineManifestVars struct {
+	pipelineName string
+}
+
+type upgradePipelineManifestOpts struct {
+	upgradePipelineManifestVars
+
+	ws     wsPipelineUpgrader
+	prompt prompter
+
+	// Cached variables.
+	pipelineMft *workspace.PipelineManifest
+	upgraded    bool
+}
+
+func newUpgradePipelineManifestOpts(vars upgradePipelineManifestVars) (*upgradePipelineManifestOpts, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace client: %w", err)
+	}
+	return &upgradePipelineManifestOpts{
+		upgradePipelineManifestVars: vars,
+		ws:                          ws,
+		prompt:                      prompt.New(),
+	}, nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (o *upgradePipelineManifestOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for the pipeline to upgrade if the workspace has several pipelines and --name is not provided.
+func (o *upgradePipelineManifestOpts) Ask() error {
+	pipelineMft, err := selectWorkspacePipeline(o.ws, o.prompt, o.pipelineName)
+	if err != nil {
+		return err
+	}
+	o.pipelineMft = pipelineMft
+	return nil
+}
+
+// Execute rewrites the pipeline manifest with the latest schema version.
+func (o *upgradePipelineManifestOpts) Execute() error {
+	data, err := o.ws.ReadPipelineManifest(o.pipelineMft.Path)
+	if err != nil {
+		return fmt.Errorf("read pipeline manifest: %w", err)
+	}
+	svcNames, err := o.ws.ServiceNames()
+	if err != nil {
+		return fmt.Errorf("service names from workspace: %w", err)
+	}
+	jobNames, err := o.ws.JobNames()
+	if err != nil {
+		return fmt.Errorf("job names from workspace: %w", err)
+	}
+	upgraded, err := manifest.UpgradePipelineManifest(data, svcNames, jobNames)
+	if err != nil {
+		if errors.Is(err, manifest.ErrPipelineManifestUpToDate) {
+			log.Infof("The manifest of pipeline %s already uses the latest schema version.\n", color.HighlightUserInput(o.pipelineMft.Name))
+			return nil
+		}
+		return fmt.Errorf("upgrade manifest of pipeline %s: %w", o.pipelineMft.Name, err)
+	}
+	if err := o.ws.OverwritePipelineManifest(o.pipelineMft.Path, upgraded); err != nil {
+		return fmt.Errorf("write manifest of pipeline %s: %w", o.pipelineMft.Name, err)
+	}
+	o.upgraded = true
+	log.Successf("Upgraded the manifest of pipeline %s to version %d at %s.\n",
+		color.HighlightUserInput(o.pipelineMft.Name), manifest.Ver2, color.HighlightResource(o.pipelineMft.Path))
+	return nil
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *upgradePipelineManifestOpts) RecommendedActions() []string {
+	if !o.upgraded {
+		return nil
+	}
+	return []string{
+		fmt.Sprintf("Review the changes to %s, stages now select their workloads with %s.",
+			color.HighlightResource(o.pipelineMft.Path), color.HighlightCode("deployments")),
+		fmt.Sprintf("Run %s to deploy the pipeline.", color.HighlightCode(fmt.Sprintf("copilot pipeline update -n %s", o.pipelineMft.Name))),
+	}
+}
+
+// buildPipelineUpgradeManifestCmd builds the command for upgrading the schema version of a pipeline manifest.
+func buildPipelineUpgradeManifestCmd() *cobra.Command {
+	vars := upgradePipelineManifestVars{}
+	cmd := &cobra.Command{
+		Use:   "upgrade-manifest",
+		Short: "Upgrades a pipeline manifest to the latest schema version.",
+		Long: `Upgrades a pipeline manifest to the latest schema version.
+The comments of the manifest are preserved.`,
+		Example: `
+  Upgrades the manifest of the pipeline in your workspace.
+  /code $ copilot pipeline upgrade-manifest
+
+  Upgrades the manifest of the pipeline named "release" in a workspace with several pipelines.
+  /code $ copilot pipeline upgrade-manifest --name release`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newUpgradePipelineManifestOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			if actions := opts.RecommendedActions(); len(actions) > 0 {
+				log.Infoln("Recommended follow-up actions:")
+				for _, followup := range actions {
+					log.Infof("- %s\n", followup)
+				}
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.pipelineName, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	return cmd
+}
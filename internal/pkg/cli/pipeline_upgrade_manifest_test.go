@@ -0,0 +1,122 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradePipelineManifestOpts_Execute(t *testing.T) {
+	const (
+		mockPath   = "/copilot/pipelines/release/manifest.yml"
+		v1Manifest = `name: release
+version: 1
+stages:
+  - name: test
+    # Only deploy the frontend.
+    services: [frontend]
+`
+	)
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockwsPipelineUpgrader)
+
+		wantedUpgraded bool
+		wantedErr      error
+	}{
+		"overwrites the manifest with the upgraded schema": {
+			setupMocks: func(m *mocks.MockwsPipelineUpgrader) {
+				m.EXPECT().ReadPipelineManifest(mockPath).Return([]byte(v1Manifest), nil)
+				m.EXPECT().ServiceNames().Return([]string{"frontend", "api"}, nil)
+				m.EXPECT().JobNames().Return([]string{"report"}, nil)
+				m.EXPECT().OverwritePipelineManifest(mockPath, gomock.Any()).DoAndReturn(func(_ string, data []byte) error {
+					require.True(t, strings.Contains(string(data), `version: 2`))
+					require.True(t, strings.Contains(string(data), `    # Only deploy the frontend.
+    deployments:
+      frontend:
+      report:
+`))
+					return nil
+				})
+			},
+			wantedUpgraded: true,
+		},
+		"does not overwrite a manifest that is up to date": {
+			setupMocks: func(m *mocks.MockwsPipelineUpgrader) {
+				m.EXPECT().ReadPipelineManifest(mockPath).Return([]byte("name: release\nversion: 2\n"), nil)
+				m.EXPECT().ServiceNames().Return(nil, nil)
+				m.EXPECT().JobNames().Return(nil, nil)
+			},
+		},
+		"errors if fail to read the manifest": {
+			setupMocks: func(m *mocks.MockwsPipelineUpgrader) {
+				m.EXPECT().ReadPipelineManifest(mockPath).Return(nil, mockError)
+			},
+			wantedErr: fmt.Errorf("read pipeline manifest: %w", mockError),
+		},
+		"errors if fail to list the jobs": {
+			setupMocks: func(m *mocks.MockwsPipelineUpgrader) {
+				m.EXPECT().ReadPipelineManifest(mockPath).Return([]byte(v1Manifest), nil)
+				m.EXPECT().ServiceNames().Return([]string{"frontend"}, nil)
+				m.EXPECT().JobNames().Return(nil, mockError)
+			},
+			wantedErr: fmt.Errorf("job names from workspace: %w", mockError),
+		},
+		"errors if the manifest is invalid": {
+			setupMocks: func(m *mocks.MockwsPipelineUpgrader) {
+				m.EXPECT().ReadPipelineManifest(mockPath).Return([]byte("name: release\nversion: 3\n"), nil)
+				m.EXPECT().ServiceNames().Return(nil, nil)
+				m.EXPECT().JobNames().Return(nil, nil)
+			},
+			wantedErr: errors.New("upgrade manifest of pipeline release: pipeline.yml contains invalid schema version: 3"),
+		},
+		"errors if fail to write the manifest": {
+			setupMocks: func(m *mocks.MockwsPipelineUpgrader) {
+				m.EXPECT().ReadPipelineManifest(mockPath).Return([]byte(v1Manifest), nil)
+				m.EXPECT().ServiceNames().Return([]string{"frontend"}, nil)
+				m.EXPECT().JobNames().Return(nil, nil)
+				m.EXPECT().OverwritePipelineManifest(mockPath, gomock.Any()).Return(mockError)
+			},
+			wantedErr: fmt.Errorf("write manifest of pipeline release: %w", mockError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ws := mocks.NewMockwsPipelineUpgrader(ctrl)
+			tc.setupMocks(ws)
+
+			opts := &upgradePipelineManifestOpts{
+				ws: ws,
+				pipelineMft: &workspace.PipelineManifest{
+					Name: "release",
+					Path: mockPath,
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedUpgraded, opts.upgraded)
+				require.Equal(t, tc.wantedUpgraded, len(opts.RecommendedActions()) > 0)
+			}
+		})
+	}
+}
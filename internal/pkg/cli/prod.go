@@ -0,0 +1,161 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+const (
+	fmtProdConfirmPrompt = "%s is a production environment. Type its name to confirm that you want to %s:"
+	prodConfirmHelp      = "Changes to production environments must be confirmed. Use --force to skip the confirmation."
+
+	defaultProdDeployBranch = "main"
+)
+
+var errProdConfirmationMismatch = errors.New("the name typed does not match the environment - no changes made")
+
+// prodGuard enforces the guardrails of environments marked as production.
+// The guardrails have no effect on other environments.
+type prodGuard struct {
+	force          bool // Skip the guardrails, the bypass is logged.
+	allowDirty     bool // Skip the check for uncommitted changes in the git working tree.
+	allowAnyBranch bool // Skip the check of the checked out git branch.
+	prompt         prompter
+	runner         runner
+}
+
+// confirm asks the user to type the name of a production environment before running the action against it.
+func (g prodGuard) confirm(env *config.Environment, action string) error {
+	if !env.Prod {
+		return nil
+	}
+	if g.force {
+		log.Warningf("Skipping the guardrails of production environment %s to %s.\n", color.HighlightUserInput(env.Name), action)
+		return nil
+	}
+	return g.confirmName(env, action)
+}
+
+// confirmDeploy returns an error if the git working tree can't be deployed to a production environment,
+// and then asks the user to type the name of the environment.
+func (g prodGuard) confirmDeploy(env *config.Environment, action string) error {
+	if !env.Prod {
+		return nil
+	}
+	if g.force {
+		log.Warningf("Skipping the guardrails of production environment %s to %s.\n", color.HighlightUserInput(env.Name), action)
+		return nil
+	}
+	if err := g.validateWorkingTree(env); err != nil {
+		return err
+	}
+	return g.confirmName(env, action)
+}
+
+func (g prodGuard) confirmName(env *config.Environment, action string) error {
+	name, err := g.prompt.Get(fmt.Sprintf(fmtProdConfirmPrompt, color.HighlightUserInput(env.Name), action), prodConfirmHelp, nil)
+	if err != nil {
+		return fmt.Errorf("confirm production environment %s: %w", env.Name, err)
+	}
+	if strings.TrimSpace(name) != env.Name {
+		return errProdConfirmationMismatch
+	}
+	return nil
+}
+
+// validateWorkingTree returns an error if the git working tree has uncommitted changes
+// or if the checked out branch is not the one production environments are deployed from.
+// Each check can be skipped on its own, the skipped checks are logged.
+func (g prodGuard) validateWorkingTree(env *config.Environment) error {
+	if g.allowDirty {
+		log.Warningf("Skipping the check for uncommitted changes before deploying to production environment %s.\n", color.HighlightUserInput(env.Name))
+	} else {
+		status, err := g.git("status", "--porcelain")
+		if err != nil {
+			return fmt.Errorf("get status of the git working tree: %w; run the command from a git repository or pass --%s and --%s to skip the git checks", err, allowDirtyFlag, allowAnyBranchFlag)
+		}
+		if status != "" {
+			return fmt.Errorf("cannot deploy to production environment %s with uncommitted changes in the git working tree: commit them or pass --%s", env.Name, allowDirtyFlag)
+		}
+	}
+	if g.allowAnyBranch {
+		log.Warningf("Skipping the check of the git branch before deploying to production environment %s.\n", color.HighlightUserInput(env.Name))
+		return nil
+	}
+	wanted := g.deployBranch(env)
+	branch, err := g.git("rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return fmt.Errorf("get the checked out git branch: %w; run the command from a git repository or pass --%s to skip the branch check", err, allowAnyBranchFlag)
+	}
+	if branch == "HEAD" {
+		return fmt.Errorf("cannot deploy to production environment %s from a detached HEAD: check out branch %s or pass --%s", env.Name, wanted, allowAnyBranchFlag)
+	}
+	if branch != wanted {
+		return fmt.Errorf("cannot deploy to production environment %s from branch %s: check out branch %s or pass --%s", env.Name, branch, wanted, allowAnyBranchFlag)
+	}
+	return nil
+}
+
+// deployBranch returns the branch production environments are deployed from: the branch configured for the environment,
+// or else the default branch of the origin remote, or else "main".
+func (g prodGuard) deployBranch(env *config.Environment) string {
+	if env.DeployBranch != "" {
+		return env.DeployBranch
+	}
+	// The remote HEAD is unset in repositories where the remote was added after cloning, and in shallow clones.
+	ref, err := g.git("symbolic-ref", "--short", "refs/remotes/origin/HEAD")
+	if err != nil || ref == "" {
+		return defaultProdDeployBranch
+	}
+	return strings.TrimPrefix(ref, "origin/")
+}
+
+func (g prodGuard) git(args ...string) (string, error) {
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if err := g.runner.Run("git", args, command.Stdout(&stdout), command.Stderr(&stderr)); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%w: %s", err, msg)
+		}
+		return "", err
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// protectWorkload enables termination protection on the stack of a workload deployed to a production environment.
+func protectWorkload(protector wlTerminationProtector, appName string, env *config.Environment, name string) error {
+	if !env.Prod {
+		return nil
+	}
+	if err := protector.SetWorkloadTerminationProtection(appName, env.Name, name, true); err != nil {
+		return fmt.Errorf("enable termination protection on %s in environment %s: %w", name, env.Name, err)
+	}
+	return nil
+}
+
+// unprotectWorkload disables termination protection on the stack of a workload in a production environment so that it can be deleted.
+// It's a no-op if the workload is not deployed to the environment.
+func unprotectWorkload(protector wlTerminationProtector, appName string, env *config.Environment, name string) error {
+	if !env.Prod {
+		return nil
+	}
+	if err := protector.SetWorkloadTerminationProtection(appName, env.Name, name, false); err != nil {
+		var errStackNotFound *awscloudformation.ErrStackNotFound
+		if errors.As(err, &errStackNotFound) {
+			return nil
+		}
+		return fmt.Errorf("disable termination protection on %s in environment %s: %w", name, env.Name, err)
+	}
+	return nil
+}
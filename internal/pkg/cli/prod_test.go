@@ -0,0 +1,282 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"testing"
+
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockGit returns a runner that writes the output of each git command to stdout.
+// Commands without an output fail as if they were run outside of a git repository.
+func mockGit(ctrl *gomock.Controller, outputs map[string]string) runner {
+	m := mocks.NewMockrunner(ctrl)
+	m.EXPECT().Run("git", gomock.Any(), gomock.Any()).DoAndReturn(func(_ string, args []string, opts ...command.Option) error {
+		cmd := &exec.Cmd{}
+		for _, opt := range opts {
+			opt(cmd)
+		}
+		out, ok := outputs[strings.Join(args, " ")]
+		if !ok {
+			if _, err := cmd.Stderr.Write([]byte("fatal: not a git repository (or any of the parent directories): .git\n")); err != nil {
+				return err
+			}
+			return errors.New("exit status 128")
+		}
+		_, err := cmd.Stdout.Write([]byte(out + "\n"))
+		return err
+	}).AnyTimes()
+	return m
+}
+
+func TestProdGuard_confirm(t *testing.T) {
+	testCases := map[string]struct {
+		inEnv      *config.Environment
+		inForce    bool
+		mockPrompt func(m *mocks.Mockprompter)
+
+		wantedErr error
+	}{
+		"does nothing if the environment is not a production environment": {
+			inEnv: &config.Environment{Name: "test"},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"skips the confirmation if forced": {
+			inEnv:   &config.Environment{Name: "prod", Prod: true},
+			inForce: true,
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"wraps prompt errors": {
+			inEnv: &config.Environment{Name: "prod", Prod: true},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Any(), prodConfirmHelp, nil).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("confirm production environment prod: some error"),
+		},
+		"returns an error if the name typed does not match": {
+			inEnv: &config.Environment{Name: "prod", Prod: true},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Any(), prodConfirmHelp, nil).Return("test", nil)
+			},
+			wantedErr: errProdConfirmationMismatch,
+		},
+		"succeeds if the name of the environment is typed": {
+			inEnv: &config.Environment{Name: "prod", Prod: true},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Any(), prodConfirmHelp, nil).Return(" prod ", nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			tc.mockPrompt(mockPrompt)
+			guard := prodGuard{
+				force:  tc.inForce,
+				prompt: mockPrompt,
+			}
+
+			// WHEN
+			err := guard.confirm(tc.inEnv, "delete it")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProdGuard_confirmDeploy(t *testing.T) {
+	testCases := map[string]struct {
+		inEnv            *config.Environment
+		inForce          bool
+		inAllowDirty     bool
+		inAllowAnyBranch bool
+		inGit            map[string]string
+		inPrompts        int
+
+		wantedErr error
+	}{
+		"does nothing if the environment is not a production environment": {
+			inEnv: &config.Environment{Name: "test"},
+		},
+		"skips the checks if forced": {
+			inEnv:   &config.Environment{Name: "prod", Prod: true},
+			inForce: true,
+		},
+		"wraps git errors": {
+			inEnv:     &config.Environment{Name: "prod", Prod: true},
+			inGit:     map[string]string{},
+			wantedErr: errors.New("get status of the git working tree: exit status 128: fatal: not a git repository (or any of the parent directories): .git; run the command from a git repository or pass --allow-dirty and --allow-any-branch to skip the git checks"),
+		},
+		"wraps git errors when getting the checked out branch": {
+			inEnv:        &config.Environment{Name: "prod", Prod: true},
+			inAllowDirty: true,
+			inGit:        map[string]string{},
+			wantedErr:    errors.New("get the checked out git branch: exit status 128: fatal: not a git repository (or any of the parent directories): .git; run the command from a git repository or pass --allow-any-branch to skip the branch check"),
+		},
+		"returns an error if the working tree has uncommitted changes": {
+			inEnv: &config.Environment{Name: "prod", Prod: true},
+			inGit: map[string]string{
+				"status --porcelain": " M copilot/api/manifest.yml",
+			},
+			wantedErr: errors.New("cannot deploy to production environment prod with uncommitted changes in the git working tree: commit them or pass --allow-dirty"),
+		},
+		"returns an error if the default branch is not checked out": {
+			inEnv: &config.Environment{Name: "prod", Prod: true},
+			inGit: map[string]string{
+				"status --porcelain":                            "",
+				"symbolic-ref --short refs/remotes/origin/HEAD": "origin/main",
+				"rev-parse --abbrev-ref HEAD":                   "feature",
+			},
+			wantedErr: errors.New("cannot deploy to production environment prod from branch feature: check out branch main or pass --allow-any-branch"),
+		},
+		"falls back to main if the default branch of the remote is unknown": {
+			inEnv: &config.Environment{Name: "prod", Prod: true},
+			inGit: map[string]string{
+				"status --porcelain":          "",
+				"rev-parse --abbrev-ref HEAD": "master",
+			},
+			wantedErr: errors.New("cannot deploy to production environment prod from branch master: check out branch main or pass --allow-any-branch"),
+		},
+		"returns an error if the configured branch is not checked out": {
+			inEnv: &config.Environment{Name: "prod", Prod: true, DeployBranch: "release"},
+			inGit: map[string]string{
+				"status --porcelain":          "",
+				"rev-parse --abbrev-ref HEAD": "main",
+			},
+			wantedErr: errors.New("cannot deploy to production environment prod from branch main: check out branch release or pass --allow-any-branch"),
+		},
+		"returns an error if the HEAD is detached": {
+			inEnv: &config.Environment{Name: "prod", Prod: true, DeployBranch: "release"},
+			inGit: map[string]string{
+				"status --porcelain":          "",
+				"rev-parse --abbrev-ref HEAD": "HEAD",
+			},
+			wantedErr: errors.New("cannot deploy to production environment prod from a detached HEAD: check out branch release or pass --allow-any-branch"),
+		},
+		"skips the check for uncommitted changes if allowed": {
+			inEnv:        &config.Environment{Name: "prod", Prod: true, DeployBranch: "release"},
+			inAllowDirty: true,
+			inGit: map[string]string{
+				"rev-parse --abbrev-ref HEAD": "release",
+			},
+			inPrompts: 1,
+		},
+		"skips the git checks outside of a git repository if allowed": {
+			inEnv:            &config.Environment{Name: "prod", Prod: true},
+			inAllowDirty:     true,
+			inAllowAnyBranch: true,
+			inGit:            map[string]string{},
+			inPrompts:        1,
+		},
+		"asks for the name of the environment if the working tree can be deployed": {
+			inEnv: &config.Environment{Name: "prod", Prod: true},
+			inGit: map[string]string{
+				"status --porcelain":                            "",
+				"symbolic-ref --short refs/remotes/origin/HEAD": "origin/main",
+				"rev-parse --abbrev-ref HEAD":                   "main",
+			},
+			inPrompts: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			mockPrompt.EXPECT().Get(gomock.Any(), prodConfirmHelp, nil).Return("prod", nil).Times(tc.inPrompts)
+			guard := prodGuard{
+				force:          tc.inForce,
+				allowDirty:     tc.inAllowDirty,
+				allowAnyBranch: tc.inAllowAnyBranch,
+				prompt:         mockPrompt,
+				runner:         mockGit(ctrl, tc.inGit),
+			}
+
+			// WHEN
+			err := guard.confirmDeploy(tc.inEnv, "deploy api to it")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUnprotectWorkload(t *testing.T) {
+	testCases := map[string]struct {
+		inEnv         *config.Environment
+		mockProtector func(m *mocks.MockwlTerminationProtector)
+
+		wantedErr error
+	}{
+		"does nothing if the environment is not a production environment": {
+			inEnv: &config.Environment{Name: "test"},
+			mockProtector: func(m *mocks.MockwlTerminationProtector) {
+				m.EXPECT().SetWorkloadTerminationProtection(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"ignores workloads that are not deployed": {
+			inEnv: &config.Environment{Name: "prod", Prod: true},
+			mockProtector: func(m *mocks.MockwlTerminationProtector) {
+				m.EXPECT().SetWorkloadTerminationProtection("phonetool", "prod", "api", false).
+					Return(fmt.Errorf("wrapped: %w", &awscloudformation.ErrStackNotFound{}))
+			},
+		},
+		"wraps other errors": {
+			inEnv: &config.Environment{Name: "prod", Prod: true},
+			mockProtector: func(m *mocks.MockwlTerminationProtector) {
+				m.EXPECT().SetWorkloadTerminationProtection("phonetool", "prod", "api", false).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("disable termination protection on api in environment prod: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockwlTerminationProtector(ctrl)
+			tc.mockProtector(m)
+
+			// WHEN
+			err := unprotectWorkload(m, "phonetool", tc.inEnv, "api")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
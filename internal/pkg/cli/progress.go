@@ -3,7 +3,18 @@
 
 package cli
 
-import termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+)
 
 // progress is the interface to inform the user that a long operation is taking place.
 type progress interface {
@@ -35,3 +46,188 @@ const (
 	textECSCluster      termprogress.Text = "- ECS Cluster to hold your services "
 	textALB             termprogress.Text = "- Application load balancer to distribute traffic "
 )
+
+const (
+	ecsServiceStackResourceType = "AWS::ECS::Service"
+	ecsPrimaryDeploymentStatus  = "PRIMARY" // The status of the most recent deployment of an ECS service.
+
+	// maxStoppedTasksDuringRollout is the number of tasks of the new deployment that can stop before we give up on it.
+	maxStoppedTasksDuringRollout = 3
+	// The reason of tasks stopped by the scheduler to replace them, not because they failed.
+	scaledInTaskReasonPrefix = "Scaling activity initiated by"
+
+	// fmtRolloutFailedRow is displayed once the rollout of a service failed while CloudFormation is still updating its stack.
+	fmtRolloutFailedRow = "  %v. Waiting for CloudFormation to roll back, run `copilot svc cancel-deploy` to roll back now.\t"
+)
+
+// humanizeWorkloadEvents returns a row for every resource of a workload stack, ordered by their first event.
+func humanizeWorkloadEvents(stackName string, events []deploy.ResourceEvent) []termprogress.TabRow {
+	var texts []termprogress.Text
+	matchers := make(map[termprogress.Text]termprogress.ResourceMatcher)
+	counts := make(map[termprogress.Text]int)
+	for _, event := range events {
+		logicalName := event.LogicalName
+		if logicalName == stackName {
+			// The events of the stack itself are already reported by the spinner.
+			continue
+		}
+		text := termprogress.Text(fmt.Sprintf("- %s (%s)", logicalName, event.Type))
+		if _, ok := matchers[text]; ok {
+			continue
+		}
+		texts = append(texts, text)
+		matchers[text] = func(r deploy.Resource) bool {
+			return r.LogicalName == logicalName
+		}
+		counts[text] = 1
+	}
+	return termprogress.HumanizeResourceEvents(texts, events, matchers, counts)
+}
+
+// serviceRollout follows the rollout of the ECS service of a workload stack while the stack deploys it.
+type serviceRollout struct {
+	ecs   ecsServiceRolloutDescriber
+	since time.Time // Tasks stopped before the deployment started are ignored.
+}
+
+// rows returns the running, pending and desired counts of each deployment of the ECS service being updated,
+// and the reasons why its tasks stopped since the deployment started.
+// It returns an error if the rollout failed, or if too many tasks of the new deployment stopped.
+// Errors from describing the service are ignored so that the progress is not interrupted.
+func (r *serviceRollout) rows(events []deploy.ResourceEvent) ([]termprogress.TabRow, error) {
+	serviceArn, ok := updatingService(events)
+	if !ok {
+		return nil, nil
+	}
+	clusterName, err := serviceArn.ClusterName()
+	if err != nil {
+		return nil, nil
+	}
+	serviceName, err := serviceArn.ServiceName()
+	if err != nil {
+		return nil, nil
+	}
+	service, err := r.ecs.Service(clusterName, serviceName)
+	if err != nil {
+		return nil, nil
+	}
+
+	var rows []termprogress.TabRow
+	var primary *awsecs.Deployment
+	for _, deployment := range service.Deployments {
+		status := aws.StringValue(deployment.Status)
+		if status == ecsPrimaryDeploymentStatus {
+			primary = deployment
+		}
+		rows = append(rows, termprogress.TabRow(fmt.Sprintf("  - %s deployment of task definition revision %s\t%d/%d running, %d pending",
+			strings.Title(strings.ToLower(status)), taskDefinitionRevision(aws.StringValue(deployment.TaskDefinition)),
+			aws.Int64Value(deployment.RunningCount), aws.Int64Value(deployment.DesiredCount), aws.Int64Value(deployment.PendingCount))))
+	}
+	if primary != nil && aws.StringValue(primary.RolloutState) == awsecs.DeploymentRolloutStateFailed {
+		return rows, fmt.Errorf("rollout of service %s failed: %s", serviceName, aws.StringValue(primary.RolloutStateReason))
+	}
+
+	tasks, err := r.ecs.StoppedServiceTasks(clusterName, serviceName)
+	if err != nil {
+		return rows, nil
+	}
+	var failed int
+	var lastReason string
+	for _, task := range tasks {
+		if task.StoppedAt == nil || task.StoppedAt.Before(r.since) {
+			continue
+		}
+		reason := aws.StringValue(task.StoppedReason)
+		taskID, err := ecs.TaskID(aws.StringValue(task.TaskArn))
+		if err != nil {
+			taskID = aws.StringValue(task.TaskArn)
+		}
+		rows = append(rows, termprogress.TabRow(fmt.Sprintf("    Stopped task %s: %s\t", taskID, reason)))
+		if strings.HasPrefix(reason, scaledInTaskReasonPrefix) {
+			continue
+		}
+		if primary != nil && aws.StringValue(task.TaskDefinitionArn) == aws.StringValue(primary.TaskDefinition) {
+			failed++
+			lastReason = reason
+		}
+	}
+	if failed >= maxStoppedTasksDuringRollout {
+		return rows, fmt.Errorf("%d tasks of the new deployment of service %s stopped, the last one because: %s", failed, serviceName, lastReason)
+	}
+	return rows, nil
+}
+
+// updatingService returns the ARN of the ECS service in the events if the service is being created or updated.
+func updatingService(events []deploy.ResourceEvent) (ecs.ServiceArn, bool) {
+	var arn string
+	var status string
+	for _, event := range events {
+		if event.Type != ecsServiceStackResourceType || !strings.HasPrefix(event.PhysicalID, "arn:") {
+			continue
+		}
+		arn, status = event.PhysicalID, event.Status
+	}
+	if arn == "" || !strings.HasSuffix(status, "_IN_PROGRESS") {
+		return "", false
+	}
+	return ecs.ServiceArn(arn), true
+}
+
+// taskDefinitionRevision returns the revision of a task definition ARN, for example "5" for "arn:aws:ecs:us-west-2:1234567890:task-definition/my-svc:5".
+func taskDefinitionRevision(arn string) string {
+	return arn[strings.LastIndex(arn, ":")+1:]
+}
+
+// followWorkloadDeployment displays the progress of a workload stack deployment until it's done.
+// If rollout is not nil, the progress of the ECS service is displayed as well. If the rollout fails,
+// the failure is displayed right away but the function still waits for CloudFormation to finish the stack operation,
+// so that the caller holds on to the deploy lock of the workload until the stack can be deployed again.
+func followWorkloadDeployment(prog progress, stackName string, events <-chan []deploy.ResourceEvent, errs <-chan error, rollout *serviceRollout) error {
+	var rolloutErr error
+	for batch := range events {
+		rows := humanizeWorkloadEvents(stackName, batch)
+		if rollout != nil {
+			rolloutRows, err := rollout.rows(batch)
+			rows = append(rows, rolloutRows...)
+			if rolloutErr == nil {
+				rolloutErr = err
+			}
+		}
+		if rolloutErr != nil {
+			rows = append(rows, termprogress.TabRow(fmt.Sprintf(fmtRolloutFailedRow, rolloutErr)))
+		}
+		prog.Events(rows)
+	}
+	err := <-errs
+	if err != nil && rolloutErr != nil {
+		return fmt.Errorf("%w; CloudFormation rolled back stack %s", rolloutErr, stackName)
+	}
+	return err
+}
+
+// lastStackOperationTime returns when the most recent operation on the stack started according to CloudFormation.
+// Record it before deploying the stack so that logStackFailure only analyzes the new operation.
+// It returns the zero time if it can't be retrieved, in which case the most recent operation is analyzed.
+func lastStackOperationTime(analyzer stackFailureAnalyzer, stackName string) time.Time {
+	since, err := analyzer.LastOperationTime(stackName)
+	if err != nil {
+		return time.Time{}
+	}
+	return since
+}
+
+// logStackFailure logs the root cause of the failed deployment of a stack, and the actions to fix it.
+// Only the operations that started after since are analyzed. Finding the root cause is a best effort,
+// nothing is logged if it can't be found.
+func logStackFailure(analyzer stackFailureAnalyzer, stackName string, since time.Time) {
+	failure, err := analyzer.Analyze(stackName, since)
+	if err != nil || failure == nil {
+		return
+	}
+	log.Infof("Root cause: %s", failure.HumanString())
+	log.Infoln()
+	log.Infoln("Recommended follow-up actions:")
+	for _, action := range failure.RecommendedActions() {
+		log.Infof("- %s\n", action)
+	}
+}
@@ -0,0 +1,280 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockServiceArn = "arn:aws:ecs:us-west-2:1111:service/my-app-test-Cluster/my-app-test-my-svc"
+	mockTaskDefV1  = "arn:aws:ecs:us-west-2:1111:task-definition/my-app-test-my-svc:1"
+	mockTaskDefV2  = "arn:aws:ecs:us-west-2:1111:task-definition/my-app-test-my-svc:2"
+)
+
+func TestHumanizeWorkloadEvents(t *testing.T) {
+	// GIVEN
+	events := []deploy.ResourceEvent{
+		{
+			Resource: deploy.Resource{LogicalName: "my-app-test-my-svc", Type: "AWS::CloudFormation::Stack"},
+			Status:   "UPDATE_IN_PROGRESS",
+		},
+		{
+			Resource: deploy.Resource{LogicalName: "TaskDefinition", Type: "AWS::ECS::TaskDefinition"},
+			Status:   "UPDATE_IN_PROGRESS",
+		},
+		{
+			Resource: deploy.Resource{LogicalName: "TaskDefinition", Type: "AWS::ECS::TaskDefinition"},
+			Status:   "UPDATE_COMPLETE",
+		},
+		{
+			Resource:     deploy.Resource{LogicalName: "Service", Type: "AWS::ECS::Service"},
+			Status:       "UPDATE_FAILED",
+			StatusReason: "Service did not stabilize",
+		},
+	}
+
+	// WHEN
+	rows := humanizeWorkloadEvents("my-app-test-my-svc", events)
+
+	// THEN
+	require.Equal(t, []termprogress.TabRow{
+		"- TaskDefinition (AWS::ECS::TaskDefinition)\t[Complete]",
+		"- Service (AWS::ECS::Service)\t[Failed]",
+		"  Service did not stabilize\t",
+	}, rows)
+}
+
+func TestServiceRollout_rows(t *testing.T) {
+	since := time.Date(2020, 12, 3, 10, 0, 0, 0, time.UTC)
+	updatingEvents := []deploy.ResourceEvent{
+		{
+			Resource: deploy.Resource{LogicalName: "Service", PhysicalID: mockServiceArn, Type: "AWS::ECS::Service"},
+			Status:   "UPDATE_IN_PROGRESS",
+		},
+	}
+	deployments := []*awsecs.Deployment{
+		{
+			Status:         aws.String("PRIMARY"),
+			TaskDefinition: aws.String(mockTaskDefV2),
+			DesiredCount:   aws.Int64(2),
+			RunningCount:   aws.Int64(1),
+			PendingCount:   aws.Int64(1),
+			RolloutState:   aws.String(awsecs.DeploymentRolloutStateInProgress),
+		},
+		{
+			Status:         aws.String("ACTIVE"),
+			TaskDefinition: aws.String(mockTaskDefV1),
+			DesiredCount:   aws.Int64(2),
+			RunningCount:   aws.Int64(2),
+		},
+	}
+	stoppedTask := func(id, taskDef, reason string, stoppedAt time.Time) *ecs.Task {
+		return &ecs.Task{
+			TaskArn:           aws.String("arn:aws:ecs:us-west-2:1111:task/my-app-test-Cluster/" + id),
+			TaskDefinitionArn: aws.String(taskDef),
+			StoppedReason:     aws.String(reason),
+			StoppedAt:         aws.Time(stoppedAt),
+		}
+	}
+	testCases := map[string]struct {
+		events     []deploy.ResourceEvent
+		setupMocks func(m *mocks.MockecsServiceRolloutDescriber)
+
+		wantedRows  []termprogress.TabRow
+		wantedError error
+	}{
+		"returns nothing if the service is not being updated": {
+			events: []deploy.ResourceEvent{
+				{
+					Resource: deploy.Resource{LogicalName: "Service", PhysicalID: mockServiceArn, Type: "AWS::ECS::Service"},
+					Status:   "UPDATE_COMPLETE",
+				},
+			},
+			setupMocks: func(m *mocks.MockecsServiceRolloutDescriber) {},
+		},
+		"ignores errors from describing the service": {
+			events: updatingEvents,
+			setupMocks: func(m *mocks.MockecsServiceRolloutDescriber) {
+				m.EXPECT().Service("my-app-test-Cluster", "my-app-test-my-svc").Return(nil, mockError)
+			},
+		},
+		"returns the deployments and the tasks stopped since the deployment started": {
+			events: updatingEvents,
+			setupMocks: func(m *mocks.MockecsServiceRolloutDescriber) {
+				m.EXPECT().Service("my-app-test-Cluster", "my-app-test-my-svc").Return(&ecs.Service{
+					Deployments: deployments,
+				}, nil)
+				m.EXPECT().StoppedServiceTasks("my-app-test-Cluster", "my-app-test-my-svc").Return([]*ecs.Task{
+					stoppedTask("1a2b3c4d", mockTaskDefV2, "Essential container in task exited", since.Add(time.Minute)),
+					stoppedTask("5e6f7a8b", mockTaskDefV1, "Scaling activity initiated by (deployment ecs-svc/123)", since.Add(time.Minute)),
+					stoppedTask("9c0d1e2f", mockTaskDefV1, "Essential container in task exited", since.Add(-time.Hour)),
+				}, nil)
+			},
+
+			wantedRows: []termprogress.TabRow{
+				"  - Primary deployment of task definition revision 2\t1/2 running, 1 pending",
+				"  - Active deployment of task definition revision 1\t2/2 running, 0 pending",
+				"    Stopped task 1a2b3c4d: Essential container in task exited\t",
+				"    Stopped task 5e6f7a8b: Scaling activity initiated by (deployment ecs-svc/123)\t",
+			},
+		},
+		"errors if the rollout failed": {
+			events: updatingEvents,
+			setupMocks: func(m *mocks.MockecsServiceRolloutDescriber) {
+				m.EXPECT().Service("my-app-test-Cluster", "my-app-test-my-svc").Return(&ecs.Service{
+					Deployments: []*awsecs.Deployment{
+						{
+							Status:             aws.String("PRIMARY"),
+							TaskDefinition:     aws.String(mockTaskDefV2),
+							DesiredCount:       aws.Int64(1),
+							RunningCount:       aws.Int64(0),
+							PendingCount:       aws.Int64(0),
+							RolloutState:       aws.String(awsecs.DeploymentRolloutStateFailed),
+							RolloutStateReason: aws.String("ECS deployment circuit breaker: tasks failed to start."),
+						},
+					},
+				}, nil)
+			},
+
+			wantedRows: []termprogress.TabRow{
+				"  - Primary deployment of task definition revision 2\t0/1 running, 0 pending",
+			},
+			wantedError: errors.New("rollout of service my-app-test-my-svc failed: ECS deployment circuit breaker: tasks failed to start."),
+		},
+		"errors if too many tasks of the new deployment stopped": {
+			events: updatingEvents,
+			setupMocks: func(m *mocks.MockecsServiceRolloutDescriber) {
+				m.EXPECT().Service("my-app-test-Cluster", "my-app-test-my-svc").Return(&ecs.Service{
+					Deployments: deployments[:1],
+				}, nil)
+				m.EXPECT().StoppedServiceTasks("my-app-test-Cluster", "my-app-test-my-svc").Return([]*ecs.Task{
+					stoppedTask("1a2b3c4d", mockTaskDefV2, "Task failed ELB health checks", since.Add(time.Minute)),
+					stoppedTask("5e6f7a8b", mockTaskDefV2, "Task failed ELB health checks", since.Add(2*time.Minute)),
+					stoppedTask("9c0d1e2f", mockTaskDefV2, "Essential container in task exited", since.Add(3*time.Minute)),
+				}, nil)
+			},
+
+			wantedRows: []termprogress.TabRow{
+				"  - Primary deployment of task definition revision 2\t1/2 running, 1 pending",
+				"    Stopped task 1a2b3c4d: Task failed ELB health checks\t",
+				"    Stopped task 5e6f7a8b: Task failed ELB health checks\t",
+				"    Stopped task 9c0d1e2f: Essential container in task exited\t",
+			},
+			wantedError: errors.New("3 tasks of the new deployment of service my-app-test-my-svc stopped, the last one because: Essential container in task exited"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockecsServiceRolloutDescriber(ctrl)
+			tc.setupMocks(m)
+			rollout := &serviceRollout{
+				ecs:   m,
+				since: since,
+			}
+
+			// WHEN
+			rows, err := rollout.rows(tc.events)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedRows, rows)
+		})
+	}
+}
+
+func TestFollowWorkloadDeployment(t *testing.T) {
+	testCases := map[string]struct {
+		withRollout bool
+		setupMocks  func(prog *mocks.Mockprogress, m *mocks.MockecsServiceRolloutDescriber)
+
+		wantedError error
+	}{
+		"returns the result of the deployment": {
+			setupMocks: func(prog *mocks.Mockprogress, m *mocks.MockecsServiceRolloutDescriber) {
+				prog.EXPECT().Events([]termprogress.TabRow{
+					"- Service (AWS::ECS::Service)\t[In Progress]",
+				})
+			},
+
+			wantedError: mockError,
+		},
+		"waits for the stack to roll back if the rollout fails": {
+			withRollout: true,
+			setupMocks: func(prog *mocks.Mockprogress, m *mocks.MockecsServiceRolloutDescriber) {
+				m.EXPECT().Service("my-app-test-Cluster", "my-app-test-my-svc").Return(&ecs.Service{
+					Deployments: []*awsecs.Deployment{
+						{
+							Status:             aws.String("PRIMARY"),
+							TaskDefinition:     aws.String(mockTaskDefV2),
+							RolloutState:       aws.String(awsecs.DeploymentRolloutStateFailed),
+							RolloutStateReason: aws.String("tasks failed to start"),
+						},
+					},
+				}, nil)
+				prog.EXPECT().Events([]termprogress.TabRow{
+					"- Service (AWS::ECS::Service)\t[In Progress]",
+					"  - Primary deployment of task definition revision 2\t0/0 running, 0 pending",
+					"  rollout of service my-app-test-my-svc failed: tasks failed to start. Waiting for CloudFormation to roll back, run `copilot svc cancel-deploy` to roll back now.\t",
+				})
+			},
+
+			wantedError: errors.New("rollout of service my-app-test-my-svc failed: tasks failed to start; CloudFormation rolled back stack my-app-test-my-svc"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockProgress := mocks.NewMockprogress(ctrl)
+			mockECS := mocks.NewMockecsServiceRolloutDescriber(ctrl)
+			tc.setupMocks(mockProgress, mockECS)
+			var rollout *serviceRollout
+			if tc.withRollout {
+				rollout = &serviceRollout{
+					ecs: mockECS,
+				}
+			}
+			events := make(chan []deploy.ResourceEvent, 1)
+			events <- []deploy.ResourceEvent{
+				{
+					Resource: deploy.Resource{LogicalName: "Service", PhysicalID: mockServiceArn, Type: "AWS::ECS::Service"},
+					Status:   "UPDATE_IN_PROGRESS",
+				},
+			}
+			close(events)
+			errs := make(chan error, 1)
+			errs <- mockError
+
+			// WHEN
+			err := followWorkloadDeployment(mockProgress, "my-app-test-my-svc", events, errs, rollout)
+
+			// THEN
+			require.EqualError(t, err, tc.wantedError.Error())
+		})
+	}
+}
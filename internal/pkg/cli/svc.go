@@ -26,11 +26,19 @@ Services are long-running Amazon ECS services.`,
 	cmd.AddCommand(buildSvcInitCmd())
 	cmd.AddCommand(buildSvcListCmd())
 	cmd.AddCommand(buildSvcPackageCmd())
+	cmd.AddCommand(buildSvcDiffCmd())
 	cmd.AddCommand(buildSvcDeployCmd())
+	cmd.AddCommand(buildSvcCancelDeployCmd())
 	cmd.AddCommand(buildSvcDeleteCmd())
 	cmd.AddCommand(buildSvcShowCmd())
 	cmd.AddCommand(buildSvcStatusCmd())
 	cmd.AddCommand(buildSvcLogsCmd())
+	cmd.AddCommand(buildSvcExecCmd())
+	cmd.AddCommand(buildSvcPauseCmd())
+	cmd.AddCommand(buildSvcResumeCmd())
+	cmd.AddCommand(buildSvcHistoryCmd())
+	cmd.AddCommand(buildSvcRollbackCmd())
+	cmd.AddCommand(buildSvcPromoteCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 
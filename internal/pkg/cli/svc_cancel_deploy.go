@@ -0,0 +1,244 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcCancelDeployNamePrompt     = "Which service's deployment would you like to cancel?"
+	svcCancelDeployNameHelpPrompt = "The stack of the service will be rolled back to its previous configuration."
+
+	fmtSvcCancelDeployConfirmPrompt     = "Are you sure you want to cancel the deployment of %s in environment %s?"
+	svcCancelDeployConfirmHelp          = "CloudFormation stops updating the stack of the service and rolls it back to its previous configuration."
+	fmtSvcContinueRollbackConfirmPrompt = "The deployment of %s in environment %s failed to roll back. Do you want to continue the rollback?"
+	svcContinueRollbackConfirmHelp      = `CloudFormation retries the rollback of the resources that failed to roll back.
+Fix the root cause of the failure first, otherwise the rollback fails again.`
+
+	fmtSvcCancelDeployStart      = "Cancelling the deployment of service %s in environment %s."
+	fmtSvcRollbackInProgress     = "Waiting for the deployment of service %s in environment %s to roll back."
+	fmtSvcContinueRollbackStart  = "Continuing the rollback of service %s in environment %s."
+	fmtSvcCancelDeployFailed     = "Failed to roll back the deployment of service %s in environment %s.\n"
+	fmtSvcCancelDeployComplete   = "Rolled back the deployment of service %s in environment %s.\n"
+	fmtSvcNoDeploymentInProgress = "service %s in environment %s has no deployment in progress: stack %s is %s"
+)
+
+var (
+	errSvcCancelDeployCancelled     = errors.New("svc cancel-deploy cancelled - no changes made")
+	errSvcContinueRollbackCancelled = errors.New("continue rollback cancelled - no changes made")
+)
+
+type svcCancelDeployVars struct {
+	appName          string
+	envName          string
+	svcName          string
+	skipConfirmation bool
+	force            bool
+}
+
+type svcCancelDeployOpts struct {
+	svcCancelDeployVars
+
+	store   store
+	sel     deploySelector
+	prompt  prompter
+	spinner progress
+
+	// Clients configured against the environment of the service.
+	svcCFN   workloadDeployCanceller
+	analyzer stackFailureAnalyzer
+
+	configureClients func(o *svcCancelDeployOpts, env *config.Environment) error
+}
+
+func newSvcCancelDeployOpts(vars svcCancelDeployVars) (*svcCancelDeployOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	prompter := prompt.New()
+	return &svcCancelDeployOpts{
+		svcCancelDeployVars: vars,
+		store:               configStore,
+		sel:                 selector.NewDeploySelect(prompter, configStore, deployStore),
+		prompt:              prompter,
+		spinner:             termprogress.NewSpinner(),
+		configureClients: func(o *svcCancelDeployOpts, env *config.Environment) error {
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("get session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			o.svcCFN = deploycfn.New(sess)
+			o.analyzer = describe.NewStackFailureAnalyzer(sess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcCancelDeployOpts) Validate() error {
+	return validateDeployedSvcFlags(o.store, o.appName, o.envName, o.svcName)
+}
+
+// Ask prompts for the service whose deployment to cancel if it's not provided.
+func (o *svcCancelDeployOpts) Ask() error {
+	deployedService, err := o.sel.DeployedService(svcCancelDeployNamePrompt, svcCancelDeployNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed service for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute rolls back the deployment of the service in progress, and displays the progress of the rollback until it's done.
+// If the stack of the service failed to roll back, the rollback is continued instead.
+func (o *svcCancelDeployOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	if err := o.configureClients(o, env); err != nil {
+		return err
+	}
+	descr, err := o.svcCFN.WorkloadStack(o.appName, o.envName, o.svcName)
+	if err != nil {
+		return fmt.Errorf("get stack of service %s in environment %s: %w", o.svcName, o.envName, err)
+	}
+	stackName := stack.NameForService(o.appName, o.envName, o.svcName)
+	svc, envName := color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName)
+
+	var events <-chan []deploy.ResourceEvent
+	var errs <-chan error
+	switch status := aws.StringValue(descr.StackStatus); status {
+	case sdkcloudformation.StackStatusUpdateInProgress:
+		if err := o.confirm(fmt.Sprintf(fmtSvcCancelDeployConfirmPrompt, svc, envName), svcCancelDeployConfirmHelp, errSvcCancelDeployCancelled); err != nil {
+			return err
+		}
+		if err := o.confirmProd(env, fmt.Sprintf("cancel the deployment of service %s in it", o.svcName)); err != nil {
+			return err
+		}
+		o.spinner.Start(fmt.Sprintf(fmtSvcCancelDeployStart, svc, envName))
+		events, errs = o.svcCFN.CancelWorkloadDeployment(o.appName, o.envName, o.svcName)
+	case sdkcloudformation.StackStatusUpdateRollbackInProgress, sdkcloudformation.StackStatusUpdateRollbackCompleteCleanupInProgress:
+		o.spinner.Start(fmt.Sprintf(fmtSvcRollbackInProgress, svc, envName))
+		events, errs = o.svcCFN.StreamWorkloadRollback(o.appName, o.envName, o.svcName)
+	case sdkcloudformation.StackStatusUpdateRollbackFailed:
+		if err := o.confirm(fmt.Sprintf(fmtSvcContinueRollbackConfirmPrompt, svc, envName), svcContinueRollbackConfirmHelp, errSvcContinueRollbackCancelled); err != nil {
+			return err
+		}
+		if err := o.confirmProd(env, fmt.Sprintf("continue the rollback of service %s in it", o.svcName)); err != nil {
+			return err
+		}
+		o.spinner.Start(fmt.Sprintf(fmtSvcContinueRollbackStart, svc, envName))
+		events, errs = o.svcCFN.ContinueWorkloadRollback(o.appName, o.envName, o.svcName)
+	default:
+		return fmt.Errorf(fmtSvcNoDeploymentInProgress, o.svcName, o.envName, stackName, status)
+	}
+
+	if err := followWorkloadDeployment(o.spinner, stackName, events, errs, nil); err != nil {
+		o.spinner.Stop(log.Serrorf(fmtSvcCancelDeployFailed, o.svcName, o.envName))
+		// The rollback belongs to the operation that was in progress, which is the most recent one.
+		logStackFailure(o.analyzer, stackName, time.Time{})
+		return fmt.Errorf("roll back service %s in environment %s: %w", o.svcName, o.envName, err)
+	}
+	o.spinner.Stop(log.Ssuccessf(fmtSvcCancelDeployComplete, svc, envName))
+	return nil
+}
+
+func (o *svcCancelDeployOpts) confirm(msg, help string, errCancelled error) error {
+	if o.skipConfirmation {
+		return nil
+	}
+	confirmed, err := o.prompt.Confirm(msg, help)
+	if err != nil {
+		return fmt.Errorf("svc cancel-deploy confirmation prompt: %w", err)
+	}
+	if !confirmed {
+		return errCancelled
+	}
+	return nil
+}
+
+// confirmProd asks the user to type the name of the environment if it's a production environment.
+// Production environments are confirmed even if the confirmation prompt is skipped.
+func (o *svcCancelDeployOpts) confirmProd(env *config.Environment, action string) error {
+	guard := prodGuard{
+		force:  o.force,
+		prompt: o.prompt,
+	}
+	return guard.confirm(env, action)
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *svcCancelDeployOpts) RecommendedActions() []string {
+	return []string{
+		fmt.Sprintf("Run %s to check the status of the service.",
+			color.HighlightCode(fmt.Sprintf("copilot svc status -n %s -e %s", o.svcName, o.envName))),
+	}
+}
+
+// buildSvcCancelDeployCmd builds the command for cancelling the deployment of a service in progress.
+func buildSvcCancelDeployCmd() *cobra.Command {
+	vars := svcCancelDeployVars{}
+	cmd := &cobra.Command{
+		Use:   "cancel-deploy",
+		Short: "Cancels the deployment of a service in progress.",
+		Long: `Cancels the deployment of a service in progress and rolls its stack back to the previous configuration.
+If the stack of the service failed to roll back, offers to continue the rollback.`,
+		Example: `
+  Cancel the deployment of the "frontend" service to the "prod" environment.
+  /code $ copilot svc cancel-deploy -n frontend -e prod
+  Cancel the deployment, or continue its rollback, without confirmation.
+  /code $ copilot svc cancel-deploy -n frontend -e prod --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcCancelDeployOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			log.Infoln("Recommended follow-up actions:")
+			for _, followup := range opts.RecommendedActions() {
+				log.Infof("- %s\n", followup)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&vars.force, forceFlag, false, forceFlagDescription)
+	return cmd
+}
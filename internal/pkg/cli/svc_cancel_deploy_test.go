@@ -0,0 +1,226 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcCancelDeployMocks struct {
+	store    *mocks.Mockstore
+	prompt   *mocks.Mockprompter
+	spinner  *mocks.Mockprogress
+	svcCFN   *mocks.MockworkloadDeployCanceller
+	analyzer *mocks.MockstackFailureAnalyzer
+}
+
+func TestSvcCancelDeployOpts_Execute(t *testing.T) {
+	stackWithStatus := func(status string) *awscloudformation.StackDescription {
+		return &awscloudformation.StackDescription{StackStatus: aws.String(status)}
+	}
+	rollbackResult := func(err error) (<-chan []deploy.ResourceEvent, <-chan error) {
+		events := make(chan []deploy.ResourceEvent)
+		close(events)
+		errs := make(chan error, 1)
+		errs <- err
+		return events, errs
+	}
+
+	testCases := map[string]struct {
+		skipConfirmation bool
+		inForce          bool
+		setupMocks       func(m svcCancelDeployMocks)
+
+		wantedError error
+	}{
+		"errors if fail to get the environment": {
+			setupMocks: func(m svcCancelDeployMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("get environment test: %w", mockError),
+		},
+		"errors if fail to describe the stack": {
+			setupMocks: func(m svcCancelDeployMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.svcCFN.EXPECT().WorkloadStack("my-app", "test", "my-svc").Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("get stack of service my-svc in environment test: %w", mockError),
+		},
+		"errors if no deployment is in progress": {
+			setupMocks: func(m svcCancelDeployMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.svcCFN.EXPECT().WorkloadStack("my-app", "test", "my-svc").Return(stackWithStatus(sdkcloudformation.StackStatusUpdateComplete), nil)
+			},
+
+			wantedError: errors.New("service my-svc in environment test has no deployment in progress: stack my-app-test-my-svc is UPDATE_COMPLETE"),
+		},
+		"does not cancel the deployment if not confirmed": {
+			setupMocks: func(m svcCancelDeployMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.svcCFN.EXPECT().WorkloadStack("my-app", "test", "my-svc").Return(stackWithStatus(sdkcloudformation.StackStatusUpdateInProgress), nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), svcCancelDeployConfirmHelp).Return(false, nil)
+				m.svcCFN.EXPECT().CancelWorkloadDeployment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			wantedError: errSvcCancelDeployCancelled,
+		},
+		"cancels the deployment in progress": {
+			setupMocks: func(m svcCancelDeployMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.svcCFN.EXPECT().WorkloadStack("my-app", "test", "my-svc").Return(stackWithStatus(sdkcloudformation.StackStatusUpdateInProgress), nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), svcCancelDeployConfirmHelp).Return(true, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.svcCFN.EXPECT().CancelWorkloadDeployment("my-app", "test", "my-svc").Return(rollbackResult(nil))
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"does not cancel the deployment in a production environment if not confirmed": {
+			skipConfirmation: true,
+			setupMocks: func(m svcCancelDeployMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test", Prod: true}, nil)
+				m.svcCFN.EXPECT().WorkloadStack("my-app", "test", "my-svc").Return(stackWithStatus(sdkcloudformation.StackStatusUpdateInProgress), nil)
+				m.prompt.EXPECT().Get(gomock.Any(), prodConfirmHelp, nil).Return("prod", nil)
+				m.svcCFN.EXPECT().CancelWorkloadDeployment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			wantedError: errProdConfirmationMismatch,
+		},
+		"cancels the deployment in a production environment once confirmed": {
+			setupMocks: func(m svcCancelDeployMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test", Prod: true}, nil)
+				m.svcCFN.EXPECT().WorkloadStack("my-app", "test", "my-svc").Return(stackWithStatus(sdkcloudformation.StackStatusUpdateInProgress), nil)
+				gomock.InOrder(
+					m.prompt.EXPECT().Confirm(gomock.Any(), svcCancelDeployConfirmHelp).Return(true, nil),
+					m.prompt.EXPECT().Get(gomock.Any(), prodConfirmHelp, nil).Return("test", nil),
+				)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.svcCFN.EXPECT().CancelWorkloadDeployment("my-app", "test", "my-svc").Return(rollbackResult(nil))
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"cancels the deployment in a production environment without confirmation if forced": {
+			skipConfirmation: true,
+			inForce:          true,
+			setupMocks: func(m svcCancelDeployMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test", Prod: true}, nil)
+				m.svcCFN.EXPECT().WorkloadStack("my-app", "test", "my-svc").Return(stackWithStatus(sdkcloudformation.StackStatusUpdateInProgress), nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.svcCFN.EXPECT().CancelWorkloadDeployment("my-app", "test", "my-svc").Return(rollbackResult(nil))
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"does not continue a failed rollback in a production environment if not confirmed": {
+			skipConfirmation: true,
+			setupMocks: func(m svcCancelDeployMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test", Prod: true}, nil)
+				m.svcCFN.EXPECT().WorkloadStack("my-app", "test", "my-svc").Return(stackWithStatus(sdkcloudformation.StackStatusUpdateRollbackFailed), nil)
+				m.prompt.EXPECT().Get(gomock.Any(), prodConfirmHelp, nil).Return("prod", nil)
+				m.svcCFN.EXPECT().ContinueWorkloadRollback(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			wantedError: errProdConfirmationMismatch,
+		},
+		"waits for a rollback already in progress": {
+			setupMocks: func(m svcCancelDeployMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.svcCFN.EXPECT().WorkloadStack("my-app", "test", "my-svc").Return(stackWithStatus(sdkcloudformation.StackStatusUpdateRollbackInProgress), nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.svcCFN.EXPECT().StreamWorkloadRollback("my-app", "test", "my-svc").Return(rollbackResult(nil))
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"continues a failed rollback without confirmation": {
+			skipConfirmation: true,
+			setupMocks: func(m svcCancelDeployMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.svcCFN.EXPECT().WorkloadStack("my-app", "test", "my-svc").Return(stackWithStatus(sdkcloudformation.StackStatusUpdateRollbackFailed), nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.svcCFN.EXPECT().ContinueWorkloadRollback("my-app", "test", "my-svc").Return(rollbackResult(nil))
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"does not continue a failed rollback if not confirmed": {
+			setupMocks: func(m svcCancelDeployMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.svcCFN.EXPECT().WorkloadStack("my-app", "test", "my-svc").Return(stackWithStatus(sdkcloudformation.StackStatusUpdateRollbackFailed), nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), svcContinueRollbackConfirmHelp).Return(false, nil)
+			},
+
+			wantedError: errSvcContinueRollbackCancelled,
+		},
+		"reports the root cause if the rollback fails": {
+			setupMocks: func(m svcCancelDeployMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.svcCFN.EXPECT().WorkloadStack("my-app", "test", "my-svc").Return(stackWithStatus(sdkcloudformation.StackStatusUpdateRollbackFailed), nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), svcContinueRollbackConfirmHelp).Return(true, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.svcCFN.EXPECT().ContinueWorkloadRollback("my-app", "test", "my-svc").Return(rollbackResult(mockError))
+				m.spinner.EXPECT().Stop(gomock.Any())
+				m.analyzer.EXPECT().Analyze("my-app-test-my-svc", time.Time{}).Return(nil, nil)
+			},
+
+			wantedError: fmt.Errorf("roll back service my-svc in environment test: %w", mockError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcCancelDeployMocks{
+				store:    mocks.NewMockstore(ctrl),
+				prompt:   mocks.NewMockprompter(ctrl),
+				spinner:  mocks.NewMockprogress(ctrl),
+				svcCFN:   mocks.NewMockworkloadDeployCanceller(ctrl),
+				analyzer: mocks.NewMockstackFailureAnalyzer(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &svcCancelDeployOpts{
+				svcCancelDeployVars: svcCancelDeployVars{
+					appName:          "my-app",
+					envName:          "test",
+					svcName:          "my-svc",
+					skipConfirmation: tc.skipConfirmation,
+					force:            tc.inForce,
+				},
+				store:   m.store,
+				prompt:  m.prompt,
+				spinner: m.spinner,
+				configureClients: func(o *svcCancelDeployOpts, env *config.Environment) error {
+					o.svcCFN = m.svcCFN
+					o.analyzer = m.analyzer
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -51,6 +51,7 @@ type deleteSvcVars struct {
 	skipConfirmation bool
 	name             string
 	envName          string
+	force            bool
 }
 
 type deleteSvcOpts struct {
@@ -123,6 +124,9 @@ func (o *deleteSvcOpts) Ask() error {
 	if err := o.askSvcName(); err != nil {
 		return err
 	}
+	if err := o.confirmProdEnvs(); err != nil {
+		return err
+	}
 
 	if o.skipConfirmation {
 		return nil
@@ -236,6 +240,25 @@ func (o *deleteSvcOpts) askSvcName() error {
 	return nil
 }
 
+// confirmProdEnvs asks the user to type the name of each production environment the service is deleted from.
+// Production environments are confirmed even if the confirmation prompt is skipped.
+func (o *deleteSvcOpts) confirmProdEnvs() error {
+	envs, err := o.appEnvironments()
+	if err != nil {
+		return err
+	}
+	guard := prodGuard{
+		force:  o.force,
+		prompt: o.prompt,
+	}
+	for _, env := range envs {
+		if err := guard.confirm(env, fmt.Sprintf("delete service %s from it", o.name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (o *deleteSvcOpts) appEnvironments() ([]*config.Environment, error) {
 	var envs []*config.Environment
 	var err error
@@ -262,6 +285,9 @@ func (o *deleteSvcOpts) deleteStacks(envs []*config.Environment) error {
 		}
 
 		cfClient := o.getSvcCFN(sess)
+		if err := unprotectWorkload(cfClient, o.appName, env, o.name); err != nil {
+			return err
+		}
 		o.spinner.Start(fmt.Sprintf(fmtSvcDeleteStart, o.name, env.Name))
 		if err := cfClient.DeleteWorkload(deploy.DeleteWorkloadInput{
 			Name:    o.name,
@@ -272,6 +298,21 @@ func (o *deleteSvcOpts) deleteStacks(envs []*config.Environment) error {
 			return fmt.Errorf("delete service: %w", err)
 		}
 		o.spinner.Stop(log.Ssuccessf(fmtSvcDeleteComplete, o.name, env.Name))
+
+		// A service deployed again to the environment should not be reported as paused.
+		if err := o.store.DeletePausedService(o.appName, env.Name, o.name); err != nil {
+			return err
+		}
+		// The deployments of a deleted service can't be rolled back to.
+		deployments, err := o.store.ListServiceDeployments(o.appName, env.Name, o.name)
+		if err != nil {
+			return err
+		}
+		for _, deployment := range deployments {
+			if err := o.store.DeleteServiceDeployment(o.appName, env.Name, o.name, deployment.ID); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -378,5 +419,6 @@ func buildSvcDeleteCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&vars.force, forceFlag, false, forceFlagDescription)
 	return cmd
 }
@@ -120,9 +120,11 @@ func TestDeleteSvcOpts_Ask(t *testing.T) {
 
 	tests := map[string]struct {
 		skipConfirmation bool
+		force            bool
 		inName           string
 		envName          string
 		appName          string
+		inProd           bool
 
 		mockSel    func(m *mocks.MockwsSelector)
 		mockPrompt func(m *mocks.Mockprompter)
@@ -249,6 +251,41 @@ func TestDeleteSvcOpts_Ask(t *testing.T) {
 				).Times(1).Return(true, nil)
 			},
 
+			wantedName: testSvcName,
+		},
+		"should require typing the name of production environments even if the confirmation is skipped": {
+			appName:          testAppName,
+			inName:           testSvcName,
+			skipConfirmation: true,
+			inProd:           true,
+			mockSel:          func(m *mocks.MockwsSelector) {},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Any(), prodConfirmHelp, nil).Return("test", nil)
+			},
+
+			wantedName: testSvcName,
+		},
+		"should return error if the name typed does not match the production environment": {
+			appName:          testAppName,
+			inName:           testSvcName,
+			skipConfirmation: true,
+			inProd:           true,
+			mockSel:          func(m *mocks.MockwsSelector) {},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Any(), prodConfirmHelp, nil).Return("prod", nil)
+			},
+
+			wantedError: errProdConfirmationMismatch,
+		},
+		"should skip the production environment guardrails if forced": {
+			appName:          testAppName,
+			inName:           testSvcName,
+			skipConfirmation: true,
+			force:            true,
+			inProd:           true,
+			mockSel:          func(m *mocks.MockwsSelector) {},
+			mockPrompt:       func(m *mocks.Mockprompter) {},
+
 			wantedName: testSvcName,
 		},
 	}
@@ -262,6 +299,10 @@ func TestDeleteSvcOpts_Ask(t *testing.T) {
 			mockSel := mocks.NewMockwsSelector(ctrl)
 			test.mockPrompt(mockPrompter)
 			test.mockSel(mockSel)
+			mockStore := mocks.NewMockstore(ctrl)
+			envs := []*config.Environment{{Name: "test", Prod: test.inProd}}
+			mockStore.EXPECT().GetEnvironment(gomock.Any(), "test").Return(envs[0], nil).AnyTimes()
+			mockStore.EXPECT().ListEnvironments(gomock.Any()).Return(envs, nil).AnyTimes()
 
 			opts := deleteSvcOpts{
 				deleteSvcVars: deleteSvcVars{
@@ -269,7 +310,9 @@ func TestDeleteSvcOpts_Ask(t *testing.T) {
 					appName:          test.appName,
 					name:             test.inName,
 					envName:          test.envName,
+					force:            test.force,
 				},
+				store:  mockStore,
 				prompt: mockPrompter,
 				sel:    mockSel,
 			}
@@ -333,6 +376,11 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
 					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteComplete, mockSvcName, mockEnvName)),
+					mocks.store.EXPECT().DeletePausedService(mockAppName, mockEnvName, mockSvcName).Return(nil),
+					mocks.store.EXPECT().ListServiceDeployments(mockAppName, mockEnvName, mockSvcName).Return([]*config.ServiceDeployment{
+						{ID: "20201203101530"},
+					}, nil),
+					mocks.store.EXPECT().DeleteServiceDeployment(mockAppName, mockEnvName, mockSvcName, "20201203101530").Return(nil),
 					// emptyECRRepos
 					mocks.ecr.EXPECT().ClearRepository(mockRepo).Return(nil),
 
@@ -363,6 +411,8 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
 					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteComplete, mockSvcName, mockEnvName)),
+					mocks.store.EXPECT().DeletePausedService(mockAppName, mockEnvName, mockSvcName).Return(nil),
+					mocks.store.EXPECT().ListServiceDeployments(mockAppName, mockEnvName, mockSvcName).Return(nil, nil),
 
 					// It should **not** emptyECRRepos
 					mocks.ecr.EXPECT().ClearRepository(gomock.Any()).Return(nil).Times(0),
@@ -6,16 +6,24 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
 	"path/filepath"
 	"strings"
+	"text/tabwriter"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
@@ -29,15 +37,21 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/pmezard/go-difflib/difflib"
 	"github.com/spf13/cobra"
 )
 
 type deploySvcVars struct {
-	appName      string
-	name         string
-	envName      string
-	imageTag     string
-	resourceTags map[string]string
+	appName        string
+	name           string
+	envName        string
+	imageTag       string
+	resourceTags   map[string]string
+	dryRun         bool
+	force          bool
+	allowDirty     bool
+	allowAnyBranch bool
+	stealLock      bool
 }
 
 type deploySvcOpts struct {
@@ -53,11 +67,27 @@ type deploySvcOpts struct {
 	appCFN             appResourcesGetter
 	svcCFN             cloudformation.CloudFormation
 	sessProvider       sessionProvider
-
+	imageDigests       imageDigestGetter
+	templateUploader   objectUploader
+	previewer          workloadPreviewer
+	deployer           workloadDeployStreamer
+	protector          wlTerminationProtector
+	ecs                ecsServiceRolloutDescriber
+	failureAnalyzer    stackFailureAnalyzer
+	deployStore        deployedEnvironmentLister
+	rgSvc              resourcesByTagsGetter
+	scaler             ecsServiceScaler
+	aasSvc             serviceScalingSuspender
+
+	w       io.Writer
 	spinner progress
 	sel     wsSelector
 	prompt  prompter
 
+	// Optional. Digest of an image already pushed to the repository to deploy instead of building one,
+	// such as an image promoted from another environment.
+	imageDigest string
+
 	// cached variables
 	targetApp         *config.Application
 	targetEnvironment *config.Environment
@@ -71,6 +101,10 @@ func newSvcDeployOpts(vars deploySvcVars) (*deploySvcOpts, error) {
 		return nil, fmt.Errorf("new config store: %w", err)
 	}
 
+	deployStore, err := deploy.NewStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
 	ws, err := workspace.New()
 	if err != nil {
 		return nil, fmt.Errorf("new workspace: %w", err)
@@ -80,8 +114,10 @@ func newSvcDeployOpts(vars deploySvcVars) (*deploySvcOpts, error) {
 		deploySvcVars: vars,
 
 		store:        store,
+		deployStore:  deployStore,
 		ws:           ws,
 		unmarshal:    manifest.UnmarshalWorkload,
+		w:            log.OutputWriter,
 		spinner:      termprogress.NewSpinner(),
 		sel:          selector.NewWorkspaceSelect(prompter, store, ws),
 		prompt:       prompter,
@@ -125,7 +161,8 @@ func (o *deploySvcOpts) Ask() error {
 }
 
 // Execute builds and pushes the container image for the service,
-func (o *deploySvcOpts) Execute() error {
+// and deploys the service stack or, in a dry run, shows the changes the deployment would make.
+func (o *deploySvcOpts) Execute() (err error) {
 	env, err := targetEnv(o.store, o.appName, o.envName)
 	if err != nil {
 		return err
@@ -144,6 +181,26 @@ func (o *deploySvcOpts) Execute() error {
 	}
 	o.targetSvc = svc
 
+	if !o.dryRun {
+		guard := prodGuard{
+			force:          o.force,
+			allowDirty:     o.allowDirty,
+			allowAnyBranch: o.allowAnyBranch,
+			prompt:         o.prompt,
+			runner:         o.cmd,
+		}
+		if err := guard.confirmDeploy(env, fmt.Sprintf("deploy service %s to it", o.name)); err != nil {
+			return err
+		}
+		locker := newDeployLocker(o.store, o.spinner, o.stealLock)
+		var lock *config.DeployLock
+		lock, err = locker.acquire(o.appName, env.Name, o.name)
+		if err != nil {
+			return err
+		}
+		defer locker.releaseOnReturn(lock, &err)
+	}
+
 	if err := o.configureClients(); err != nil {
 		return err
 	}
@@ -152,6 +209,14 @@ func (o *deploySvcOpts) Execute() error {
 		return err
 	}
 
+	if o.dryRun {
+		addons, addonsURL, err := dryRunAddons(o.addons, o.previewer, o.targetEnvironment, o.name)
+		if err != nil {
+			return err
+		}
+		return o.previewSvc(addons, addonsURL)
+	}
+
 	addonsURL, err := o.pushAddonsTemplateToS3Bucket()
 	if err != nil {
 		return err
@@ -241,11 +306,23 @@ func (o *deploySvcOpts) configureClients() error {
 	if err != nil {
 		return fmt.Errorf("initiate image builder pusher: %w", err)
 	}
+	o.imageDigests = registry
 
-	o.s3 = s3.New(defaultSessEnvRegion)
+	s3Client := s3.New(defaultSessEnvRegion)
+	o.s3 = s3Client
+	o.templateUploader = s3Client
 
 	// CF client against env account profile AND target environment region
 	o.svcCFN = cloudformation.New(envSession)
+	o.previewer = o.svcCFN
+	o.deployer = o.svcCFN
+	o.protector = o.svcCFN
+	ecsClient := ecs.New(envSession)
+	o.ecs = ecsClient
+	o.scaler = ecsClient
+	o.failureAnalyzer = describe.NewStackFailureAnalyzer(envSession)
+	o.rgSvc = resourcegroups.New(envSession)
+	o.aasSvc = aas.New(envSession)
 
 	addonsSvc, err := addon.New(o.name)
 	if err != nil {
@@ -274,6 +351,12 @@ func (o *deploySvcOpts) configureContainerImage() error {
 	if !required {
 		return nil
 	}
+	if o.dryRun || o.imageDigest != "" {
+		// The stack only references the image, so it doesn't need to be pushed to preview the changes,
+		// and a promoted image is already in the repository.
+		o.buildRequired = true
+		return nil
+	}
 	// If it is built from local Dockerfile, build and push to the ECR repo.
 	buildArg, err := o.dfBuildArgs(svc)
 	if err != nil {
@@ -351,12 +434,26 @@ func (o *deploySvcOpts) manifest() (interface{}, error) {
 	return mft, nil
 }
 
-func (o *deploySvcOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, error) {
+func (o *deploySvcOpts) runtimeConfig(mft interface{}, addonsURL string) (*stack.RuntimeConfig, error) {
+	endpoints, err := dependencyEndpoints(dependencyEndpointsInput{
+		app:         o.appName,
+		env:         o.targetEnvironment.Name,
+		svc:         o.name,
+		deps:        manifest.ServiceDependencies(mft),
+		deployStore: o.deployStore,
+		ws:          o.ws,
+		unmarshal:   o.unmarshal,
+	})
+	if err != nil {
+		return nil, err
+	}
+	rc := &stack.RuntimeConfig{
+		AddonsTemplateURL:   addonsURL,
+		AdditionalTags:      tags.Merge(o.targetApp.Tags, o.resourceTags),
+		DependencyEndpoints: endpoints,
+	}
 	if !o.buildRequired {
-		return &stack.RuntimeConfig{
-			AddonsTemplateURL: addonsURL,
-			AdditionalTags:    tags.Merge(o.targetApp.Tags, o.resourceTags),
-		}, nil
+		return rc, nil
 	}
 	resources, err := o.appCFN.GetAppResourcesByRegion(o.targetApp, o.targetEnvironment.Region)
 	if err != nil {
@@ -370,14 +467,12 @@ func (o *deploySvcOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, e
 			appAccountID: o.targetApp.AccountID,
 		}
 	}
-	return &stack.RuntimeConfig{
-		AddonsTemplateURL: addonsURL,
-		AdditionalTags:    tags.Merge(o.targetApp.Tags, o.resourceTags),
-		Image: &stack.ECRImage{
-			RepoURL:  repoURL,
-			ImageTag: o.imageTag,
-		},
-	}, nil
+	rc.Image = &stack.ECRImage{
+		RepoURL:  repoURL,
+		ImageTag: o.imageTag,
+		Digest:   o.imageDigest,
+	}
+	return rc, nil
 }
 
 func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.StackConfiguration, error) {
@@ -385,7 +480,7 @@ func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 	if err != nil {
 		return nil, err
 	}
-	rc, err := o.runtimeConfig(addonsURL)
+	rc, err := o.runtimeConfig(mft, addonsURL)
 	if err != nil {
 		return nil, err
 	}
@@ -418,14 +513,220 @@ func (o *deploySvcOpts) deploySvc(addonsURL string) error {
 			fmt.Sprintf("%s:%s", color.HighlightUserInput(o.name), color.HighlightUserInput(o.imageTag)),
 			color.HighlightUserInput(o.targetEnvironment.Name)))
 
-	if err := o.svcCFN.DeployService(conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN)); err != nil {
+	since := lastStackOperationTime(o.failureAnalyzer, conf.StackName())
+	startedAt := time.Now()
+	rollout := &serviceRollout{
+		ecs:   o.ecs,
+		since: startedAt,
+	}
+	events, errs := o.deployer.StreamServiceDeployment(conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN))
+	if err := followWorkloadDeployment(o.spinner, conf.StackName(), events, errs, rollout); err != nil {
 		o.spinner.Stop(log.Serrorf("Failed to deploy service.\n"))
+		logStackFailure(o.failureAnalyzer, conf.StackName(), since)
 		return fmt.Errorf("deploy service: %w", err)
 	}
 	o.spinner.Stop("\n")
+	if err := protectWorkload(o.protector, o.appName, o.targetEnvironment, o.name); err != nil {
+		return err
+	}
+	if err := o.recordDeployment(conf); err != nil {
+		// The service is deployed, only rolling back to this deployment won't be possible.
+		log.Warningf("Failed to record the deployment of %s: %v\n", o.name, err)
+	}
+	return resumeRedeployedSvc(resumeRedeployedSvcInput{
+		store: o.store,
+		rg:    o.rgSvc,
+		ecs:   o.scaler,
+		aas:   o.aasSvc,
+		app:   o.appName,
+		env:   o.targetEnvironment.Name,
+		svc:   o.name,
+	})
+}
+
+func (o *deploySvcOpts) previewSvc(addons, addonsURL string) error {
+	conf, err := o.stackConfiguration(addonsURL)
+	if err != nil {
+		return err
+	}
+	return previewWorkload(previewWorkloadInput{
+		w:         o.w,
+		spinner:   o.spinner,
+		previewer: o.previewer,
+		env:       o.targetEnvironment,
+		name:      o.name,
+		stack:     conf,
+		addons:    addons,
+	})
+}
+
+// dryRunAddons returns the local addons template of the workload, and the URL of the addons template used by
+// its deployed stack. A dry run doesn't upload the local addons template to S3, so the change set of the workload
+// stack is created with the deployed addons and the local addons template is diffed separately.
+// The template is empty if the workload doesn't have addons, and the URL is empty if they were never deployed.
+func dryRunAddons(addons templater, previewer workloadPreviewer, env *config.Environment, name string) (tpl, url string, err error) {
+	tpl, err = addons.Template()
+	if err != nil {
+		var notExistErr *addon.ErrDirNotExist
+		if errors.As(err, &notExistErr) {
+			return "", "", nil
+		}
+		return "", "", fmt.Errorf("retrieve addons template: %w", err)
+	}
+	descr, err := previewer.WorkloadStack(env.App, env.Name, name)
+	if err != nil {
+		var errStackNotFound *awscloudformation.ErrStackNotFound
+		if errors.As(err, &errStackNotFound) {
+			return tpl, "", nil
+		}
+		return "", "", fmt.Errorf("get stack of deployed %s: %w", name, err)
+	}
+	for _, param := range descr.Parameters {
+		if aws.StringValue(param.ParameterKey) == stack.WorkloadAddonsTemplateURLParamKey {
+			return tpl, aws.StringValue(param.ParameterValue), nil
+		}
+	}
+	return tpl, "", nil
+}
+
+type previewWorkloadInput struct {
+	w         io.Writer
+	spinner   progress
+	previewer workloadPreviewer
+	env       *config.Environment
+	name      string
+	stack     cloudformation.StackConfiguration
+	addons    string // Local addons template of the workload, empty if it doesn't have addons.
+}
+
+// previewWorkload writes the resource changes that deploying the workload stack would make, followed by
+// the diff between the deployed template and the new one, without deploying the stack.
+// If the workload has addons, the diff between the deployed addons template and the local one is written too.
+func previewWorkload(in previewWorkloadInput) error {
+	deployed, err := in.previewer.WorkloadTemplate(in.env.App, in.env.Name, in.name)
+	if err != nil {
+		var errStackNotFound *awscloudformation.ErrStackNotFound
+		if !errors.As(err, &errStackNotFound) {
+			return fmt.Errorf("get template of deployed %s: %w", in.name, err)
+		}
+		// The workload isn't deployed yet, the whole template is new.
+	}
+	proposed, err := in.stack.Template()
+	if err != nil {
+		return fmt.Errorf("generate template of %s: %w", in.name, err)
+	}
+
+	in.spinner.Start(fmt.Sprintf("Creating a change set for %s in %s.", color.HighlightUserInput(in.name), color.HighlightUserInput(in.env.Name)))
+	changes, err := in.previewer.PreviewService(in.stack, awscloudformation.WithRoleARN(in.env.ExecutionRoleARN))
+	if err != nil {
+		in.spinner.Stop(log.Serrorf("Failed to create a change set.\n"))
+		return fmt.Errorf("preview changes of %s: %w", in.name, err)
+	}
+	in.spinner.Stop("\n")
+
+	if len(changes) == 0 {
+		fmt.Fprintln(in.w, "No resource changes.")
+	} else {
+		fmt.Fprintln(in.w, "Resource changes:")
+		writer := tabwriter.NewWriter(in.w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(writer, "Action\tLogical ID\tType\tReplacement")
+		fmt.Fprintln(writer, "------\t----------\t----\t-----------")
+		for _, change := range changes {
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", change.Action, change.LogicalID, change.Type, orDash(change.Replacement))
+		}
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+	}
+	if in.addons != "" {
+		fmt.Fprintf(in.w, "The resource changes don't include the resources of the addons of %s: the addons template isn't uploaded in a dry run.\n", in.name)
+	}
+	fmt.Fprintln(in.w)
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(deployed),
+		B:        splitLines(proposed),
+		FromFile: "deployed",
+		ToFile:   "proposed",
+		Context:  3,
+	})
+	if err != nil {
+		return fmt.Errorf("diff templates of %s: %w", in.name, err)
+	}
+	if diff == "" {
+		fmt.Fprintln(in.w, "No template changes.")
+	} else {
+		fmt.Fprintln(in.w, "Template changes:")
+		fmt.Fprint(in.w, diff)
+	}
+	if in.addons == "" {
+		return nil
+	}
+
+	deployedAddons, err := in.previewer.WorkloadAddonsTemplate(in.env.App, in.env.Name, in.name)
+	if err != nil {
+		var errStackNotFound *awscloudformation.ErrStackNotFound
+		if !errors.As(err, &errStackNotFound) {
+			return fmt.Errorf("get addons template of deployed %s: %w", in.name, err)
+		}
+		// The addons aren't deployed yet, the whole addons template is new.
+	}
+	diff, err = difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(deployedAddons),
+		B:        splitLines(in.addons),
+		FromFile: "deployed",
+		ToFile:   "proposed",
+		Context:  3,
+	})
+	if err != nil {
+		return fmt.Errorf("diff addons templates of %s: %w", in.name, err)
+	}
+	fmt.Fprintln(in.w)
+	if diff == "" {
+		fmt.Fprintln(in.w, "No addons template changes.")
+		return nil
+	}
+	fmt.Fprintln(in.w, "Addons template changes:")
+	fmt.Fprint(in.w, diff)
 	return nil
 }
 
+// splitLines splits s into lines that keep their trailing newline.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	if !strings.HasSuffix(s, "\n") {
+		s += "\n"
+	}
+	lines := strings.SplitAfter(s, "\n")
+	return lines[:len(lines)-1]
+}
+
+// recordDeployment records the successful deployment of the service so that it can be rolled back to.
+func (o *deploySvcOpts) recordDeployment(conf cloudformation.StackConfiguration) error {
+	resources, err := o.appCFN.GetAppResourcesByRegion(o.targetApp, o.targetEnvironment.Region)
+	if err != nil {
+		return fmt.Errorf("get application %s resources from region %s: %w", o.targetApp.Name, o.targetEnvironment.Region, err)
+	}
+	digest := o.imageDigest
+	if o.buildRequired && digest == "" {
+		digest, err = o.imageDigests.ImageDigest(fmt.Sprintf("%s/%s", o.appName, o.name), o.imageTag)
+		if err != nil {
+			return fmt.Errorf("get digest of image %s: %w", o.imageTag, err)
+		}
+	}
+	return recordSvcDeployment(o.store, o.templateUploader, recordSvcDeploymentInput{
+		app:         o.appName,
+		env:         o.targetEnvironment.Name,
+		svc:         o.name,
+		bucket:      resources.S3Bucket,
+		stack:       conf,
+		imageDigest: digest,
+		deployedAt:  time.Now(),
+	})
+}
+
 func (o *deploySvcOpts) showSvcURI() error {
 	type identifier interface {
 		URI(string) (string, error)
@@ -485,7 +786,13 @@ func buildSvcDeployCmd() *cobra.Command {
   Deploys a service named "frontend" to a "test" environment.
   /code $ copilot svc deploy --name frontend --env test
   Deploys a service with additional resource tags.
-  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Shows the changes that deploying the "frontend" service to the "prod" environment would make.
+  /code $ copilot svc deploy --name frontend --env prod --dry-run
+  Deploys the "frontend" service to the "prod" production environment without the guardrails.
+  /code $ copilot svc deploy --name frontend --env prod --force
+  Deploys the "frontend" service to a "test" environment while taking over the deploy lock of someone else.
+  /code $ copilot svc deploy --name frontend --env test --steal-lock`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcDeployOpts(vars)
 			if err != nil {
@@ -508,6 +815,11 @@ func buildSvcDeployCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, dryRunFlagDescription)
+	cmd.Flags().BoolVar(&vars.force, forceFlag, false, forceFlagDescription)
+	cmd.Flags().BoolVar(&vars.allowDirty, allowDirtyFlag, false, allowDirtyFlagDescription)
+	cmd.Flags().BoolVar(&vars.allowAnyBranch, allowAnyBranchFlag, false, allowAnyBranchFlagDescription)
+	cmd.Flags().BoolVar(&vars.stealLock, stealLockFlag, false, stealLockFlagDescription)
 
 	return cmd
 }
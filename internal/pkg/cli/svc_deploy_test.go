@@ -4,12 +4,16 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"path/filepath"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	addon "github.com/aws/copilot-cli/internal/pkg/addon"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/docker"
@@ -222,8 +226,10 @@ image:
     dockerfile: path/to/Dockerfile`)
 
 	tests := map[string]struct {
-		inputSvc   string
-		setupMocks func(mocks deploySvcMocks)
+		inputSvc      string
+		inDryRun      bool
+		inImageDigest string
+		setupMocks    func(mocks deploySvcMocks)
 
 		wantErr error
 	}{
@@ -256,6 +262,26 @@ image:
 				)
 			},
 		},
+		"should not build and push in a dry run": {
+			inputSvc: "serviceA",
+			inDryRun: true,
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0),
+				)
+			},
+		},
+		"should not build and push a promoted image": {
+			inputSvc:      "serviceA",
+			inImageDigest: "sha256:1234",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0),
+				)
+			},
+		},
 		"should return error if fail to build and push": {
 			inputSvc: "serviceA",
 			setupMocks: func(m deploySvcMocks) {
@@ -322,11 +348,13 @@ image:
 			test.setupMocks(mocks)
 			opts := deploySvcOpts{
 				deploySvcVars: deploySvcVars{
-					name: test.inputSvc,
+					name:   test.inputSvc,
+					dryRun: test.inDryRun,
 				},
 				unmarshal:          manifest.UnmarshalWorkload,
 				imageBuilderPusher: mockimageBuilderPusher,
 				ws:                 mockWorkspace,
+				imageDigest:        test.inImageDigest,
 			}
 
 			gotErr := opts.configureContainerImage()
@@ -492,3 +520,377 @@ func TestSvcDeployOpts_pushAddonsTemplateToS3Bucket(t *testing.T) {
 		})
 	}
 }
+
+func TestDryRunAddons(t *testing.T) {
+	mockError := errors.New("some error")
+	env := &config.Environment{
+		App:  "mockApp",
+		Name: "mockEnv",
+	}
+	tests := map[string]struct {
+		setupMocks func(addons *mocks.Mocktemplater, previewer *mocks.MockworkloadPreviewer)
+
+		wantedTemplate string
+		wantedURL      string
+		wantedErr      error
+	}{
+		"returns an empty template and URL if the workload doesn't have addons": {
+			setupMocks: func(addons *mocks.Mocktemplater, previewer *mocks.MockworkloadPreviewer) {
+				addons.EXPECT().Template().Return("", &addon.ErrDirNotExist{})
+				previewer.EXPECT().WorkloadStack(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"returns an error if the addons template can't be generated": {
+			setupMocks: func(addons *mocks.Mocktemplater, previewer *mocks.MockworkloadPreviewer) {
+				addons.EXPECT().Template().Return("", mockError)
+			},
+			wantedErr: fmt.Errorf("retrieve addons template: %w", mockError),
+		},
+		"returns the addons template with an empty URL if the workload isn't deployed yet": {
+			setupMocks: func(addons *mocks.Mocktemplater, previewer *mocks.MockworkloadPreviewer) {
+				addons.EXPECT().Template().Return("some data", nil)
+				previewer.EXPECT().WorkloadStack("mockApp", "mockEnv", "mockSvc").Return(nil, &awscloudformation.ErrStackNotFound{})
+			},
+			wantedTemplate: "some data",
+		},
+		"returns an error if the deployed stack can't be described": {
+			setupMocks: func(addons *mocks.Mocktemplater, previewer *mocks.MockworkloadPreviewer) {
+				addons.EXPECT().Template().Return("some data", nil)
+				previewer.EXPECT().WorkloadStack("mockApp", "mockEnv", "mockSvc").Return(nil, mockError)
+			},
+			wantedErr: fmt.Errorf("get stack of deployed mockSvc: %w", mockError),
+		},
+		"returns the addons template and the addons template URL of the deployed stack": {
+			setupMocks: func(addons *mocks.Mocktemplater, previewer *mocks.MockworkloadPreviewer) {
+				addons.EXPECT().Template().Return("some data", nil)
+				previewer.EXPECT().WorkloadStack("mockApp", "mockEnv", "mockSvc").Return(&awscloudformation.StackDescription{
+					Parameters: []*sdkcloudformation.Parameter{
+						{
+							ParameterKey:   aws.String(stack.WorkloadTaskCountParamKey),
+							ParameterValue: aws.String("1"),
+						},
+						{
+							ParameterKey:   aws.String(stack.WorkloadAddonsTemplateURLParamKey),
+							ParameterValue: aws.String("https://mockBucket.s3.us-west-2.amazonaws.com/mockSvc.addons.stack.yml"),
+						},
+					},
+				}, nil)
+			},
+			wantedTemplate: "some data",
+			wantedURL:      "https://mockBucket.s3.us-west-2.amazonaws.com/mockSvc.addons.stack.yml",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			addons := mocks.NewMocktemplater(ctrl)
+			previewer := mocks.NewMockworkloadPreviewer(ctrl)
+			tc.setupMocks(addons, previewer)
+
+			// WHEN
+			tpl, url, err := dryRunAddons(addons, previewer, env, "mockSvc")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTemplate, tpl)
+			require.Equal(t, tc.wantedURL, url)
+		})
+	}
+}
+
+func TestSvcDeployOpts_recordDeployment(t *testing.T) {
+	mockError := errors.New("some error")
+	conf := &mockStackConfig{template: "template"}
+	tests := map[string]struct {
+		inBuildRequired bool
+		setupMocks      func(appCFN *mocks.MockappResourcesGetter, digests *mocks.MockimageDigestGetter, uploader *mocks.MockobjectUploader, store *mocks.Mockstore)
+
+		wantErr error
+	}{
+		"should return error if fail to get app resources": {
+			setupMocks: func(appCFN *mocks.MockappResourcesGetter, digests *mocks.MockimageDigestGetter, uploader *mocks.MockobjectUploader, store *mocks.Mockstore) {
+				appCFN.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(nil, mockError)
+			},
+
+			wantErr: fmt.Errorf("get application mockApp resources from region us-west-2: some error"),
+		},
+		"should return error if fail to get the image digest": {
+			inBuildRequired: true,
+			setupMocks: func(appCFN *mocks.MockappResourcesGetter, digests *mocks.MockimageDigestGetter, uploader *mocks.MockobjectUploader, store *mocks.Mockstore) {
+				appCFN.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(&stack.AppRegionalResources{S3Bucket: "mockBucket"}, nil)
+				digests.EXPECT().ImageDigest("mockApp/mockSvc", "v1").Return("", mockError)
+			},
+
+			wantErr: fmt.Errorf("get digest of image v1: some error"),
+		},
+		"should record the deployment with the digest of the built image": {
+			inBuildRequired: true,
+			setupMocks: func(appCFN *mocks.MockappResourcesGetter, digests *mocks.MockimageDigestGetter, uploader *mocks.MockobjectUploader, store *mocks.Mockstore) {
+				appCFN.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(&stack.AppRegionalResources{S3Bucket: "mockBucket"}, nil)
+				digests.EXPECT().ImageDigest("mockApp/mockSvc", "v1").Return("sha256:abc", nil)
+				uploader.EXPECT().PutObject("mockBucket", gomock.Any(), gomock.Any()).Return(nil)
+				store.EXPECT().CreateServiceDeployment(gomock.Any()).Do(func(d *config.ServiceDeployment) {
+					require.Equal(t, "mockEnv", d.Env)
+					require.Equal(t, "sha256:abc", d.ImageDigest)
+					require.Equal(t, "mockBucket", d.TemplateBucket)
+				}).Return(nil)
+				store.EXPECT().ListServiceDeployments("mockApp", "mockEnv", "mockSvc").Return(nil, nil)
+			},
+		},
+		"should record the deployment without a digest if the image isn't built": {
+			setupMocks: func(appCFN *mocks.MockappResourcesGetter, digests *mocks.MockimageDigestGetter, uploader *mocks.MockobjectUploader, store *mocks.Mockstore) {
+				appCFN.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(&stack.AppRegionalResources{S3Bucket: "mockBucket"}, nil)
+				digests.EXPECT().ImageDigest(gomock.Any(), gomock.Any()).Times(0)
+				uploader.EXPECT().PutObject("mockBucket", gomock.Any(), gomock.Any()).Return(nil)
+				store.EXPECT().CreateServiceDeployment(gomock.Any()).Return(nil)
+				store.EXPECT().ListServiceDeployments("mockApp", "mockEnv", "mockSvc").Return(nil, nil)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAppCFN := mocks.NewMockappResourcesGetter(ctrl)
+			mockDigests := mocks.NewMockimageDigestGetter(ctrl)
+			mockUploader := mocks.NewMockobjectUploader(ctrl)
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockAppCFN, mockDigests, mockUploader, mockStore)
+
+			opts := deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					appName:  "mockApp",
+					name:     "mockSvc",
+					imageTag: "v1",
+				},
+				store:            mockStore,
+				appCFN:           mockAppCFN,
+				imageDigests:     mockDigests,
+				templateUploader: mockUploader,
+				targetEnvironment: &config.Environment{
+					Name:   "mockEnv",
+					Region: "us-west-2",
+				},
+				targetApp:     &config.Application{Name: "mockApp"},
+				buildRequired: tc.inBuildRequired,
+			}
+
+			gotErr := opts.recordDeployment(conf)
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestPreviewWorkload(t *testing.T) {
+	mockError := errors.New("some error")
+	conf := &mockStackConfig{template: "Resources:\n  Service:\n    Type: AWS::ECS::Service\n"}
+	tests := map[string]struct {
+		inAddons   string
+		setupMocks func(previewer *mocks.MockworkloadPreviewer, spinner *mocks.Mockprogress)
+
+		wantedContent string
+		wantErr       error
+	}{
+		"should return error if fail to get the deployed template": {
+			setupMocks: func(previewer *mocks.MockworkloadPreviewer, spinner *mocks.Mockprogress) {
+				previewer.EXPECT().WorkloadTemplate("mockApp", "mockEnv", "mockSvc").Return("", mockError)
+			},
+
+			wantErr: fmt.Errorf("get template of deployed mockSvc: some error"),
+		},
+		"should return error if fail to preview the changes": {
+			setupMocks: func(previewer *mocks.MockworkloadPreviewer, spinner *mocks.Mockprogress) {
+				previewer.EXPECT().WorkloadTemplate("mockApp", "mockEnv", "mockSvc").Return("", nil)
+				spinner.EXPECT().Start(gomock.Any())
+				previewer.EXPECT().PreviewService(conf, gomock.Any()).Return(nil, mockError)
+				spinner.EXPECT().Stop(gomock.Any())
+			},
+
+			wantErr: fmt.Errorf("preview changes of mockSvc: some error"),
+		},
+		"should write the resource changes and the template diff": {
+			setupMocks: func(previewer *mocks.MockworkloadPreviewer, spinner *mocks.Mockprogress) {
+				previewer.EXPECT().WorkloadTemplate("mockApp", "mockEnv", "mockSvc").Return("Resources:\n  Service:\n    Type: AWS::ECS::TaskDefinition\n", nil)
+				spinner.EXPECT().Start(gomock.Any())
+				previewer.EXPECT().PreviewService(conf, gomock.Any()).Return([]awscloudformation.ResourceChange{
+					{
+						Action:      "Modify",
+						LogicalID:   "Service",
+						Type:        "AWS::ECS::Service",
+						Replacement: "True",
+					},
+					{
+						Action:    "Remove",
+						LogicalID: "TaskDefinition",
+						Type:      "AWS::ECS::TaskDefinition",
+					},
+				}, nil)
+				spinner.EXPECT().Stop(gomock.Any())
+			},
+
+			wantedContent: `Resource changes:
+Action  Logical ID      Type                      Replacement
+------  ----------      ----                      -----------
+Modify  Service         AWS::ECS::Service         True
+Remove  TaskDefinition  AWS::ECS::TaskDefinition  -
+
+Template changes:
+--- deployed
++++ proposed
+@@ -1,3 +1,3 @@
+ Resources:
+   Service:
+-    Type: AWS::ECS::TaskDefinition
++    Type: AWS::ECS::Service
+`,
+		},
+		"should write the whole template if the workload isn't deployed": {
+			setupMocks: func(previewer *mocks.MockworkloadPreviewer, spinner *mocks.Mockprogress) {
+				previewer.EXPECT().WorkloadTemplate("mockApp", "mockEnv", "mockSvc").Return("", &awscloudformation.ErrStackNotFound{})
+				spinner.EXPECT().Start(gomock.Any())
+				previewer.EXPECT().PreviewService(conf, gomock.Any()).Return([]awscloudformation.ResourceChange{}, nil)
+				spinner.EXPECT().Stop(gomock.Any())
+			},
+
+			wantedContent: `No resource changes.
+
+Template changes:
+--- deployed
++++ proposed
+@@ -0,0 +1,3 @@
++Resources:
++  Service:
++    Type: AWS::ECS::Service
+`,
+		},
+		"should write that there are no changes": {
+			setupMocks: func(previewer *mocks.MockworkloadPreviewer, spinner *mocks.Mockprogress) {
+				previewer.EXPECT().WorkloadTemplate("mockApp", "mockEnv", "mockSvc").Return(conf.template, nil)
+				spinner.EXPECT().Start(gomock.Any())
+				previewer.EXPECT().PreviewService(conf, gomock.Any()).Return([]awscloudformation.ResourceChange{}, nil)
+				spinner.EXPECT().Stop(gomock.Any())
+			},
+
+			wantedContent: "No resource changes.\n\nNo template changes.\n",
+		},
+		"should return error if fail to get the deployed addons template": {
+			inAddons: "Resources:\n  Table:\n    Type: AWS::DynamoDB::Table\n",
+			setupMocks: func(previewer *mocks.MockworkloadPreviewer, spinner *mocks.Mockprogress) {
+				previewer.EXPECT().WorkloadTemplate("mockApp", "mockEnv", "mockSvc").Return(conf.template, nil)
+				spinner.EXPECT().Start(gomock.Any())
+				previewer.EXPECT().PreviewService(conf, gomock.Any()).Return([]awscloudformation.ResourceChange{}, nil)
+				spinner.EXPECT().Stop(gomock.Any())
+				previewer.EXPECT().WorkloadAddonsTemplate("mockApp", "mockEnv", "mockSvc").Return("", mockError)
+			},
+
+			wantErr: fmt.Errorf("get addons template of deployed mockSvc: some error"),
+		},
+		"should write the addons template diff": {
+			inAddons: "Resources:\n  Table:\n    Type: AWS::DynamoDB::Table\n",
+			setupMocks: func(previewer *mocks.MockworkloadPreviewer, spinner *mocks.Mockprogress) {
+				previewer.EXPECT().WorkloadTemplate("mockApp", "mockEnv", "mockSvc").Return(conf.template, nil)
+				spinner.EXPECT().Start(gomock.Any())
+				previewer.EXPECT().PreviewService(conf, gomock.Any()).Return([]awscloudformation.ResourceChange{}, nil)
+				spinner.EXPECT().Stop(gomock.Any())
+				previewer.EXPECT().WorkloadAddonsTemplate("mockApp", "mockEnv", "mockSvc").Return("Resources:\n  Table:\n    Type: AWS::S3::Bucket\n", nil)
+			},
+
+			wantedContent: `No resource changes.
+The resource changes don't include the resources of the addons of mockSvc: the addons template isn't uploaded in a dry run.
+
+No template changes.
+
+Addons template changes:
+--- deployed
++++ proposed
+@@ -1,3 +1,3 @@
+ Resources:
+   Table:
+-    Type: AWS::S3::Bucket
++    Type: AWS::DynamoDB::Table
+`,
+		},
+		"should write the whole addons template if the addons aren't deployed": {
+			inAddons: "Resources:\n  Table:\n    Type: AWS::DynamoDB::Table\n",
+			setupMocks: func(previewer *mocks.MockworkloadPreviewer, spinner *mocks.Mockprogress) {
+				previewer.EXPECT().WorkloadTemplate("mockApp", "mockEnv", "mockSvc").Return(conf.template, nil)
+				spinner.EXPECT().Start(gomock.Any())
+				previewer.EXPECT().PreviewService(conf, gomock.Any()).Return([]awscloudformation.ResourceChange{}, nil)
+				spinner.EXPECT().Stop(gomock.Any())
+				previewer.EXPECT().WorkloadAddonsTemplate("mockApp", "mockEnv", "mockSvc").Return("", &awscloudformation.ErrStackNotFound{})
+			},
+
+			wantedContent: `No resource changes.
+The resource changes don't include the resources of the addons of mockSvc: the addons template isn't uploaded in a dry run.
+
+No template changes.
+
+Addons template changes:
+--- deployed
++++ proposed
+@@ -0,0 +1,3 @@
++Resources:
++  Table:
++    Type: AWS::DynamoDB::Table
+`,
+		},
+		"should write that there are no addons template changes": {
+			inAddons: "Resources:\n  Table:\n    Type: AWS::DynamoDB::Table\n",
+			setupMocks: func(previewer *mocks.MockworkloadPreviewer, spinner *mocks.Mockprogress) {
+				previewer.EXPECT().WorkloadTemplate("mockApp", "mockEnv", "mockSvc").Return(conf.template, nil)
+				spinner.EXPECT().Start(gomock.Any())
+				previewer.EXPECT().PreviewService(conf, gomock.Any()).Return([]awscloudformation.ResourceChange{}, nil)
+				spinner.EXPECT().Stop(gomock.Any())
+				previewer.EXPECT().WorkloadAddonsTemplate("mockApp", "mockEnv", "mockSvc").Return("Resources:\n  Table:\n    Type: AWS::DynamoDB::Table\n", nil)
+			},
+
+			wantedContent: "No resource changes.\nThe resource changes don't include the resources of the addons of mockSvc: the addons template isn't uploaded in a dry run.\n\nNo template changes.\n\nNo addons template changes.\n",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPreviewer := mocks.NewMockworkloadPreviewer(ctrl)
+			mockSpinner := mocks.NewMockprogress(ctrl)
+			tc.setupMocks(mockPreviewer, mockSpinner)
+			b := &bytes.Buffer{}
+
+			gotErr := previewWorkload(previewWorkloadInput{
+				w:         b,
+				spinner:   mockSpinner,
+				previewer: mockPreviewer,
+				env: &config.Environment{
+					App:  "mockApp",
+					Name: "mockEnv",
+				},
+				name:   "mockSvc",
+				stack:  conf,
+				addons: tc.inAddons,
+			})
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,284 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/template/diff"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcDiffSvcNamePrompt = "Which service would you like to compare with its deployment?"
+	svcDiffEnvNamePrompt = "Which environment is the service deployed to?"
+)
+
+type svcDiffVars struct {
+	appName string
+	envName string
+	name    string
+	tag     string
+}
+
+type svcDiffOpts struct {
+	svcDiffVars
+
+	store            store
+	deployStore      deployedEnvironmentLister
+	ws               wsSvcReader
+	appCFN           appResourcesGetter
+	svcCFN           deployedWorkloadGetter
+	stackSerializer  func(mft interface{}, env *config.Environment, app *config.Application, rc stack.RuntimeConfig) (stackSerializer, error)
+	runner           runner
+	sel              wsSelector
+	prompt           prompter
+	w                io.Writer
+	configureClients func(o *svcDiffOpts, env *config.Environment) error
+}
+
+func newSvcDiffOpts(vars svcDiffVars) (*svcDiffOpts, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	provider := sessions.NewProvider()
+	sess, err := provider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve default session: %w", err)
+	}
+	prompter := prompt.New()
+	return &svcDiffOpts{
+		svcDiffVars:     vars,
+		store:           store,
+		deployStore:     deployStore,
+		ws:              ws,
+		appCFN:          cloudformation.New(sess),
+		stackSerializer: newWorkloadStackSerializer,
+		runner:          command.New(),
+		sel:             selector.NewWorkspaceSelect(prompter, store, ws),
+		prompt:          prompter,
+		w:               log.OutputWriter,
+		configureClients: func(o *svcDiffOpts, env *config.Environment) error {
+			envSess, err := provider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("get session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			o.svcCFN = cloudformation.New(envSess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcDiffOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.name != "" {
+		names, err := o.ws.ServiceNames()
+		if err != nil {
+			return fmt.Errorf("list services in the workspace: %w", err)
+		}
+		if !contains(o.name, names) {
+			return fmt.Errorf("service '%s' does not exist in the workspace", o.name)
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask prompts the user for any missing required fields.
+func (o *svcDiffOpts) Ask() error {
+	if o.name == "" {
+		name, err := o.sel.Service(svcDiffSvcNamePrompt, "")
+		if err != nil {
+			return fmt.Errorf("select service: %w", err)
+		}
+		o.name = name
+	}
+	if o.envName == "" {
+		name, err := o.sel.Environment(svcDiffEnvNamePrompt, "", o.appName)
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		o.envName = name
+	}
+	tag, err := askImageTag(o.tag, o.prompt, o.runner)
+	if err != nil {
+		return err
+	}
+	o.tag = tag
+	return nil
+}
+
+// Execute compares the template and parameters that "svc package" generates with the ones of the deployed stack.
+// It writes the differences and returns an error if there are any.
+func (o *svcDiffOpts) Execute() error {
+	env, err := targetEnv(o.store, o.appName, o.envName)
+	if err != nil {
+		return err
+	}
+	if err := o.configureClients(o, env); err != nil {
+		return err
+	}
+	pkg := &packageSvcOpts{
+		packageSvcVars: packageSvcVars{
+			appName: o.appName,
+			envName: o.envName,
+			name:    o.name,
+			tag:     o.tag,
+		},
+		store:           o.store,
+		deployStore:     o.deployStore,
+		ws:              o.ws,
+		appCFN:          o.appCFN,
+		stackSerializer: o.stackSerializer,
+	}
+	local, err := pkg.getSvcTemplates(env)
+	if err != nil {
+		return err
+	}
+
+	deployedTpl, err := o.svcCFN.WorkloadTemplate(o.appName, o.envName, o.name)
+	if err != nil {
+		var errStackNotFound *awscloudformation.ErrStackNotFound
+		if errors.As(err, &errStackNotFound) {
+			return fmt.Errorf("service %s is not deployed in environment %s", o.name, o.envName)
+		}
+		return fmt.Errorf("get template of deployed service %s: %w", o.name, err)
+	}
+	descr, err := o.svcCFN.WorkloadStack(o.appName, o.envName, o.name)
+	if err != nil {
+		return fmt.Errorf("describe stack of deployed service %s: %w", o.name, err)
+	}
+	deployedConf, err := stackConfigurationJSON(descr)
+	if err != nil {
+		return err
+	}
+
+	tplChanges, err := diff.YAML([]byte(deployedTpl), []byte(local.stack))
+	if err != nil {
+		return fmt.Errorf("compare templates of service %s: %w", o.name, err)
+	}
+	paramChanges, err := diff.YAML(deployedConf, []byte(local.configuration))
+	if err != nil {
+		return fmt.Errorf("compare parameters of service %s: %w", o.name, err)
+	}
+	paramChanges = withoutAddonsTemplateURL(paramChanges)
+
+	if len(tplChanges) == 0 && len(paramChanges) == 0 {
+		log.Successf("Service %s in environment %s is up to date with its deployment.\n",
+			color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName))
+		return nil
+	}
+	writeChanges(o.w, "Template changes:", tplChanges)
+	writeChanges(o.w, "Parameter changes:", paramChanges)
+	return fmt.Errorf("service %s differs from its deployment in environment %s", o.name, o.envName)
+}
+
+// stackConfigurationJSON returns the parameters and tags of the stack in the same format as "svc package".
+func stackConfigurationJSON(descr *awscloudformation.StackDescription) ([]byte, error) {
+	conf := struct {
+		Parameters map[string]string `json:"Parameters"`
+		Tags       map[string]string `json:"Tags,omitempty"`
+	}{
+		Parameters: make(map[string]string),
+		Tags:       make(map[string]string),
+	}
+	for _, param := range descr.Parameters {
+		conf.Parameters[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
+	}
+	for _, tag := range descr.Tags {
+		conf.Tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	out, err := json.Marshal(conf)
+	if err != nil {
+		return nil, fmt.Errorf("marshal stack configuration: %w", err)
+	}
+	return out, nil
+}
+
+// withoutAddonsTemplateURL removes the change to the addons template URL parameter.
+// The addons template is uploaded to a new URL on every deployment, and "svc package" doesn't upload it.
+func withoutAddonsTemplateURL(changes []diff.Change) []diff.Change {
+	var filtered []diff.Change
+	for _, change := range changes {
+		if change.Path == "Parameters."+stack.WorkloadAddonsTemplateURLParamKey {
+			continue
+		}
+		filtered = append(filtered, change)
+	}
+	return filtered
+}
+
+func writeChanges(w io.Writer, title string, changes []diff.Change) {
+	if len(changes) == 0 {
+		return
+	}
+	fmt.Fprintln(w, title)
+	for _, change := range changes {
+		fmt.Fprintf(w, "  %s\n", change)
+	}
+}
+
+// buildSvcDiffCmd builds the command for comparing a service in the workspace with its deployment.
+func buildSvcDiffCmd() *cobra.Command {
+	vars := svcDiffVars{}
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Compares a service in your workspace with its deployment.",
+		Long: `Compares the CloudFormation template and parameters of a service in your workspace with the ones deployed to an environment.
+The command exits with a non-zero status if there are differences.`,
+		Example: `
+  Compare the "frontend" service with its deployment in the "prod" environment.
+  /code $ copilot svc diff -n frontend -e prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcDiffOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVar(&vars.tag, imageTagFlag, "", imageTagFlagDescription)
+	return cmd
+}
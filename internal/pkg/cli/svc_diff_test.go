@@ -0,0 +1,166 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcDiffOpts_Execute(t *testing.T) {
+	const (
+		localTemplate = `Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: !Ref TaskCount
+`
+		localParams = `{
+  "Parameters" : {
+    "AddonsTemplateURL": "",
+    "TaskCount": "2"
+  },
+  "Tags": {
+    "copilot-application": "my-app"
+  }
+}`
+	)
+	deployedStack := &awscloudformation.StackDescription{
+		Parameters: []*sdkcloudformation.Parameter{
+			{
+				ParameterKey:   aws.String("AddonsTemplateURL"),
+				ParameterValue: aws.String("https://my-bucket.s3.amazonaws.com/manual/1607000000/my-svc.addons.stack.yml"),
+			},
+			{
+				ParameterKey:   aws.String("TaskCount"),
+				ParameterValue: aws.String("2"),
+			},
+		},
+		Tags: []*sdkcloudformation.Tag{
+			{
+				Key:   aws.String("copilot-application"),
+				Value: aws.String("my-app"),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockdeployedWorkloadGetter)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if the service is not deployed": {
+			setupMocks: func(m *mocks.MockdeployedWorkloadGetter) {
+				m.EXPECT().WorkloadTemplate("my-app", "test", "my-svc").Return("", &awscloudformation.ErrStackNotFound{})
+			},
+
+			wantedError: errors.New("service my-svc is not deployed in environment test"),
+		},
+		"errors if fail to describe the deployed stack": {
+			setupMocks: func(m *mocks.MockdeployedWorkloadGetter) {
+				m.EXPECT().WorkloadTemplate("my-app", "test", "my-svc").Return(localTemplate, nil)
+				m.EXPECT().WorkloadStack("my-app", "test", "my-svc").Return(nil, mockError)
+			},
+
+			wantedError: errors.New("describe stack of deployed service my-svc: mock error"),
+		},
+		"succeeds if the only difference is the addons template URL": {
+			setupMocks: func(m *mocks.MockdeployedWorkloadGetter) {
+				m.EXPECT().WorkloadTemplate("my-app", "test", "my-svc").Return(`Resources:
+  Service:
+    Properties:
+      DesiredCount:
+        Ref: TaskCount
+    Type: AWS::ECS::Service
+`, nil)
+				m.EXPECT().WorkloadStack("my-app", "test", "my-svc").Return(deployedStack, nil)
+			},
+		},
+		"writes the differences and errors": {
+			setupMocks: func(m *mocks.MockdeployedWorkloadGetter) {
+				m.EXPECT().WorkloadTemplate("my-app", "test", "my-svc").Return(`Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 1
+`, nil)
+				m.EXPECT().WorkloadStack("my-app", "test", "my-svc").Return(&awscloudformation.StackDescription{
+					Parameters: deployedStack.Parameters[:1],
+					Tags:       deployedStack.Tags,
+				}, nil)
+			},
+
+			wantedContent: `Template changes:
+  ~ Resources.Service.Properties.DesiredCount: 1 -> {"Ref":"TaskCount"}
+Parameter changes:
+  + Parameters.TaskCount: "2"
+`,
+			wantedError: errors.New("service my-svc differs from its deployment in environment test"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockStore.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{App: "my-app", Name: "test"}, nil)
+			mockStore.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			mockWs := mocks.NewMockwsSvcReader(ctrl)
+			mockWs.EXPECT().ReadServiceManifest("my-svc").Return([]byte(`name: my-svc
+type: Backend Service
+image:
+  location: nginx
+`), nil)
+			mockSvcCFN := mocks.NewMockdeployedWorkloadGetter(ctrl)
+			tc.setupMocks(mockSvcCFN)
+			b := &bytes.Buffer{}
+
+			opts := &svcDiffOpts{
+				svcDiffVars: svcDiffVars{
+					appName: "my-app",
+					envName: "test",
+					name:    "my-svc",
+					tag:     "v1",
+				},
+				store: mockStore,
+				ws:    mockWs,
+				stackSerializer: func(_ interface{}, _ *config.Environment, _ *config.Application, _ stack.RuntimeConfig) (stackSerializer, error) {
+					mockStackSerializer := mocks.NewMockstackSerializer(ctrl)
+					mockStackSerializer.EXPECT().Template().Return(localTemplate, nil)
+					mockStackSerializer.EXPECT().SerializedParameters().Return(localParams, nil)
+					return mockStackSerializer, nil
+				},
+				w: b,
+				configureClients: func(o *svcDiffOpts, env *config.Environment) error {
+					o.svcCFN = mockSvcCFN
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
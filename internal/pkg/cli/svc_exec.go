@@ -0,0 +1,264 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcExecNamePrompt     = "Into which service would you like to execute?"
+	svcExecNameHelpPrompt = `Copilot runs your command in one of your chosen service's tasks.
+Unless a task ID is provided, the first running task is used, along with its first essential container.`
+
+	defaultExecCommand = "/bin/sh"
+
+	fmtSvcExecNotEnabled = `execute command is not enabled for service %s in environment %s: set "exec: true" in its manifest and run "copilot svc deploy -n %s" to enable it`
+
+	ecsServiceResourceType = "ecs:service"
+	ecsTaskStatusRunning   = "RUNNING"
+)
+
+type execVars struct {
+	appName       string
+	envName       string
+	name          string
+	command       string
+	taskID        string
+	containerName string
+}
+
+type svcExecOpts struct {
+	execVars
+
+	store store
+	sel   deploySelector
+
+	// Clients configured against the environment of the service.
+	rgSvc     resourcesByTagsGetter
+	ecsSvc    ecsServiceCommandExecutor
+	ssmPlugin ssmSessionStarter
+
+	configureClients func(o *svcExecOpts, env *config.Environment) error
+}
+
+func newSvcExecOpts(vars execVars) (*svcExecOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcExecOpts{
+		execVars: vars,
+		store:    configStore,
+		sel:      selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		configureClients: func(o *svcExecOpts, env *config.Environment) error {
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("get session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			o.rgSvc = resourcegroups.New(sess)
+			o.ecsSvc = ecs.New(sess)
+			o.ssmPlugin = exec.NewSSMPluginCommand(env.Region)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcExecOpts) Validate() error {
+	if err := validateDeployedSvcFlags(o.store, o.appName, o.envName, o.name); err != nil {
+		return err
+	}
+	return validateExecCommand(o.command)
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcExecOpts) Ask() error {
+	deployedService, err := o.sel.DeployedService(svcExecNamePrompt, svcExecNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed service for application %s: %w", o.appName, err)
+	}
+	o.name = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute executes the command in a running container of the service.
+func (o *svcExecOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	if err := o.configureClients(o, env); err != nil {
+		return err
+	}
+	clusterName, serviceName, err := ecsClusterAndServiceNames(o.rgSvc, o.appName, o.envName, o.name)
+	if err != nil {
+		return err
+	}
+	tasks, err := o.ecsSvc.ServiceTasks(clusterName, serviceName)
+	if err != nil {
+		return fmt.Errorf("get tasks of service %s: %w", o.name, err)
+	}
+	task, err := selectExecTask(tasks, o.taskID)
+	if err != nil {
+		return fmt.Errorf("service %s in environment %s: %w", o.name, o.envName, err)
+	}
+	if !task.ExecuteCommandEnabled() {
+		// Services only allow executing commands once they opt in from their manifest.
+		return fmt.Errorf(fmtSvcExecNotEnabled, o.name, o.envName, o.name)
+	}
+	container := o.containerName
+	if container == "" {
+		// The main container of a service is named after it.
+		container = o.name
+	}
+	return execInTask(o.ecsSvc, o.ssmPlugin, execInTaskInput{
+		cluster:   clusterName,
+		task:      task,
+		container: container,
+		command:   o.command,
+	})
+}
+
+// ecsServiceARN returns the ARN of the ECS service of a service deployed in an environment.
+func ecsServiceARN(getter resourcesByTagsGetter, app, env, svc string) (*ecs.ServiceArn, error) {
+	resources, err := getter.GetResourcesByTags(ecsServiceResourceType, map[string]string{
+		deploy.AppTagKey:     app,
+		deploy.EnvTagKey:     env,
+		deploy.ServiceTagKey: svc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get ECS service of %s: %w", svc, err)
+	}
+	if len(resources) == 0 {
+		return nil, fmt.Errorf("cannot find the ECS service of %s in environment %s", svc, env)
+	}
+	arn := ecs.ServiceArn(resources[0].ARN)
+	return &arn, nil
+}
+
+func validateExecCommand(command string) error {
+	if strings.TrimSpace(command) == "" {
+		return fmt.Errorf("--%s cannot be empty", commandFlag)
+	}
+	return nil
+}
+
+// selectExecTask returns the running task whose ID starts with taskID, or the first running task if taskID is empty.
+func selectExecTask(tasks []*ecs.Task, taskID string) (*ecs.Task, error) {
+	var running []*ecs.Task
+	for _, task := range tasks {
+		if aws.StringValue(task.LastStatus) == ecsTaskStatusRunning {
+			running = append(running, task)
+		}
+	}
+	if len(running) == 0 {
+		return nil, fmt.Errorf("no running tasks found")
+	}
+	if taskID == "" {
+		return running[0], nil
+	}
+	for _, task := range running {
+		id, err := ecs.TaskID(aws.StringValue(task.TaskArn))
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasPrefix(id, taskID) {
+			return task, nil
+		}
+	}
+	return nil, fmt.Errorf("no running task found with ID %s", taskID)
+}
+
+type execInTaskInput struct {
+	cluster   string
+	task      *ecs.Task
+	container string
+	command   string
+}
+
+// execInTask executes the command in the container of the task and connects the terminal to it until the command exits.
+func execInTask(executor ecsCommandExecutor, plugin ssmSessionStarter, in execInTaskInput) error {
+	taskID, err := ecs.TaskID(aws.StringValue(in.task.TaskArn))
+	if err != nil {
+		return err
+	}
+	if !in.task.ExecuteCommandEnabled() {
+		return fmt.Errorf("execute command is not enabled for task %s, redeploy to enable it", taskID)
+	}
+	// Check for the plugin first, otherwise the session opened on the task is left behind until it times out.
+	if err := plugin.ValidateInstalled(); err != nil {
+		return err
+	}
+	log.Infof("Execute %s in container %s of task %s.\n",
+		color.HighlightCode(in.command), color.HighlightUserInput(in.container), color.HighlightResource(taskID))
+	sess, err := executor.ExecuteCommand(ecs.ExecuteCommandInput{
+		Cluster:   in.cluster,
+		Command:   in.command,
+		Task:      taskID,
+		Container: in.container,
+	})
+	if err != nil {
+		return err
+	}
+	if err := plugin.StartSession(sess); err != nil {
+		return fmt.Errorf("start session for task %s: %w", taskID, err)
+	}
+	return nil
+}
+
+// buildSvcExecCmd builds the command for executing commands in the running containers of a service.
+func buildSvcExecCmd() *cobra.Command {
+	vars := execVars{}
+	cmd := &cobra.Command{
+		Use:   "exec",
+		Short: "Execute a command in a running container part of a service.",
+		Long: `Execute a command in a running container part of a service.
+The Session Manager plugin for the AWS CLI must be installed, and the service must set "exec: true" in its manifest.`,
+		Example: `
+  Start an interactive bash session with a task part of the "frontend" service.
+  /code $ copilot svc exec -a my-app -e test -n frontend --command bash
+  Open a shell in a specific task and container of the "backend" service.
+  /code $ copilot svc exec -a my-app -e test -n backend --task-id 8c38184 --container nginx`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcExecOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVar(&vars.command, commandFlag, defaultExecCommand, execCommandFlagDescription)
+	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", execTaskIDFlagDescription)
+	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", execContainerFlagDescription)
+	return cmd
+}
@@ -0,0 +1,371 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcExecOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp     string
+		inputSvc     string
+		inputEnv     string
+		inputCommand string
+		setupMocks   func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"errors if no app in workspace": {
+			inputCommand: "/bin/sh",
+			setupMocks:   func(m *mocks.Mockstore) {},
+
+			wantedError: errNoAppInWorkspace,
+		},
+		"errors if the service does not exist": {
+			inputApp:     "my-app",
+			inputSvc:     "my-svc",
+			inputCommand: "/bin/sh",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetService("my-app", "my-svc").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"errors if the command is empty": {
+			inputApp:     "my-app",
+			inputEnv:     "test",
+			inputCommand: " ",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+			},
+
+			wantedError: errors.New("--command cannot be empty"),
+		},
+		"success": {
+			inputApp:     "my-app",
+			inputSvc:     "my-svc",
+			inputEnv:     "test",
+			inputCommand: "/bin/sh",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetService("my-app", "my-svc").Return(&config.Workload{Name: "my-svc"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+
+			opts := &svcExecOpts{
+				execVars: execVars{
+					appName: tc.inputApp,
+					name:    tc.inputSvc,
+					envName: tc.inputEnv,
+					command: tc.inputCommand,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSvcExecOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockdeploySelector)
+
+		wantedSvc   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"errors if fail to select the service": {
+			setupMocks: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedService(svcExecNamePrompt, svcExecNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("select deployed service for application my-app: %w", mockError),
+		},
+		"success": {
+			setupMocks: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedService(svcExecNamePrompt, svcExecNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Svc: "my-svc", Env: "test"}, nil)
+			},
+
+			wantedSvc: "my-svc",
+			wantedEnv: "test",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSel := mocks.NewMockdeploySelector(ctrl)
+			tc.setupMocks(mockSel)
+
+			opts := &svcExecOpts{
+				execVars: execVars{
+					appName: "my-app",
+				},
+				sel: mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedSvc, opts.name)
+				require.Equal(t, tc.wantedEnv, opts.envName)
+			}
+		})
+	}
+}
+
+type svcExecMocks struct {
+	store     *mocks.Mockstore
+	rg        *mocks.MockresourcesByTagsGetter
+	ecs       *mocks.MockecsServiceCommandExecutor
+	ssmPlugin *mocks.MockssmSessionStarter
+}
+
+func TestSvcExecOpts_Execute(t *testing.T) {
+	const (
+		mockServiceARN = "arn:aws:ecs:us-west-2:123456789012:service/my-app-test-Cluster-abc/my-app-test-my-svc-Service-xyz"
+		mockCluster    = "my-app-test-Cluster-abc"
+		mockService    = "my-app-test-my-svc-Service-xyz"
+	)
+	mockSession := &ecs.Session{
+		SessionId:  aws.String("mockSessionID"),
+		StreamUrl:  aws.String("mockStreamURL"),
+		TokenValue: aws.String("mockToken"),
+	}
+	mockTags := map[string]string{
+		deploy.AppTagKey:     "my-app",
+		deploy.EnvTagKey:     "test",
+		deploy.ServiceTagKey: "my-svc",
+	}
+	runningTask := func(id string, enabled bool) *ecs.Task {
+		return &ecs.Task{
+			TaskArn:              aws.String("arn:aws:ecs:us-west-2:123456789012:task/" + mockCluster + "/" + id),
+			LastStatus:           aws.String("RUNNING"),
+			EnableExecuteCommand: aws.Bool(enabled),
+		}
+	}
+	testCases := map[string]struct {
+		inputTaskID    string
+		inputContainer string
+		setupMocks     func(m svcExecMocks)
+
+		wantedError error
+	}{
+		"errors if fail to find the service": {
+			setupMocks: func(m svcExecMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.rg.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return(nil, nil)
+			},
+
+			wantedError: errors.New("cannot find the ECS service of my-svc in environment test"),
+		},
+		"errors if fail to get the tasks of the service": {
+			setupMocks: func(m svcExecMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.rg.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).
+					Return([]*resourcegroups.Resource{{ARN: mockServiceARN}}, nil)
+				m.ecs.EXPECT().ServiceTasks(mockCluster, mockService).Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("get tasks of service my-svc: %w", mockError),
+		},
+		"errors if there are no running tasks": {
+			setupMocks: func(m svcExecMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.rg.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).
+					Return([]*resourcegroups.Resource{{ARN: mockServiceARN}}, nil)
+				m.ecs.EXPECT().ServiceTasks(mockCluster, mockService).Return([]*ecs.Task{
+					{
+						TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789012:task/" + mockCluster + "/abc"),
+						LastStatus: aws.String("PROVISIONING"),
+					},
+				}, nil)
+			},
+
+			wantedError: errors.New("service my-svc in environment test: no running tasks found"),
+		},
+		"errors if no running task matches the task ID": {
+			inputTaskID: "def",
+			setupMocks: func(m svcExecMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.rg.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).
+					Return([]*resourcegroups.Resource{{ARN: mockServiceARN}}, nil)
+				m.ecs.EXPECT().ServiceTasks(mockCluster, mockService).Return([]*ecs.Task{runningTask("abc123", true)}, nil)
+			},
+
+			wantedError: errors.New("service my-svc in environment test: no running task found with ID def"),
+		},
+		"errors with how to enable execute command if it's not enabled for the service": {
+			setupMocks: func(m svcExecMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.rg.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).
+					Return([]*resourcegroups.Resource{{ARN: mockServiceARN}}, nil)
+				m.ecs.EXPECT().ServiceTasks(mockCluster, mockService).Return([]*ecs.Task{runningTask("abc123", false)}, nil)
+			},
+
+			wantedError: errors.New(`execute command is not enabled for service my-svc in environment test: set "exec: true" in its manifest and run "copilot svc deploy -n my-svc" to enable it`),
+		},
+		"errors without executing the command if the Session Manager plugin is not installed": {
+			setupMocks: func(m svcExecMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.rg.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).
+					Return([]*resourcegroups.Resource{{ARN: mockServiceARN}}, nil)
+				m.ecs.EXPECT().ServiceTasks(mockCluster, mockService).Return([]*ecs.Task{runningTask("abc123", true)}, nil)
+				m.ssmPlugin.EXPECT().ValidateInstalled().Return(exec.ErrSSMPluginNotExist)
+				m.ecs.EXPECT().ExecuteCommand(gomock.Any()).Times(0)
+				m.ssmPlugin.EXPECT().StartSession(gomock.Any()).Times(0)
+			},
+
+			wantedError: exec.ErrSSMPluginNotExist,
+		},
+		"errors if fail to start the session": {
+			setupMocks: func(m svcExecMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.rg.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).
+					Return([]*resourcegroups.Resource{{ARN: mockServiceARN}}, nil)
+				m.ecs.EXPECT().ServiceTasks(mockCluster, mockService).Return([]*ecs.Task{runningTask("abc123", true)}, nil)
+				m.ssmPlugin.EXPECT().ValidateInstalled().Return(nil)
+				m.ecs.EXPECT().ExecuteCommand(gomock.Any()).Return(mockSession, nil)
+				m.ssmPlugin.EXPECT().StartSession(mockSession).Return(mockError)
+			},
+
+			wantedError: fmt.Errorf("start session for task abc123: %w", mockError),
+		},
+		"executes the command in the main container of the first running task": {
+			setupMocks: func(m svcExecMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.rg.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).
+					Return([]*resourcegroups.Resource{{ARN: mockServiceARN}}, nil)
+				m.ecs.EXPECT().ServiceTasks(mockCluster, mockService).Return([]*ecs.Task{
+					{
+						TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789012:task/" + mockCluster + "/stopped"),
+						LastStatus: aws.String(awsecs.DesiredStatusStopped),
+					},
+					runningTask("abc123", true),
+					runningTask("def456", true),
+				}, nil)
+				m.ssmPlugin.EXPECT().ValidateInstalled().Return(nil)
+				m.ecs.EXPECT().ExecuteCommand(ecs.ExecuteCommandInput{
+					Cluster:   mockCluster,
+					Command:   "/bin/sh",
+					Task:      "abc123",
+					Container: "my-svc",
+				}).Return(mockSession, nil)
+				m.ssmPlugin.EXPECT().StartSession(mockSession).Return(nil)
+			},
+		},
+		"executes the command in the chosen task and container": {
+			inputTaskID:    "def",
+			inputContainer: "nginx",
+			setupMocks: func(m svcExecMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.rg.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).
+					Return([]*resourcegroups.Resource{{ARN: mockServiceARN}}, nil)
+				m.ecs.EXPECT().ServiceTasks(mockCluster, mockService).Return([]*ecs.Task{
+					runningTask("abc123", true),
+					runningTask("def456", true),
+				}, nil)
+				m.ssmPlugin.EXPECT().ValidateInstalled().Return(nil)
+				m.ecs.EXPECT().ExecuteCommand(ecs.ExecuteCommandInput{
+					Cluster:   mockCluster,
+					Command:   "/bin/sh",
+					Task:      "def456",
+					Container: "nginx",
+				}).Return(mockSession, nil)
+				m.ssmPlugin.EXPECT().StartSession(mockSession).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := svcExecMocks{
+				store:     mocks.NewMockstore(ctrl),
+				rg:        mocks.NewMockresourcesByTagsGetter(ctrl),
+				ecs:       mocks.NewMockecsServiceCommandExecutor(ctrl),
+				ssmPlugin: mocks.NewMockssmSessionStarter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &svcExecOpts{
+				execVars: execVars{
+					appName:       "my-app",
+					envName:       "test",
+					name:          "my-svc",
+					command:       "/bin/sh",
+					taskID:        tc.inputTaskID,
+					containerName: tc.inputContainer,
+				},
+				store: m.store,
+				configureClients: func(o *svcExecOpts, env *config.Environment) error {
+					o.rgSvc = m.rg
+					o.ecsSvc = m.ecs
+					o.ssmPlugin = m.ssmPlugin
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
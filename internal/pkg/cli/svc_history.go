@@ -0,0 +1,163 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcHistoryNamePrompt     = "Which service's deployments would you like to show?"
+	svcHistoryNameHelpPrompt = "The recorded deployments of the service in the environment will be shown."
+
+	shortImageDigestLen  = 12
+	shortTemplateHashLen = 8
+)
+
+type svcHistoryVars struct {
+	appName          string
+	envName          string
+	svcName          string
+	shouldOutputJSON bool
+}
+
+type svcHistoryOpts struct {
+	svcHistoryVars
+
+	w     io.Writer
+	store store
+	sel   deploySelector
+}
+
+func newSvcHistoryOpts(vars svcHistoryVars) (*svcHistoryOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcHistoryOpts{
+		svcHistoryVars: vars,
+		w:              log.OutputWriter,
+		store:          configStore,
+		sel:            selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcHistoryOpts) Validate() error {
+	return validateDeployedSvcFlags(o.store, o.appName, o.envName, o.svcName)
+}
+
+// Ask prompts for the service to show the deployments of if it's not provided.
+func (o *svcHistoryOpts) Ask() error {
+	deployedService, err := o.sel.DeployedService(svcHistoryNamePrompt, svcHistoryNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed service for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute writes the recorded deployments of the service in the environment, the most recent first.
+func (o *svcHistoryOpts) Execute() error {
+	deployments, err := o.store.ListServiceDeployments(o.appName, o.envName, o.svcName)
+	if err != nil {
+		return err
+	}
+	if o.shouldOutputJSON {
+		data, err := json.Marshal(struct {
+			Deployments []*config.ServiceDeployment `json:"deployments"`
+		}{
+			Deployments: deployments,
+		})
+		if err != nil {
+			return fmt.Errorf("marshal deployments: %w", err)
+		}
+		fmt.Fprintf(o.w, "%s\n", data)
+		return nil
+	}
+	if len(deployments) == 0 {
+		log.Infof("No deployments of service %s in environment %s were recorded.\n", o.svcName, o.envName)
+		return nil
+	}
+	writer := tabwriter.NewWriter(o.w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(writer, "ID\tDeployed At\tImage Digest\tTemplate Hash\tNote")
+	fmt.Fprintln(writer, "--\t-----------\t------------\t-------------\t----")
+	for i, d := range deployments {
+		var notes []string
+		if i == 0 {
+			notes = append(notes, "current")
+		}
+		if d.RolledBackTo != "" {
+			notes = append(notes, fmt.Sprintf("rollback to %s", d.RolledBackTo))
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", d.ID, d.DeployedAt.UTC().Format(time.RFC3339),
+			shorten(strings.TrimPrefix(d.ImageDigest, "sha256:"), shortImageDigestLen),
+			shorten(d.TemplateHash, shortTemplateHashLen),
+			orDash(strings.Join(notes, ", ")))
+	}
+	return writer.Flush()
+}
+
+func shorten(s string, n int) string {
+	if len(s) > n {
+		s = s[:n]
+	}
+	return orDash(s)
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// buildSvcHistoryCmd builds the command for listing the deployments of a service.
+func buildSvcHistoryCmd() *cobra.Command {
+	vars := svcHistoryVars{}
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Lists the deployments of a service.",
+		Long: `Lists the recorded deployments of a service in an environment, the most recent first.
+A service can be rolled back to any of these deployments with "copilot svc rollback".`,
+		Example: `
+  List the deployments of the "frontend" service in the "test" environment.
+  /code $ copilot svc history -n frontend -e test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcHistoryOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,100 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcHistoryOpts_Execute(t *testing.T) {
+	deployments := []*config.ServiceDeployment{
+		{
+			ID:           "20201203101530",
+			DeployedAt:   time.Date(2020, 12, 3, 10, 15, 30, 0, time.UTC),
+			ImageDigest:  "sha256:69671a968e8ec3648e2697417750e",
+			TemplateHash: "5cde0f1298f41f7d",
+			RolledBackTo: "20201201101530",
+		},
+		{
+			ID:           "20201202101530",
+			DeployedAt:   time.Date(2020, 12, 2, 10, 15, 30, 0, time.UTC),
+			TemplateHash: "1a2b3c4d5e6f",
+		},
+	}
+	testCases := map[string]struct {
+		inJSON     bool
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if fail to list the deployments": {
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().ListServiceDeployments("my-app", "test", "my-svc").Return(nil, mockError)
+			},
+
+			wantedError: mockError,
+		},
+		"writes the deployments": {
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().ListServiceDeployments("my-app", "test", "my-svc").Return(deployments, nil)
+			},
+
+			wantedContent: `ID              Deployed At           Image Digest  Template Hash  Note
+--              -----------           ------------  -------------  ----
+20201203101530  2020-12-03T10:15:30Z  69671a968e8e  5cde0f12       current, rollback to 20201201101530
+20201202101530  2020-12-02T10:15:30Z  -             1a2b3c4d       -
+`,
+		},
+		"writes the deployments in JSON": {
+			inJSON: true,
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().ListServiceDeployments("my-app", "test", "my-svc").Return(deployments[1:], nil)
+			},
+
+			wantedContent: `{"deployments":[{"app":"","env":"","name":"","id":"20201202101530","deployedAt":"2020-12-02T10:15:30Z","templateHash":"1a2b3c4d5e6f","templateBucket":"","templateKey":"","parameters":null}]}` + "\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+			b := &bytes.Buffer{}
+
+			opts := &svcHistoryOpts{
+				svcHistoryVars: svcHistoryVars{
+					appName:          "my-app",
+					envName:          "test",
+					svcName:          "my-svc",
+					shouldOutputJSON: tc.inJSON,
+				},
+				w:     b,
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
@@ -55,6 +56,7 @@ type packageSvcOpts struct {
 	initAddonsSvc   func(*packageSvcOpts) error // Overridden in tests.
 	ws              wsSvcReader
 	store           store
+	deployStore     deployedEnvironmentLister
 	appCFN          appResourcesGetter
 	stackWriter     io.Writer
 	paramsWriter    io.Writer
@@ -75,6 +77,10 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("connect to config store: %w", err)
 	}
+	deployStore, err := deploy.NewStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
 	p := sessions.NewProvider()
 	sess, err := p.Default()
 	if err != nil {
@@ -86,6 +92,7 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 		initAddonsSvc:  initPackageAddonsSvc,
 		ws:             ws,
 		store:          store,
+		deployStore:    deployStore,
 		appCFN:         cloudformation.New(sess),
 		runner:         command.New(),
 		sel:            selector.NewWorkspaceSelect(prompter, store, ws),
@@ -95,33 +102,52 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 		addonsWriter:   ioutil.Discard,
 		fs:             &afero.Afero{Fs: afero.NewOsFs()},
 	}
+	opts.stackSerializer = newWorkloadStackSerializer
+	return opts, nil
+}
 
-	opts.stackSerializer = func(mft interface{}, env *config.Environment, app *config.Application, rc stack.RuntimeConfig) (stackSerializer, error) {
-		var serializer stackSerializer
-		switch v := mft.(type) {
-		case *manifest.LoadBalancedWebService:
-			if app.RequiresDNSDelegation() {
-				serializer, err = stack.NewHTTPSLoadBalancedWebService(v, env.Name, app.Name, rc)
-				if err != nil {
-					return nil, fmt.Errorf("init https load balanced web service stack serializer: %w", err)
-				}
-			} else {
-				serializer, err = stack.NewLoadBalancedWebService(v, env.Name, app.Name, rc)
-				if err != nil {
-					return nil, fmt.Errorf("init load balanced web service stack serializer: %w", err)
-				}
+// newWorkloadStackSerializer returns the stack serializer for the workload manifest.
+func newWorkloadStackSerializer(mft interface{}, env *config.Environment, app *config.Application, rc stack.RuntimeConfig) (stackSerializer, error) {
+	return newWorkloadStack(mft, env, app, rc)
+}
+
+// workloadStack is the CloudFormation stack of a service or job, that can be deployed or serialized.
+type workloadStack interface {
+	cloudformation.StackConfiguration
+	stackSerializer
+}
+
+// newWorkloadStack returns the stack of the workload manifest for the environment.
+func newWorkloadStack(mft interface{}, env *config.Environment, app *config.Application, rc stack.RuntimeConfig) (workloadStack, error) {
+	var serializer workloadStack
+	var err error
+	switch v := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		if app.RequiresDNSDelegation() {
+			serializer, err = stack.NewHTTPSLoadBalancedWebService(v, env.Name, app.Name, rc)
+			if err != nil {
+				return nil, fmt.Errorf("init https load balanced web service stack serializer: %w", err)
 			}
-		case *manifest.BackendService:
-			serializer, err = stack.NewBackendService(v, env.Name, app.Name, rc)
+		} else {
+			serializer, err = stack.NewLoadBalancedWebService(v, env.Name, app.Name, rc)
 			if err != nil {
-				return nil, fmt.Errorf("init backend service stack serializer: %w", err)
+				return nil, fmt.Errorf("init load balanced web service stack serializer: %w", err)
 			}
-		default:
-			return nil, fmt.Errorf("create stack serializer for manifest of type %T", v)
 		}
-		return serializer, nil
+	case *manifest.BackendService:
+		serializer, err = stack.NewBackendService(v, env.Name, app.Name, rc)
+		if err != nil {
+			return nil, fmt.Errorf("init backend service stack serializer: %w", err)
+		}
+	case *manifest.ScheduledJob:
+		serializer, err = stack.NewScheduledJob(v, env.Name, app.Name, rc)
+		if err != nil {
+			return nil, fmt.Errorf("init scheduled job stack serializer: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("create stack serializer for manifest of type %T", v)
 	}
-	return opts, nil
+	return serializer, nil
 }
 
 // Validate returns an error if the values provided by the user are invalid.
@@ -263,8 +289,21 @@ func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*svcCfnTempla
 	if err != nil {
 		return nil, err
 	}
+	endpoints, err := dependencyEndpoints(dependencyEndpointsInput{
+		app:         o.appName,
+		env:         env.Name,
+		svc:         o.name,
+		deps:        manifest.ServiceDependencies(mft),
+		deployStore: o.deployStore,
+		ws:          o.ws,
+		unmarshal:   manifest.UnmarshalWorkload,
+	})
+	if err != nil {
+		return nil, err
+	}
 	rc := stack.RuntimeConfig{
-		AdditionalTags: app.Tags,
+		AdditionalTags:      app.Tags,
+		DependencyEndpoints: endpoints,
 	}
 	if imgNeedsBuild {
 		resources, err := o.appCFN.GetAppResourcesByRegion(app, env.Region)
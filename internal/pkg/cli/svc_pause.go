@@ -0,0 +1,285 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcPauseNamePrompt       = "Which service would you like to pause?"
+	svcPauseNameHelpPrompt   = "The service will be scaled down to zero tasks until it's resumed."
+	fmtSvcPauseConfirmPrompt = "Are you sure you want to pause %s in environment %s?"
+	svcPauseConfirmHelp      = "This will stop all the running tasks of the service."
+
+	fmtSvcPauseStart    = "Pausing service %s in environment %s."
+	fmtSvcPauseFailed   = "Failed to pause service %s in environment %s: %v.\n"
+	fmtSvcPauseComplete = "Paused service %s in environment %s.\n"
+)
+
+var (
+	errSvcPauseCancelled = errors.New("svc pause cancelled - no changes made")
+)
+
+type svcPauseVars struct {
+	appName          string
+	envName          string
+	svcName          string
+	skipConfirmation bool
+	force            bool
+	stealLock        bool
+}
+
+type svcPauseOpts struct {
+	svcPauseVars
+
+	store   store
+	sel     deploySelector
+	prompt  prompter
+	spinner progress
+
+	// Clients configured against the environment of the service.
+	rgSvc  resourcesByTagsGetter
+	ecsSvc ecsServiceScaler
+	aasSvc serviceScalingSuspender
+
+	configureClients func(o *svcPauseOpts, env *config.Environment) error
+
+	// Cached variables.
+	alreadyPaused bool
+}
+
+func newSvcPauseOpts(vars svcPauseVars) (*svcPauseOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	prompter := prompt.New()
+	return &svcPauseOpts{
+		svcPauseVars: vars,
+		store:        configStore,
+		sel:          selector.NewDeploySelect(prompter, configStore, deployStore),
+		prompt:       prompter,
+		spinner:      termprogress.NewSpinner(),
+		configureClients: func(o *svcPauseOpts, env *config.Environment) error {
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("get session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			o.rgSvc = resourcegroups.New(sess)
+			o.ecsSvc = ecs.New(sess)
+			o.aasSvc = aas.New(sess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcPauseOpts) Validate() error {
+	return validateDeployedSvcFlags(o.store, o.appName, o.envName, o.svcName)
+}
+
+// Ask prompts for the service to pause if it's not provided, and confirms the pause.
+func (o *svcPauseOpts) Ask() error {
+	deployedService, err := o.sel.DeployedService(svcPauseNamePrompt, svcPauseNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed service for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+
+	if o.skipConfirmation {
+		return nil
+	}
+	confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtSvcPauseConfirmPrompt, o.svcName, o.envName), svcPauseConfirmHelp)
+	if err != nil {
+		return fmt.Errorf("svc pause confirmation prompt: %w", err)
+	}
+	if !confirmed {
+		return errSvcPauseCancelled
+	}
+	return nil
+}
+
+// Execute scales the service down to zero tasks and suspends its auto scaling.
+// The desired count of the service is recorded in the config store so that it can be resumed.
+func (o *svcPauseOpts) Execute() (err error) {
+	locker := newDeployLocker(o.store, o.spinner, o.stealLock)
+	lock, err := locker.acquire(o.appName, o.envName, o.svcName)
+	if err != nil {
+		return err
+	}
+	defer locker.releaseOnReturn(lock, &err)
+
+	_, err = o.store.GetPausedService(o.appName, o.envName, o.svcName)
+	if err == nil {
+		o.alreadyPaused = true
+		log.Infof("Service %s is already paused in environment %s.\n", color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName))
+		return nil
+	}
+	var errNotPaused *config.ErrServiceNotPaused
+	if !errors.As(err, &errNotPaused) {
+		return err
+	}
+
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	guard := prodGuard{
+		force:  o.force,
+		prompt: o.prompt,
+	}
+	if err := guard.confirm(env, fmt.Sprintf("pause service %s in it", o.svcName)); err != nil {
+		return err
+	}
+	if err := o.configureClients(o, env); err != nil {
+		return err
+	}
+	clusterName, serviceName, err := ecsClusterAndServiceNames(o.rgSvc, o.appName, o.envName, o.svcName)
+	if err != nil {
+		return err
+	}
+	svc, err := o.ecsSvc.Service(clusterName, serviceName)
+	if err != nil {
+		return fmt.Errorf("get ECS service of %s: %w", o.svcName, err)
+	}
+
+	o.spinner.Start(fmt.Sprintf(fmtSvcPauseStart, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName)))
+	if err := o.pause(clusterName, serviceName, aws.Int64Value(svc.DesiredCount)); err != nil {
+		o.spinner.Stop(log.Serrorf(fmtSvcPauseFailed, o.svcName, o.envName, err))
+		return err
+	}
+	o.spinner.Stop(log.Ssuccessf(fmtSvcPauseComplete, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName)))
+	return nil
+}
+
+func (o *svcPauseOpts) pause(clusterName, serviceName string, desiredCount int64) error {
+	// Record the desired count first so that the service can always be resumed to it.
+	if err := o.store.PauseService(&config.PausedService{
+		App:          o.appName,
+		Env:          o.envName,
+		Name:         o.svcName,
+		DesiredCount: desiredCount,
+	}); err != nil {
+		return err
+	}
+	// Otherwise scaling policies would scale the service out again.
+	if err := o.aasSvc.SuspendECSServiceScaling(clusterName, serviceName); err != nil {
+		return err
+	}
+	return o.ecsSvc.UpdateServiceDesiredCount(clusterName, serviceName, 0)
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *svcPauseOpts) RecommendedActions() []string {
+	if o.alreadyPaused {
+		return nil
+	}
+	return []string{
+		fmt.Sprintf("Run %s to restore the tasks of the service.",
+			color.HighlightCode(fmt.Sprintf("copilot svc resume -n %s -e %s", o.svcName, o.envName))),
+	}
+}
+
+// validateDeployedSvcFlags returns an error if the application, environment, or service
+// passed as flags don't exist in the config store.
+func validateDeployedSvcFlags(store store, appName, envName, svcName string) error {
+	if appName == "" {
+		return errNoAppInWorkspace
+	}
+	if _, err := store.GetApplication(appName); err != nil {
+		return err
+	}
+	if svcName != "" {
+		if _, err := store.GetService(appName, svcName); err != nil {
+			return err
+		}
+	}
+	if envName != "" {
+		if _, err := store.GetEnvironment(appName, envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ecsClusterAndServiceNames returns the names of the ECS cluster and service of a service deployed in an environment.
+func ecsClusterAndServiceNames(getter resourcesByTagsGetter, app, env, svc string) (clusterName string, serviceName string, err error) {
+	svcARN, err := ecsServiceARN(getter, app, env, svc)
+	if err != nil {
+		return "", "", err
+	}
+	clusterName, err = svcARN.ClusterName()
+	if err != nil {
+		return "", "", fmt.Errorf("get cluster name: %w", err)
+	}
+	serviceName, err = svcARN.ServiceName()
+	if err != nil {
+		return "", "", fmt.Errorf("get service name: %w", err)
+	}
+	return clusterName, serviceName, nil
+}
+
+// buildSvcPauseCmd builds the command for scaling a service down to zero tasks.
+func buildSvcPauseCmd() *cobra.Command {
+	vars := svcPauseVars{}
+	cmd := &cobra.Command{
+		Use:   "pause",
+		Short: "Scales a service down to zero tasks.",
+		Long: `Scales a service down to zero tasks and suspends its auto scaling.
+The service keeps its resources and can be restored with "copilot svc resume".`,
+		Example: `
+  Pause the "frontend" service in the "test" environment.
+  /code $ copilot svc pause -n frontend -e test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcPauseOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			if actions := opts.RecommendedActions(); len(actions) > 0 {
+				log.Infoln("Recommended follow-up actions:")
+				for _, followup := range actions {
+					log.Infof("- %s\n", followup)
+				}
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&vars.force, forceFlag, false, forceFlagDescription)
+	cmd.Flags().BoolVar(&vars.stealLock, stealLockFlag, false, stealLockFlagDescription)
+	return cmd
+}
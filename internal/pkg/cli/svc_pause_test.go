@@ -0,0 +1,382 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcPauseOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp   string
+		inputEnv   string
+		inputSvc   string
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"errors if app is not specified": {
+			setupMocks: func(m *mocks.Mockstore) {},
+
+			wantedError: errNoAppInWorkspace,
+		},
+		"errors if the service does not exist": {
+			inputApp: "my-app",
+			inputSvc: "my-svc",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetService("my-app", "my-svc").Return(nil, mockError)
+			},
+
+			wantedError: mockError,
+		},
+		"errors if the environment does not exist": {
+			inputApp: "my-app",
+			inputEnv: "test",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(nil, mockError)
+			},
+
+			wantedError: mockError,
+		},
+		"success": {
+			inputApp: "my-app",
+			inputEnv: "test",
+			inputSvc: "my-svc",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetService("my-app", "my-svc").Return(&config.Workload{Name: "my-svc"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+
+			opts := &svcPauseOpts{
+				svcPauseVars: svcPauseVars{
+					appName: tc.inputApp,
+					envName: tc.inputEnv,
+					svcName: tc.inputSvc,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSvcPauseOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		skipConfirmation bool
+		setupMocks       func(sel *mocks.MockdeploySelector, prompt *mocks.Mockprompter)
+
+		wantedError error
+	}{
+		"errors if fail to select the service": {
+			setupMocks: func(sel *mocks.MockdeploySelector, prompt *mocks.Mockprompter) {
+				sel.EXPECT().DeployedService(svcPauseNamePrompt, svcPauseNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("select deployed service for application my-app: %w", mockError),
+		},
+		"errors if the pause is not confirmed": {
+			setupMocks: func(sel *mocks.MockdeploySelector, prompt *mocks.Mockprompter) {
+				sel.EXPECT().DeployedService(svcPauseNamePrompt, svcPauseNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Svc: "my-svc", Env: "test"}, nil)
+				prompt.EXPECT().Confirm(fmt.Sprintf(fmtSvcPauseConfirmPrompt, "my-svc", "test"), svcPauseConfirmHelp).Return(false, nil)
+			},
+
+			wantedError: errSvcPauseCancelled,
+		},
+		"errors if the confirmation prompt fails": {
+			setupMocks: func(sel *mocks.MockdeploySelector, prompt *mocks.Mockprompter) {
+				sel.EXPECT().DeployedService(svcPauseNamePrompt, svcPauseNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Svc: "my-svc", Env: "test"}, nil)
+				prompt.EXPECT().Confirm(fmt.Sprintf(fmtSvcPauseConfirmPrompt, "my-svc", "test"), svcPauseConfirmHelp).Return(false, mockError)
+			},
+
+			wantedError: fmt.Errorf("svc pause confirmation prompt: %w", mockError),
+		},
+		"skips the confirmation with --yes": {
+			skipConfirmation: true,
+			setupMocks: func(sel *mocks.MockdeploySelector, prompt *mocks.Mockprompter) {
+				sel.EXPECT().DeployedService(svcPauseNamePrompt, svcPauseNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Svc: "my-svc", Env: "test"}, nil)
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"success": {
+			setupMocks: func(sel *mocks.MockdeploySelector, prompt *mocks.Mockprompter) {
+				sel.EXPECT().DeployedService(svcPauseNamePrompt, svcPauseNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Svc: "my-svc", Env: "test"}, nil)
+				prompt.EXPECT().Confirm(fmt.Sprintf(fmtSvcPauseConfirmPrompt, "my-svc", "test"), svcPauseConfirmHelp).Return(true, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSel := mocks.NewMockdeploySelector(ctrl)
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(mockSel, mockPrompt)
+
+			opts := &svcPauseOpts{
+				svcPauseVars: svcPauseVars{
+					appName:          "my-app",
+					skipConfirmation: tc.skipConfirmation,
+				},
+				sel:    mockSel,
+				prompt: mockPrompt,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "my-svc", opts.svcName)
+				require.Equal(t, "test", opts.envName)
+			}
+		})
+	}
+}
+
+type svcScalingMocks struct {
+	store   *mocks.Mockstore
+	spinner *mocks.Mockprogress
+	prompt  *mocks.Mockprompter
+	rg      *mocks.MockresourcesByTagsGetter
+	ecs     *mocks.MockecsServiceScaler
+	aas     *mocks.MockserviceScalingSuspender
+}
+
+func newSvcScalingMocks(ctrl *gomock.Controller) svcScalingMocks {
+	return svcScalingMocks{
+		store:   mocks.NewMockstore(ctrl),
+		spinner: mocks.NewMockprogress(ctrl),
+		prompt:  mocks.NewMockprompter(ctrl),
+		rg:      mocks.NewMockresourcesByTagsGetter(ctrl),
+		ecs:     mocks.NewMockecsServiceScaler(ctrl),
+		aas:     mocks.NewMockserviceScalingSuspender(ctrl),
+	}
+}
+
+const (
+	mockScalingServiceARN = "arn:aws:ecs:us-west-2:123456789012:service/my-app-test-Cluster-abc/my-app-test-my-svc-Service-xyz"
+	mockScalingCluster    = "my-app-test-Cluster-abc"
+	mockScalingService    = "my-app-test-my-svc-Service-xyz"
+)
+
+var mockScalingTags = map[string]string{
+	deploy.AppTagKey:     "my-app",
+	deploy.EnvTagKey:     "test",
+	deploy.ServiceTagKey: "my-svc",
+}
+
+func TestSvcPauseOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inForce    bool
+		setupMocks func(m svcScalingMocks)
+
+		wantedError         error
+		wantedAlreadyPaused bool
+	}{
+		"does nothing if the service is already paused": {
+			setupMocks: func(m svcScalingMocks) {
+				m.store.EXPECT().GetPausedService("my-app", "test", "my-svc").Return(&config.PausedService{DesiredCount: 2}, nil)
+			},
+
+			wantedAlreadyPaused: true,
+		},
+		"errors if fail to check if the service is paused": {
+			setupMocks: func(m svcScalingMocks) {
+				m.store.EXPECT().GetPausedService("my-app", "test", "my-svc").Return(nil, mockError)
+			},
+
+			wantedError: mockError,
+		},
+		"errors if fail to get the environment": {
+			setupMocks: func(m svcScalingMocks) {
+				m.store.EXPECT().GetPausedService("my-app", "test", "my-svc").Return(nil, &config.ErrServiceNotPaused{})
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("get environment test: %w", mockError),
+		},
+		"errors if the production environment is not confirmed": {
+			setupMocks: func(m svcScalingMocks) {
+				m.store.EXPECT().GetPausedService("my-app", "test", "my-svc").Return(nil, &config.ErrServiceNotPaused{})
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test", Prod: true}, nil)
+				m.prompt.EXPECT().Get(gomock.Any(), prodConfirmHelp, nil).Return("prod", nil)
+			},
+
+			wantedError: errProdConfirmationMismatch,
+		},
+		"errors if fail to find the service": {
+			setupMocks: func(m svcScalingMocks) {
+				m.store.EXPECT().GetPausedService("my-app", "test", "my-svc").Return(nil, &config.ErrServiceNotPaused{})
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.rg.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockScalingTags).Return(nil, nil)
+			},
+
+			wantedError: errors.New("cannot find the ECS service of my-svc in environment test"),
+		},
+		"errors if fail to describe the service": {
+			setupMocks: func(m svcScalingMocks) {
+				m.store.EXPECT().GetPausedService("my-app", "test", "my-svc").Return(nil, &config.ErrServiceNotPaused{})
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.rg.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockScalingTags).
+					Return([]*resourcegroups.Resource{{ARN: mockScalingServiceARN}}, nil)
+				m.ecs.EXPECT().Service(mockScalingCluster, mockScalingService).Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("get ECS service of my-svc: %w", mockError),
+		},
+		"errors if fail to suspend auto scaling": {
+			setupMocks: func(m svcScalingMocks) {
+				m.store.EXPECT().GetPausedService("my-app", "test", "my-svc").Return(nil, &config.ErrServiceNotPaused{})
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.rg.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockScalingTags).
+					Return([]*resourcegroups.Resource{{ARN: mockScalingServiceARN}}, nil)
+				m.ecs.EXPECT().Service(mockScalingCluster, mockScalingService).Return(&ecs.Service{DesiredCount: aws.Int64(3)}, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.store.EXPECT().PauseService(&config.PausedService{App: "my-app", Env: "test", Name: "my-svc", DesiredCount: 3}).Return(nil)
+				m.aas.EXPECT().SuspendECSServiceScaling(mockScalingCluster, mockScalingService).Return(mockError)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+
+			wantedError: mockError,
+		},
+		"pauses a service in a production environment once confirmed": {
+			setupMocks: func(m svcScalingMocks) {
+				gomock.InOrder(
+					m.store.EXPECT().GetPausedService("my-app", "test", "my-svc").Return(nil, &config.ErrServiceNotPaused{}),
+					m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test", Prod: true}, nil),
+					m.prompt.EXPECT().Get(gomock.Any(), prodConfirmHelp, nil).Return("test", nil),
+					m.rg.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockScalingTags).
+						Return([]*resourcegroups.Resource{{ARN: mockScalingServiceARN}}, nil),
+					m.ecs.EXPECT().Service(mockScalingCluster, mockScalingService).Return(&ecs.Service{DesiredCount: aws.Int64(3)}, nil),
+					m.spinner.EXPECT().Start(gomock.Any()),
+					m.store.EXPECT().PauseService(&config.PausedService{App: "my-app", Env: "test", Name: "my-svc", DesiredCount: 3}).Return(nil),
+					m.aas.EXPECT().SuspendECSServiceScaling(mockScalingCluster, mockScalingService).Return(nil),
+					m.ecs.EXPECT().UpdateServiceDesiredCount(mockScalingCluster, mockScalingService, int64(0)).Return(nil),
+					m.spinner.EXPECT().Stop(gomock.Any()),
+				)
+			},
+		},
+		"skips the production confirmation if forced": {
+			inForce: true,
+			setupMocks: func(m svcScalingMocks) {
+				gomock.InOrder(
+					m.store.EXPECT().GetPausedService("my-app", "test", "my-svc").Return(nil, &config.ErrServiceNotPaused{}),
+					m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test", Prod: true}, nil),
+					m.rg.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockScalingTags).
+						Return([]*resourcegroups.Resource{{ARN: mockScalingServiceARN}}, nil),
+					m.ecs.EXPECT().Service(mockScalingCluster, mockScalingService).Return(&ecs.Service{DesiredCount: aws.Int64(3)}, nil),
+					m.spinner.EXPECT().Start(gomock.Any()),
+					m.store.EXPECT().PauseService(&config.PausedService{App: "my-app", Env: "test", Name: "my-svc", DesiredCount: 3}).Return(nil),
+					m.aas.EXPECT().SuspendECSServiceScaling(mockScalingCluster, mockScalingService).Return(nil),
+					m.ecs.EXPECT().UpdateServiceDesiredCount(mockScalingCluster, mockScalingService, int64(0)).Return(nil),
+					m.spinner.EXPECT().Stop(gomock.Any()),
+				)
+			},
+		},
+		"success": {
+			setupMocks: func(m svcScalingMocks) {
+				gomock.InOrder(
+					m.store.EXPECT().GetPausedService("my-app", "test", "my-svc").Return(nil, &config.ErrServiceNotPaused{}),
+					m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil),
+					m.rg.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockScalingTags).
+						Return([]*resourcegroups.Resource{{ARN: mockScalingServiceARN}}, nil),
+					m.ecs.EXPECT().Service(mockScalingCluster, mockScalingService).Return(&ecs.Service{DesiredCount: aws.Int64(3)}, nil),
+					m.spinner.EXPECT().Start(gomock.Any()),
+					m.store.EXPECT().PauseService(&config.PausedService{App: "my-app", Env: "test", Name: "my-svc", DesiredCount: 3}).Return(nil),
+					m.aas.EXPECT().SuspendECSServiceScaling(mockScalingCluster, mockScalingService).Return(nil),
+					m.ecs.EXPECT().UpdateServiceDesiredCount(mockScalingCluster, mockScalingService, int64(0)).Return(nil),
+					m.spinner.EXPECT().Stop(gomock.Any()),
+				)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newSvcScalingMocks(ctrl)
+			m.store.EXPECT().AcquireDeployLock(gomock.Any()).Return(nil)
+			m.store.EXPECT().ReleaseDeployLock(gomock.Any()).Return(nil)
+			tc.setupMocks(m)
+
+			opts := &svcPauseOpts{
+				svcPauseVars: svcPauseVars{
+					appName: "my-app",
+					envName: "test",
+					svcName: "my-svc",
+					force:   tc.inForce,
+				},
+				store:   m.store,
+				prompt:  m.prompt,
+				spinner: m.spinner,
+				configureClients: func(o *svcPauseOpts, env *config.Environment) error {
+					o.rgSvc = m.rg
+					o.ecsSvc = m.ecs
+					o.aasSvc = m.aas
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedAlreadyPaused, opts.alreadyPaused)
+			}
+		})
+	}
+}
@@ -0,0 +1,364 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/docker"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/repository"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcPromoteNamePrompt     = "Which service would you like to promote?"
+	svcPromoteNameHelpPrompt = "The image running in the environment is deployed to another environment."
+	svcPromoteToEnvPrompt    = "Which environment would you like to promote the service to?"
+	svcPromoteToEnvHelp      = "The service is deployed to the environment with the image running in the source environment."
+)
+
+type svcPromoteVars struct {
+	appName        string
+	name           string
+	fromEnv        string
+	toEnv          string
+	resourceTags   map[string]string
+	force          bool
+	allowDirty     bool
+	allowAnyBranch bool
+	stealLock      bool
+}
+
+type svcPromoteOpts struct {
+	svcPromoteVars
+
+	store         store
+	ws            wsSvcReader
+	unmarshal     func([]byte) (interface{}, error)
+	sel           deployEnvSelector
+	dockerService repository.ContainerLoginPullPusher
+
+	// Clients configured against the source environment of the service.
+	rgSvc  resourcesByTagsGetter
+	ecsSvc ecsServiceTasksDescriber
+
+	configureClients func(o *svcPromoteOpts, env *config.Environment) error
+	// Clients configured against the repository of the service in a region, overridden in tests.
+	newRepository func(repoName, region string) (imagePullPusher, error)
+	newRegistry   func(region string) (imageDigestGetter, error)
+	// newSvcDeployer returns the command that deploys the service to the target environment with the image of the digest.
+	newSvcDeployer func(vars deploySvcVars, imageDigest string) (actionCommand, error)
+}
+
+func newSvcPromoteOpts(vars svcPromoteVars) (*svcPromoteOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	provider := sessions.NewProvider()
+	return &svcPromoteOpts{
+		svcPromoteVars: vars,
+		store:          configStore,
+		ws:             ws,
+		unmarshal:      manifest.UnmarshalWorkload,
+		sel:            selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		dockerService:  docker.New(),
+		configureClients: func(o *svcPromoteOpts, env *config.Environment) error {
+			sess, err := provider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("get session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			o.rgSvc = resourcegroups.New(sess)
+			o.ecsSvc = ecs.New(sess)
+			return nil
+		},
+		newRepository: func(repoName, region string) (imagePullPusher, error) {
+			sess, err := provider.DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create ECR session with region %s: %w", region, err)
+			}
+			return repository.New(repoName, ecr.New(sess))
+		},
+		newRegistry: func(region string) (imageDigestGetter, error) {
+			sess, err := provider.DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create ECR session with region %s: %w", region, err)
+			}
+			return ecr.New(sess), nil
+		},
+		newSvcDeployer: func(vars deploySvcVars, imageDigest string) (actionCommand, error) {
+			opts, err := newSvcDeployOpts(vars)
+			if err != nil {
+				return nil, err
+			}
+			opts.imageDigest = imageDigest
+			return opts, nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcPromoteOpts) Validate() error {
+	if err := validateDeployedSvcFlags(o.store, o.appName, o.fromEnv, o.name); err != nil {
+		return err
+	}
+	if o.toEnv != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.toEnv); err != nil {
+			return err
+		}
+	}
+	if o.fromEnv != "" && o.fromEnv == o.toEnv {
+		return fmt.Errorf("cannot promote service to the environment it is promoted from")
+	}
+	return nil
+}
+
+// Ask prompts for the service, the environment to promote it from and the environment to promote it to if they're not provided.
+func (o *svcPromoteOpts) Ask() error {
+	deployedService, err := o.sel.DeployedService(svcPromoteNamePrompt, svcPromoteNameHelpPrompt, o.appName, selector.WithEnv(o.fromEnv), selector.WithSvc(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed service for application %s: %w", o.appName, err)
+	}
+	o.name = deployedService.Svc
+	o.fromEnv = deployedService.Env
+	if o.toEnv != "" {
+		return nil
+	}
+	env, err := o.sel.Environment(svcPromoteToEnvPrompt, svcPromoteToEnvHelp, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment to promote service %s to: %w", o.name, err)
+	}
+	if env == o.fromEnv {
+		return fmt.Errorf("cannot promote service to the environment it is promoted from")
+	}
+	o.toEnv = env
+	return nil
+}
+
+// Execute finds the image running in the source environment, copies it to the repository of the target environment's
+// region if it isn't there yet, and deploys the service to the target environment with the image pinned to its digest.
+func (o *svcPromoteOpts) Execute() error {
+	if err := o.validateImageBuilt(); err != nil {
+		return err
+	}
+	from, err := o.store.GetEnvironment(o.appName, o.fromEnv)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.fromEnv, err)
+	}
+	to, err := o.store.GetEnvironment(o.appName, o.toEnv)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.toEnv, err)
+	}
+	if err := o.configureClients(o, from); err != nil {
+		return err
+	}
+	image, digest, err := o.runningImage()
+	if err != nil {
+		return err
+	}
+	tag := promotedImageTag(image, digest)
+	log.Infof("Promoting image %s of service %s from environment %s to %s.\n",
+		color.HighlightResource(digest), color.HighlightUserInput(o.name), color.HighlightUserInput(o.fromEnv), color.HighlightUserInput(o.toEnv))
+	if err := o.copyImage(from.Region, to.Region, digest, tag); err != nil {
+		return err
+	}
+
+	deployer, err := o.newSvcDeployer(deploySvcVars{
+		appName:        o.appName,
+		name:           o.name,
+		envName:        o.toEnv,
+		imageTag:       tag,
+		resourceTags:   o.resourceTags,
+		force:          o.force,
+		allowDirty:     o.allowDirty,
+		allowAnyBranch: o.allowAnyBranch,
+		stealLock:      o.stealLock,
+	}, digest)
+	if err != nil {
+		return err
+	}
+	if err := deployer.Validate(); err != nil {
+		return err
+	}
+	return deployer.Execute()
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *svcPromoteOpts) RecommendedActions() []string {
+	return []string{
+		fmt.Sprintf("Run %s to see the deployments of the service.",
+			color.HighlightCode(fmt.Sprintf("copilot svc history -n %s -e %s", o.name, o.toEnv))),
+	}
+}
+
+// validateImageBuilt returns an error if the image of the service is not built by Copilot, since there is then
+// no image in the application's repositories to promote.
+func (o *svcPromoteOpts) validateImageBuilt() error {
+	wl, err := readLocalWorkload(o.name, "service", o.ws.ReadServiceManifest, o.unmarshal)
+	if err != nil {
+		return err
+	}
+	required, err := manifest.ServiceDockerfileBuildRequired(wl.manifest)
+	if err != nil {
+		return err
+	}
+	if !required {
+		return fmt.Errorf("service %s uses an existing image instead of building one, there is no image to promote", o.name)
+	}
+	return nil
+}
+
+// runningImage returns the image and image digest of the main container of the running tasks of the primary
+// deployment of the service in the source environment.
+func (o *svcPromoteOpts) runningImage() (image string, digest string, err error) {
+	clusterName, serviceName, err := ecsClusterAndServiceNames(o.rgSvc, o.appName, o.fromEnv, o.name)
+	if err != nil {
+		return "", "", err
+	}
+	service, err := o.ecsSvc.Service(clusterName, serviceName)
+	if err != nil {
+		return "", "", fmt.Errorf("get ECS service of %s: %w", o.name, err)
+	}
+	var taskDefinition string
+	for _, deployment := range service.Deployments {
+		if aws.StringValue(deployment.Status) == ecsPrimaryDeploymentStatus {
+			taskDefinition = aws.StringValue(deployment.TaskDefinition)
+		}
+	}
+	tasks, err := o.ecsSvc.ServiceTasks(clusterName, serviceName)
+	if err != nil {
+		return "", "", fmt.Errorf("get tasks of service %s: %w", o.name, err)
+	}
+	for _, task := range tasks {
+		if aws.StringValue(task.LastStatus) != ecsTaskStatusRunning || aws.StringValue(task.TaskDefinitionArn) != taskDefinition {
+			continue
+		}
+		if container := mainContainer(task, o.name); container != nil && aws.StringValue(container.ImageDigest) != "" {
+			return aws.StringValue(container.Image), aws.StringValue(container.ImageDigest), nil
+		}
+	}
+	return "", "", fmt.Errorf("no running task of the latest deployment of service %s found in environment %s", o.name, o.fromEnv)
+}
+
+// mainContainer returns the container of the task named after the service.
+func mainContainer(task *ecs.Task, name string) *awsecs.Container {
+	for _, container := range task.Containers {
+		if aws.StringValue(container.Name) == name {
+			return container
+		}
+	}
+	return nil
+}
+
+// copyImage pushes the image of the digest to the repository of the service in the target region with the tag,
+// unless the image is already in the repository.
+func (o *svcPromoteOpts) copyImage(fromRegion, toRegion, digest, tag string) error {
+	if fromRegion == toRegion {
+		// The environments share the repository of the region.
+		return nil
+	}
+	repoName := fmt.Sprintf("%s/%s", o.appName, o.name)
+	registry, err := o.newRegistry(toRegion)
+	if err != nil {
+		return err
+	}
+	if existing, err := registry.ImageDigest(repoName, tag); err == nil && existing == digest {
+		log.Infof("Image %s is already in the repository of region %s.\n", color.HighlightResource(digest), toRegion)
+		return nil
+	}
+	source, err := o.newRepository(repoName, fromRegion)
+	if err != nil {
+		return fmt.Errorf("initiate repository %s in region %s: %w", repoName, fromRegion, err)
+	}
+	target, err := o.newRepository(repoName, toRegion)
+	if err != nil {
+		return fmt.Errorf("initiate repository %s in region %s: %w", repoName, toRegion, err)
+	}
+	image, err := source.Pull(o.dockerService, digest)
+	if err != nil {
+		return fmt.Errorf("pull image %s from region %s: %w", digest, fromRegion, err)
+	}
+	if err := target.PushImage(o.dockerService, image, tag); err != nil {
+		return fmt.Errorf("push image %s to region %s: %w", digest, toRegion, err)
+	}
+	return nil
+}
+
+// promotedImageTag returns the tag of the image, or a tag derived from its digest if the image is referenced by digest.
+// For example, "repo/app/svc:v1" returns "v1" and "repo/app/svc@sha256:abc" returns "sha256-abc".
+func promotedImageTag(image, digest string) string {
+	if !strings.Contains(image, "@") {
+		if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+			return image[i+1:]
+		}
+	}
+	return strings.ReplaceAll(digest, ":", "-")
+}
+
+// buildSvcPromoteCmd builds the command for promoting the image of a service from one environment to another.
+func buildSvcPromoteCmd() *cobra.Command {
+	vars := svcPromoteVars{}
+	cmd := &cobra.Command{
+		Use:   "promote",
+		Short: "Deploys the image running in one environment to another environment.",
+		Long: `Deploys the image running in one environment to another environment.
+The image is not rebuilt: the exact image tested in the source environment is deployed, pinned to its digest.`,
+		Example: `
+  Promote the "frontend" service from the "test" environment to the "prod" environment.
+  /code $ copilot svc promote -n frontend --from test --to prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcPromoteOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			log.Infoln("Recommended follow-up actions:")
+			for _, followup := range opts.RecommendedActions() {
+				log.Infof("- %s\n", followup)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVar(&vars.fromEnv, promoteFromFlag, "", promoteFromFlagDescription)
+	cmd.Flags().StringVar(&vars.toEnv, promoteToFlag, "", promoteToFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().BoolVar(&vars.force, forceFlag, false, forceFlagDescription)
+	cmd.Flags().BoolVar(&vars.allowDirty, allowDirtyFlag, false, allowDirtyFlagDescription)
+	cmd.Flags().BoolVar(&vars.allowAnyBranch, allowAnyBranchFlag, false, allowAnyBranchFlagDescription)
+	cmd.Flags().BoolVar(&vars.stealLock, stealLockFlag, false, stealLockFlagDescription)
+	return cmd
+}
@@ -0,0 +1,347 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcPromoteOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inFromEnv  string
+		inToEnv    string
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"errors if the target environment does not exist": {
+			inFromEnv: "test",
+			inToEnv:   "prod",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetService("my-app", "my-svc").Return(&config.Workload{Name: "my-svc"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "prod").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"errors if the environments are the same": {
+			inFromEnv: "test",
+			inToEnv:   "test",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetService("my-app", "my-svc").Return(&config.Workload{Name: "my-svc"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil).Times(2)
+			},
+
+			wantedError: errors.New("cannot promote service to the environment it is promoted from"),
+		},
+		"success": {
+			inFromEnv: "test",
+			inToEnv:   "prod",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetService("my-app", "my-svc").Return(&config.Workload{Name: "my-svc"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "prod").Return(&config.Environment{Name: "prod"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+			opts := &svcPromoteOpts{
+				svcPromoteVars: svcPromoteVars{
+					appName: "my-app",
+					name:    "my-svc",
+					fromEnv: tc.inFromEnv,
+					toEnv:   tc.inToEnv,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type svcPromoteMocks struct {
+	store    *mocks.Mockstore
+	ws       *mocks.MockwsSvcReader
+	rg       *mocks.MockresourcesByTagsGetter
+	ecs      *mocks.MockecsServiceTasksDescriber
+	source   *mocks.MockimagePullPusher
+	target   *mocks.MockimagePullPusher
+	registry *mocks.MockimageDigestGetter
+	deployer *mocks.MockactionCommand
+}
+
+func TestSvcPromoteOpts_Execute(t *testing.T) {
+	const (
+		mockServiceARN = "arn:aws:ecs:us-west-2:123456789012:service/my-app-test-Cluster-abc/my-app-test-my-svc-Service-xyz"
+		mockCluster    = "my-app-test-Cluster-abc"
+		mockService    = "my-app-test-my-svc-Service-xyz"
+		mockTaskDef    = "arn:aws:ecs:us-west-2:123456789012:task-definition/my-app-test-my-svc:3"
+		mockDigest     = "sha256:1234"
+		mockSourceRepo = "123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/my-svc"
+	)
+	testEnv := &config.Environment{App: "my-app", Name: "test", Region: "us-west-2"}
+	prodEnv := &config.Environment{App: "my-app", Name: "prod", Region: "us-east-1"}
+	buildMft := manifest.NewBackendService(manifest.BackendServiceProps{
+		WorkloadProps: manifest.WorkloadProps{
+			Name:       "my-svc",
+			Dockerfile: "my-svc/Dockerfile",
+		},
+		Port: 80,
+	})
+	locationMft := manifest.NewBackendService(manifest.BackendServiceProps{
+		WorkloadProps: manifest.WorkloadProps{
+			Name:  "my-svc",
+			Image: "nginx",
+		},
+		Port: 80,
+	})
+	mockTags := map[string]string{
+		deploy.AppTagKey:     "my-app",
+		deploy.EnvTagKey:     "test",
+		deploy.ServiceTagKey: "my-svc",
+	}
+	runningTask := func(taskDef, image, digest string) *ecs.Task {
+		return &ecs.Task{
+			LastStatus:        aws.String("RUNNING"),
+			TaskDefinitionArn: aws.String(taskDef),
+			Containers: []*awsecs.Container{
+				{
+					Name:  aws.String("firelens_log_router"),
+					Image: aws.String("fluentbit"),
+				},
+				{
+					Name:        aws.String("my-svc"),
+					Image:       aws.String(image),
+					ImageDigest: aws.String(digest),
+				},
+			},
+		}
+	}
+	setupRunningImage := func(m svcPromoteMocks, tasks []*ecs.Task) {
+		m.store.EXPECT().GetEnvironment("my-app", "test").Return(testEnv, nil)
+		m.store.EXPECT().GetEnvironment("my-app", "prod").Return(prodEnv, nil)
+		m.rg.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).
+			Return([]*resourcegroups.Resource{{ARN: mockServiceARN}}, nil)
+		m.ecs.EXPECT().Service(mockCluster, mockService).Return(&ecs.Service{
+			Deployments: []*awsecs.Deployment{
+				{
+					Status:         aws.String("PRIMARY"),
+					TaskDefinition: aws.String(mockTaskDef),
+				},
+				{
+					Status:         aws.String("ACTIVE"),
+					TaskDefinition: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/my-app-test-my-svc:2"),
+				},
+			},
+		}, nil)
+		m.ecs.EXPECT().ServiceTasks(mockCluster, mockService).Return(tasks, nil)
+	}
+
+	testCases := map[string]struct {
+		inManifest interface{}
+		setupMocks func(m svcPromoteMocks)
+
+		wantedDeployVars deploySvcVars
+		wantedDigest     string
+		wantedError      error
+	}{
+		"errors if the service doesn't build its image": {
+			inManifest: locationMft,
+			setupMocks: func(m svcPromoteMocks) {},
+
+			wantedError: errors.New("service my-svc uses an existing image instead of building one, there is no image to promote"),
+		},
+		"errors if no task of the latest deployment is running": {
+			inManifest: buildMft,
+			setupMocks: func(m svcPromoteMocks) {
+				setupRunningImage(m, []*ecs.Task{
+					runningTask("arn:aws:ecs:us-west-2:123456789012:task-definition/my-app-test-my-svc:2", mockSourceRepo+":v1", "sha256:old"),
+				})
+			},
+
+			wantedError: errors.New("no running task of the latest deployment of service my-svc found in environment test"),
+		},
+		"errors if fails to pull the image": {
+			inManifest: buildMft,
+			setupMocks: func(m svcPromoteMocks) {
+				setupRunningImage(m, []*ecs.Task{runningTask(mockTaskDef, mockSourceRepo+":v2", mockDigest)})
+				m.registry.EXPECT().ImageDigest("my-app/my-svc", "v2").Return("", errors.New("not found"))
+				m.source.EXPECT().Pull(gomock.Any(), mockDigest).Return("", mockError)
+			},
+
+			wantedError: fmt.Errorf("pull image sha256:1234 from region us-west-2: %w", mockError),
+		},
+		"copies the image to the target region and deploys it pinned to its digest": {
+			inManifest: buildMft,
+			setupMocks: func(m svcPromoteMocks) {
+				setupRunningImage(m, []*ecs.Task{runningTask(mockTaskDef, mockSourceRepo+":v2", mockDigest)})
+				m.registry.EXPECT().ImageDigest("my-app/my-svc", "v2").Return("", errors.New("not found"))
+				m.source.EXPECT().Pull(gomock.Any(), mockDigest).Return(mockSourceRepo+"@"+mockDigest, nil)
+				m.target.EXPECT().PushImage(gomock.Any(), mockSourceRepo+"@"+mockDigest, "v2").Return(nil)
+				m.deployer.EXPECT().Validate().Return(nil)
+				m.deployer.EXPECT().Execute().Return(nil)
+			},
+
+			wantedDeployVars: deploySvcVars{
+				appName:  "my-app",
+				name:     "my-svc",
+				envName:  "prod",
+				imageTag: "v2",
+			},
+			wantedDigest: mockDigest,
+		},
+		"doesn't copy an image that is already in the target region": {
+			inManifest: buildMft,
+			setupMocks: func(m svcPromoteMocks) {
+				setupRunningImage(m, []*ecs.Task{runningTask(mockTaskDef, mockSourceRepo+"@"+mockDigest, mockDigest)})
+				m.registry.EXPECT().ImageDigest("my-app/my-svc", "sha256-1234").Return(mockDigest, nil)
+				m.deployer.EXPECT().Validate().Return(nil)
+				m.deployer.EXPECT().Execute().Return(nil)
+			},
+
+			wantedDeployVars: deploySvcVars{
+				appName:  "my-app",
+				name:     "my-svc",
+				envName:  "prod",
+				imageTag: "sha256-1234",
+			},
+			wantedDigest: mockDigest,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcPromoteMocks{
+				store:    mocks.NewMockstore(ctrl),
+				ws:       mocks.NewMockwsSvcReader(ctrl),
+				rg:       mocks.NewMockresourcesByTagsGetter(ctrl),
+				ecs:      mocks.NewMockecsServiceTasksDescriber(ctrl),
+				source:   mocks.NewMockimagePullPusher(ctrl),
+				target:   mocks.NewMockimagePullPusher(ctrl),
+				registry: mocks.NewMockimageDigestGetter(ctrl),
+				deployer: mocks.NewMockactionCommand(ctrl),
+			}
+			m.ws.EXPECT().ReadServiceManifest("my-svc").Return([]byte("my-svc"), nil)
+			tc.setupMocks(m)
+			var deployVars deploySvcVars
+			var digest string
+			opts := &svcPromoteOpts{
+				svcPromoteVars: svcPromoteVars{
+					appName: "my-app",
+					name:    "my-svc",
+					fromEnv: "test",
+					toEnv:   "prod",
+				},
+				store: m.store,
+				ws:    m.ws,
+				unmarshal: func(in []byte) (interface{}, error) {
+					return tc.inManifest, nil
+				},
+				configureClients: func(o *svcPromoteOpts, env *config.Environment) error {
+					require.Equal(t, "test", env.Name)
+					o.rgSvc = m.rg
+					o.ecsSvc = m.ecs
+					return nil
+				},
+				newRepository: func(repoName, region string) (imagePullPusher, error) {
+					require.Equal(t, "my-app/my-svc", repoName)
+					if region == "us-west-2" {
+						return m.source, nil
+					}
+					return m.target, nil
+				},
+				newRegistry: func(region string) (imageDigestGetter, error) {
+					require.Equal(t, "us-east-1", region)
+					return m.registry, nil
+				},
+				newSvcDeployer: func(vars deploySvcVars, imageDigest string) (actionCommand, error) {
+					deployVars, digest = vars, imageDigest
+					return m.deployer, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDeployVars, deployVars)
+			require.Equal(t, tc.wantedDigest, digest)
+		})
+	}
+}
+
+func TestPromotedImageTag(t *testing.T) {
+	testCases := map[string]struct {
+		inImage  string
+		inDigest string
+
+		wanted string
+	}{
+		"returns the tag of the image": {
+			inImage:  "123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/my-svc:v1",
+			inDigest: "sha256:1234",
+
+			wanted: "v1",
+		},
+		"derives the tag from the digest if the image is referenced by digest": {
+			inImage:  "123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/my-svc@sha256:1234",
+			inDigest: "sha256:1234",
+
+			wanted: "sha256-1234",
+		},
+		"derives the tag from the digest if the image has no tag": {
+			inImage:  "localhost:5000/my-svc",
+			inDigest: "sha256:1234",
+
+			wanted: "sha256-1234",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, promotedImageTag(tc.inImage, tc.inDigest))
+		})
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcResumeNamePrompt     = "Which service would you like to resume?"
+	svcResumeNameHelpPrompt = "The service will be scaled back to the number of tasks it had before it was paused."
+
+	fmtSvcResumeStart    = "Resuming service %s in environment %s."
+	fmtSvcResumeFailed   = "Failed to resume service %s in environment %s: %v.\n"
+	fmtSvcResumeComplete = "Resumed service %s in environment %s with %d tasks.\n"
+)
+
+type svcResumeVars struct {
+	appName   string
+	envName   string
+	svcName   string
+	force     bool
+	stealLock bool
+}
+
+type svcResumeOpts struct {
+	svcResumeVars
+
+	store   store
+	sel     deploySelector
+	prompt  prompter
+	spinner progress
+
+	// Clients configured against the environment of the service.
+	rgSvc  resourcesByTagsGetter
+	ecsSvc ecsServiceScaler
+	aasSvc serviceScalingSuspender
+
+	configureClients func(o *svcResumeOpts, env *config.Environment) error
+}
+
+func newSvcResumeOpts(vars svcResumeVars) (*svcResumeOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	prompter := prompt.New()
+	return &svcResumeOpts{
+		svcResumeVars: vars,
+		store:         configStore,
+		sel:           selector.NewDeploySelect(prompter, configStore, deployStore),
+		prompt:        prompter,
+		spinner:       termprogress.NewSpinner(),
+		configureClients: func(o *svcResumeOpts, env *config.Environment) error {
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("get session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			o.rgSvc = resourcegroups.New(sess)
+			o.ecsSvc = ecs.New(sess)
+			o.aasSvc = aas.New(sess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcResumeOpts) Validate() error {
+	return validateDeployedSvcFlags(o.store, o.appName, o.envName, o.svcName)
+}
+
+// Ask prompts for the service to resume if it's not provided.
+func (o *svcResumeOpts) Ask() error {
+	deployedService, err := o.sel.DeployedService(svcResumeNamePrompt, svcResumeNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed service for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute restores the desired count of a paused service and resumes its auto scaling.
+func (o *svcResumeOpts) Execute() (err error) {
+	locker := newDeployLocker(o.store, o.spinner, o.stealLock)
+	lock, err := locker.acquire(o.appName, o.envName, o.svcName)
+	if err != nil {
+		return err
+	}
+	defer locker.releaseOnReturn(lock, &err)
+
+	paused, err := o.store.GetPausedService(o.appName, o.envName, o.svcName)
+	if err != nil {
+		var errNotPaused *config.ErrServiceNotPaused
+		if errors.As(err, &errNotPaused) {
+			log.Infof("Service %s is not paused in environment %s.\n", color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName))
+			return nil
+		}
+		return err
+	}
+
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	guard := prodGuard{
+		force:  o.force,
+		prompt: o.prompt,
+	}
+	if err := guard.confirm(env, fmt.Sprintf("resume service %s in it", o.svcName)); err != nil {
+		return err
+	}
+	if err := o.configureClients(o, env); err != nil {
+		return err
+	}
+	clusterName, serviceName, err := ecsClusterAndServiceNames(o.rgSvc, o.appName, o.envName, o.svcName)
+	if err != nil {
+		return err
+	}
+
+	o.spinner.Start(fmt.Sprintf(fmtSvcResumeStart, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName)))
+	if err := o.resume(clusterName, serviceName, paused.DesiredCount); err != nil {
+		o.spinner.Stop(log.Serrorf(fmtSvcResumeFailed, o.svcName, o.envName, err))
+		return err
+	}
+	o.spinner.Stop(log.Ssuccessf(fmtSvcResumeComplete, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName), paused.DesiredCount))
+	return nil
+}
+
+func (o *svcResumeOpts) resume(clusterName, serviceName string, desiredCount int64) error {
+	return restoreSvc(restoreSvcInput{
+		store:        o.store,
+		ecs:          o.ecsSvc,
+		aas:          o.aasSvc,
+		app:          o.appName,
+		env:          o.envName,
+		svc:          o.svcName,
+		clusterName:  clusterName,
+		serviceName:  serviceName,
+		desiredCount: desiredCount,
+	})
+}
+
+type restoreSvcInput struct {
+	store pausedServiceStore
+	ecs   ecsServiceScaler
+	aas   serviceScalingSuspender
+
+	app, env, svc            string
+	clusterName, serviceName string
+	desiredCount             int64
+}
+
+// restoreSvc scales a paused service back to its desired count and resumes its auto scaling.
+func restoreSvc(in restoreSvcInput) error {
+	if err := in.ecs.UpdateServiceDesiredCount(in.clusterName, in.serviceName, in.desiredCount); err != nil {
+		return err
+	}
+	if err := in.aas.ResumeECSServiceScaling(in.clusterName, in.serviceName); err != nil {
+		return err
+	}
+	// Only forget the pause once the service is fully restored so that a failed resume can be retried.
+	return in.store.DeletePausedService(in.app, in.env, in.svc)
+}
+
+type resumeRedeployedSvcInput struct {
+	store pausedServiceStore
+	rg    resourcesByTagsGetter
+	ecs   ecsServiceScaler
+	aas   serviceScalingSuspender
+
+	app, env, svc string
+}
+
+// resumeRedeployedSvc restores a service that was paused before being deployed. The tasks and the auto scaling
+// of a paused service are changed outside of its stack, so updating the stack doesn't restore them.
+func resumeRedeployedSvc(in resumeRedeployedSvcInput) error {
+	paused, err := in.store.GetPausedService(in.app, in.env, in.svc)
+	if err != nil {
+		var errNotPaused *config.ErrServiceNotPaused
+		if errors.As(err, &errNotPaused) {
+			return nil
+		}
+		return err
+	}
+	clusterName, serviceName, err := ecsClusterAndServiceNames(in.rg, in.app, in.env, in.svc)
+	if err != nil {
+		return err
+	}
+	err = restoreSvc(restoreSvcInput{
+		store:        in.store,
+		ecs:          in.ecs,
+		aas:          in.aas,
+		app:          in.app,
+		env:          in.env,
+		svc:          in.svc,
+		clusterName:  clusterName,
+		serviceName:  serviceName,
+		desiredCount: paused.DesiredCount,
+	})
+	if err != nil {
+		return fmt.Errorf("resume paused service %s in environment %s: %w", in.svc, in.env, err)
+	}
+	log.Infof("Resumed paused service %s in environment %s with %d tasks.\n", color.HighlightUserInput(in.svc), color.HighlightUserInput(in.env), paused.DesiredCount)
+	return nil
+}
+
+// buildSvcResumeCmd builds the command for restoring the tasks of a paused service.
+func buildSvcResumeCmd() *cobra.Command {
+	vars := svcResumeVars{}
+	cmd := &cobra.Command{
+		Use:   "resume",
+		Short: "Restores the tasks of a paused service.",
+		Long: `Scales a paused service back to the number of tasks it had before "copilot svc pause",
+and resumes its auto scaling.`,
+		Example: `
+  Resume the "frontend" service in the "test" environment.
+  /code $ copilot svc resume -n frontend -e test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcResumeOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().BoolVar(&vars.force, forceFlag, false, forceFlagDescription)
+	cmd.Flags().BoolVar(&vars.stealLock, stealLockFlag, false, stealLockFlagDescription)
+	return cmd
+}
@@ -0,0 +1,155 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcResumeOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inForce    bool
+		setupMocks func(m svcScalingMocks)
+
+		wantedError error
+	}{
+		"does nothing if the service is not paused": {
+			setupMocks: func(m svcScalingMocks) {
+				m.store.EXPECT().GetPausedService("my-app", "test", "my-svc").Return(nil, &config.ErrServiceNotPaused{})
+			},
+		},
+		"errors if fail to check if the service is paused": {
+			setupMocks: func(m svcScalingMocks) {
+				m.store.EXPECT().GetPausedService("my-app", "test", "my-svc").Return(nil, mockError)
+			},
+
+			wantedError: mockError,
+		},
+		"errors if fail to get the environment": {
+			setupMocks: func(m svcScalingMocks) {
+				m.store.EXPECT().GetPausedService("my-app", "test", "my-svc").Return(&config.PausedService{DesiredCount: 3}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("get environment test: %w", mockError),
+		},
+		"keeps the pause record if fail to restore the desired count": {
+			setupMocks: func(m svcScalingMocks) {
+				m.store.EXPECT().GetPausedService("my-app", "test", "my-svc").Return(&config.PausedService{DesiredCount: 3}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.rg.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockScalingTags).
+					Return([]*resourcegroups.Resource{{ARN: mockScalingServiceARN}}, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.ecs.EXPECT().UpdateServiceDesiredCount(mockScalingCluster, mockScalingService, int64(3)).Return(mockError)
+				m.store.EXPECT().DeletePausedService(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+
+			wantedError: mockError,
+		},
+		"errors if the production environment is not confirmed": {
+			setupMocks: func(m svcScalingMocks) {
+				m.store.EXPECT().GetPausedService("my-app", "test", "my-svc").Return(&config.PausedService{DesiredCount: 3}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test", Prod: true}, nil)
+				m.prompt.EXPECT().Get(gomock.Any(), prodConfirmHelp, nil).Return("prod", nil)
+			},
+
+			wantedError: errProdConfirmationMismatch,
+		},
+		"resumes a service in a production environment once confirmed": {
+			setupMocks: func(m svcScalingMocks) {
+				gomock.InOrder(
+					m.store.EXPECT().GetPausedService("my-app", "test", "my-svc").Return(&config.PausedService{DesiredCount: 3}, nil),
+					m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test", Prod: true}, nil),
+					m.prompt.EXPECT().Get(gomock.Any(), prodConfirmHelp, nil).Return("test", nil),
+					m.rg.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockScalingTags).
+						Return([]*resourcegroups.Resource{{ARN: mockScalingServiceARN}}, nil),
+					m.spinner.EXPECT().Start(gomock.Any()),
+					m.ecs.EXPECT().UpdateServiceDesiredCount(mockScalingCluster, mockScalingService, int64(3)).Return(nil),
+					m.aas.EXPECT().ResumeECSServiceScaling(mockScalingCluster, mockScalingService).Return(nil),
+					m.store.EXPECT().DeletePausedService("my-app", "test", "my-svc").Return(nil),
+					m.spinner.EXPECT().Stop(gomock.Any()),
+				)
+			},
+		},
+		"skips the production confirmation if forced": {
+			inForce: true,
+			setupMocks: func(m svcScalingMocks) {
+				gomock.InOrder(
+					m.store.EXPECT().GetPausedService("my-app", "test", "my-svc").Return(&config.PausedService{DesiredCount: 3}, nil),
+					m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test", Prod: true}, nil),
+					m.rg.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockScalingTags).
+						Return([]*resourcegroups.Resource{{ARN: mockScalingServiceARN}}, nil),
+					m.spinner.EXPECT().Start(gomock.Any()),
+					m.ecs.EXPECT().UpdateServiceDesiredCount(mockScalingCluster, mockScalingService, int64(3)).Return(nil),
+					m.aas.EXPECT().ResumeECSServiceScaling(mockScalingCluster, mockScalingService).Return(nil),
+					m.store.EXPECT().DeletePausedService("my-app", "test", "my-svc").Return(nil),
+					m.spinner.EXPECT().Stop(gomock.Any()),
+				)
+			},
+		},
+		"success": {
+			setupMocks: func(m svcScalingMocks) {
+				gomock.InOrder(
+					m.store.EXPECT().GetPausedService("my-app", "test", "my-svc").Return(&config.PausedService{DesiredCount: 3}, nil),
+					m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil),
+					m.rg.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockScalingTags).
+						Return([]*resourcegroups.Resource{{ARN: mockScalingServiceARN}}, nil),
+					m.spinner.EXPECT().Start(gomock.Any()),
+					m.ecs.EXPECT().UpdateServiceDesiredCount(mockScalingCluster, mockScalingService, int64(3)).Return(nil),
+					m.aas.EXPECT().ResumeECSServiceScaling(mockScalingCluster, mockScalingService).Return(nil),
+					m.store.EXPECT().DeletePausedService("my-app", "test", "my-svc").Return(nil),
+					m.spinner.EXPECT().Stop(gomock.Any()),
+				)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newSvcScalingMocks(ctrl)
+			m.store.EXPECT().AcquireDeployLock(gomock.Any()).Return(nil)
+			m.store.EXPECT().ReleaseDeployLock(gomock.Any()).Return(nil)
+			tc.setupMocks(m)
+
+			opts := &svcResumeOpts{
+				svcResumeVars: svcResumeVars{
+					appName: "my-app",
+					envName: "test",
+					svcName: "my-svc",
+					force:   tc.inForce,
+				},
+				store:   m.store,
+				prompt:  m.prompt,
+				spinner: m.spinner,
+				configureClients: func(o *svcResumeOpts, env *config.Environment) error {
+					o.rgSvc = m.rg
+					o.ecsSvc = m.ecs
+					o.aasSvc = m.aas
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,419 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcRollbackNamePrompt     = "Which service would you like to roll back?"
+	svcRollbackNameHelpPrompt = "The service will be redeployed with the template and parameters of one of its previous deployments."
+
+	fmtSvcRollbackStart    = "Rolling back service %s in environment %s to deployment %s."
+	fmtSvcRollbackFailed   = "Failed to roll back service %s in environment %s to deployment %s.\n"
+	fmtSvcRollbackComplete = "Rolled back service %s in environment %s to deployment %s.\n"
+
+	// svcDeploymentHistoryLimit is the number of deployments recorded for a service in an environment.
+	svcDeploymentHistoryLimit = 10
+	// svcDeploymentIDFormat is the time layout of the ID of a deployment.
+	svcDeploymentIDFormat = "20060102150405"
+	// fmtSvcDeploymentTemplateKey is the S3 key of a deployed template by service, environment, and template hash.
+	fmtSvcDeploymentTemplateKey = "manual/deployments/%s/%s/%s.stack.yml"
+)
+
+type svcRollbackVars struct {
+	appName      string
+	envName      string
+	svcName      string
+	deploymentID string
+	force        bool
+	stealLock    bool
+}
+
+type svcRollbackOpts struct {
+	svcRollbackVars
+
+	store   store
+	sel     deploySelector
+	prompt  prompter
+	spinner progress
+
+	// Clients configured against the environment of the service.
+	svcCFN   serviceDeployer
+	s3       objectStore
+	analyzer stackFailureAnalyzer
+	rgSvc    resourcesByTagsGetter
+	ecsSvc   ecsServiceScaler
+	aasSvc   serviceScalingSuspender
+
+	configureClients func(o *svcRollbackOpts, env *config.Environment) error
+}
+
+func newSvcRollbackOpts(vars svcRollbackVars) (*svcRollbackOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	prompter := prompt.New()
+	return &svcRollbackOpts{
+		svcRollbackVars: vars,
+		store:           configStore,
+		sel:             selector.NewDeploySelect(prompter, configStore, deployStore),
+		prompt:          prompter,
+		spinner:         termprogress.NewSpinner(),
+		configureClients: func(o *svcRollbackOpts, env *config.Environment) error {
+			provider := sessions.NewProvider()
+			envSess, err := provider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("get session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			// The templates are stored in the application's bucket in the region of the environment.
+			defaultSessEnvRegion, err := provider.DefaultWithRegion(env.Region)
+			if err != nil {
+				return fmt.Errorf("create default session with region %s: %w", env.Region, err)
+			}
+			o.svcCFN = deploycfn.New(envSess)
+			o.s3 = s3.New(defaultSessEnvRegion)
+			o.analyzer = describe.NewStackFailureAnalyzer(envSess)
+			o.rgSvc = resourcegroups.New(envSess)
+			o.ecsSvc = ecs.New(envSess)
+			o.aasSvc = aas.New(envSess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcRollbackOpts) Validate() error {
+	return validateDeployedSvcFlags(o.store, o.appName, o.envName, o.svcName)
+}
+
+// Ask prompts for the service to roll back if it's not provided.
+func (o *svcRollbackOpts) Ask() error {
+	deployedService, err := o.sel.DeployedService(svcRollbackNamePrompt, svcRollbackNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed service for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute redeploys the template and parameters of a previous deployment of the service,
+// and records the rollback as a new deployment.
+func (o *svcRollbackOpts) Execute() (err error) {
+	target, err := o.targetDeployment()
+	if err != nil {
+		return err
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	guard := prodGuard{
+		force:  o.force,
+		prompt: o.prompt,
+	}
+	if err := guard.confirm(env, fmt.Sprintf("roll back service %s in it to deployment %s", o.svcName, target.ID)); err != nil {
+		return err
+	}
+	if err := o.configureClients(o, env); err != nil {
+		return err
+	}
+	template, err := o.s3.GetObject(target.TemplateBucket, target.TemplateKey)
+	if err != nil {
+		return fmt.Errorf("get template of deployment %s: %w", target.ID, err)
+	}
+	// The recorded template is redeployed as is, including the endpoints of the dependencies of the service
+	// at the time of the deployment, instead of being generated again from the manifest.
+	conf := &deployedStack{
+		deployment: target,
+		template:   template,
+	}
+
+	locker := newDeployLocker(o.store, o.spinner, o.stealLock)
+	lock, err := locker.acquire(o.appName, o.envName, o.svcName)
+	if err != nil {
+		return err
+	}
+	defer locker.releaseOnReturn(lock, &err)
+
+	o.spinner.Start(fmt.Sprintf(fmtSvcRollbackStart, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName), color.HighlightUserInput(target.ID)))
+	since := lastStackOperationTime(o.analyzer, conf.StackName())
+	if err := o.svcCFN.DeployService(conf, awscloudformation.WithRoleARN(env.ExecutionRoleARN)); err != nil {
+		o.spinner.Stop(log.Serrorf(fmtSvcRollbackFailed, o.svcName, o.envName, target.ID))
+		logStackFailure(o.analyzer, conf.StackName(), since)
+		return fmt.Errorf("deploy service: %w", err)
+	}
+	o.spinner.Stop(log.Ssuccessf(fmtSvcRollbackComplete, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName), color.HighlightUserInput(target.ID)))
+
+	err = resumeRedeployedSvc(resumeRedeployedSvcInput{
+		store: o.store,
+		rg:    o.rgSvc,
+		ecs:   o.ecsSvc,
+		aas:   o.aasSvc,
+		app:   o.appName,
+		env:   o.envName,
+		svc:   o.svcName,
+	})
+	if err != nil {
+		return err
+	}
+	return recordSvcDeployment(o.store, o.s3, recordSvcDeploymentInput{
+		app:          o.appName,
+		env:          o.envName,
+		svc:          o.svcName,
+		bucket:       target.TemplateBucket,
+		stack:        conf,
+		imageDigest:  target.ImageDigest,
+		rolledBackTo: target.ID,
+		deployedAt:   time.Now(),
+	})
+}
+
+// targetDeployment returns the deployment to roll back to. If no deployment ID is provided,
+// it returns the deployment prior to the current one.
+func (o *svcRollbackOpts) targetDeployment() (*config.ServiceDeployment, error) {
+	deployments, err := o.store.ListServiceDeployments(o.appName, o.envName, o.svcName)
+	if err != nil {
+		return nil, err
+	}
+	if len(deployments) == 0 {
+		return nil, fmt.Errorf("no deployments of service %s in environment %s were recorded", o.svcName, o.envName)
+	}
+	if o.deploymentID == "" {
+		if len(deployments) < 2 {
+			return nil, fmt.Errorf("service %s in environment %s has no deployment prior to %s", o.svcName, o.envName, deployments[0].ID)
+		}
+		return deployments[1], nil
+	}
+	for i, deployment := range deployments {
+		if deployment.ID != o.deploymentID {
+			continue
+		}
+		if i == 0 {
+			return nil, fmt.Errorf("deployment %s is the current deployment of service %s in environment %s", o.deploymentID, o.svcName, o.envName)
+		}
+		return deployment, nil
+	}
+	return nil, fmt.Errorf("cannot find deployment %s of service %s in environment %s", o.deploymentID, o.svcName, o.envName)
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *svcRollbackOpts) RecommendedActions() []string {
+	return []string{
+		fmt.Sprintf("Run %s to see the deployments of the service.",
+			color.HighlightCode(fmt.Sprintf("copilot svc history -n %s -e %s", o.svcName, o.envName))),
+	}
+}
+
+type recordSvcDeploymentInput struct {
+	app          string
+	env          string
+	svc          string
+	bucket       string // Bucket storing the template of the deployment.
+	stack        deploycfn.StackConfiguration
+	imageDigest  string
+	rolledBackTo string
+	deployedAt   time.Time
+}
+
+// recordSvcDeployment stores the template of a successful deployment in the application's bucket,
+// and records the deployment in the config store so that the service can be rolled back to it.
+// Only the most recent deployments of the service in the environment are kept.
+func recordSvcDeployment(store svcDeploymentStore, uploader objectUploader, in recordSvcDeploymentInput) error {
+	template, err := in.stack.Template()
+	if err != nil {
+		return fmt.Errorf("get template of deployment: %w", err)
+	}
+	params, err := in.stack.Parameters()
+	if err != nil {
+		return fmt.Errorf("get parameters of deployment: %w", err)
+	}
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte(template)))
+	// Templates are stored by hash so that identical templates are only stored once.
+	key := fmt.Sprintf(fmtSvcDeploymentTemplateKey, in.svc, in.env, hash)
+	if err := uploader.PutObject(in.bucket, key, strings.NewReader(template)); err != nil {
+		return fmt.Errorf("upload template of deployment: %w", err)
+	}
+
+	deployment := &config.ServiceDeployment{
+		App:            in.app,
+		Env:            in.env,
+		Name:           in.svc,
+		ID:             in.deployedAt.UTC().Format(svcDeploymentIDFormat),
+		DeployedAt:     in.deployedAt.UTC(),
+		ImageDigest:    in.imageDigest,
+		TemplateHash:   hash,
+		TemplateBucket: in.bucket,
+		TemplateKey:    key,
+		Parameters:     make(map[string]string),
+		RolledBackTo:   in.rolledBackTo,
+	}
+	for _, param := range params {
+		deployment.Parameters[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
+	}
+	if tags := in.stack.Tags(); len(tags) > 0 {
+		deployment.Tags = make(map[string]string)
+		for _, tag := range tags {
+			deployment.Tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+	}
+	if err := store.CreateServiceDeployment(deployment); err != nil {
+		return err
+	}
+
+	deployments, err := store.ListServiceDeployments(in.app, in.env, in.svc)
+	if err != nil {
+		return err
+	}
+	if len(deployments) <= svcDeploymentHistoryLimit {
+		return nil
+	}
+	for _, old := range deployments[svcDeploymentHistoryLimit:] {
+		if err := store.DeleteServiceDeployment(in.app, in.env, in.svc, old.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deployedStack is the configuration of the stack of a recorded service deployment.
+type deployedStack struct {
+	deployment *config.ServiceDeployment
+	template   string
+}
+
+// StackName returns the name of the stack of the service.
+func (s *deployedStack) StackName() string {
+	return stack.NameForService(s.deployment.App, s.deployment.Env, s.deployment.Name)
+}
+
+// Template returns the template of the deployment.
+func (s *deployedStack) Template() (string, error) {
+	return s.template, nil
+}
+
+// Parameters returns the parameters of the deployment. If the digest of the container image is known,
+// the image is pinned to it so that the image deployed at the time is restored even if its tag was reused.
+func (s *deployedStack) Parameters() ([]*sdkcloudformation.Parameter, error) {
+	var params []*sdkcloudformation.Parameter
+	for _, key := range sortedKeys(s.deployment.Parameters) {
+		value := s.deployment.Parameters[key]
+		if key == stack.WorkloadContainerImageParamKey && s.deployment.ImageDigest != "" {
+			value = imageWithDigest(value, s.deployment.ImageDigest)
+		}
+		params = append(params, &sdkcloudformation.Parameter{
+			ParameterKey:   aws.String(key),
+			ParameterValue: aws.String(value),
+		})
+	}
+	return params, nil
+}
+
+// Tags returns the tags of the deployment.
+func (s *deployedStack) Tags() []*sdkcloudformation.Tag {
+	var tags []*sdkcloudformation.Tag
+	for _, key := range sortedKeys(s.deployment.Tags) {
+		tags = append(tags, &sdkcloudformation.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(s.deployment.Tags[key]),
+		})
+	}
+	return tags
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// imageWithDigest replaces the tag or digest of an image reference with the digest.
+// For example, "repo/app/svc:v1" with digest "sha256:abc" becomes "repo/app/svc@sha256:abc".
+func imageWithDigest(image, digest string) string {
+	name := image
+	if i := strings.Index(name, "@"); i != -1 {
+		name = name[:i]
+	}
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name = name[:i]
+	}
+	return fmt.Sprintf("%s@%s", name, digest)
+}
+
+// buildSvcRollbackCmd builds the command for rolling back a service to a previous deployment.
+func buildSvcRollbackCmd() *cobra.Command {
+	vars := svcRollbackVars{}
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Rolls back a service to a previous deployment.",
+		Long: `Rolls back a service to a previous deployment.
+The template and parameters of the deployment are redeployed, and the rollback is recorded as a new deployment.`,
+		Example: `
+  Roll back the "frontend" service in the "test" environment to its previous deployment.
+  /code $ copilot svc rollback -n frontend -e test
+  Roll back the "frontend" service to a specific deployment listed by "copilot svc history".
+  /code $ copilot svc rollback -n frontend -e test --to 20201203101530`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcRollbackOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			log.Infoln("Recommended follow-up actions:")
+			for _, followup := range opts.RecommendedActions() {
+				log.Infof("- %s\n", followup)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVar(&vars.deploymentID, rollbackToFlag, "", rollbackToFlagDescription)
+	cmd.Flags().BoolVar(&vars.force, forceFlag, false, forceFlagDescription)
+	cmd.Flags().BoolVar(&vars.stealLock, stealLockFlag, false, stealLockFlagDescription)
+	return cmd
+}
@@ -0,0 +1,434 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcRollbackMocks struct {
+	store    *mocks.Mockstore
+	spinner  *mocks.Mockprogress
+	prompt   *mocks.Mockprompter
+	svcCFN   *mocks.MockserviceDeployer
+	s3       *mocks.MockobjectStore
+	analyzer *mocks.MockstackFailureAnalyzer
+	rg       *mocks.MockresourcesByTagsGetter
+	ecs      *mocks.MockecsServiceScaler
+	aas      *mocks.MockserviceScalingSuspender
+}
+
+func TestSvcRollbackOpts_Execute(t *testing.T) {
+	current := &config.ServiceDeployment{
+		App:            "my-app",
+		Env:            "test",
+		Name:           "my-svc",
+		ID:             "20201203101530",
+		TemplateBucket: "my-bucket",
+		TemplateKey:    "manual/deployments/my-svc/test/def.stack.yml",
+	}
+	previous := &config.ServiceDeployment{
+		App:            "my-app",
+		Env:            "test",
+		Name:           "my-svc",
+		ID:             "20201202101530",
+		ImageDigest:    "sha256:abc",
+		TemplateHash:   "abc",
+		TemplateBucket: "my-bucket",
+		TemplateKey:    "manual/deployments/my-svc/test/abc.stack.yml",
+		Parameters: map[string]string{
+			"ContainerImage": "1234.dkr.ecr.us-west-2.amazonaws.com/my-app/my-svc:v1",
+			"TaskCount":      "1",
+		},
+	}
+	lastOperationAt := time.Date(2020, 12, 3, 10, 15, 30, 0, time.UTC)
+	testCases := map[string]struct {
+		inDeploymentID string
+		inForce        bool
+		setupMocks     func(m svcRollbackMocks)
+
+		wantedError error
+	}{
+		"errors if there are no recorded deployments": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.store.EXPECT().ListServiceDeployments("my-app", "test", "my-svc").Return(nil, nil)
+			},
+
+			wantedError: errors.New("no deployments of service my-svc in environment test were recorded"),
+		},
+		"errors if there is no previous deployment": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.store.EXPECT().ListServiceDeployments("my-app", "test", "my-svc").Return([]*config.ServiceDeployment{current}, nil)
+			},
+
+			wantedError: errors.New("service my-svc in environment test has no deployment prior to 20201203101530"),
+		},
+		"errors if the deployment does not exist": {
+			inDeploymentID: "20200101000000",
+			setupMocks: func(m svcRollbackMocks) {
+				m.store.EXPECT().ListServiceDeployments("my-app", "test", "my-svc").Return([]*config.ServiceDeployment{current, previous}, nil)
+			},
+
+			wantedError: errors.New("cannot find deployment 20200101000000 of service my-svc in environment test"),
+		},
+		"errors if the deployment is the current one": {
+			inDeploymentID: "20201203101530",
+			setupMocks: func(m svcRollbackMocks) {
+				m.store.EXPECT().ListServiceDeployments("my-app", "test", "my-svc").Return([]*config.ServiceDeployment{current, previous}, nil)
+			},
+
+			wantedError: errors.New("deployment 20201203101530 is the current deployment of service my-svc in environment test"),
+		},
+		"errors if the production environment is not confirmed": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.store.EXPECT().ListServiceDeployments("my-app", "test", "my-svc").Return([]*config.ServiceDeployment{current, previous}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test", Prod: true}, nil)
+				m.prompt.EXPECT().Get(gomock.Any(), prodConfirmHelp, nil).Return("prod", nil)
+			},
+
+			wantedError: errProdConfirmationMismatch,
+		},
+		"rolls back a service in a production environment once confirmed": {
+			setupMocks: func(m svcRollbackMocks) {
+				gomock.InOrder(
+					m.store.EXPECT().ListServiceDeployments("my-app", "test", "my-svc").Return([]*config.ServiceDeployment{current, previous}, nil),
+					m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test", Prod: true}, nil),
+					m.prompt.EXPECT().Get(gomock.Any(), prodConfirmHelp, nil).Return("test", nil),
+					m.s3.EXPECT().GetObject("my-bucket", "manual/deployments/my-svc/test/abc.stack.yml").Return("template", nil),
+					m.store.EXPECT().AcquireDeployLock(gomock.Any()).Return(mockError),
+				)
+			},
+
+			wantedError: mockError,
+		},
+		"skips the production confirmation if forced": {
+			inForce: true,
+			setupMocks: func(m svcRollbackMocks) {
+				gomock.InOrder(
+					m.store.EXPECT().ListServiceDeployments("my-app", "test", "my-svc").Return([]*config.ServiceDeployment{current, previous}, nil),
+					m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test", Prod: true}, nil),
+					m.s3.EXPECT().GetObject("my-bucket", "manual/deployments/my-svc/test/abc.stack.yml").Return("template", nil),
+					m.store.EXPECT().AcquireDeployLock(gomock.Any()).Return(mockError),
+				)
+			},
+
+			wantedError: mockError,
+		},
+		"errors if fail to get the template of the deployment": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.store.EXPECT().ListServiceDeployments("my-app", "test", "my-svc").Return([]*config.ServiceDeployment{current, previous}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.s3.EXPECT().GetObject("my-bucket", "manual/deployments/my-svc/test/abc.stack.yml").Return("", mockError)
+			},
+
+			wantedError: fmt.Errorf("get template of deployment 20201202101530: %w", mockError),
+		},
+		"errors if the deploy lock is held by someone else": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.store.EXPECT().ListServiceDeployments("my-app", "test", "my-svc").Return([]*config.ServiceDeployment{current, previous}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.s3.EXPECT().GetObject("my-bucket", "manual/deployments/my-svc/test/abc.stack.yml").Return("template", nil)
+				m.store.EXPECT().AcquireDeployLock(gomock.Any()).Return(mockError)
+			},
+
+			wantedError: mockError,
+		},
+		"errors if fail to deploy": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.store.EXPECT().ListServiceDeployments("my-app", "test", "my-svc").Return([]*config.ServiceDeployment{current, previous}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.s3.EXPECT().GetObject("my-bucket", "manual/deployments/my-svc/test/abc.stack.yml").Return("template", nil)
+				m.store.EXPECT().AcquireDeployLock(gomock.Any()).Return(nil)
+				m.store.EXPECT().ReleaseDeployLock(gomock.Any()).Return(nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.analyzer.EXPECT().LastOperationTime("my-app-test-my-svc").Return(lastOperationAt, nil)
+				m.svcCFN.EXPECT().DeployService(gomock.Any(), gomock.Any()).Return(mockError)
+				m.spinner.EXPECT().Stop(gomock.Any())
+				m.analyzer.EXPECT().Analyze("my-app-test-my-svc", lastOperationAt).Return(nil, nil)
+			},
+
+			wantedError: fmt.Errorf("deploy service: %w", mockError),
+		},
+		"rolls back to the previous deployment and records the rollback": {
+			setupMocks: func(m svcRollbackMocks) {
+				gomock.InOrder(
+					m.store.EXPECT().ListServiceDeployments("my-app", "test", "my-svc").Return([]*config.ServiceDeployment{current, previous}, nil),
+					m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil),
+					m.s3.EXPECT().GetObject("my-bucket", "manual/deployments/my-svc/test/abc.stack.yml").Return("template", nil),
+					m.store.EXPECT().AcquireDeployLock(gomock.Any()).Return(nil),
+					m.spinner.EXPECT().Start(gomock.Any()),
+					m.analyzer.EXPECT().LastOperationTime("my-app-test-my-svc").Return(lastOperationAt, nil),
+					m.svcCFN.EXPECT().DeployService(&deployedStack{deployment: previous, template: "template"}, gomock.Any()).Return(nil),
+					m.spinner.EXPECT().Stop(gomock.Any()),
+					m.store.EXPECT().GetPausedService("my-app", "test", "my-svc").Return(nil, &config.ErrServiceNotPaused{}),
+					m.s3.EXPECT().PutObject("my-bucket", gomock.Any(), gomock.Any()).Return(nil),
+					m.store.EXPECT().CreateServiceDeployment(gomock.Any()).Do(func(d *config.ServiceDeployment) {
+						require.Equal(t, "20201202101530", d.RolledBackTo)
+						require.Equal(t, "sha256:abc", d.ImageDigest)
+						require.Equal(t, "1234.dkr.ecr.us-west-2.amazonaws.com/my-app/my-svc@sha256:abc", d.Parameters["ContainerImage"])
+					}).Return(nil),
+					m.store.EXPECT().ListServiceDeployments("my-app", "test", "my-svc").Return([]*config.ServiceDeployment{current, previous}, nil),
+					m.store.EXPECT().ReleaseDeployLock(gomock.Any()).Return(nil),
+				)
+			},
+		},
+		"rolls back to a specific deployment": {
+			inDeploymentID: "20201202101530",
+			setupMocks: func(m svcRollbackMocks) {
+				m.store.EXPECT().ListServiceDeployments("my-app", "test", "my-svc").Return([]*config.ServiceDeployment{current, previous}, nil).Times(2)
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.s3.EXPECT().GetObject("my-bucket", "manual/deployments/my-svc/test/abc.stack.yml").Return("template", nil)
+				m.store.EXPECT().AcquireDeployLock(gomock.Any()).Return(nil)
+				m.store.EXPECT().ReleaseDeployLock(gomock.Any()).Return(nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.analyzer.EXPECT().LastOperationTime("my-app-test-my-svc").Return(lastOperationAt, nil)
+				m.svcCFN.EXPECT().DeployService(&deployedStack{deployment: previous, template: "template"}, gomock.Any()).Return(nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+				m.store.EXPECT().GetPausedService("my-app", "test", "my-svc").Return(nil, &config.ErrServiceNotPaused{})
+				m.s3.EXPECT().PutObject("my-bucket", gomock.Any(), gomock.Any()).Return(nil)
+				m.store.EXPECT().CreateServiceDeployment(gomock.Any()).Return(nil)
+			},
+		},
+		"resumes the service if it was paused": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.store.EXPECT().ListServiceDeployments("my-app", "test", "my-svc").Return([]*config.ServiceDeployment{current, previous}, nil).Times(2)
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.s3.EXPECT().GetObject("my-bucket", "manual/deployments/my-svc/test/abc.stack.yml").Return("template", nil)
+				m.store.EXPECT().AcquireDeployLock(gomock.Any()).Return(nil)
+				m.store.EXPECT().ReleaseDeployLock(gomock.Any()).Return(nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.analyzer.EXPECT().LastOperationTime("my-app-test-my-svc").Return(lastOperationAt, nil)
+				m.svcCFN.EXPECT().DeployService(gomock.Any(), gomock.Any()).Return(nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+				gomock.InOrder(
+					m.store.EXPECT().GetPausedService("my-app", "test", "my-svc").Return(&config.PausedService{DesiredCount: 3}, nil),
+					m.rg.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockScalingTags).
+						Return([]*resourcegroups.Resource{{ARN: mockScalingServiceARN}}, nil),
+					m.ecs.EXPECT().UpdateServiceDesiredCount(mockScalingCluster, mockScalingService, int64(3)).Return(nil),
+					m.aas.EXPECT().ResumeECSServiceScaling(mockScalingCluster, mockScalingService).Return(nil),
+					m.store.EXPECT().DeletePausedService("my-app", "test", "my-svc").Return(nil),
+				)
+				m.s3.EXPECT().PutObject("my-bucket", gomock.Any(), gomock.Any()).Return(nil)
+				m.store.EXPECT().CreateServiceDeployment(gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := svcRollbackMocks{
+				store:    mocks.NewMockstore(ctrl),
+				spinner:  mocks.NewMockprogress(ctrl),
+				prompt:   mocks.NewMockprompter(ctrl),
+				svcCFN:   mocks.NewMockserviceDeployer(ctrl),
+				s3:       mocks.NewMockobjectStore(ctrl),
+				analyzer: mocks.NewMockstackFailureAnalyzer(ctrl),
+				rg:       mocks.NewMockresourcesByTagsGetter(ctrl),
+				ecs:      mocks.NewMockecsServiceScaler(ctrl),
+				aas:      mocks.NewMockserviceScalingSuspender(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &svcRollbackOpts{
+				svcRollbackVars: svcRollbackVars{
+					appName:      "my-app",
+					envName:      "test",
+					svcName:      "my-svc",
+					deploymentID: tc.inDeploymentID,
+					force:        tc.inForce,
+				},
+				store:   m.store,
+				prompt:  m.prompt,
+				spinner: m.spinner,
+				configureClients: func(o *svcRollbackOpts, env *config.Environment) error {
+					o.svcCFN = m.svcCFN
+					o.s3 = m.s3
+					o.analyzer = m.analyzer
+					o.rgSvc = m.rg
+					o.ecsSvc = m.ecs
+					o.aasSvc = m.aas
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type mockStackConfig struct {
+	template string
+	params   []*sdkcloudformation.Parameter
+	tags     []*sdkcloudformation.Tag
+}
+
+func (m *mockStackConfig) StackName() string { return "my-app-test-my-svc" }
+
+func (m *mockStackConfig) Template() (string, error) { return m.template, nil }
+
+func (m *mockStackConfig) Parameters() ([]*sdkcloudformation.Parameter, error) { return m.params, nil }
+
+func (m *mockStackConfig) Tags() []*sdkcloudformation.Tag { return m.tags }
+
+func TestRecordSvcDeployment(t *testing.T) {
+	const (
+		// SHA256 hash of "template".
+		mockTemplateHash = "5cde0f1298f41f7d1c8b907a36992a7a513225a2615bd6e307bf1a9149b06b40"
+		mockTemplateKey  = "manual/deployments/my-svc/test/" + mockTemplateHash + ".stack.yml"
+	)
+	deployedAt := time.Date(2020, 12, 3, 10, 15, 30, 0, time.UTC)
+	conf := &mockStackConfig{
+		template: "template",
+		params: []*sdkcloudformation.Parameter{
+			{
+				ParameterKey:   aws.String("ContainerImage"),
+				ParameterValue: aws.String("1234.dkr.ecr.us-west-2.amazonaws.com/my-app/my-svc:v1"),
+			},
+		},
+		tags: []*sdkcloudformation.Tag{
+			{
+				Key:   aws.String("copilot-application"),
+				Value: aws.String("my-app"),
+			},
+		},
+	}
+	wantedDeployment := &config.ServiceDeployment{
+		App:            "my-app",
+		Env:            "test",
+		Name:           "my-svc",
+		ID:             "20201203101530",
+		DeployedAt:     deployedAt,
+		ImageDigest:    "sha256:abc",
+		TemplateHash:   mockTemplateHash,
+		TemplateBucket: "my-bucket",
+		TemplateKey:    mockTemplateKey,
+		Parameters: map[string]string{
+			"ContainerImage": "1234.dkr.ecr.us-west-2.amazonaws.com/my-app/my-svc:v1",
+		},
+		Tags: map[string]string{
+			"copilot-application": "my-app",
+		},
+	}
+	var history []*config.ServiceDeployment
+	for i := 0; i < svcDeploymentHistoryLimit+2; i++ {
+		history = append(history, &config.ServiceDeployment{ID: fmt.Sprintf("%d", i)})
+	}
+	testCases := map[string]struct {
+		setupMocks func(store *mocks.Mockstore, uploader *mocks.MockobjectUploader)
+
+		wantedError error
+	}{
+		"errors if fail to upload the template": {
+			setupMocks: func(store *mocks.Mockstore, uploader *mocks.MockobjectUploader) {
+				uploader.EXPECT().PutObject("my-bucket", mockTemplateKey, gomock.Any()).Return(mockError)
+			},
+
+			wantedError: fmt.Errorf("upload template of deployment: %w", mockError),
+		},
+		"errors if fail to create the deployment": {
+			setupMocks: func(store *mocks.Mockstore, uploader *mocks.MockobjectUploader) {
+				uploader.EXPECT().PutObject("my-bucket", mockTemplateKey, gomock.Any()).Return(nil)
+				store.EXPECT().CreateServiceDeployment(wantedDeployment).Return(mockError)
+			},
+
+			wantedError: mockError,
+		},
+		"records the deployment": {
+			setupMocks: func(store *mocks.Mockstore, uploader *mocks.MockobjectUploader) {
+				uploader.EXPECT().PutObject("my-bucket", mockTemplateKey, gomock.Any()).Return(nil)
+				store.EXPECT().CreateServiceDeployment(wantedDeployment).Return(nil)
+				store.EXPECT().ListServiceDeployments("my-app", "test", "my-svc").Return(history[:svcDeploymentHistoryLimit], nil)
+			},
+		},
+		"deletes the oldest deployments above the limit": {
+			setupMocks: func(store *mocks.Mockstore, uploader *mocks.MockobjectUploader) {
+				uploader.EXPECT().PutObject("my-bucket", mockTemplateKey, gomock.Any()).Return(nil)
+				store.EXPECT().CreateServiceDeployment(wantedDeployment).Return(nil)
+				store.EXPECT().ListServiceDeployments("my-app", "test", "my-svc").Return(history, nil)
+				store.EXPECT().DeleteServiceDeployment("my-app", "test", "my-svc", fmt.Sprintf("%d", svcDeploymentHistoryLimit)).Return(nil)
+				store.EXPECT().DeleteServiceDeployment("my-app", "test", "my-svc", fmt.Sprintf("%d", svcDeploymentHistoryLimit+1)).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockUploader := mocks.NewMockobjectUploader(ctrl)
+			tc.setupMocks(mockStore, mockUploader)
+
+			// WHEN
+			err := recordSvcDeployment(mockStore, mockUploader, recordSvcDeploymentInput{
+				app:         "my-app",
+				env:         "test",
+				svc:         "my-svc",
+				bucket:      "my-bucket",
+				stack:       conf,
+				imageDigest: "sha256:abc",
+				deployedAt:  deployedAt,
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestImageWithDigest(t *testing.T) {
+	testCases := map[string]struct {
+		inImage string
+
+		wanted string
+	}{
+		"image with a tag": {
+			inImage: "1234.dkr.ecr.us-west-2.amazonaws.com/my-app/my-svc:v1",
+			wanted:  "1234.dkr.ecr.us-west-2.amazonaws.com/my-app/my-svc@sha256:abc",
+		},
+		"image from a registry with a port": {
+			inImage: "localhost:5000/my-svc",
+			wanted:  "localhost:5000/my-svc@sha256:abc",
+		},
+		"image with a digest": {
+			inImage: "nginx@sha256:def",
+			wanted:  "nginx@sha256:abc",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, imageWithDigest(tc.inImage, "sha256:abc"))
+		})
+	}
+}
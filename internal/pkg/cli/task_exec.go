@@ -0,0 +1,194 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/task"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+type taskExecVars struct {
+	execVars
+	useDefault bool
+}
+
+type taskExecOpts struct {
+	taskExecVars
+
+	store store
+
+	// Clients configured against the environment of the task, or the default session.
+	rgSvc     resourcesByTagsGetter
+	ecsSvc    ecsTaskCommandExecutor
+	ssmPlugin ssmSessionStarter
+
+	// configureClients configures the clients with the environment's session, or the default session if env is nil.
+	configureClients func(o *taskExecOpts, env *config.Environment) error
+}
+
+func newTaskExecOpts(vars taskExecVars) (*taskExecOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	return &taskExecOpts{
+		taskExecVars: vars,
+		store:        store,
+		configureClients: func(o *taskExecOpts, env *config.Environment) error {
+			provider := sessions.NewProvider()
+			if env == nil {
+				sess, err := provider.Default()
+				if err != nil {
+					return fmt.Errorf("get default session: %w", err)
+				}
+				o.ecsSvc = ecs.New(sess)
+				o.ssmPlugin = exec.NewSSMPluginCommand(aws.StringValue(sess.Config.Region))
+				return nil
+			}
+			sess, err := provider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("get session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			o.rgSvc = resourcegroups.New(sess)
+			o.ecsSvc = ecs.New(sess)
+			o.ssmPlugin = exec.NewSSMPluginCommand(env.Region)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (o *taskExecOpts) Validate() error {
+	if o.useDefault {
+		if o.appName != "" {
+			return fmt.Errorf("cannot specify both `--%s` and `--%s`", appFlag, taskDefaultFlag)
+		}
+		if o.envName != "" {
+			return fmt.Errorf("cannot specify both `--%s` and `--%s`", envFlag, taskDefaultFlag)
+		}
+	}
+	if o.name != "" {
+		if err := basicNameValidation(o.name); err != nil {
+			return err
+		}
+	}
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if o.appName == "" {
+			return fmt.Errorf("`--%s` must be specified with `--%s`", appFlag, envFlag)
+		}
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if !o.useDefault && o.envName == "" {
+		return fmt.Errorf("must specify either `--%s` or `--%s`", envFlag, taskDefaultFlag)
+	}
+	return validateExecCommand(o.command)
+}
+
+// Execute executes the command in a running container of the task group.
+func (o *taskExecOpts) Execute() error {
+	if o.name == "" {
+		dir, err := os.Getwd()
+		if err != nil {
+			log.Errorf("Cannot retrieve working directory, please use --%s to specify a task group name.\n", taskGroupNameFlag)
+			return fmt.Errorf("get working directory: %v", err)
+		}
+		o.name = filepath.Base(dir)
+	}
+	cluster, err := o.cluster()
+	if err != nil {
+		return err
+	}
+	tasks, err := o.ecsSvc.RunningTasksInFamily(cluster, task.FamilyName(o.name))
+	if err != nil {
+		return fmt.Errorf("get running tasks of task group %s: %w", o.name, err)
+	}
+	t, err := selectExecTask(tasks, o.taskID)
+	if err != nil {
+		return fmt.Errorf("task group %s: %w", o.name, err)
+	}
+	container := o.containerName
+	if container == "" {
+		// The container of a one-off task is named after its task group.
+		container = o.name
+	}
+	return execInTask(o.ecsSvc, o.ssmPlugin, execInTaskInput{
+		cluster:   cluster,
+		task:      t,
+		container: container,
+		command:   o.command,
+	})
+}
+
+func (o *taskExecOpts) cluster() (string, error) {
+	if o.useDefault {
+		if err := o.configureClients(o, nil); err != nil {
+			return "", err
+		}
+		cluster, err := o.ecsSvc.DefaultCluster()
+		if err != nil {
+			return "", fmt.Errorf(`get "default" cluster: %w`, err)
+		}
+		return cluster, nil
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return "", fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	if err := o.configureClients(o, env); err != nil {
+		return "", err
+	}
+	return task.EnvCluster(o.rgSvc, o.appName, o.envName)
+}
+
+// BuildTaskExecCmd builds the command for executing commands in the running containers of one-off tasks.
+func BuildTaskExecCmd() *cobra.Command {
+	vars := taskExecVars{}
+	cmd := &cobra.Command{
+		Use:   "exec",
+		Short: "Execute a command in a running container part of a task group.",
+		Long: `Execute a command in a running container part of a task group.
+The Session Manager plugin for the AWS CLI must be installed.`,
+		Example: `
+Start an interactive shell in a task of the "db-migrate" task group in the "test" environment.
+/code $ copilot task exec -n db-migrate --app my-app --env test
+Run a command in a specific task of the "db-migrate" task group in the default cluster.
+/code $ copilot task exec -n db-migrate --default --task-id 8c38184 --command "ls /tmp"`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newTaskExecOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, taskGroupNameFlag, nameFlagShort, "", taskGroupFlagDescription)
+	cmd.Flags().StringVar(&vars.appName, appFlag, "", taskExecAppFlagDescription)
+	cmd.Flags().StringVar(&vars.envName, envFlag, "", taskExecEnvFlagDescription)
+	cmd.Flags().BoolVar(&vars.useDefault, taskDefaultFlag, false, taskExecDefaultFlagDescription)
+	cmd.Flags().StringVar(&vars.command, commandFlag, defaultExecCommand, execCommandFlagDescription)
+	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", execTaskIDFlagDescription)
+	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", execContainerFlagDescription)
+	return cmd
+}
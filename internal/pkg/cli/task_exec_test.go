@@ -0,0 +1,258 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskExecOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp     string
+		inputEnv     string
+		inputDefault bool
+		inputCommand string
+		setupMocks   func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"errors if both app and default are specified": {
+			inputApp:     "my-app",
+			inputDefault: true,
+			setupMocks:   func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("cannot specify both `--app` and `--default`"),
+		},
+		"errors if env is specified without app": {
+			inputEnv:   "test",
+			setupMocks: func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("`--app` must be specified with `--env`"),
+		},
+		"errors if neither env nor default are specified": {
+			inputApp: "my-app",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+
+			wantedError: errors.New("must specify either `--env` or `--default`"),
+		},
+		"errors if the environment does not exist": {
+			inputApp: "my-app",
+			inputEnv: "test",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(nil, mockError)
+			},
+
+			wantedError: mockError,
+		},
+		"success with an environment": {
+			inputApp:     "my-app",
+			inputEnv:     "test",
+			inputCommand: "/bin/sh",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+			},
+		},
+		"success with the default cluster": {
+			inputDefault: true,
+			inputCommand: "/bin/sh",
+			setupMocks:   func(m *mocks.Mockstore) {},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+
+			opts := &taskExecOpts{
+				taskExecVars: taskExecVars{
+					execVars: execVars{
+						appName: tc.inputApp,
+						envName: tc.inputEnv,
+						name:    "db-migrate",
+						command: tc.inputCommand,
+					},
+					useDefault: tc.inputDefault,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type taskExecMocks struct {
+	store     *mocks.Mockstore
+	rg        *mocks.MockresourcesByTagsGetter
+	ecs       *mocks.MockecsTaskCommandExecutor
+	ssmPlugin *mocks.MockssmSessionStarter
+}
+
+func TestTaskExecOpts_Execute(t *testing.T) {
+	const mockClusterARN = "arn:aws:ecs:us-west-2:123456789012:cluster/my-app-test-Cluster"
+	mockSession := &ecs.Session{
+		SessionId: aws.String("mockSessionID"),
+	}
+	mockTask := &ecs.Task{
+		TaskArn:              aws.String("arn:aws:ecs:us-west-2:123456789012:task/my-app-test-Cluster/abc123"),
+		LastStatus:           aws.String("RUNNING"),
+		EnableExecuteCommand: aws.Bool(true),
+	}
+	testCases := map[string]struct {
+		inputApp     string
+		inputEnv     string
+		inputDefault bool
+		setupMocks   func(m taskExecMocks)
+
+		wantedError error
+	}{
+		"errors if fail to get the default cluster": {
+			inputDefault: true,
+			setupMocks: func(m taskExecMocks) {
+				m.ecs.EXPECT().DefaultCluster().Return("", mockError)
+			},
+
+			wantedError: fmt.Errorf(`get "default" cluster: %w`, mockError),
+		},
+		"errors if fail to get the running tasks": {
+			inputDefault: true,
+			setupMocks: func(m taskExecMocks) {
+				m.ecs.EXPECT().DefaultCluster().Return("default", nil)
+				m.ecs.EXPECT().RunningTasksInFamily("default", "copilot-db-migrate").Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("get running tasks of task group db-migrate: %w", mockError),
+		},
+		"errors if there are no running tasks": {
+			inputDefault: true,
+			setupMocks: func(m taskExecMocks) {
+				m.ecs.EXPECT().DefaultCluster().Return("default", nil)
+				m.ecs.EXPECT().RunningTasksInFamily("default", "copilot-db-migrate").Return(nil, nil)
+			},
+
+			wantedError: errors.New("task group db-migrate: no running tasks found"),
+		},
+		"errors without executing the command if the Session Manager plugin is not installed": {
+			inputDefault: true,
+			setupMocks: func(m taskExecMocks) {
+				m.ecs.EXPECT().DefaultCluster().Return("default", nil)
+				m.ecs.EXPECT().RunningTasksInFamily("default", "copilot-db-migrate").Return([]*ecs.Task{mockTask}, nil)
+				m.ssmPlugin.EXPECT().ValidateInstalled().Return(exec.ErrSSMPluginNotExist)
+				m.ecs.EXPECT().ExecuteCommand(gomock.Any()).Times(0)
+				m.ssmPlugin.EXPECT().StartSession(gomock.Any()).Times(0)
+			},
+
+			wantedError: exec.ErrSSMPluginNotExist,
+		},
+		"executes the command in a task in the default cluster": {
+			inputDefault: true,
+			setupMocks: func(m taskExecMocks) {
+				m.ecs.EXPECT().DefaultCluster().Return("default", nil)
+				m.ecs.EXPECT().RunningTasksInFamily("default", "copilot-db-migrate").Return([]*ecs.Task{mockTask}, nil)
+				m.ssmPlugin.EXPECT().ValidateInstalled().Return(nil)
+				m.ecs.EXPECT().ExecuteCommand(ecs.ExecuteCommandInput{
+					Cluster:   "default",
+					Command:   "/bin/sh",
+					Task:      "abc123",
+					Container: "db-migrate",
+				}).Return(mockSession, nil)
+				m.ssmPlugin.EXPECT().StartSession(mockSession).Return(nil)
+			},
+		},
+		"executes the command in a task in the environment cluster": {
+			inputApp: "my-app",
+			inputEnv: "test",
+			setupMocks: func(m taskExecMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.rg.EXPECT().GetResourcesByTags(gomock.Any(), map[string]string{
+					deploy.AppTagKey: "my-app",
+					deploy.EnvTagKey: "test",
+				}).Return([]*resourcegroups.Resource{{ARN: mockClusterARN}}, nil)
+				m.ecs.EXPECT().RunningTasksInFamily(mockClusterARN, "copilot-db-migrate").Return([]*ecs.Task{mockTask}, nil)
+				m.ssmPlugin.EXPECT().ValidateInstalled().Return(nil)
+				m.ecs.EXPECT().ExecuteCommand(ecs.ExecuteCommandInput{
+					Cluster:   mockClusterARN,
+					Command:   "/bin/sh",
+					Task:      "abc123",
+					Container: "db-migrate",
+				}).Return(mockSession, nil)
+				m.ssmPlugin.EXPECT().StartSession(mockSession).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := taskExecMocks{
+				store:     mocks.NewMockstore(ctrl),
+				rg:        mocks.NewMockresourcesByTagsGetter(ctrl),
+				ecs:       mocks.NewMockecsTaskCommandExecutor(ctrl),
+				ssmPlugin: mocks.NewMockssmSessionStarter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &taskExecOpts{
+				taskExecVars: taskExecVars{
+					execVars: execVars{
+						appName: tc.inputApp,
+						envName: tc.inputEnv,
+						name:    "db-migrate",
+						command: "/bin/sh",
+					},
+					useDefault: tc.inputDefault,
+				},
+				store: m.store,
+				configureClients: func(o *taskExecOpts, env *config.Environment) error {
+					o.rgSvc = m.rg
+					o.ecsSvc = m.ecs
+					o.ssmPlugin = m.ssmPlugin
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/robfig/cron/v3"
 
 	"github.com/spf13/afero"
@@ -43,6 +44,7 @@ var (
 	errDurationInvalid                    = errors.New("value must be a valid Go duration string (example: 1h30m)")
 	errDurationBadUnits                   = errors.New("duration cannot be in units smaller than a second")
 	errScheduleInvalid                    = errors.New("value must be a valid cron expression (examples: @weekly; @every 30m; 0 0 * * 0)")
+	errConnectionARNInvalid               = errors.New("value must be the ARN of an AWS CodeStar connection (example: arn:aws:codestar-connections:us-west-2:123456789012:connection/abcd)")
 )
 
 var (
@@ -92,6 +94,13 @@ func validateAppName(val interface{}) error {
 	return nil
 }
 
+func validatePipelineName(val interface{}) error {
+	if err := basicNameValidation(val); err != nil {
+		return fmt.Errorf("pipeline name %v is invalid: %w", val, err)
+	}
+	return nil
+}
+
 func validateSvcName(val interface{}) error {
 	if err := basicNameValidation(val); err != nil {
 		return fmt.Errorf("service name %v is invalid: %w", val, err)
@@ -148,6 +157,18 @@ func validateSchedule(sched interface{}) error {
 	return validateCron(s)
 }
 
+func validateTimezone(timezone interface{}) error {
+	tz, ok := timezone.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	// "Local" loads the time zone of this machine, which the schedule isn't evaluated in.
+	if _, err := time.LoadLocation(tz); err != nil || tz == "Local" {
+		return fmt.Errorf("time zone %s is invalid: must be an IANA time zone name such as Europe/Berlin", tz)
+	}
+	return nil
+}
+
 func validateTimeout(timeout interface{}) error {
 	t, ok := timeout.(string)
 	if !ok {
@@ -456,3 +477,15 @@ func validateCIDRSlice(val interface{}) error {
 	}
 	return nil
 }
+
+func validateConnectionARN(val interface{}) error {
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	parsed, err := arn.Parse(s)
+	if err != nil || parsed.Service != "codestar-connections" {
+		return errConnectionARNInvalid
+	}
+	return nil
+}
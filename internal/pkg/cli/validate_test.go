@@ -300,6 +300,33 @@ func TestValidateKey(t *testing.T) {
 	}
 }
 
+func TestValidateConnectionARN(t *testing.T) {
+	testCases := map[string]struct {
+		input string
+		want  error
+	}{
+		"good connection ARN": {
+			input: "arn:aws:codestar-connections:us-west-2:123456789012:connection/abcd",
+			want:  nil,
+		},
+		"not an ARN": {
+			input: "abcd",
+			want:  errConnectionARNInvalid,
+		},
+		"ARN of another service": {
+			input: "arn:aws:secretsmanager:us-west-2:123456789012:secret:abcd",
+			want:  errConnectionARNInvalid,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateConnectionARN(tc.input)
+
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
 func TestValidateLSIs(t *testing.T) {
 	testCases := map[string]struct {
 		inputAttributes []string
@@ -0,0 +1,87 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+// ServiceDeployment represents a successful deployment of a service to an environment.
+type ServiceDeployment struct {
+	App            string            `json:"app"`                    // Name of the app the service belongs to.
+	Env            string            `json:"env"`                    // Name of the environment the service was deployed to.
+	Name           string            `json:"name"`                   // Name of the service.
+	ID             string            `json:"id"`                     // ID of the deployment, unique within the service and environment.
+	DeployedAt     time.Time         `json:"deployedAt"`             // Time at which the deployment completed.
+	ImageDigest    string            `json:"imageDigest,omitempty"`  // Digest of the container image built for the deployment, if any.
+	TemplateHash   string            `json:"templateHash"`           // SHA256 hash of the CloudFormation template.
+	TemplateBucket string            `json:"templateBucket"`         // S3 bucket storing the CloudFormation template.
+	TemplateKey    string            `json:"templateKey"`            // S3 key of the CloudFormation template.
+	Parameters     map[string]string `json:"parameters"`             // Parameters of the CloudFormation stack.
+	Tags           map[string]string `json:"tags,omitempty"`         // Tags of the CloudFormation stack.
+	RolledBackTo   string            `json:"rolledBackTo,omitempty"` // ID of the deployment restored, if the deployment is a rollback.
+}
+
+// CreateServiceDeployment records a successful deployment of a service to an environment.
+func (s *Store) CreateServiceDeployment(deployment *ServiceDeployment) error {
+	data, err := marshal(deployment)
+	if err != nil {
+		return fmt.Errorf("serialize data: %w", err)
+	}
+	_, err = s.ssmClient.PutParameter(&ssm.PutParameterInput{
+		Name:        aws.String(fmt.Sprintf(fmtSvcDeploymentParamPath, deployment.App, deployment.Name, deployment.Env, deployment.ID)),
+		Description: aws.String(fmt.Sprintf("Copilot deployment %s of service %s in environment %s", deployment.ID, deployment.Name, deployment.Env)),
+		Type:        aws.String(ssm.ParameterTypeString),
+		Value:       aws.String(data),
+	})
+	if err != nil {
+		return fmt.Errorf("create deployment %s of service %s in environment %s: %w", deployment.ID, deployment.Name, deployment.Env, err)
+	}
+	return nil
+}
+
+// ListServiceDeployments returns the recorded deployments of a service in an environment, the most recent first.
+func (s *Store) ListServiceDeployments(appName, envName, svcName string) ([]*ServiceDeployment, error) {
+	serializedDeployments, err := s.listParams(fmt.Sprintf(rootSvcDeploymentParamPath, appName, svcName, envName))
+	if err != nil {
+		return nil, fmt.Errorf("list deployments of service %s in environment %s: %w", svcName, envName, err)
+	}
+	var deployments []*ServiceDeployment
+	for _, serializedDeployment := range serializedDeployments {
+		var deployment ServiceDeployment
+		if err := json.Unmarshal([]byte(*serializedDeployment), &deployment); err != nil {
+			return nil, fmt.Errorf("read deployments of service %s in environment %s: %w", svcName, envName, err)
+		}
+		deployments = append(deployments, &deployment)
+	}
+	sort.SliceStable(deployments, func(i, j int) bool {
+		return deployments[i].DeployedAt.After(deployments[j].DeployedAt)
+	})
+	return deployments, nil
+}
+
+// DeleteServiceDeployment removes the record of a deployment of a service in an environment.
+// If the record does not exist or is successfully deleted then returns nil. Otherwise, returns an error.
+func (s *Store) DeleteServiceDeployment(appName, envName, svcName, id string) error {
+	_, err := s.ssmClient.DeleteParameter(&ssm.DeleteParameterInput{
+		Name: aws.String(fmt.Sprintf(fmtSvcDeploymentParamPath, appName, svcName, envName, id)),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			case ssm.ErrCodeParameterNotFound:
+				return nil
+			}
+		}
+		return fmt.Errorf("delete deployment %s of service %s in environment %s: %w", id, svcName, envName, err)
+	}
+	return nil
+}
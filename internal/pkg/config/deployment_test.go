@@ -0,0 +1,196 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_CreateServiceDeployment(t *testing.T) {
+	testDeployment := ServiceDeployment{
+		App:          "chicken",
+		Env:          "test",
+		Name:         "api",
+		ID:           "20201203101530",
+		TemplateHash: "abc",
+		Parameters: map[string]string{
+			"ContainerImage": "1234.dkr.ecr.us-west-2.amazonaws.com/chicken/api:v1",
+		},
+	}
+	testDeploymentString, err := marshal(testDeployment)
+	require.NoError(t, err, "Marshal deployment should not fail")
+
+	testCases := map[string]struct {
+		mockPutParameter func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+		wantedErr        error
+	}{
+		"creates the record of the deployment": {
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, "/copilot/applications/chicken/components/api/deployments/test/20201203101530", *param.Name)
+				require.Equal(t, testDeploymentString, *param.Value)
+				return &ssm.PutParameterOutput{}, nil
+			},
+		},
+		"with SSM error": {
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, fmt.Errorf("broken")
+			},
+			wantedErr: fmt.Errorf("create deployment 20201203101530 of service api in environment test: broken"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				ssmClient: &mockSSM{
+					t:                t,
+					mockPutParameter: tc.mockPutParameter,
+				},
+			}
+
+			// WHEN
+			err := store.CreateServiceDeployment(&testDeployment)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStore_ListServiceDeployments(t *testing.T) {
+	olderDeployment := ServiceDeployment{App: "chicken", Env: "test", Name: "api", ID: "1", DeployedAt: time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC)}
+	olderDeploymentString, err := marshal(olderDeployment)
+	require.NoError(t, err, "Marshal deployment should not fail")
+	newerDeployment := ServiceDeployment{App: "chicken", Env: "test", Name: "api", ID: "2", DeployedAt: time.Date(2020, 12, 2, 0, 0, 0, 0, time.UTC)}
+	newerDeploymentString, err := marshal(newerDeployment)
+	require.NoError(t, err, "Marshal deployment should not fail")
+
+	testCases := map[string]struct {
+		mockGetParametersByPath func(t *testing.T, param *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error)
+
+		wantedDeployments []*ServiceDeployment
+		wantedErr         error
+	}{
+		"returns the deployments with the most recent first": {
+			mockGetParametersByPath: func(t *testing.T, param *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+				require.Equal(t, "/copilot/applications/chicken/components/api/deployments/test/", *param.Path)
+				return &ssm.GetParametersByPathOutput{
+					Parameters: []*ssm.Parameter{
+						{
+							Value: aws.String(olderDeploymentString),
+						},
+						{
+							Value: aws.String(newerDeploymentString),
+						},
+					},
+				}, nil
+			},
+
+			wantedDeployments: []*ServiceDeployment{&newerDeployment, &olderDeployment},
+		},
+		"with malformed json": {
+			mockGetParametersByPath: func(t *testing.T, param *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+				return &ssm.GetParametersByPathOutput{
+					Parameters: []*ssm.Parameter{
+						{
+							Value: aws.String("oops"),
+						},
+					},
+				}, nil
+			},
+
+			wantedErr: fmt.Errorf("read deployments of service api in environment test: invalid character 'o' looking for beginning of value"),
+		},
+		"with SSM error": {
+			mockGetParametersByPath: func(t *testing.T, param *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+				return nil, fmt.Errorf("broken")
+			},
+
+			wantedErr: fmt.Errorf("list deployments of service api in environment test: broken"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				ssmClient: &mockSSM{
+					t:                       t,
+					mockGetParametersByPath: tc.mockGetParametersByPath,
+				},
+			}
+
+			// WHEN
+			deployments, err := store.ListServiceDeployments("chicken", "test", "api")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDeployments, deployments)
+			}
+		})
+	}
+}
+
+func TestStore_DeleteServiceDeployment(t *testing.T) {
+	testCases := map[string]struct {
+		mockDeleteParam func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
+
+		wantedErr error
+	}{
+		"deployment does not exist": {
+			mockDeleteParam: func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "Not found", nil)
+			},
+		},
+		"unexpected error": {
+			mockDeleteParam: func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				return nil, fmt.Errorf("broken")
+			},
+			wantedErr: fmt.Errorf("delete deployment 1 of service api in environment test: broken"),
+		},
+		"successfully deleted record": {
+			mockDeleteParam: func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				require.Equal(t, "/copilot/applications/chicken/components/api/deployments/test/1", *in.Name)
+				return nil, nil
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				ssmClient: &mockSSM{
+					t:                   t,
+					mockDeleteParameter: tc.mockDeleteParam,
+				},
+			}
+
+			// WHEN
+			err := store.DeleteServiceDeployment("chicken", "test", "api", "1")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ type Environment struct {
 	ExecutionRoleARN string        `json:"executionRoleARN"`       // ARN used by CloudFormation to make modification to the environment stack.
 	ManagerRoleARN   string        `json:"managerRoleARN"`         // ARN for the manager role assumed to manipulate the environment and its services.
 	CustomConfig     *CustomizeEnv `json:"customConfig,omitempty"` // Custom environment configuration by users.
+	DeployBranch     string        `json:"deployBranch,omitempty"` // Git branch to deploy from if this is a production environment. Defaults to the repository's default branch.
 }
 
 // CustomizeEnv represents the custom environment config.
@@ -3,7 +3,10 @@
 
 package config
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // ErrNoSuchApplication means an application couldn't be found within a specific account and region.
 type ErrNoSuchApplication struct {
@@ -90,3 +93,36 @@ func (e *ErrNoSuchJob) Error() string {
 	return fmt.Sprintf("couldn't find job %s in the application %s",
 		e.Name, e.App)
 }
+
+// ErrServiceNotPaused means a specific service isn't paused in an environment.
+type ErrServiceNotPaused struct {
+	App  string
+	Env  string
+	Name string
+}
+
+// Is returns whether the provided error equals this error.
+func (e *ErrServiceNotPaused) Is(target error) bool {
+	t, ok := target.(*ErrServiceNotPaused)
+	if !ok {
+		return false
+	}
+	return e.App == t.App &&
+		e.Env == t.Env &&
+		e.Name == t.Name
+}
+
+func (e *ErrServiceNotPaused) Error() string {
+	return fmt.Sprintf("service %s is not paused in environment %s of the application %s",
+		e.Name, e.Env, e.App)
+}
+
+// ErrDeployLocked means a workload is being deployed to an environment by someone else.
+type ErrDeployLocked struct {
+	Lock *DeployLock // Lock held by someone else.
+}
+
+func (e *ErrDeployLocked) Error() string {
+	return fmt.Sprintf("%s in environment %s is locked by %s since %s",
+		e.Lock.Name, e.Lock.Env, e.Lock.Owner, e.Lock.AcquiredAt.Format(time.RFC3339))
+}
@@ -0,0 +1,245 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+// DeployLock represents an advisory lock held while a workload is deployed to an environment.
+type DeployLock struct {
+	App        string        `json:"app"`        // Name of the app the workload belongs to.
+	Env        string        `json:"env"`        // Name of the environment the workload is deployed to.
+	Name       string        `json:"name"`       // Name of the workload.
+	ID         string        `json:"id"`         // ID of the lock, unique to each deployment.
+	Owner      string        `json:"owner"`      // ARN of the identity holding the lock.
+	AcquiredAt time.Time     `json:"acquiredAt"` // Time at which the lock was acquired.
+	TTL        time.Duration `json:"ttl"`        // Duration after which the lock expires if it isn't refreshed.
+
+	// Time at which the lock was last written according to SSM, so that the expiry
+	// of a lock doesn't depend on the clock of its holder. Set when the lock is read.
+	RefreshedAt time.Time `json:"-"`
+}
+
+// deployLockMaxClockSkew is how far the clock of a caller can be from the clock of SSM.
+// AWS rejects the requests signed more than 5 minutes away from its own time, so callers that can read the lock are within it.
+const deployLockMaxClockSkew = 5 * time.Minute
+
+// Expired returns true if the lock can no longer be held at the given time of the caller's clock.
+// The lock expires a TTL after SSM last wrote it, plus the clock skew allowed between the caller and SSM
+// so that a caller whose clock runs ahead doesn't take over a lock that is still refreshed.
+func (l *DeployLock) Expired(now time.Time) bool {
+	return now.After(l.RefreshedAt.Add(l.TTL + deployLockMaxClockSkew))
+}
+
+// AcquireDeployLock takes the deploy lock of a workload in an environment on behalf of the caller.
+// An expired lock is replaced. If the lock is held by someone else then returns ErrDeployLocked.
+func (s *Store) AcquireDeployLock(lock *DeployLock) error {
+	if err := s.setDeployLockOwner(lock); err != nil {
+		return err
+	}
+	for {
+		err := s.putDeployLock(lock, false)
+		if err == nil {
+			return nil
+		}
+		if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != ssm.ErrCodeParameterAlreadyExists {
+			return fmt.Errorf("acquire deploy lock of %s in environment %s: %w", lock.Name, lock.Env, err)
+		}
+		held, err := s.getDeployLock(lock.App, lock.Env, lock.Name)
+		if err != nil {
+			return err
+		}
+		if held == nil {
+			// The lock was released in the meantime.
+			continue
+		}
+		if !held.Expired(lock.AcquiredAt) {
+			return &ErrDeployLocked{Lock: held}
+		}
+		// The holder didn't release the lock before it expired.
+		replaced, err := s.replaceDeployLock(held, lock)
+		if err != nil {
+			return err
+		}
+		if !replaced {
+			// Someone else is taking over the expired lock.
+			return &ErrDeployLocked{Lock: held}
+		}
+		return nil
+	}
+}
+
+// StealDeployLock takes the deploy lock of a workload in an environment on behalf of the caller,
+// whether or not the lock is held by someone else.
+func (s *Store) StealDeployLock(lock *DeployLock) error {
+	if err := s.setDeployLockOwner(lock); err != nil {
+		return err
+	}
+	if err := s.putDeployLock(lock, true); err != nil {
+		return fmt.Errorf("steal deploy lock of %s in environment %s: %w", lock.Name, lock.Env, err)
+	}
+	return nil
+}
+
+// RefreshDeployLock pushes back the expiry of a deploy lock held by the caller.
+// If the lock was taken over by someone else in the meantime then returns ErrDeployLocked.
+func (s *Store) RefreshDeployLock(lock *DeployLock) error {
+	replaced, err := s.replaceDeployLock(lock, lock)
+	if err != nil {
+		return err
+	}
+	if replaced {
+		return nil
+	}
+	held, err := s.getDeployLock(lock.App, lock.Env, lock.Name)
+	if err != nil {
+		return err
+	}
+	if held == nil || held.ID == lock.ID {
+		return fmt.Errorf("refresh deploy lock of %s in environment %s: lock was released or is being taken over", lock.Name, lock.Env)
+	}
+	return &ErrDeployLocked{Lock: held}
+}
+
+// ReleaseDeployLock releases the deploy lock of a workload in an environment.
+// If the lock was taken over by someone else in the meantime then it's left untouched.
+func (s *Store) ReleaseDeployLock(lock *DeployLock) error {
+	held, err := s.getDeployLock(lock.App, lock.Env, lock.Name)
+	if err != nil {
+		return err
+	}
+	if held == nil || held.ID != lock.ID {
+		return nil
+	}
+	_, err = s.replaceDeployLock(held, nil)
+	return err
+}
+
+// replaceDeployLock replaces the held deploy lock with a new lock, or deletes it if the new lock is nil.
+//
+// SSM parameters can't be updated conditionally, so callers that read the same held lock first race to create
+// a claim parameter named after the ID of the held lock, which only one of them can create. The winner then
+// checks that the held lock is still in place before replacing it. This way a lock is never replaced or deleted
+// by a caller that didn't read it. Returns false if the held lock was already replaced or is being replaced.
+func (s *Store) replaceDeployLock(held, lock *DeployLock) (bool, error) {
+	claimed, err := s.claimDeployLock(held)
+	if err != nil {
+		return false, err
+	}
+	if !claimed {
+		return false, nil
+	}
+	defer s.unclaimDeployLock(held)
+
+	current, err := s.getDeployLock(held.App, held.Env, held.Name)
+	if err != nil {
+		return false, err
+	}
+	if current == nil || current.ID != held.ID {
+		return false, nil
+	}
+	if lock == nil {
+		return true, s.deleteDeployLock(held)
+	}
+	if err := s.putDeployLock(lock, true); err != nil {
+		return false, fmt.Errorf("replace deploy lock of %s in environment %s: %w", lock.Name, lock.Env, err)
+	}
+	return true, nil
+}
+
+func (s *Store) setDeployLockOwner(lock *DeployLock) error {
+	caller, err := s.idClient.Get()
+	if err != nil {
+		return fmt.Errorf("get identity for deploy lock of %s in environment %s: %w", lock.Name, lock.Env, err)
+	}
+	lock.Owner = caller.ARN
+	return nil
+}
+
+func (s *Store) putDeployLock(lock *DeployLock, overwrite bool) error {
+	data, err := marshal(lock)
+	if err != nil {
+		return fmt.Errorf("serialize data: %w", err)
+	}
+	_, err = s.ssmClient.PutParameter(&ssm.PutParameterInput{
+		Name:        aws.String(fmt.Sprintf(fmtDeployLockParamPath, lock.App, lock.Name, lock.Env)),
+		Description: aws.String(fmt.Sprintf("Copilot deploy lock of %s in environment %s", lock.Name, lock.Env)),
+		Type:        aws.String(ssm.ParameterTypeString),
+		Value:       aws.String(data),
+		Overwrite:   aws.Bool(overwrite),
+	})
+	return err
+}
+
+// getDeployLock returns the deploy lock of a workload in an environment, or nil if the lock isn't held.
+func (s *Store) getDeployLock(appName, envName, name string) (*DeployLock, error) {
+	param, err := s.ssmClient.GetParameter(&ssm.GetParameterInput{
+		Name: aws.String(fmt.Sprintf(fmtDeployLockParamPath, appName, name, envName)),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			case ssm.ErrCodeParameterNotFound:
+				return nil, nil
+			}
+		}
+		return nil, fmt.Errorf("get deploy lock of %s in environment %s: %w", name, envName, err)
+	}
+
+	var lock DeployLock
+	if err := json.Unmarshal([]byte(aws.StringValue(param.Parameter.Value)), &lock); err != nil {
+		return nil, fmt.Errorf("read deploy lock of %s in environment %s: %w", name, envName, err)
+	}
+	lock.RefreshedAt = aws.TimeValue(param.Parameter.LastModifiedDate)
+	return &lock, nil
+}
+
+// claimDeployLock returns true if the caller created the claim on the lock, and false if someone else holds it.
+func (s *Store) claimDeployLock(lock *DeployLock) (bool, error) {
+	_, err := s.ssmClient.PutParameter(&ssm.PutParameterInput{
+		Name:        aws.String(fmt.Sprintf(fmtDeployLockClaimParamPath, lock.App, lock.Name, lock.ID)),
+		Description: aws.String(fmt.Sprintf("Copilot claim on the deploy lock of %s in environment %s", lock.Name, lock.Env)),
+		Type:        aws.String(ssm.ParameterTypeString),
+		Value:       aws.String(lock.Env),
+		Overwrite:   aws.Bool(false),
+	})
+	if err == nil {
+		return true, nil
+	}
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == ssm.ErrCodeParameterAlreadyExists {
+		return false, nil
+	}
+	return false, fmt.Errorf("claim deploy lock of %s in environment %s: %w", lock.Name, lock.Env, err)
+}
+
+// unclaimDeployLock deletes the claim on the lock. A claim that fails to be deleted only blocks
+// other callers from replacing that specific lock, which is released, refreshed or stolen anyway.
+func (s *Store) unclaimDeployLock(lock *DeployLock) {
+	_, _ = s.ssmClient.DeleteParameter(&ssm.DeleteParameterInput{
+		Name: aws.String(fmt.Sprintf(fmtDeployLockClaimParamPath, lock.App, lock.Name, lock.ID)),
+	})
+}
+
+func (s *Store) deleteDeployLock(lock *DeployLock) error {
+	_, err := s.ssmClient.DeleteParameter(&ssm.DeleteParameterInput{
+		Name: aws.String(fmt.Sprintf(fmtDeployLockParamPath, lock.App, lock.Name, lock.Env)),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			case ssm.ErrCodeParameterNotFound:
+				return nil
+			}
+		}
+		return fmt.Errorf("delete deploy lock of %s in environment %s: %w", lock.Name, lock.Env, err)
+	}
+	return nil
+}
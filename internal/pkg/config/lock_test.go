@@ -0,0 +1,426 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testDeployLockPath      = "/copilot/applications/chicken/components/api/locks/test"
+	testDeployLockClaimPath = "/copilot/applications/chicken/components/api/lock-claims/1"
+)
+
+func TestStore_AcquireDeployLock(t *testing.T) {
+	now := time.Date(2020, 12, 1, 10, 0, 0, 0, time.UTC)
+	heldLock := DeployLock{App: "chicken", Env: "test", Name: "api", ID: "1", Owner: "arn:aws:iam::1234:user/alice", AcquiredAt: now.Add(-2 * time.Hour), TTL: 10 * time.Minute}
+	heldLockString, err := marshal(heldLock)
+	require.NoError(t, err, "Marshal lock should not fail")
+	otherLockString, err := marshal(DeployLock{App: "chicken", Env: "test", Name: "api", ID: "3", Owner: "arn:aws:iam::1234:user/carol", TTL: 10 * time.Minute})
+	require.NoError(t, err, "Marshal lock should not fail")
+	errAlreadyExists := awserr.New(ssm.ErrCodeParameterAlreadyExists, "Already exists", nil)
+	// The lock was acquired two hours ago, it's expired unless it was refreshed since.
+	refreshedLock := &ssm.GetParameterOutput{
+		Parameter: &ssm.Parameter{Value: aws.String(heldLockString), LastModifiedDate: aws.Time(now.Add(-time.Minute))},
+	}
+	// The lock wasn't refreshed within its TTL, but the clock of the caller may run ahead of SSM.
+	recentlyExpiredLock := &ssm.GetParameterOutput{
+		Parameter: &ssm.Parameter{Value: aws.String(heldLockString), LastModifiedDate: aws.Time(now.Add(-12 * time.Minute))},
+	}
+	expiredLock := &ssm.GetParameterOutput{
+		Parameter: &ssm.Parameter{Value: aws.String(heldLockString), LastModifiedDate: aws.Time(now.Add(-time.Hour))},
+	}
+
+	testCases := map[string]struct {
+		mockIdentity        func() (identity.Caller, error)
+		mockPutParameter    func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+		mockGetParameter    func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+		mockDeleteParameter func(t *testing.T, param *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
+
+		wantedErr error
+	}{
+		"wraps identity errors": {
+			mockIdentity: func() (identity.Caller, error) {
+				return identity.Caller{}, errors.New("broken")
+			},
+			wantedErr: errors.New("get identity for deploy lock of api in environment test: broken"),
+		},
+		"creates the lock if it isn't held": {
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, testDeployLockPath, *param.Name)
+				require.False(t, aws.BoolValue(param.Overwrite))
+				require.Contains(t, *param.Value, `"owner":"arn:aws:iam::1234:user/bob"`)
+				return &ssm.PutParameterOutput{}, nil
+			},
+		},
+		"returns ErrDeployLocked if the lock is held by someone else": {
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, errAlreadyExists
+			},
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				require.Equal(t, testDeployLockPath, *param.Name)
+				return refreshedLock, nil
+			},
+			wantedErr: &ErrDeployLocked{Lock: &heldLock},
+		},
+		"returns ErrDeployLocked if the lock expired within the allowed clock skew": {
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, errAlreadyExists
+			},
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return recentlyExpiredLock, nil
+			},
+			wantedErr: &ErrDeployLocked{Lock: &heldLock},
+		},
+		"replaces an expired lock after claiming it": {
+			mockPutParameter: func() func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				calls := 0
+				return func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+					calls++
+					switch calls {
+					case 1:
+						return nil, errAlreadyExists
+					case 2:
+						require.Equal(t, testDeployLockClaimPath, *param.Name)
+						require.False(t, aws.BoolValue(param.Overwrite))
+					default:
+						require.Equal(t, testDeployLockPath, *param.Name)
+						require.True(t, aws.BoolValue(param.Overwrite))
+						require.Contains(t, *param.Value, `"id":"2"`)
+					}
+					return &ssm.PutParameterOutput{}, nil
+				}
+			}(),
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return expiredLock, nil
+			},
+			mockDeleteParameter: func(t *testing.T, param *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				require.Equal(t, testDeployLockClaimPath, *param.Name)
+				return &ssm.DeleteParameterOutput{}, nil
+			},
+		},
+		"returns ErrDeployLocked if someone else is taking over the expired lock": {
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, errAlreadyExists
+			},
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return expiredLock, nil
+			},
+			wantedErr: &ErrDeployLocked{Lock: &heldLock},
+		},
+		"does not replace an expired lock that was replaced after it was read": {
+			mockPutParameter: func() func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				calls := 0
+				return func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+					calls++
+					if calls == 1 {
+						return nil, errAlreadyExists
+					}
+					require.Equal(t, testDeployLockClaimPath, *param.Name)
+					return &ssm.PutParameterOutput{}, nil
+				}
+			}(),
+			mockGetParameter: func() func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				calls := 0
+				return func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+					calls++
+					if calls == 1 {
+						return expiredLock, nil
+					}
+					return &ssm.GetParameterOutput{
+						Parameter: &ssm.Parameter{Value: aws.String(otherLockString), LastModifiedDate: aws.Time(now)},
+					}, nil
+				}
+			}(),
+			mockDeleteParameter: func(t *testing.T, param *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				require.Equal(t, testDeployLockClaimPath, *param.Name)
+				return &ssm.DeleteParameterOutput{}, nil
+			},
+			wantedErr: &ErrDeployLocked{Lock: &heldLock},
+		},
+		"with SSM error": {
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, fmt.Errorf("broken")
+			},
+			wantedErr: fmt.Errorf("acquire deploy lock of api in environment test: broken"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			mockIdentity := tc.mockIdentity
+			if mockIdentity == nil {
+				mockIdentity = func() (identity.Caller, error) {
+					return identity.Caller{ARN: "arn:aws:iam::1234:user/bob"}, nil
+				}
+			}
+			store := &Store{
+				idClient: mockIdentityService{mockIdentityServiceGet: mockIdentity},
+				ssmClient: &mockSSM{
+					t:                   t,
+					mockPutParameter:    tc.mockPutParameter,
+					mockGetParameter:    tc.mockGetParameter,
+					mockDeleteParameter: tc.mockDeleteParameter,
+				},
+			}
+
+			// WHEN
+			err := store.AcquireDeployLock(&DeployLock{App: "chicken", Env: "test", Name: "api", ID: "2", AcquiredAt: now, TTL: 10 * time.Minute})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStore_StealDeployLock(t *testing.T) {
+	testCases := map[string]struct {
+		mockPutParameter func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+
+		wantedErr error
+	}{
+		"overwrites the lock": {
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, testDeployLockPath, *param.Name)
+				require.True(t, aws.BoolValue(param.Overwrite))
+				return &ssm.PutParameterOutput{}, nil
+			},
+		},
+		"with SSM error": {
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, fmt.Errorf("broken")
+			},
+			wantedErr: fmt.Errorf("steal deploy lock of api in environment test: broken"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				idClient: mockIdentityService{
+					mockIdentityServiceGet: func() (identity.Caller, error) {
+						return identity.Caller{ARN: "arn:aws:iam::1234:user/bob"}, nil
+					},
+				},
+				ssmClient: &mockSSM{
+					t:                t,
+					mockPutParameter: tc.mockPutParameter,
+				},
+			}
+
+			// WHEN
+			err := store.StealDeployLock(&DeployLock{App: "chicken", Env: "test", Name: "api", ID: "2"})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStore_RefreshDeployLock(t *testing.T) {
+	ownLockString, err := marshal(DeployLock{App: "chicken", Env: "test", Name: "api", ID: "1"})
+	require.NoError(t, err, "Marshal lock should not fail")
+	stolenLock := DeployLock{App: "chicken", Env: "test", Name: "api", ID: "2", Owner: "arn:aws:iam::1234:user/alice"}
+	stolenLockString, err := marshal(stolenLock)
+	require.NoError(t, err, "Marshal lock should not fail")
+
+	testCases := map[string]struct {
+		mockPutParameter func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+		mockGetParameter func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+
+		wantedErr error
+	}{
+		"rewrites the lock after claiming it": {
+			mockPutParameter: func() func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				calls := 0
+				return func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+					calls++
+					if calls == 1 {
+						require.Equal(t, testDeployLockClaimPath, *param.Name)
+						return &ssm.PutParameterOutput{}, nil
+					}
+					require.Equal(t, testDeployLockPath, *param.Name)
+					require.True(t, aws.BoolValue(param.Overwrite))
+					return &ssm.PutParameterOutput{}, nil
+				}
+			}(),
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return &ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{Value: aws.String(ownLockString)},
+				}, nil
+			},
+		},
+		"returns ErrDeployLocked if the lock was stolen": {
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, testDeployLockClaimPath, *param.Name)
+				return &ssm.PutParameterOutput{}, nil
+			},
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return &ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{Value: aws.String(stolenLockString)},
+				}, nil
+			},
+			wantedErr: &ErrDeployLocked{Lock: &stolenLock},
+		},
+		"returns an error if the lock was released": {
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return &ssm.PutParameterOutput{}, nil
+			},
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "Not found", nil)
+			},
+			wantedErr: errors.New("refresh deploy lock of api in environment test: lock was released or is being taken over"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				ssmClient: &mockSSM{
+					t:                t,
+					mockPutParameter: tc.mockPutParameter,
+					mockGetParameter: tc.mockGetParameter,
+					mockDeleteParameter: func(t *testing.T, param *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+						require.Equal(t, testDeployLockClaimPath, *param.Name)
+						return &ssm.DeleteParameterOutput{}, nil
+					},
+				},
+			}
+
+			// WHEN
+			err := store.RefreshDeployLock(&DeployLock{App: "chicken", Env: "test", Name: "api", ID: "1"})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStore_ReleaseDeployLock(t *testing.T) {
+	ownLockString, err := marshal(DeployLock{App: "chicken", Env: "test", Name: "api", ID: "1"})
+	require.NoError(t, err, "Marshal lock should not fail")
+	stolenLockString, err := marshal(DeployLock{App: "chicken", Env: "test", Name: "api", ID: "2"})
+	require.NoError(t, err, "Marshal lock should not fail")
+
+	testCases := map[string]struct {
+		mockPutParameter    func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+		mockGetParameter    func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+		mockDeleteParameter func(t *testing.T, param *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
+
+		wantedErr error
+	}{
+		"lock is not held": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "Not found", nil)
+			},
+		},
+		"lock was stolen": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return &ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{Value: aws.String(stolenLockString)},
+				}, nil
+			},
+		},
+		"lock is being taken over": {
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, testDeployLockClaimPath, *param.Name)
+				return nil, awserr.New(ssm.ErrCodeParameterAlreadyExists, "Already exists", nil)
+			},
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return &ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{Value: aws.String(ownLockString)},
+				}, nil
+			},
+		},
+		"deletes the lock after claiming it": {
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, testDeployLockClaimPath, *param.Name)
+				require.False(t, aws.BoolValue(param.Overwrite))
+				return &ssm.PutParameterOutput{}, nil
+			},
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return &ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{Value: aws.String(ownLockString)},
+				}, nil
+			},
+			mockDeleteParameter: func() func(t *testing.T, param *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				calls := 0
+				return func(t *testing.T, param *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+					calls++
+					if calls == 1 {
+						require.Equal(t, testDeployLockPath, *param.Name)
+					} else {
+						require.Equal(t, testDeployLockClaimPath, *param.Name)
+					}
+					return &ssm.DeleteParameterOutput{}, nil
+				}
+			}(),
+		},
+		"unexpected error": {
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return &ssm.PutParameterOutput{}, nil
+			},
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return &ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{Value: aws.String(ownLockString)},
+				}, nil
+			},
+			mockDeleteParameter: func(t *testing.T, param *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				if *param.Name == testDeployLockClaimPath {
+					return &ssm.DeleteParameterOutput{}, nil
+				}
+				return nil, fmt.Errorf("broken")
+			},
+			wantedErr: fmt.Errorf("delete deploy lock of api in environment test: broken"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				ssmClient: &mockSSM{
+					t:                   t,
+					mockPutParameter:    tc.mockPutParameter,
+					mockGetParameter:    tc.mockGetParameter,
+					mockDeleteParameter: tc.mockDeleteParameter,
+				},
+			}
+
+			// WHEN
+			err := store.ReleaseDeployLock(&DeployLock{App: "chicken", Env: "test", Name: "api", ID: "1"})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -31,12 +31,17 @@ const schemaVersion = "1.0"
 
 // schema formats supported in current schemaVersion. NOTE: May change to map in the future.
 const (
-	rootApplicationPath = "/copilot/applications/"
-	fmtApplicationPath  = "/copilot/applications/%s"
-	rootEnvParamPath    = "/copilot/applications/%s/environments/"
-	fmtEnvParamPath     = "/copilot/applications/%s/environments/%s" // path for an environment in an application
-	rootWkldParamPath   = "/copilot/applications/%s/components/"
-	fmtWkldParamPath    = "/copilot/applications/%s/components/%s" // path for a workload in an application
+	rootApplicationPath         = "/copilot/applications/"
+	fmtApplicationPath          = "/copilot/applications/%s"
+	rootEnvParamPath            = "/copilot/applications/%s/environments/"
+	fmtEnvParamPath             = "/copilot/applications/%s/environments/%s" // path for an environment in an application
+	rootWkldParamPath           = "/copilot/applications/%s/components/"
+	fmtWkldParamPath            = "/copilot/applications/%s/components/%s"           // path for a workload in an application
+	fmtPausedSvcParamPath       = "/copilot/applications/%s/components/%s/paused/%s" // path for a paused service in an environment
+	rootSvcDeploymentParamPath  = "/copilot/applications/%s/components/%s/deployments/%s/"
+	fmtSvcDeploymentParamPath   = "/copilot/applications/%s/components/%s/deployments/%s/%s" // path for a deployment of a service in an environment
+	fmtDeployLockParamPath      = "/copilot/applications/%s/components/%s/locks/%s"          // path for the deploy lock of a workload in an environment
+	fmtDeployLockClaimParamPath = "/copilot/applications/%s/components/%s/lock-claims/%s"    // path for the claim on a deploy lock of a workload with its ID
 )
 
 type identityGetter interface {
@@ -36,6 +36,14 @@ type Workload struct {
 	Type string `json:"type"` // Type of the workload (ex: Load Balanced Web Service, etc)
 }
 
+// PausedService represents a service scaled down to zero tasks in an environment.
+type PausedService struct {
+	App          string `json:"app"`          // Name of the app the service belongs to.
+	Env          string `json:"env"`          // Name of the environment the service is paused in.
+	Name         string `json:"name"`         // Name of the service.
+	DesiredCount int64  `json:"desiredCount"` // Desired count of tasks of the service before it was paused.
+}
+
 // CreateService instantiates a new service within an existing application. Skip if
 // the service already exists in the application.
 func (s *Store) CreateService(svc *Workload) error {
@@ -241,3 +249,68 @@ func (s *Store) deleteWorkload(appName, wkldName string) error {
 	}
 	return nil
 }
+
+// PauseService records that a service is paused in an environment.
+// If the service is already paused then its record is overwritten.
+func (s *Store) PauseService(paused *PausedService) error {
+	data, err := marshal(paused)
+	if err != nil {
+		return fmt.Errorf("serialize data: %w", err)
+	}
+	_, err = s.ssmClient.PutParameter(&ssm.PutParameterInput{
+		Name:        aws.String(fmt.Sprintf(fmtPausedSvcParamPath, paused.App, paused.Name, paused.Env)),
+		Description: aws.String(fmt.Sprintf("Copilot paused service %s in environment %s", paused.Name, paused.Env)),
+		Type:        aws.String(ssm.ParameterTypeString),
+		Value:       aws.String(data),
+		Overwrite:   aws.Bool(true),
+	})
+	if err != nil {
+		return fmt.Errorf("pause service %s in environment %s: %w", paused.Name, paused.Env, err)
+	}
+	return nil
+}
+
+// GetPausedService gets the record of a service paused in an environment. If the service isn't paused
+// it returns ErrServiceNotPaused.
+func (s *Store) GetPausedService(appName, envName, svcName string) (*PausedService, error) {
+	param, err := s.ssmClient.GetParameter(&ssm.GetParameterInput{
+		Name: aws.String(fmt.Sprintf(fmtPausedSvcParamPath, appName, svcName, envName)),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			case ssm.ErrCodeParameterNotFound:
+				return nil, &ErrServiceNotPaused{
+					App:  appName,
+					Env:  envName,
+					Name: svcName,
+				}
+			}
+		}
+		return nil, fmt.Errorf("get paused service %s in environment %s: %w", svcName, envName, err)
+	}
+
+	var paused PausedService
+	if err := json.Unmarshal([]byte(aws.StringValue(param.Parameter.Value)), &paused); err != nil {
+		return nil, fmt.Errorf("read configuration for paused service %s in environment %s: %w", svcName, envName, err)
+	}
+	return &paused, nil
+}
+
+// DeletePausedService removes the record of a service paused in an environment.
+// If the service is not paused or its record is successfully deleted then returns nil. Otherwise, returns an error.
+func (s *Store) DeletePausedService(appName, envName, svcName string) error {
+	_, err := s.ssmClient.DeleteParameter(&ssm.DeleteParameterInput{
+		Name: aws.String(fmt.Sprintf(fmtPausedSvcParamPath, appName, svcName, envName)),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			case ssm.ErrCodeParameterNotFound:
+				return nil
+			}
+		}
+		return fmt.Errorf("delete paused service %s in environment %s: %w", svcName, envName, err)
+	}
+	return nil
+}
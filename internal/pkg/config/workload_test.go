@@ -522,3 +522,165 @@ func TestDeleteService(t *testing.T) {
 		})
 	}
 }
+
+func TestStore_PauseService(t *testing.T) {
+	testPaused := PausedService{App: "chicken", Env: "test", Name: "api", DesiredCount: 3}
+	testPausedString, err := marshal(testPaused)
+	require.NoError(t, err, "Marshal paused service should not fail")
+	testPausedPath := fmt.Sprintf(fmtPausedSvcParamPath, "chicken", "api", "test")
+
+	testCases := map[string]struct {
+		mockPutParameter func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+		wantedErr        error
+	}{
+		"overwrites the record of the paused service": {
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, testPausedPath, *param.Name)
+				require.Equal(t, testPausedString, *param.Value)
+				require.True(t, *param.Overwrite)
+				return &ssm.PutParameterOutput{}, nil
+			},
+		},
+		"with SSM error": {
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, fmt.Errorf("broken")
+			},
+			wantedErr: fmt.Errorf("pause service api in environment test: broken"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				ssmClient: &mockSSM{
+					t:                t,
+					mockPutParameter: tc.mockPutParameter,
+				},
+			}
+
+			// WHEN
+			err := store.PauseService(&testPaused)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStore_GetPausedService(t *testing.T) {
+	testPaused := PausedService{App: "chicken", Env: "test", Name: "api", DesiredCount: 3}
+	testPausedString, err := marshal(testPaused)
+	require.NoError(t, err, "Marshal paused service should not fail")
+	testPausedPath := fmt.Sprintf(fmtPausedSvcParamPath, "chicken", "api", "test")
+
+	testCases := map[string]struct {
+		mockGetParameter func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+		wantedPaused     PausedService
+		wantedErr        error
+	}{
+		"with paused service": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				require.Equal(t, testPausedPath, *param.Name)
+				return &ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Name:  aws.String(testPausedPath),
+						Value: aws.String(testPausedString),
+					},
+				}, nil
+			},
+			wantedPaused: testPaused,
+		},
+		"with service that is not paused": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "bloop", nil)
+			},
+			wantedErr: &ErrServiceNotPaused{
+				App:  "chicken",
+				Env:  "test",
+				Name: "api",
+			},
+		},
+		"with SSM error": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return nil, fmt.Errorf("broken")
+			},
+			wantedErr: fmt.Errorf("get paused service api in environment test: broken"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				ssmClient: &mockSSM{
+					t:                t,
+					mockGetParameter: tc.mockGetParameter,
+				},
+			}
+
+			// WHEN
+			paused, err := store.GetPausedService("chicken", "test", "api")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedPaused, *paused)
+			}
+		})
+	}
+}
+
+func TestStore_DeletePausedService(t *testing.T) {
+	testCases := map[string]struct {
+		mockDeleteParam func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
+
+		wantedErr error
+	}{
+		"service is not paused": {
+			mockDeleteParam: func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "Not found", nil)
+			},
+		},
+		"unexpected error": {
+			mockDeleteParam: func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				return nil, fmt.Errorf("broken")
+			},
+			wantedErr: fmt.Errorf("delete paused service api in environment test: broken"),
+		},
+		"successfully deleted record": {
+			mockDeleteParam: func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				require.Equal(t, fmt.Sprintf(fmtPausedSvcParamPath, "chicken", "api", "test"), *in.Name)
+				return nil, nil
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				ssmClient: &mockSSM{
+					t:                   t,
+					mockDeleteParameter: tc.mockDeleteParam,
+				},
+			}
+
+			// WHEN
+			err := store.DeletePausedService("chicken", "test", "api")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
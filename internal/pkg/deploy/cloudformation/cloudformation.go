@@ -38,7 +38,13 @@ type cfnClient interface {
 	DeleteAndWaitWithRoleARN(stackName, roleARN string) error
 	Describe(stackName string) (*cloudformation.StackDescription, error)
 	TemplateBody(stackName string) (string, error)
+	NestedStackTemplateBody(stackName, logicalID string) (string, error)
+	PreviewChanges(*cloudformation.Stack) ([]cloudformation.ResourceChange, error)
 	Events(stackName string) ([]cloudformation.StackEvent, error)
+	SetTerminationProtection(stackName string, enabled bool) error
+	CancelUpdate(stackName string) error
+	ContinueUpdateRollback(stackName string) error
+	WaitForUpdateRollback(stackName string) error
 }
 
 type stackSetClient interface {
@@ -73,9 +79,10 @@ func New(sess *session.Session) CloudFormation {
 }
 
 // streamResourceEvents sends a list of ResourceEvent every 3 seconds to the events channel.
+// Only the events that happened after since are sent, use the zero time to send all the events of the stack.
 // The events channel is closed only when the done channel receives a message.
 // If an error occurs while describing stack events, it is ignored so that the stream is not interrupted.
-func (cf CloudFormation) streamResourceEvents(done <-chan struct{}, events chan []deploy.ResourceEvent, stackName string) {
+func (cf CloudFormation) streamResourceEvents(done <-chan struct{}, events chan []deploy.ResourceEvent, stackName string, since time.Time) {
 	sendStatusUpdates := func() {
 		// Send a list of ResourceEvent to events if there was no error.
 		cfEvents, err := cf.cfnClient.Events(stackName)
@@ -84,9 +91,14 @@ func (cf CloudFormation) streamResourceEvents(done <-chan struct{}, events chan
 		}
 		var transformedEvents []deploy.ResourceEvent
 		for _, cfEvent := range cfEvents {
+			if cfEvent.Timestamp != nil && cfEvent.Timestamp.Before(since) {
+				// The event belongs to a previous deployment of the stack.
+				continue
+			}
 			transformedEvents = append(transformedEvents, deploy.ResourceEvent{
 				Resource: deploy.Resource{
 					LogicalName: aws.StringValue(cfEvent.LogicalResourceId),
+					PhysicalID:  aws.StringValue(cfEvent.PhysicalResourceId),
 					Type:        aws.StringValue(cfEvent.ResourceType),
 				},
 				Status: aws.StringValue(cfEvent.ResourceStatus),
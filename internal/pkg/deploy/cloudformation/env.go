@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
@@ -46,7 +47,7 @@ func (cf CloudFormation) StreamEnvironmentCreation(env *deploy.CreateEnvironment
 	resp := make(chan deploy.CreateEnvironmentResponse, 1)
 
 	stack := stack.NewEnvStackConfig(env)
-	go cf.streamResourceEvents(done, events, stack.StackName())
+	go cf.streamResourceEvents(done, events, stack.StackName(), time.Time{}) // The stack is new, all its events are relevant.
 	go cf.streamEnvironmentResponse(done, resp, stack)
 	return events, resp
 }
@@ -60,6 +61,11 @@ func (cf CloudFormation) DeleteEnvironment(appName, envName, cfnExecRoleARN stri
 	return cf.cfnClient.DeleteAndWaitWithRoleARN(conf.StackName(), cfnExecRoleARN)
 }
 
+// SetEnvironmentTerminationProtection enables or disables termination protection on the CloudFormation stack of an environment.
+func (cf CloudFormation) SetEnvironmentTerminationProtection(appName, envName string, enabled bool) error {
+	return cf.cfnClient.SetTerminationProtection(stack.NameForEnv(appName, envName), enabled)
+}
+
 // streamEnvironmentResponse sends a CreateEnvironmentResponse to the response channel once the stack creation halts.
 // The done channel is closed once this method exits to notify other streams that they should stop working.
 func (cf CloudFormation) streamEnvironmentResponse(done chan struct{}, resp chan deploy.CreateEnvironmentResponse, stack *stack.EnvStackConfig) {
@@ -216,6 +216,23 @@ func TestCloudFormation_EnvironmentTemplate(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_SetEnvironmentTerminationProtection(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockcfnClient(ctrl)
+	m.EXPECT().SetTerminationProtection("phonetool-test", false).Return(nil)
+	cf := &CloudFormation{
+		cfnClient: m,
+	}
+
+	// WHEN
+	err := cf.SetEnvironmentTerminationProtection("phonetool", "test", false)
+
+	// THEN
+	require.NoError(t, err)
+}
+
 func TestCloudFormation_UpdateEnvironmentTemplate(t *testing.T) {
 	testCases := map[string]struct {
 		inAppName      string
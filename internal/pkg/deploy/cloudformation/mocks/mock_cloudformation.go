@@ -272,6 +272,36 @@ func (mr *MockcfnClientMockRecorder) TemplateBody(stackName interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TemplateBody", reflect.TypeOf((*MockcfnClient)(nil).TemplateBody), stackName)
 }
 
+// NestedStackTemplateBody mocks base method
+func (m *MockcfnClient) NestedStackTemplateBody(stackName, logicalID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NestedStackTemplateBody", stackName, logicalID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NestedStackTemplateBody indicates an expected call of NestedStackTemplateBody
+func (mr *MockcfnClientMockRecorder) NestedStackTemplateBody(stackName, logicalID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NestedStackTemplateBody", reflect.TypeOf((*MockcfnClient)(nil).NestedStackTemplateBody), stackName, logicalID)
+}
+
+// PreviewChanges mocks base method
+func (m *MockcfnClient) PreviewChanges(arg0 *cloudformation0.Stack) ([]cloudformation0.ResourceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewChanges", arg0)
+	ret0, _ := ret[0].([]cloudformation0.ResourceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewChanges indicates an expected call of PreviewChanges
+func (mr *MockcfnClientMockRecorder) PreviewChanges(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewChanges", reflect.TypeOf((*MockcfnClient)(nil).PreviewChanges), arg0)
+}
+
 // Events mocks base method
 func (m *MockcfnClient) Events(stackName string) ([]cloudformation0.StackEvent, error) {
 	m.ctrl.T.Helper()
@@ -287,6 +317,62 @@ func (mr *MockcfnClientMockRecorder) Events(stackName interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Events", reflect.TypeOf((*MockcfnClient)(nil).Events), stackName)
 }
 
+// SetTerminationProtection mocks base method
+func (m *MockcfnClient) SetTerminationProtection(stackName string, enabled bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTerminationProtection", stackName, enabled)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTerminationProtection indicates an expected call of SetTerminationProtection
+func (mr *MockcfnClientMockRecorder) SetTerminationProtection(stackName, enabled interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTerminationProtection", reflect.TypeOf((*MockcfnClient)(nil).SetTerminationProtection), stackName, enabled)
+}
+
+// CancelUpdate mocks base method
+func (m *MockcfnClient) CancelUpdate(stackName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelUpdate", stackName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelUpdate indicates an expected call of CancelUpdate
+func (mr *MockcfnClientMockRecorder) CancelUpdate(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUpdate", reflect.TypeOf((*MockcfnClient)(nil).CancelUpdate), stackName)
+}
+
+// ContinueUpdateRollback mocks base method
+func (m *MockcfnClient) ContinueUpdateRollback(stackName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContinueUpdateRollback", stackName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ContinueUpdateRollback indicates an expected call of ContinueUpdateRollback
+func (mr *MockcfnClientMockRecorder) ContinueUpdateRollback(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContinueUpdateRollback", reflect.TypeOf((*MockcfnClient)(nil).ContinueUpdateRollback), stackName)
+}
+
+// WaitForUpdateRollback mocks base method
+func (m *MockcfnClient) WaitForUpdateRollback(stackName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForUpdateRollback", stackName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitForUpdateRollback indicates an expected call of WaitForUpdateRollback
+func (mr *MockcfnClientMockRecorder) WaitForUpdateRollback(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForUpdateRollback", reflect.TypeOf((*MockcfnClient)(nil).WaitForUpdateRollback), stackName)
+}
+
 // MockstackSetClient is a mock of stackSetClient interface
 type MockstackSetClient struct {
 	ctrl     *gomock.Controller
@@ -6,6 +6,7 @@ package stack
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -63,6 +64,7 @@ const (
 
 var cfTemplateFunctions = map[string]interface{}{
 	"logicalIDSafe": template.ReplaceDashesFunc,
+	"quote":         strconv.Quote,
 }
 
 // AppConfigFrom takes a template file and extracts the metadata block,
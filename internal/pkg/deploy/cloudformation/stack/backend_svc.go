@@ -84,7 +84,7 @@ func (s *BackendService) Template() (string, error) {
 		return "", fmt.Errorf("convert the Auto Scaling configuration for service %s: %w", s.name, err)
 	}
 	content, err := s.parser.ParseBackendService(template.WorkloadOpts{
-		Variables:          s.manifest.BackendServiceConfig.Variables,
+		Variables:          s.variables(s.manifest.BackendServiceConfig.Variables),
 		Secrets:            s.manifest.BackendServiceConfig.Secrets,
 		NestedStack:        outputs,
 		Sidecars:           sidecars,
@@ -92,6 +92,7 @@ func (s *BackendService) Template() (string, error) {
 		HealthCheck:        s.manifest.BackendServiceConfig.ImageConfig.HealthCheckOpts(),
 		LogConfig:          s.manifest.LogConfigOpts(),
 		DesiredCountLambda: desiredCountLambda.String(),
+		ExecuteCommand:     aws.BoolValue(s.manifest.BackendServiceConfig.Exec),
 	})
 	if err != nil {
 		return "", fmt.Errorf("parse backend service template: %w", err)
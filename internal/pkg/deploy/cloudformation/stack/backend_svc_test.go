@@ -63,6 +63,8 @@ func TestBackendService_Template(t *testing.T) {
 	testBackendSvcManifestWithBadAutoScaling.Count.Autoscaling = manifest.Autoscaling{
 		Range: &badRange,
 	}
+	testBackendSvcManifestWithExec := manifest.NewBackendService(baseProps)
+	testBackendSvcManifestWithExec.Exec = aws.Bool(true)
 	testCases := map[string]struct {
 		mockDependencies func(t *testing.T, ctrl *gomock.Controller, svc *BackendService)
 		manifest         *manifest.BackendService
@@ -159,6 +161,20 @@ func TestBackendService_Template(t *testing.T) {
 			},
 			wantedTemplate: "template",
 		},
+		"render template with execute command enabled": {
+			manifest: testBackendSvcManifestWithExec,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseBackendService(template.WorkloadOpts{
+					DesiredCountLambda: "something",
+					ExecuteCommand:     true,
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+			wantedTemplate: "template",
+		},
 	}
 
 	for name, tc := range testCases {
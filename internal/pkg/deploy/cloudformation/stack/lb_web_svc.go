@@ -109,7 +109,7 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		return "", fmt.Errorf("convert the Auto Scaling configuration for service %s: %w", s.name, err)
 	}
 	content, err := s.parser.ParseLoadBalancedWebService(template.WorkloadOpts{
-		Variables:          s.manifest.Variables,
+		Variables:          s.variables(s.manifest.Variables),
 		Secrets:            s.manifest.Secrets,
 		NestedStack:        outputs,
 		Sidecars:           sidecars,
@@ -117,6 +117,7 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		Autoscaling:        autoscaling,
 		RulePriorityLambda: rulePriorityLambda.String(),
 		DesiredCountLambda: desiredCountLambda.String(),
+		ExecuteCommand:     aws.BoolValue(s.manifest.Exec),
 	})
 	if err != nil {
 		return "", err
@@ -36,6 +36,14 @@ func (p *pipelineStackConfig) Template() (string, error) {
 	return content.String(), nil
 }
 
+// BuildEnvironment returns the environment of the CodeBuild project of the build stage.
+func (p *pipelineStackConfig) BuildEnvironment() *deploy.PipelineBuild {
+	if p.Build == nil {
+		return deploy.DefaultPipelineBuild()
+	}
+	return p.Build
+}
+
 func (p *pipelineStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
 	return nil, nil
 }
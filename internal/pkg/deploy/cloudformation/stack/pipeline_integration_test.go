@@ -1,3 +1,4 @@
+//go:build integration
 // +build integration
 
 // Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
@@ -28,6 +29,18 @@ func TestPipeline_Template(t *testing.T) {
 				"access_token_secret": "mysecret",
 			},
 		},
+		BuildspecPath: "ci/buildspec.yml",
+		Build: &deploy.PipelineBuild{
+			Image:          "1111.dkr.ecr.us-west-2.amazonaws.com/toolchain:latest",
+			ComputeType:    "BUILD_GENERAL1_LARGE",
+			PrivilegedMode: true,
+			EnvironmentVariables: map[string]string{
+				"GOFLAGS":  "-mod=vendor",
+				"NODE_ENV": "production",
+			},
+			TimeoutInMinutes:   90,
+			ImageRepositoryARN: "arn:aws:ecr:us-west-2:1111:repository/toolchain",
+		},
 		Stages: []deploy.PipelineStage{
 			{
 				AssociatedEnvironment: &deploy.AssociatedEnvironment{
@@ -35,9 +48,52 @@ func TestPipeline_Template(t *testing.T) {
 					Region:    "us-west-2",
 					AccountID: "1111",
 				},
+				LocalServices:        []string{"api"},
+				LocalJobs:            []string{"report"},
+				RequiresApproval:     false,
+				TestCommands:         []string{`echo "test"`},
+				LoadBalancedServices: []string{"api"},
+				PreDeployments: []deploy.PipelineAction{
+					{
+						Name:          "db-migration",
+						BuildspecPath: "copilot/pipelines/phonetool-pipeline/migrate.yml",
+						ComputeType:   "BUILD_GENERAL1_SMALL",
+					},
+				},
+				PostDeployments: []deploy.PipelineAction{
+					{
+						Name:          "integration-tests",
+						BuildspecPath: "copilot/pipelines/phonetool-pipeline/integration.yml",
+						ComputeType:   "BUILD_GENERAL1_MEDIUM",
+					},
+				},
+			},
+			{
+				AssociatedEnvironment: &deploy.AssociatedEnvironment{
+					Name:      "prod",
+					Region:    "us-east-1",
+					AccountID: "2222",
+				},
 				LocalServices:    []string{"api"},
-				RequiresApproval: false,
-				TestCommands:     []string{`echo "test"`},
+				RequiresApproval: true,
+				Approval: deploy.PipelineApproval{
+					Message:   `Check the "api" dashboards. Services deployed to test: api: #{test-api.ServiceURL}.`,
+					ReviewURL: "#{test-api.ServiceURL}",
+				},
+			},
+		},
+		Notifications: []deploy.PipelineNotification{
+			{
+				Event:           "approval_needed",
+				LogicalIDPrefix: "ApprovalNeeded",
+				EventTypeID:     "codepipeline-pipeline-manual-approval-needed",
+				Emails:          []string{"reviewers@example.com"},
+			},
+			{
+				Event:           "failed",
+				LogicalIDPrefix: "Failed",
+				EventTypeID:     "codepipeline-pipeline-pipeline-execution-failed",
+				TopicARNs:       []string{"arn:aws:sns:us-west-2:1111:oncall"},
 			},
 		},
 		ArtifactBuckets: []deploy.ArtifactBucket{
@@ -113,6 +113,25 @@ func TestPipelineStackConfig_Template(t *testing.T) {
 	}
 }
 
+func TestPipelineStackConfig_BuildEnvironment(t *testing.T) {
+	t.Run("defaults the environment if the input doesn't customize it", func(t *testing.T) {
+		pipeline := NewPipelineStackConfig(mockCreatePipelineInput())
+
+		require.Equal(t, deploy.DefaultPipelineBuild(), pipeline.BuildEnvironment())
+	})
+	t.Run("uses the environment of the input", func(t *testing.T) {
+		in := mockCreatePipelineInput()
+		in.Build = &deploy.PipelineBuild{
+			Image:            "aws/codebuild/standard:5.0",
+			ComputeType:      "BUILD_GENERAL1_LARGE",
+			TimeoutInMinutes: 120,
+		}
+		pipeline := NewPipelineStackConfig(in)
+
+		require.Equal(t, in.Build, pipeline.BuildEnvironment())
+	})
+}
+
 func mockAssociatedEnv(envName, region string) *deploy.AssociatedEnvironment {
 	return &deploy.AssociatedEnvironment{
 		Name:      envName,
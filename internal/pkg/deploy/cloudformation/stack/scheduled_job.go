@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 	"unicode"
@@ -42,8 +43,14 @@ var (
 	fmtCronScheduleExpression = "cron(%s)"
 
 	awsScheduleRegexp = regexp.MustCompile(`(?:rate|cron)\(.*\)`) // Validates that an expression is of the form rate(xyz) or cron(abc)
+
+	stepNameRegexp    = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*$`)
+	nonAlphaNumRegexp = regexp.MustCompile(`[^a-zA-Z0-9]+`)
 )
 
+// Environment variable that holds the output of a step a step depends on, for example COPILOT_STEP_EXTRACT_OUTPUT.
+const fmtStepOutputEnvVar = "COPILOT_STEP_%s_OUTPUT"
+
 const (
 	// Cron expressions in AWS Cloudwatch are of the form "M H DoM Mo DoW Y"
 	// We use these predefined schedules when a customer specifies "@daily" or "@annually"
@@ -79,6 +86,14 @@ func (e errScheduleInvalid) Error() string {
 	return fmt.Sprintf("schedule is not valid cron, rate, or preset: %v", e.reason)
 }
 
+type errTimezoneInvalid struct {
+	reason error
+}
+
+func (e errTimezoneInvalid) Error() string {
+	return fmt.Sprintf("timezone is not a valid IANA time zone name: %v", e.reason)
+}
+
 type errDurationInvalid struct {
 	reason error
 }
@@ -126,7 +141,12 @@ func (j *ScheduledJob) Template() (string, error) {
 		return "", fmt.Errorf("convert the sidecar configuration for job %s: %w", j.name, err)
 	}
 
-	schedule, err := j.awsSchedule()
+	schedules, err := j.awsSchedules()
+	if err != nil {
+		return "", fmt.Errorf("convert schedule for job %s: %w", j.name, err)
+	}
+
+	timezone, err := j.timezone()
 	if err != nil {
 		return "", fmt.Errorf("convert schedule for job %s: %w", j.name, err)
 	}
@@ -137,13 +157,14 @@ func (j *ScheduledJob) Template() (string, error) {
 	}
 
 	content, err := j.parser.ParseScheduledJob(template.WorkloadOpts{
-		Variables:          j.manifest.Variables,
-		Secrets:            j.manifest.Secrets,
-		NestedStack:        outputs,
-		Sidecars:           sidecars,
-		ScheduleExpression: schedule,
-		StateMachine:       stateMachine,
-		LogConfig:          j.manifest.LogConfigOpts(),
+		Variables:           j.manifest.Variables,
+		Secrets:             j.manifest.Secrets,
+		NestedStack:         outputs,
+		Sidecars:            sidecars,
+		ScheduleExpressions: schedules,
+		ScheduleTimezone:    timezone,
+		StateMachine:        stateMachine,
+		LogConfig:           j.manifest.LogConfigOpts(),
 	})
 	if err != nil {
 		return "", fmt.Errorf("parse scheduled job template: %w", err)
@@ -157,14 +178,14 @@ func (j *ScheduledJob) Parameters() ([]*cloudformation.Parameter, error) {
 	if err != nil {
 		return nil, err
 	}
-	schedule, err := j.awsSchedule()
+	schedules, err := j.awsSchedules()
 	if err != nil {
 		return nil, err
 	}
 	return append(wkldParams, []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(ScheduledJobScheduleParamKey),
-			ParameterValue: aws.String(schedule),
+			ParameterValue: aws.String(schedules[0]),
 		},
 	}...), nil
 }
@@ -175,7 +196,45 @@ func (j *ScheduledJob) SerializedParameters() (string, error) {
 	return j.wkld.templateConfiguration(j)
 }
 
-// awsSchedule converts the Schedule string to the format required by Cloudwatch Events
+// awsSchedules converts the job's schedules to the format required by Cloudwatch Events.
+func (j *ScheduledJob) awsSchedules() ([]string, error) {
+	var schedules []string
+	if j.manifest.On.Schedule != "" {
+		schedules = append(schedules, j.manifest.On.Schedule)
+	}
+	schedules = append(schedules, j.manifest.On.Schedules...)
+	if len(schedules) == 0 {
+		return nil, fmt.Errorf(`missing required field "schedule" in manifest for job %s`, j.name)
+	}
+	var exprs []string
+	for _, schedule := range schedules {
+		expr, err := toAWSSchedule(schedule)
+		if err != nil {
+			return nil, err
+		}
+		exprs = appendUnique(exprs, expr)
+	}
+	return exprs, nil
+}
+
+// timezone returns the IANA time zone in which the job's schedules are evaluated, or an empty string for UTC.
+func (j *ScheduledJob) timezone() (string, error) {
+	tz := j.manifest.On.Timezone
+	// Schedules without a time zone are evaluated in UTC by EventBridge rules.
+	if tz == "" || tz == manifest.DefaultJobTimezone {
+		return "", nil
+	}
+	// "Local" is the time zone of the machine running the CLI, not one that the schedule can be evaluated in.
+	if tz == "Local" {
+		return "", errTimezoneInvalid{reason: fmt.Errorf("unknown time zone %s", tz)}
+	}
+	if _, err := time.LoadLocation(tz); err != nil {
+		return "", errTimezoneInvalid{reason: err}
+	}
+	return tz, nil
+}
+
+// toAWSSchedule converts a schedule string to the format required by Cloudwatch Events
 // https://docs.aws.amazon.com/lambda/latest/dg/services-cloudwatchevents-expressions.html
 // Cron expressions must have an sixth "year" field, and must contain at least one ? (either-or)
 // in either day-of-month or day-of-week.
@@ -184,33 +243,30 @@ func (j *ScheduledJob) SerializedParameters() (string, error) {
 // All others become cron expressions.
 // Exception is made for strings of the form "rate( )" or "cron( )". These are accepted as-is and
 // validated server-side by CloudFormation.
-func (j *ScheduledJob) awsSchedule() (string, error) {
-	if j.manifest.On.Schedule == "" {
-		return "", fmt.Errorf(`missing required field "schedule" in manifest for job %s`, j.name)
-	}
+func toAWSSchedule(schedule string) (string, error) {
 	// If the schedule uses default CloudWatch Events syntax, pass it through for server-side validation.
-	if match := awsScheduleRegexp.FindStringSubmatch(j.manifest.On.Schedule); match != nil {
-		return j.manifest.On.Schedule, nil
+	if match := awsScheduleRegexp.FindStringSubmatch(schedule); match != nil {
+		return schedule, nil
 	}
 	// Try parsing the string as a cron expression to validate it.
-	if _, err := cron.ParseStandard(j.manifest.On.Schedule); err != nil {
+	if _, err := cron.ParseStandard(schedule); err != nil {
 		return "", errScheduleInvalid{reason: err}
 	}
 	var scheduleExpression string
 	var err error
 	switch {
-	case strings.HasPrefix(j.manifest.On.Schedule, every):
-		scheduleExpression, err = toRate(j.manifest.On.Schedule[len(every):])
+	case strings.HasPrefix(schedule, every):
+		scheduleExpression, err = toRate(schedule[len(every):])
 		if err != nil {
 			return "", fmt.Errorf("parse fixed interval: %w", err)
 		}
-	case strings.HasPrefix(j.manifest.On.Schedule, "@"):
-		scheduleExpression, err = toFixedSchedule(j.manifest.On.Schedule)
+	case strings.HasPrefix(schedule, "@"):
+		scheduleExpression, err = toFixedSchedule(schedule)
 		if err != nil {
 			return "", fmt.Errorf("parse preset schedule: %w", err)
 		}
 	default:
-		scheduleExpression, err = toAWSCron(j.manifest.On.Schedule)
+		scheduleExpression, err = toAWSCron(schedule)
 		if err != nil {
 			return "", fmt.Errorf("parse cron schedule: %w", err)
 		}
@@ -218,6 +274,15 @@ func (j *ScheduledJob) awsSchedule() (string, error) {
 	return scheduleExpression, nil
 }
 
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
+
 // toRate converts a cron "@every" directive to a rate expression defined in minutes.
 // example input: @every 1h30m
 //        output: rate(90 minutes)
@@ -360,8 +425,394 @@ func (j *ScheduledJob) stateMachineOpts() (*template.StateMachineOpts, error) {
 		}
 		retries = aws.Int(j.manifest.Retries)
 	}
+	stages, err := j.stepStages(retries, timeoutSeconds)
+	if err != nil {
+		return nil, err
+	}
 	return &template.StateMachineOpts{
 		Timeout: timeoutSeconds,
 		Retries: retries,
+		Stages:  stages,
 	}, nil
 }
+
+// stepStages converts the steps of a multi-step job into stages that run sequentially.
+// The stages are laid out so that each step only waits for the steps it depends on.
+func (j *ScheduledJob) stepStages(defaultRetries, timeout *int) ([]*template.JobStageOpts, error) {
+	steps := j.manifest.Steps
+	if len(steps) == 0 {
+		return nil, nil
+	}
+	byName := make(map[string]manifest.JobStep, len(steps))
+	byLogicalID := make(map[string]string, len(steps))
+	for _, step := range steps {
+		if step.Name == "" {
+			return nil, errors.New(`missing required field "name" for a step`)
+		}
+		if !stepNameRegexp.MatchString(step.Name) {
+			return nil, fmt.Errorf("step name %s must start with a letter and contain only letters, numbers, hyphens, and underscores", step.Name)
+		}
+		if _, ok := byName[step.Name]; ok {
+			return nil, fmt.Errorf("step %s is defined more than once", step.Name)
+		}
+		if other, ok := byLogicalID[stepLogicalID(step.Name)]; ok {
+			return nil, fmt.Errorf("step names %s and %s are too similar", other, step.Name)
+		}
+		byLogicalID[stepLogicalID(step.Name)] = step.Name
+		if step.Retries != nil && aws.IntValue(step.Retries) < 0 {
+			return nil, fmt.Errorf("number of retries for step %s cannot be negative", step.Name)
+		}
+		byName[step.Name] = step
+	}
+	for _, step := range steps {
+		for _, dep := range step.DependsOn {
+			if _, ok := byName[dep]; !ok {
+				return nil, fmt.Errorf("step %s depends on step %s which does not exist", step.Name, dep)
+			}
+		}
+	}
+
+	visited := make(map[string]bool, len(steps))
+	var visit func(name string, visiting map[string]bool) error
+	visit = func(name string, visiting map[string]bool) error {
+		if visited[name] {
+			return nil
+		}
+		if visiting[name] {
+			return fmt.Errorf("steps have a circular dependency on step %s", name)
+		}
+		visiting[name] = true
+		for _, dep := range byName[name].DependsOn {
+			if err := visit(dep, visiting); err != nil {
+				return err
+			}
+		}
+		delete(visiting, name)
+		visited[name] = true
+		return nil
+	}
+	names := make([]string, len(steps))
+	for i, step := range steps {
+		if err := visit(step.Name, make(map[string]bool)); err != nil {
+			return nil, err
+		}
+		names[i] = step.Name
+	}
+
+	b := &stepStagesBuilder{
+		steps: byName,
+		opts: func(step manifest.JobStep) *template.JobStepOpts {
+			return j.stepOpts(step, defaultRetries, timeout)
+		},
+	}
+	return b.sequence(names, make(map[string]string)), nil
+}
+
+// stepStagesBuilder arranges the steps of a multi-step job into sequences of stages.
+type stepStagesBuilder struct {
+	steps     map[string]manifest.JobStep
+	opts      func(step manifest.JobStep) *template.JobStepOpts
+	parallels int // Number of parallel stages so far, to name them uniquely.
+}
+
+// sequence returns the stages that run the steps, given that the steps they depend on outside of names are done.
+// outputPaths holds where the outputs of the steps that are done are stored, and is updated with the outputs of names.
+//
+// Independent groups of steps run in parallel branches, and a group runs in two sequences one after the other
+// when every step of the second one depends on every step of the first one. Steps that can't be laid out
+// this way without waiting for steps they don't depend on run in a coordinated parallel stage instead.
+func (b *stepStagesBuilder) sequence(names []string, outputPaths map[string]string) []*template.JobStageOpts {
+	if groups := b.independentGroups(names); len(groups) > 1 {
+		return []*template.JobStageOpts{b.parallel(groups, outputPaths)}
+	}
+	if len(names) == 1 {
+		return []*template.JobStageOpts{b.single(names[0], outputPaths)}
+	}
+	first, rest, ok := b.seriesCut(names)
+	if !ok {
+		return []*template.JobStageOpts{b.coordinated(names, outputPaths)}
+	}
+	stages := b.sequence(first, outputPaths)
+	next := b.sequence(rest, outputPaths)
+	stages[len(stages)-1].Next = next[0].Name
+	return append(stages, next...)
+}
+
+// single returns a stage that runs the step alone.
+func (b *stepStagesBuilder) single(name string, outputPaths map[string]string) *template.JobStageOpts {
+	step := b.opts(b.steps[name])
+	for _, dep := range b.steps[name].DependsOn {
+		path, ok := outputPaths[dep]
+		if !ok {
+			continue
+		}
+		step.Inputs = append(step.Inputs, &template.JobStepInputOpts{
+			Name: fmt.Sprintf(fmtStepOutputEnvVar, stepEnvVarName(dep)),
+			Path: path,
+		})
+	}
+	if step.Output {
+		outputPaths[name] = fmt.Sprintf("$.%s", step.OutputKey)
+	}
+	return &template.JobStageOpts{
+		Name: name,
+		Step: step,
+	}
+}
+
+// parallel returns a stage that runs each group of steps in its own branch.
+func (b *stepStagesBuilder) parallel(groups [][]string, outputPaths map[string]string) *template.JobStageOpts {
+	b.parallels++
+	stage := &template.JobStageOpts{
+		Name: fmt.Sprintf("Parallel %d", b.parallels),
+	}
+	resultPath := fmt.Sprintf("Parallel%dOutputs", b.parallels)
+	var outputs []string
+	for i, group := range groups {
+		branchPaths := make(map[string]string, len(outputPaths))
+		for name, path := range outputPaths {
+			branchPaths[name] = path
+		}
+		stage.Branches = append(stage.Branches, b.sequence(group, branchPaths))
+		for _, name := range group {
+			path, ok := branchPaths[name]
+			if !ok {
+				continue
+			}
+			key := b.opts(b.steps[name]).OutputKey
+			stage.Outputs = append(stage.Outputs, &template.JobStageOutputOpts{
+				Key:  key,
+				Path: fmt.Sprintf("$[%d]%s", i, strings.TrimPrefix(path, "$")),
+			})
+			outputs = append(outputs, name)
+		}
+	}
+	if len(stage.Outputs) > 0 {
+		stage.ResultPath = resultPath
+	}
+	for i, name := range outputs {
+		outputPaths[name] = fmt.Sprintf("$.%s.%s", resultPath, stage.Outputs[i].Key)
+	}
+	return stage
+}
+
+// coordinated returns a stage that runs each step in its own branch, where the steps wait for the steps they depend on
+// in the other branches.
+func (b *stepStagesBuilder) coordinated(names []string, outputPaths map[string]string) *template.JobStageOpts {
+	in := stringSet(names)
+	waitedFor := make(map[string]bool)
+	for _, name := range names {
+		for _, dep := range b.steps[name].DependsOn {
+			if in[dep] {
+				waitedFor[dep] = true
+			}
+		}
+	}
+	groups := make([][]string, len(names))
+	for i, name := range names {
+		groups[i] = []string{name}
+	}
+	stage := b.parallel(groups, outputPaths)
+	stage.Coordinated = true
+	for _, branch := range stage.Branches {
+		waiting := branch[0]
+		waiting.Signals = waitedFor[waiting.Name]
+		for _, dep := range b.steps[waiting.Name].DependsOn {
+			if !in[dep] {
+				continue
+			}
+			key := stepLogicalID(dep)
+			waiting.Waits = append(waiting.Waits, &template.JobStageWaitOpts{
+				Name: dep,
+				Key:  key,
+			})
+			if !b.steps[dep].Output {
+				continue
+			}
+			waiting.Step.Inputs = append(waiting.Step.Inputs, &template.JobStepInputOpts{
+				Name:    fmt.Sprintf(fmtStepOutputEnvVar, stepEnvVarName(dep)),
+				Path:    fmt.Sprintf("$.%sCompletion.Item.%s.S", key, key),
+				Encoded: true,
+			})
+		}
+	}
+	return stage
+}
+
+// seriesCut splits names into the steps that run first and the ones that run after them, such that
+// every step that runs after depends, directly or not, on every step that runs first.
+// Returns false if the steps can't be split this way.
+func (b *stepStagesBuilder) seriesCut(names []string) (first, rest []string, ok bool) {
+	in := stringSet(names)
+	ancestors := make(map[string]map[string]bool, len(names))
+	var ancestorsOf func(name string) map[string]bool
+	ancestorsOf = func(name string) map[string]bool {
+		if a, ok := ancestors[name]; ok {
+			return a
+		}
+		a := make(map[string]bool)
+		for _, dep := range b.steps[name].DependsOn {
+			if !in[dep] {
+				continue
+			}
+			a[dep] = true
+			for ancestor := range ancestorsOf(dep) {
+				a[ancestor] = true
+			}
+		}
+		ancestors[name] = a
+		return a
+	}
+	// The steps that run after start with the steps whose ancestors are exactly the steps that run first.
+	// Try the candidates from the earliest cut to the latest.
+	candidates := make([]string, 0, len(names))
+	for _, name := range names {
+		if len(ancestorsOf(name)) > 0 {
+			candidates = append(candidates, name)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return len(ancestors[candidates[i]]) < len(ancestors[candidates[j]])
+	})
+	for _, candidate := range candidates {
+		before := ancestors[candidate]
+		starts := make(map[string]bool)
+		for _, name := range names {
+			if sameSet(ancestors[name], before) {
+				starts[name] = true
+			}
+		}
+		first, rest = nil, nil
+		for _, name := range names {
+			switch {
+			case before[name]:
+				first = append(first, name)
+			case starts[name] || intersects(ancestors[name], starts):
+				rest = append(rest, name)
+			}
+		}
+		if len(first)+len(rest) == len(names) {
+			return first, rest, true
+		}
+	}
+	return nil, nil, false
+}
+
+// independentGroups splits names into groups of steps such that no step of a group depends, directly or not,
+// on a step of another group.
+func (b *stepStagesBuilder) independentGroups(names []string) [][]string {
+	in := stringSet(names)
+	neighbors := make(map[string][]string, len(names))
+	for _, name := range names {
+		for _, dep := range b.steps[name].DependsOn {
+			if !in[dep] {
+				continue
+			}
+			neighbors[name] = append(neighbors[name], dep)
+			neighbors[dep] = append(neighbors[dep], name)
+		}
+	}
+	group := make(map[string]int, len(names))
+	var count int
+	var mark func(name string, id int)
+	mark = func(name string, id int) {
+		if _, ok := group[name]; ok {
+			return
+		}
+		group[name] = id
+		for _, neighbor := range neighbors[name] {
+			mark(neighbor, id)
+		}
+	}
+	for _, name := range names {
+		if _, ok := group[name]; !ok {
+			mark(name, count)
+			count++
+		}
+	}
+	groups := make([][]string, count)
+	for _, name := range names {
+		groups[group[name]] = append(groups[group[name]], name)
+	}
+	return groups
+}
+
+func sameSet(a, b map[string]bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for v := range a {
+		if !b[v] {
+			return false
+		}
+	}
+	return true
+}
+
+func intersects(a, b map[string]bool) bool {
+	for v := range a {
+		if b[v] {
+			return true
+		}
+	}
+	return false
+}
+
+func stringSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
+
+func (j *ScheduledJob) stepOpts(step manifest.JobStep, defaultRetries, timeout *int) *template.JobStepOpts {
+	retries := defaultRetries
+	if step.Retries != nil {
+		retries = nil
+		if aws.IntValue(step.Retries) > 0 {
+			retries = step.Retries
+		}
+	}
+	cpu, memory := aws.IntValue(j.manifest.CPU), aws.IntValue(j.manifest.Memory)
+	if step.CPU != nil {
+		cpu = aws.IntValue(step.CPU)
+	}
+	if step.Memory != nil {
+		memory = aws.IntValue(step.Memory)
+	}
+	logicalID := stepLogicalID(step.Name)
+	if !step.Output {
+		// Only the steps that report an output wait for a task token, the others fail as soon as their task stops.
+		timeout = nil
+	}
+	return &template.JobStepOpts{
+		Name:      step.Name,
+		LogicalID: logicalID,
+		Image:     step.Image,
+		Command:   aws.StringSlice(step.Command),
+		CPU:       cpu,
+		Memory:    memory,
+		Retries:   retries,
+		Output:    step.Output,
+		OutputKey: logicalID + "Output",
+		Timeout:   timeout,
+	}
+}
+
+// stepLogicalID converts a step name such as "extract-data" to a string usable in CloudFormation logical IDs such as "ExtractData".
+func stepLogicalID(name string) string {
+	var b strings.Builder
+	for _, word := range nonAlphaNumRegexp.Split(name, -1) {
+		if word == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return b.String()
+}
+
+// stepEnvVarName converts a step name such as "extract-data" to an environment variable friendly string such as "EXTRACT_DATA".
+func stepEnvVarName(name string) string {
+	return strings.ToUpper(strings.Trim(nonAlphaNumRegexp.ReplaceAllString(name, "_"), "_"))
+}
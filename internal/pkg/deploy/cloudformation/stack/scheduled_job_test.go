@@ -48,7 +48,7 @@ func TestScheduledJob_Template(t *testing.T) {
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, j *ScheduledJob) {
 				m := mocks.NewMockscheduledJobParser(ctrl)
 				m.EXPECT().ParseScheduledJob(gomock.Eq(template.WorkloadOpts{
-					ScheduleExpression: "cron(0 0 * * ? *)",
+					ScheduleExpressions: []string{"cron(0 0 * * ? *)"},
 					StateMachine: &template.StateMachineOpts{
 						Timeout: aws.Int(5400),
 						Retries: aws.Int(3),
@@ -70,7 +70,7 @@ func TestScheduledJob_Template(t *testing.T) {
 						SecretOutputs:   []string{"MySecretArn"},
 						PolicyOutputs:   []string{"AdditionalResourcesPolicyArn"},
 					},
-					ScheduleExpression: "cron(0 0 * * ? *)",
+					ScheduleExpressions: []string{"cron(0 0 * * ? *)"},
 					StateMachine: &template.StateMachineOpts{
 						Timeout: aws.Int(5400),
 						Retries: aws.Int(3),
@@ -163,10 +163,12 @@ Outputs:
 	}
 }
 
-func TestScheduledJob_awsSchedule(t *testing.T) {
+func TestScheduledJob_awsSchedules(t *testing.T) {
 	testCases := map[string]struct {
 		inputSchedule   string
+		inputSchedules  []string
 		wantedSchedule  string
+		wantedSchedules []string
 		wantedError     error
 		wantedErrorType interface{}
 	}{
@@ -274,6 +276,15 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 			inputSchedule:  "rate(5 minutes)",
 			wantedSchedule: "rate(5 minutes)",
 		},
+		"multiple schedules": {
+			inputSchedule:   "0 9 * * MON-FRI",
+			inputSchedules:  []string{"@weekly", "rate(5 minutes)", "0 9 * * 1-5"},
+			wantedSchedules: []string{"cron(0 9 ? * MON-FRI *)", "cron(0 0 ? * 1 *)", "rate(5 minutes)", "cron(0 9 ? * 2-6 *)"},
+		},
+		"only the schedules field": {
+			inputSchedules:  []string{"@daily"},
+			wantedSchedules: []string{"cron(0 0 * * ? *)"},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -285,13 +296,19 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 				manifest: &manifest.ScheduledJob{
 					ScheduledJobConfig: manifest.ScheduledJobConfig{
 						On: manifest.JobTriggerConfig{
-							Schedule: tc.inputSchedule,
+							Schedule:  tc.inputSchedule,
+							Schedules: tc.inputSchedules,
 						},
 					},
 				},
 			}
+			wantedSchedules := tc.wantedSchedules
+			if tc.wantedSchedule != "" {
+				wantedSchedules = []string{tc.wantedSchedule}
+			}
+
 			// WHEN
-			parsedSchedule, err := job.awsSchedule()
+			parsedSchedules, err := job.awsSchedules()
 
 			// THEN
 			if tc.wantedErrorType != nil {
@@ -302,7 +319,60 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 				require.EqualError(t, err, tc.wantedError.Error())
 			} else {
 				require.NoError(t, err)
-				require.Equal(t, tc.wantedSchedule, parsedSchedule)
+				require.Equal(t, wantedSchedules, parsedSchedules)
+			}
+		})
+	}
+}
+
+func TestScheduledJob_timezone(t *testing.T) {
+	testCases := map[string]struct {
+		inputTimezone  string
+		wantedTimezone string
+		wantedError    error
+	}{
+		"defaults to UTC": {
+			wantedTimezone: "",
+		},
+		"UTC": {
+			inputTimezone:  "UTC",
+			wantedTimezone: "",
+		},
+		"IANA time zone": {
+			inputTimezone:  "Europe/Berlin",
+			wantedTimezone: "Europe/Berlin",
+		},
+		"invalid time zone": {
+			inputTimezone: "Mars/Olympus_Mons",
+			wantedError:   errors.New("timezone is not a valid IANA time zone name: unknown time zone Mars/Olympus_Mons"),
+		},
+		"local time zone": {
+			inputTimezone: "Local",
+			wantedError:   errors.New("timezone is not a valid IANA time zone name: unknown time zone Local"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			job := &ScheduledJob{
+				manifest: &manifest.ScheduledJob{
+					ScheduledJobConfig: manifest.ScheduledJobConfig{
+						On: manifest.JobTriggerConfig{
+							Timezone: tc.inputTimezone,
+						},
+					},
+				},
+			}
+
+			// WHEN
+			timezone, err := job.timezone()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTimezone, timezone)
 			}
 		})
 	}
@@ -388,6 +458,511 @@ func TestScheduledJob_stateMachine(t *testing.T) {
 	}
 }
 
+func TestScheduledJob_stepStages(t *testing.T) {
+	testCases := map[string]struct {
+		inputSteps   []manifest.JobStep
+		inputRetries *int
+		inputTimeout *int
+
+		wantedStages []*template.JobStageOpts
+		wantedError  error
+	}{
+		"no steps": {},
+		"sequential and parallel steps with outputs": {
+			inputRetries: aws.Int(2),
+			inputTimeout: aws.Int(3600),
+			inputSteps: []manifest.JobStep{
+				{
+					Name:    "extract",
+					Command: []string{"python", "extract.py"},
+					Output:  true,
+				},
+				{
+					Name:      "transform-users",
+					Image:     aws.String("transformer:latest"),
+					CPU:       aws.Int(1024),
+					Memory:    aws.Int(2048),
+					DependsOn: []string{"extract"},
+					Retries:   aws.Int(0),
+					Output:    true,
+				},
+				{
+					Name:      "transform-orders",
+					DependsOn: []string{"extract"},
+					Retries:   aws.Int(5),
+				},
+				{
+					Name:      "load",
+					DependsOn: []string{"transform-orders", "transform-users"},
+				},
+			},
+			wantedStages: []*template.JobStageOpts{
+				{
+					Name: "extract",
+					Next: "Parallel 1",
+					Step: &template.JobStepOpts{
+						Name:      "extract",
+						LogicalID: "Extract",
+						Command:   aws.StringSlice([]string{"python", "extract.py"}),
+						CPU:       256,
+						Memory:    512,
+						Retries:   aws.Int(2),
+						Output:    true,
+						OutputKey: "ExtractOutput",
+						Timeout:   aws.Int(3600),
+					},
+				},
+				{
+					Name: "Parallel 1",
+					Next: "load",
+					Branches: [][]*template.JobStageOpts{
+						{
+							{
+								Name: "transform-users",
+								Step: &template.JobStepOpts{
+									Name:      "transform-users",
+									LogicalID: "TransformUsers",
+									Image:     aws.String("transformer:latest"),
+									Command:   []*string{},
+									CPU:       1024,
+									Memory:    2048,
+									Output:    true,
+									OutputKey: "TransformUsersOutput",
+									Timeout:   aws.Int(3600),
+									Inputs: []*template.JobStepInputOpts{
+										{
+											Name: "COPILOT_STEP_EXTRACT_OUTPUT",
+											Path: "$.ExtractOutput",
+										},
+									},
+								},
+							},
+						},
+						{
+							{
+								Name: "transform-orders",
+								Step: &template.JobStepOpts{
+									Name:      "transform-orders",
+									LogicalID: "TransformOrders",
+									Command:   []*string{},
+									CPU:       256,
+									Memory:    512,
+									Retries:   aws.Int(5),
+									OutputKey: "TransformOrdersOutput",
+									Inputs: []*template.JobStepInputOpts{
+										{
+											Name: "COPILOT_STEP_EXTRACT_OUTPUT",
+											Path: "$.ExtractOutput",
+										},
+									},
+								},
+							},
+						},
+					},
+					ResultPath: "Parallel1Outputs",
+					Outputs: []*template.JobStageOutputOpts{
+						{
+							Key:  "TransformUsersOutput",
+							Path: "$[0].TransformUsersOutput",
+						},
+					},
+				},
+				{
+					Name: "load",
+					Step: &template.JobStepOpts{
+						Name:      "load",
+						LogicalID: "Load",
+						Command:   []*string{},
+						CPU:       256,
+						Memory:    512,
+						Retries:   aws.Int(2),
+						OutputKey: "LoadOutput",
+						Inputs: []*template.JobStepInputOpts{
+							{
+								Name: "COPILOT_STEP_TRANSFORM_USERS_OUTPUT",
+								Path: "$.Parallel1Outputs.TransformUsersOutput",
+							},
+						},
+					},
+				},
+			},
+		},
+		"steps only wait for the steps they depend on": {
+			inputSteps: []manifest.JobStep{
+				{
+					Name:   "extract-users",
+					Output: true,
+				},
+				{
+					Name:   "extract-orders",
+					Output: true,
+				},
+				{
+					Name:      "load-users",
+					DependsOn: []string{"extract-users"},
+				},
+				{
+					Name:      "load-orders",
+					DependsOn: []string{"extract-orders"},
+				},
+				{
+					Name:      "report",
+					DependsOn: []string{"load-users"},
+				},
+			},
+			wantedStages: []*template.JobStageOpts{
+				{
+					Name: "Parallel 1",
+					Branches: [][]*template.JobStageOpts{
+						{
+							{
+								Name: "extract-users",
+								Next: "load-users",
+								Step: &template.JobStepOpts{
+									Name:      "extract-users",
+									LogicalID: "ExtractUsers",
+									Command:   []*string{},
+									CPU:       256,
+									Memory:    512,
+									Output:    true,
+									OutputKey: "ExtractUsersOutput",
+								},
+							},
+							{
+								Name: "load-users",
+								Next: "report",
+								Step: &template.JobStepOpts{
+									Name:      "load-users",
+									LogicalID: "LoadUsers",
+									Command:   []*string{},
+									CPU:       256,
+									Memory:    512,
+									OutputKey: "LoadUsersOutput",
+									Inputs: []*template.JobStepInputOpts{
+										{
+											Name: "COPILOT_STEP_EXTRACT_USERS_OUTPUT",
+											Path: "$.ExtractUsersOutput",
+										},
+									},
+								},
+							},
+							{
+								Name: "report",
+								Step: &template.JobStepOpts{
+									Name:      "report",
+									LogicalID: "Report",
+									Command:   []*string{},
+									CPU:       256,
+									Memory:    512,
+									OutputKey: "ReportOutput",
+								},
+							},
+						},
+						{
+							{
+								Name: "extract-orders",
+								Next: "load-orders",
+								Step: &template.JobStepOpts{
+									Name:      "extract-orders",
+									LogicalID: "ExtractOrders",
+									Command:   []*string{},
+									CPU:       256,
+									Memory:    512,
+									Output:    true,
+									OutputKey: "ExtractOrdersOutput",
+								},
+							},
+							{
+								Name: "load-orders",
+								Step: &template.JobStepOpts{
+									Name:      "load-orders",
+									LogicalID: "LoadOrders",
+									Command:   []*string{},
+									CPU:       256,
+									Memory:    512,
+									OutputKey: "LoadOrdersOutput",
+									Inputs: []*template.JobStepInputOpts{
+										{
+											Name: "COPILOT_STEP_EXTRACT_ORDERS_OUTPUT",
+											Path: "$.ExtractOrdersOutput",
+										},
+									},
+								},
+							},
+						},
+					},
+					ResultPath: "Parallel1Outputs",
+					Outputs: []*template.JobStageOutputOpts{
+						{
+							Key:  "ExtractUsersOutput",
+							Path: "$[0].ExtractUsersOutput",
+						},
+						{
+							Key:  "ExtractOrdersOutput",
+							Path: "$[1].ExtractOrdersOutput",
+						},
+					},
+				},
+			},
+		},
+		"steps that depend on overlapping steps wait for each other in a coordinated stage": {
+			inputSteps: []manifest.JobStep{
+				{
+					Name:   "extract",
+					Output: true,
+				},
+				{
+					Name: "fetch-rates",
+				},
+				{
+					Name:      "clean",
+					DependsOn: []string{"extract"},
+				},
+				{
+					Name:      "convert",
+					DependsOn: []string{"extract", "fetch-rates"},
+				},
+			},
+			wantedStages: []*template.JobStageOpts{
+				{
+					Name: "Parallel 1",
+					Branches: [][]*template.JobStageOpts{
+						{
+							{
+								Name: "extract",
+								Step: &template.JobStepOpts{
+									Name:      "extract",
+									LogicalID: "Extract",
+									Command:   []*string{},
+									CPU:       256,
+									Memory:    512,
+									Output:    true,
+									OutputKey: "ExtractOutput",
+								},
+								Signals: true,
+							},
+						},
+						{
+							{
+								Name: "fetch-rates",
+								Step: &template.JobStepOpts{
+									Name:      "fetch-rates",
+									LogicalID: "FetchRates",
+									Command:   []*string{},
+									CPU:       256,
+									Memory:    512,
+									OutputKey: "FetchRatesOutput",
+								},
+								Signals: true,
+							},
+						},
+						{
+							{
+								Name: "clean",
+								Step: &template.JobStepOpts{
+									Name:      "clean",
+									LogicalID: "Clean",
+									Command:   []*string{},
+									CPU:       256,
+									Memory:    512,
+									OutputKey: "CleanOutput",
+									Inputs: []*template.JobStepInputOpts{
+										{
+											Name:    "COPILOT_STEP_EXTRACT_OUTPUT",
+											Path:    "$.ExtractCompletion.Item.Extract.S",
+											Encoded: true,
+										},
+									},
+								},
+								Waits: []*template.JobStageWaitOpts{
+									{
+										Name: "extract",
+										Key:  "Extract",
+									},
+								},
+							},
+						},
+						{
+							{
+								Name: "convert",
+								Step: &template.JobStepOpts{
+									Name:      "convert",
+									LogicalID: "Convert",
+									Command:   []*string{},
+									CPU:       256,
+									Memory:    512,
+									OutputKey: "ConvertOutput",
+									Inputs: []*template.JobStepInputOpts{
+										{
+											Name:    "COPILOT_STEP_EXTRACT_OUTPUT",
+											Path:    "$.ExtractCompletion.Item.Extract.S",
+											Encoded: true,
+										},
+									},
+								},
+								Waits: []*template.JobStageWaitOpts{
+									{
+										Name: "extract",
+										Key:  "Extract",
+									},
+									{
+										Name: "fetch-rates",
+										Key:  "FetchRates",
+									},
+								},
+							},
+						},
+					},
+					ResultPath: "Parallel1Outputs",
+					Outputs: []*template.JobStageOutputOpts{
+						{
+							Key:  "ExtractOutput",
+							Path: "$[0].ExtractOutput",
+						},
+					},
+					Coordinated: true,
+				},
+			},
+		},
+		"dependencies on every step of a parallel stage run after it": {
+			inputSteps: []manifest.JobStep{
+				{
+					Name: "extract-users",
+				},
+				{
+					Name:      "transform-users",
+					DependsOn: []string{"extract-users"},
+				},
+				{
+					Name: "extract-orders",
+				},
+				{
+					Name:      "load",
+					DependsOn: []string{"transform-users", "extract-orders"},
+				},
+			},
+			wantedStages: []*template.JobStageOpts{
+				{
+					Name: "Parallel 1",
+					Next: "load",
+					Branches: [][]*template.JobStageOpts{
+						{
+							{
+								Name: "extract-users",
+								Next: "transform-users",
+								Step: &template.JobStepOpts{
+									Name:      "extract-users",
+									LogicalID: "ExtractUsers",
+									Command:   []*string{},
+									CPU:       256,
+									Memory:    512,
+									OutputKey: "ExtractUsersOutput",
+								},
+							},
+							{
+								Name: "transform-users",
+								Step: &template.JobStepOpts{
+									Name:      "transform-users",
+									LogicalID: "TransformUsers",
+									Command:   []*string{},
+									CPU:       256,
+									Memory:    512,
+									OutputKey: "TransformUsersOutput",
+								},
+							},
+						},
+						{
+							{
+								Name: "extract-orders",
+								Step: &template.JobStepOpts{
+									Name:      "extract-orders",
+									LogicalID: "ExtractOrders",
+									Command:   []*string{},
+									CPU:       256,
+									Memory:    512,
+									OutputKey: "ExtractOrdersOutput",
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "load",
+					Step: &template.JobStepOpts{
+						Name:      "load",
+						LogicalID: "Load",
+						Command:   []*string{},
+						CPU:       256,
+						Memory:    512,
+						OutputKey: "LoadOutput",
+					},
+				},
+			},
+		},
+		"missing step name": {
+			inputSteps:  []manifest.JobStep{{}},
+			wantedError: errors.New(`missing required field "name" for a step`),
+		},
+		"invalid step name": {
+			inputSteps:  []manifest.JobStep{{Name: "Stage 1"}},
+			wantedError: errors.New("step name Stage 1 must start with a letter and contain only letters, numbers, hyphens, and underscores"),
+		},
+		"duplicate step": {
+			inputSteps:  []manifest.JobStep{{Name: "load"}, {Name: "load"}},
+			wantedError: errors.New("step load is defined more than once"),
+		},
+		"step names that map to the same resource": {
+			inputSteps:  []manifest.JobStep{{Name: "load-data"}, {Name: "load_data"}},
+			wantedError: errors.New("step names load-data and load_data are too similar"),
+		},
+		"unknown dependency": {
+			inputSteps:  []manifest.JobStep{{Name: "load", DependsOn: []string{"extract"}}},
+			wantedError: errors.New("step load depends on step extract which does not exist"),
+		},
+		"circular dependency": {
+			inputSteps: []manifest.JobStep{
+				{Name: "extract", DependsOn: []string{"load"}},
+				{Name: "load", DependsOn: []string{"extract"}},
+			},
+			wantedError: errors.New("steps have a circular dependency on step extract"),
+		},
+		"negative step retries": {
+			inputSteps:  []manifest.JobStep{{Name: "load", Retries: aws.Int(-1)}},
+			wantedError: errors.New("number of retries for step load cannot be negative"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			job := &ScheduledJob{
+				wkld: &wkld{
+					name: "etl",
+				},
+				manifest: &manifest.ScheduledJob{
+					ScheduledJobConfig: manifest.ScheduledJobConfig{
+						TaskConfig: manifest.TaskConfig{
+							CPU:    aws.Int(256),
+							Memory: aws.Int(512),
+						},
+						Steps: tc.inputSteps,
+					},
+				},
+			}
+
+			// WHEN
+			stages, err := job.stepStages(tc.inputRetries, tc.inputTimeout)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedStages, stages)
+			}
+		})
+	}
+}
+
 func TestScheduledJob_Parameters(t *testing.T) {
 	baseProps := &manifest.ScheduledJobProps{
 		WorkloadProps: &manifest.WorkloadProps{
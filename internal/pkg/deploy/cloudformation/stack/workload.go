@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -40,6 +41,8 @@ type RuntimeConfig struct {
 	Image             *ECRImage         // Optional. Image location in an ECR repository.
 	AddonsTemplateURL string            // Optional. S3 object URL for the addons template.
 	AdditionalTags    map[string]string // AdditionalTags are labels applied to resources in the workload stack.
+
+	DependencyEndpoints map[string]string // Optional. Endpoints of the services the workload depends on, keyed by service name.
 }
 
 // ECRImage represents configuration about the pushed ECR image that is needed to
@@ -47,10 +50,15 @@ type RuntimeConfig struct {
 type ECRImage struct {
 	RepoURL  string // RepoURL is the ECR repository URL the container image should be pushed to.
 	ImageTag string // Tag is the container image's unique tag.
+	Digest   string // Optional. Digest pins the image, such as an image promoted from another environment.
 }
 
 // GetLocation returns the location of the ECR image.
+// If the digest is set, the image is referenced by its digest instead of its tag.
 func (i ECRImage) GetLocation() string {
+	if i.Digest != "" {
+		return fmt.Sprintf("%s@%s", i.RepoURL, i.Digest)
+	}
 	return fmt.Sprintf("%s:%s", i.RepoURL, i.ImageTag)
 }
 
@@ -195,6 +203,28 @@ func (w *wkld) addonsOutputs() (*template.WorkloadNestedStackOpts, error) {
 	}, nil
 }
 
+// variables returns the environment variables of the workload's main container: the manifest variables
+// along with a COPILOT_<NAME>_URL variable for each endpoint in the runtime configuration.
+// Variables defined in the manifest take precedence.
+func (w *wkld) variables(mftVars map[string]string) map[string]string {
+	if len(w.rc.DependencyEndpoints) == 0 {
+		return mftVars
+	}
+	vars := make(map[string]string, len(mftVars)+len(w.rc.DependencyEndpoints))
+	for name, endpoint := range w.rc.DependencyEndpoints {
+		vars[DependencyEndpointVarName(name)] = endpoint
+	}
+	for k, v := range mftVars {
+		vars[k] = v
+	}
+	return vars
+}
+
+// DependencyEndpointVarName returns the name of the environment variable holding the endpoint of a dependency.
+func DependencyEndpointVarName(svc string) string {
+	return fmt.Sprintf("COPILOT_%s_URL", strings.ToUpper(strings.ReplaceAll(svc, "-", "_")))
+}
+
 func secretOutputNames(outputs []addon.Output) []string {
 	var secrets []string
 	for _, out := range outputs {
@@ -0,0 +1,105 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkload_variables(t *testing.T) {
+	testCases := map[string]struct {
+		inEndpoints map[string]string
+		inVars      map[string]string
+
+		wantedVars map[string]string
+	}{
+		"returns the manifest variables if there are no dependencies": {
+			inVars: map[string]string{
+				"LOG_LEVEL": "info",
+			},
+
+			wantedVars: map[string]string{
+				"LOG_LEVEL": "info",
+			},
+		},
+		"adds a variable for each dependency endpoint": {
+			inEndpoints: map[string]string{
+				"api":        "http://api.phonetool.local:8080",
+				"orders-svc": "http://orders-svc.phonetool.local:80",
+			},
+			inVars: map[string]string{
+				"LOG_LEVEL": "info",
+			},
+
+			wantedVars: map[string]string{
+				"LOG_LEVEL":              "info",
+				"COPILOT_API_URL":        "http://api.phonetool.local:8080",
+				"COPILOT_ORDERS_SVC_URL": "http://orders-svc.phonetool.local:80",
+			},
+		},
+		"manifest variables take precedence over dependency endpoints": {
+			inEndpoints: map[string]string{
+				"api": "http://api.phonetool.local:8080",
+			},
+			inVars: map[string]string{
+				"COPILOT_API_URL": "https://api.example.com",
+			},
+
+			wantedVars: map[string]string{
+				"COPILOT_API_URL": "https://api.example.com",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			w := &wkld{
+				rc: RuntimeConfig{
+					DependencyEndpoints: tc.inEndpoints,
+				},
+			}
+
+			// WHEN
+			vars := w.variables(tc.inVars)
+
+			// THEN
+			require.Equal(t, tc.wantedVars, vars)
+		})
+	}
+}
+
+func TestECRImage_GetLocation(t *testing.T) {
+	testCases := map[string]struct {
+		in ECRImage
+
+		wanted string
+	}{
+		"references the image by tag": {
+			in: ECRImage{
+				RepoURL:  "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api",
+				ImageTag: "v1",
+			},
+
+			wanted: "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v1",
+		},
+		"references the image by digest if it's set": {
+			in: ECRImage{
+				RepoURL:  "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api",
+				ImageTag: "v1",
+				Digest:   "sha256:1234",
+			},
+
+			wanted: "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api@sha256:1234",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.GetLocation())
+		})
+	}
+}
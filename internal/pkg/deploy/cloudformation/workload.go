@@ -6,9 +6,12 @@ package cloudformation
 import (
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 )
 
 // DeployService deploys a service stack and waits until the deployment is done.
@@ -35,6 +38,128 @@ func (cf CloudFormation) DeployService(conf StackConfiguration, opts ...cloudfor
 	return cf.cfnClient.UpdateAndWait(stack)
 }
 
+// StreamServiceDeployment deploys a service stack and streams the events of its resources until the deployment is done.
+// If the service stack doesn't exist, then it creates the stack. If the service stack already exists, it updates the stack.
+// The events channel is closed once the deployment halts, and the result of the deployment is then sent to the error channel.
+func (cf CloudFormation) StreamServiceDeployment(conf StackConfiguration, opts ...cloudformation.StackOption) (<-chan []deploy.ResourceEvent, <-chan error) {
+	events := make(chan []deploy.ResourceEvent)
+	errs := make(chan error, 1)
+	go func() {
+		stack, err := toStack(conf)
+		if err != nil {
+			close(events)
+			errs <- err
+			return
+		}
+		for _, opt := range opts {
+			opt(stack)
+		}
+
+		since := time.Now()
+		wait := cf.cfnClient.WaitForCreate
+		if err := cf.cfnClient.Create(stack); err != nil {
+			// The stack already exists, we need to update it instead.
+			var errAlreadyExists *cloudformation.ErrStackAlreadyExists
+			if !errors.As(err, &errAlreadyExists) {
+				close(events)
+				errs <- err
+				return
+			}
+			if err := cf.cfnClient.Update(stack); err != nil {
+				close(events)
+				errs <- err
+				return
+			}
+			wait = cf.cfnClient.WaitForUpdate
+		}
+
+		done := make(chan struct{})
+		go cf.streamResourceEvents(done, events, stack.Name, since)
+		err = wait(stack.Name)
+		close(done)
+		errs <- err
+	}()
+	return events, errs
+}
+
+// PreviewService returns the resource changes that deploying the service stack would apply, without deploying it.
+func (cf CloudFormation) PreviewService(conf StackConfiguration, opts ...cloudformation.StackOption) ([]cloudformation.ResourceChange, error) {
+	stack, err := toStack(conf)
+	if err != nil {
+		return nil, err
+	}
+	for _, opt := range opts {
+		opt(stack)
+	}
+	return cf.cfnClient.PreviewChanges(stack)
+}
+
+// WorkloadTemplate returns the template of the deployed workload's stack.
+// If the workload is not deployed in the environment, returns cloudformation.ErrStackNotFound.
+func (cf CloudFormation) WorkloadTemplate(appName, envName, name string) (string, error) {
+	return cf.cfnClient.TemplateBody(stack.NameForService(appName, envName, name))
+}
+
+// WorkloadAddonsTemplate returns the template of the addons nested in the deployed workload's stack.
+// If the workload or its addons are not deployed in the environment, returns cloudformation.ErrStackNotFound.
+func (cf CloudFormation) WorkloadAddonsTemplate(appName, envName, name string) (string, error) {
+	return cf.cfnClient.NestedStackTemplateBody(stack.NameForService(appName, envName, name), addon.StackName)
+}
+
+// WorkloadStack returns the description of the deployed workload's stack.
+// If the workload is not deployed in the environment, returns cloudformation.ErrStackNotFound.
+func (cf CloudFormation) WorkloadStack(appName, envName, name string) (*cloudformation.StackDescription, error) {
+	return cf.cfnClient.Describe(stack.NameForService(appName, envName, name))
+}
+
+// SetWorkloadTerminationProtection enables or disables termination protection on the deployed workload's stack.
+// If the workload is not deployed in the environment, returns cloudformation.ErrStackNotFound.
+func (cf CloudFormation) SetWorkloadTerminationProtection(appName, envName, name string, enabled bool) error {
+	return cf.cfnClient.SetTerminationProtection(stack.NameForService(appName, envName, name), enabled)
+}
+
+// CancelWorkloadDeployment cancels the update of the deployed workload's stack and streams the events of its resources
+// until the stack is rolled back. The events channel is closed once the rollback halts, and the result of the rollback
+// is then sent to the error channel.
+func (cf CloudFormation) CancelWorkloadDeployment(appName, envName, name string) (<-chan []deploy.ResourceEvent, <-chan error) {
+	return cf.streamWorkloadRollback(stack.NameForService(appName, envName, name), cf.cfnClient.CancelUpdate)
+}
+
+// ContinueWorkloadRollback resumes the rollback of the workload's stack after it failed to roll back,
+// and streams the events of its resources like CancelWorkloadDeployment.
+func (cf CloudFormation) ContinueWorkloadRollback(appName, envName, name string) (<-chan []deploy.ResourceEvent, <-chan error) {
+	return cf.streamWorkloadRollback(stack.NameForService(appName, envName, name), cf.cfnClient.ContinueUpdateRollback)
+}
+
+// StreamWorkloadRollback streams the events of the resources of the workload's stack until the rollback
+// in progress is done, like CancelWorkloadDeployment.
+func (cf CloudFormation) StreamWorkloadRollback(appName, envName, name string) (<-chan []deploy.ResourceEvent, <-chan error) {
+	return cf.streamWorkloadRollback(stack.NameForService(appName, envName, name), nil)
+}
+
+// streamWorkloadRollback calls start, if any, and then streams the events of the stack until its update is rolled back.
+func (cf CloudFormation) streamWorkloadRollback(stackName string, start func(stackName string) error) (<-chan []deploy.ResourceEvent, <-chan error) {
+	events := make(chan []deploy.ResourceEvent)
+	errs := make(chan error, 1)
+	go func() {
+		since := time.Now()
+		if start != nil {
+			if err := start(stackName); err != nil {
+				close(events)
+				errs <- err
+				return
+			}
+		}
+
+		done := make(chan struct{})
+		go cf.streamResourceEvents(done, events, stackName, since)
+		err := cf.cfnClient.WaitForUpdateRollback(stackName)
+		close(done)
+		errs <- err
+	}()
+	return events, errs
+}
+
 // DeleteWorkload removes the CloudFormation stack of a deployed workload.
 func (cf CloudFormation) DeleteWorkload(in deploy.DeleteWorkloadInput) error {
 	return cf.cfnClient.DeleteAndWait(fmt.Sprintf("%s-%s-%s", in.AppName, in.EnvName, in.Name))
@@ -4,7 +4,9 @@
 package cloudformation
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
@@ -112,6 +114,338 @@ func TestCloudFormation_DeployService(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_StreamServiceDeployment(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedEvents []deploy.ResourceEvent
+		wantedErr    error
+	}{
+		"streams the events of a new stack": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Create(gomock.Any()).Return(nil)
+				m.EXPECT().WaitForCreate("webhook").Return(nil)
+				m.EXPECT().Update(gomock.Any()).Times(0)
+				m.EXPECT().Events("webhook").Return([]cloudformation.StackEvent{
+					{
+						LogicalResourceId:  aws.String("Service"),
+						PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:1111:service/phonetool-test-Cluster/phonetool-test-webhook"),
+						ResourceType:       aws.String("AWS::ECS::Service"),
+						ResourceStatus:     aws.String("CREATE_COMPLETE"),
+						Timestamp:          aws.Time(time.Now().Add(time.Hour)),
+					},
+				}, nil)
+				return m
+			},
+			wantedEvents: []deploy.ResourceEvent{
+				{
+					Resource: deploy.Resource{
+						LogicalName: "Service",
+						PhysicalID:  "arn:aws:ecs:us-west-2:1111:service/phonetool-test-Cluster/phonetool-test-webhook",
+						Type:        "AWS::ECS::Service",
+					},
+					Status: "CREATE_COMPLETE",
+				},
+			},
+		},
+		"streams only the events of the update if the stack already exists": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Create(gomock.Any()).Return(&cloudformation.ErrStackAlreadyExists{
+					Name: "webhook",
+				})
+				m.EXPECT().Update(gomock.Any()).Return(nil)
+				m.EXPECT().WaitForUpdate("webhook").Return(errors.New("some error"))
+				m.EXPECT().Events("webhook").Return([]cloudformation.StackEvent{
+					{
+						LogicalResourceId: aws.String("Service"),
+						ResourceType:      aws.String("AWS::ECS::Service"),
+						ResourceStatus:    aws.String("CREATE_COMPLETE"),
+						Timestamp:         aws.Time(time.Date(2020, 12, 1, 10, 0, 0, 0, time.UTC)),
+					},
+					{
+						LogicalResourceId:    aws.String("Service"),
+						ResourceType:         aws.String("AWS::ECS::Service"),
+						ResourceStatus:       aws.String("UPDATE_FAILED"),
+						ResourceStatusReason: aws.String("Service did not stabilize. Request ID: 1234"),
+						Timestamp:            aws.Time(time.Now().Add(time.Hour)),
+					},
+				}, nil)
+				return m
+			},
+			wantedEvents: []deploy.ResourceEvent{
+				{
+					Resource: deploy.Resource{
+						LogicalName: "Service",
+						Type:        "AWS::ECS::Service",
+					},
+					Status:       "UPDATE_FAILED",
+					StatusReason: "Service did not stabilize",
+				},
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"closes the stream if the stack can't be updated": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Create(gomock.Any()).Return(&cloudformation.ErrStackAlreadyExists{
+					Name: "webhook",
+				})
+				m.EXPECT().Update(gomock.Any()).Return(&cloudformation.ErrStackUpdateInProgress{
+					Name: "webhook",
+				})
+				m.EXPECT().Events(gomock.Any()).Times(0)
+				return m
+			},
+			wantedErr: &cloudformation.ErrStackUpdateInProgress{
+				Name: "webhook",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+			conf := &mockStackConfig{
+				name:     "webhook",
+				template: "template",
+			}
+
+			// WHEN
+			events, errs := c.StreamServiceDeployment(conf, cloudformation.WithRoleARN("myrole"))
+			var gotEvents []deploy.ResourceEvent
+			for batch := range events {
+				gotEvents = append(gotEvents, batch...)
+			}
+			err := <-errs
+
+			// THEN
+			require.Equal(t, tc.wantedEvents, gotEvents)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCloudFormation_PreviewService(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	wantedChanges := []cloudformation.ResourceChange{
+		{
+			Action:    "Add",
+			LogicalID: "Service",
+			Type:      "AWS::ECS::Service",
+		},
+	}
+	m := mocks.NewMockcfnClient(ctrl)
+	m.EXPECT().PreviewChanges(cloudformation.NewStack("webhook", "template",
+		cloudformation.WithParameters(map[string]string{
+			"port": "80",
+		}),
+		cloudformation.WithRoleARN("myrole"))).Return(wantedChanges, nil)
+	c := CloudFormation{
+		cfnClient: m,
+	}
+	conf := &mockStackConfig{
+		name:     "webhook",
+		template: "template",
+		parameters: map[string]string{
+			"port": "80",
+		},
+	}
+
+	// WHEN
+	changes, err := c.PreviewService(conf, cloudformation.WithRoleARN("myrole"))
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, wantedChanges, changes)
+}
+
+func TestCloudFormation_WorkloadTemplate(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockcfnClient(ctrl)
+	m.EXPECT().TemplateBody("kudos-test-webhook").Return("template", nil)
+	c := CloudFormation{
+		cfnClient: m,
+	}
+
+	// WHEN
+	tpl, err := c.WorkloadTemplate("kudos", "test", "webhook")
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "template", tpl)
+}
+
+func TestCloudFormation_WorkloadAddonsTemplate(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockcfnClient(ctrl)
+	m.EXPECT().NestedStackTemplateBody("kudos-test-webhook", "AddonsStack").Return("template", nil)
+	c := CloudFormation{
+		cfnClient: m,
+	}
+
+	// WHEN
+	tpl, err := c.WorkloadAddonsTemplate("kudos", "test", "webhook")
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "template", tpl)
+}
+
+func TestCloudFormation_WorkloadStack(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	wantedStack := &cloudformation.StackDescription{
+		StackName: aws.String("kudos-test-webhook"),
+	}
+	m := mocks.NewMockcfnClient(ctrl)
+	m.EXPECT().Describe("kudos-test-webhook").Return(wantedStack, nil)
+	c := CloudFormation{
+		cfnClient: m,
+	}
+
+	// WHEN
+	descr, err := c.WorkloadStack("kudos", "test", "webhook")
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, wantedStack, descr)
+}
+
+func TestCloudFormation_SetWorkloadTerminationProtection(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockcfnClient(ctrl)
+	m.EXPECT().SetTerminationProtection("kudos-test-webhook", true).Return(nil)
+	c := CloudFormation{
+		cfnClient: m,
+	}
+
+	// WHEN
+	err := c.SetWorkloadTerminationProtection("kudos", "test", "webhook", true)
+
+	// THEN
+	require.NoError(t, err)
+}
+
+func TestCloudFormation_CancelWorkloadDeployment(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedEvents []deploy.ResourceEvent
+		wantedErr    error
+	}{
+		"streams the events of the rollback": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().CancelUpdate("kudos-test-webhook").Return(nil)
+				m.EXPECT().WaitForUpdateRollback("kudos-test-webhook").Return(nil)
+				m.EXPECT().Events("kudos-test-webhook").Return([]cloudformation.StackEvent{
+					{
+						LogicalResourceId: aws.String("Service"),
+						ResourceType:      aws.String("AWS::ECS::Service"),
+						ResourceStatus:    aws.String("UPDATE_IN_PROGRESS"),
+						Timestamp:         aws.Time(time.Date(2020, 12, 1, 10, 0, 0, 0, time.UTC)),
+					},
+					{
+						LogicalResourceId: aws.String("Service"),
+						ResourceType:      aws.String("AWS::ECS::Service"),
+						ResourceStatus:    aws.String("UPDATE_COMPLETE"),
+						Timestamp:         aws.Time(time.Now().Add(time.Hour)),
+					},
+				}, nil)
+				return m
+			},
+			wantedEvents: []deploy.ResourceEvent{
+				{
+					Resource: deploy.Resource{
+						LogicalName: "Service",
+						Type:        "AWS::ECS::Service",
+					},
+					Status: "UPDATE_COMPLETE",
+				},
+			},
+		},
+		"closes the stream if the update can't be cancelled": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().CancelUpdate("kudos-test-webhook").Return(errors.New("some error"))
+				m.EXPECT().WaitForUpdateRollback(gomock.Any()).Times(0)
+				m.EXPECT().Events(gomock.Any()).Times(0)
+				return m
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			events, errs := c.CancelWorkloadDeployment("kudos", "test", "webhook")
+			var gotEvents []deploy.ResourceEvent
+			for batch := range events {
+				gotEvents = append(gotEvents, batch...)
+			}
+			err := <-errs
+
+			// THEN
+			require.Equal(t, tc.wantedEvents, gotEvents)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCloudFormation_ContinueWorkloadRollback(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockcfnClient(ctrl)
+	m.EXPECT().ContinueUpdateRollback("kudos-test-webhook").Return(nil)
+	m.EXPECT().WaitForUpdateRollback("kudos-test-webhook").Return(errors.New("some error"))
+	m.EXPECT().Events("kudos-test-webhook").Return(nil, nil)
+	c := CloudFormation{
+		cfnClient: m,
+	}
+
+	// WHEN
+	events, errs := c.ContinueWorkloadRollback("kudos", "test", "webhook")
+	for range events {
+	}
+	err := <-errs
+
+	// THEN
+	require.EqualError(t, err, "some error")
+}
+
 func TestCloudFormation_DeleteWorkload(t *testing.T) {
 	testCases := map[string]struct {
 		in         deploy.DeleteWorkloadInput
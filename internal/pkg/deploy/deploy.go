@@ -30,6 +30,7 @@ const (
 // Resource represents an AWS resource.
 type Resource struct {
 	LogicalName string
+	PhysicalID  string
 	Type        string
 }
 
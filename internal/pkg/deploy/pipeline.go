@@ -6,9 +6,14 @@
 package deploy
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"regexp"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
 
@@ -16,11 +21,75 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 )
 
-// NOTE: this is duplicated from validate.go
-var githubRepoExp = regexp.MustCompile(`(https:\/\/github\.com\/|)(?P<owner>.+)\/(?P<repo>.+)`)
+var (
+	// NOTE: this is duplicated from validate.go
+	githubRepoExp = regexp.MustCompile(`(https:\/\/github\.com\/|)(?P<owner>.+)\/(?P<repo>.+)`)
+	// Matches the repository URLs of providers accessed through a CodeStar connection,
+	// such as "https://bitbucket.org/owner/repo" or "https://github.mycompany.com/owner/repo".
+	connectionRepoExp = regexp.MustCompile(`^(https:\/\/[^\/]+\/|)(?P<owner>[^\/]+)\/(?P<repo>[^\/]+)$`)
+	// Matches CodeCommit repository URLs such as "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/repo".
+	codecommitRepoExp = regexp.MustCompile(`^https:\/\/git-codecommit\.(?P<region>[a-z0-9-]+)\.amazonaws\.com(\.cn)?\/v1\/repos\/(?P<repo>[^\/]+)$`)
+	// Pipeline action names are used in the names and logical IDs of their CodeBuild projects.
+	pipelineActionNameExp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9-]*$`)
+	// Matches the email addresses notified of the events of a pipeline.
+	emailExp = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+	// Matches the characters of a pipeline name that aren't allowed in the name of a notification rule.
+	notificationRuleNameInvalidCharsExp = regexp.MustCompile(`[^A-Za-z0-9_-]`)
+	// Matches the URIs of images stored in ECR, such as "123456789012.dkr.ecr.us-west-2.amazonaws.com/toolchain:latest".
+	ecrImageURIExp = regexp.MustCompile(`^(\d{12})\.dkr\.ecr\.([a-z0-9-]+)\.amazonaws\.com(\.cn)?\/([^:@]+)`)
+	// Matches the names of the environment variables of the build project.
+	buildEnvVarNameExp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+	// CodeBuild compute types of the sizes available to pipeline actions.
+	pipelineActionComputeTypes = map[string]string{
+		"small":   "BUILD_GENERAL1_SMALL",
+		"medium":  "BUILD_GENERAL1_MEDIUM",
+		"large":   "BUILD_GENERAL1_LARGE",
+		"2xlarge": "BUILD_GENERAL1_2XLARGE",
+	}
+)
 
 const (
 	fmtInvalidGitHubRepo = "unable to locate the repository from the properties: %+v"
+
+	// The maximum length of a CodeStar connection name.
+	maxConnectionNameLength = 32
+
+	// The maximum length of a pipeline action name.
+	maxPipelineActionNameLength = 64
+
+	defaultPipelineActionComputeSize = "small"
+
+	// Defaults of the CodeBuild project of the build stage.
+	defaultPipelineBuildImage       = "aws/codebuild/amazonlinux2-x86_64-standard:1.0"
+	defaultPipelineBuildComputeSize = "small"
+	defaultPipelineBuildTimeout     = 60 * time.Minute
+
+	// Limits of the timeout of a CodeBuild project.
+	minPipelineBuildTimeout = 5 * time.Minute
+	maxPipelineBuildTimeout = 8 * time.Hour
+
+	// Prefix of the environment variables reserved by CodeBuild.
+	reservedCodeBuildEnvVarPrefix = "CODEBUILD_"
+
+	// The maximum length of the custom message of a manual approval, leaving room for the service URLs.
+	maxPipelineApprovalMessageLength = 256
+	// The maximum length of the custom data of a manual approval action, which holds the message and the service URLs.
+	maxPipelineApprovalCustomDataLength = 500
+
+	// Limits of CodeStar notification rules.
+	maxNotificationRuleNameLength = 64
+	maxNotificationRuleTargets    = 10
+)
+
+// ServiceURLOutputKey is the stack output of a Load Balanced Web Service that holds its URL.
+const ServiceURLOutputKey = "ServiceURL"
+
+// Environment variables passed to the test actions of a stage.
+const (
+	testEnvVarAppName       = "COPILOT_APPLICATION_NAME"
+	testEnvVarEnvName       = "COPILOT_ENVIRONMENT_NAME"
+	fmtTestEnvVarServiceURL = "COPILOT_%s_URL"
 )
 
 // CreatePipelineInput represents the fields required to deploy a pipeline.
@@ -34,10 +103,21 @@ type CreatePipelineInput struct {
 	// The source code provider for this pipeline
 	Source *Source
 
+	// Path to the buildspec of the build stage, relative to the root of the source repository.
+	// For example, "copilot/pipelines/release/buildspec.yml".
+	BuildspecPath string
+
+	// The environment of the CodeBuild project of the build stage.
+	// If nil, the project uses DefaultPipelineBuild.
+	Build *PipelineBuild
+
 	// The stages of the pipeline. The order of stages in this list
 	// will be the order we deploy to.
 	Stages []PipelineStage
 
+	// The notification rules of the pipeline.
+	Notifications []PipelineNotification
+
 	// A list of artifact buckets and corresponding KMS keys that will
 	// be used in this pipeline.
 	ArtifactBuckets []ArtifactBucket
@@ -46,6 +126,103 @@ type CreatePipelineInput struct {
 	AdditionalTags map[string]string
 }
 
+// PipelineBuild represents the environment of the CodeBuild project that builds the workloads of the pipeline.
+type PipelineBuild struct {
+	Image string
+	// CodeBuild compute type of the project, such as "BUILD_GENERAL1_SMALL".
+	ComputeType    string
+	PrivilegedMode bool
+	// EnvironmentVariables are passed to the build in plaintext.
+	EnvironmentVariables map[string]string
+	TimeoutInMinutes     int
+	// ImageRepositoryARN is the ARN of the ECR repository of the Image, if it's stored in ECR.
+	// The project pulls such images with its service role.
+	ImageRepositoryARN string
+}
+
+// DefaultPipelineBuild returns the environment of the build project of a pipeline that doesn't customize it.
+func DefaultPipelineBuild() *PipelineBuild {
+	return &PipelineBuild{
+		Image:            defaultPipelineBuildImage,
+		ComputeType:      pipelineActionComputeTypes[defaultPipelineBuildComputeSize],
+		PrivilegedMode:   true,
+		TimeoutInMinutes: int(defaultPipelineBuildTimeout.Minutes()),
+	}
+}
+
+// NewPipelineBuild validates the build section of the pipeline manifest and returns the environment of the build project.
+// Fields that are omitted keep their default values.
+func NewPipelineBuild(in *manifest.PipelineBuild) (*PipelineBuild, error) {
+	build := DefaultPipelineBuild()
+	if in == nil {
+		return build, nil
+	}
+	if in.Image != "" {
+		build.Image = in.Image
+		build.ImageRepositoryARN = ecrRepositoryARN(in.Image)
+	}
+	if in.ComputeSize != "" {
+		computeType, ok := pipelineActionComputeTypes[in.ComputeSize]
+		if !ok {
+			return nil, fmt.Errorf("build compute size %q must be one of small, medium, large or 2xlarge", in.ComputeSize)
+		}
+		build.ComputeType = computeType
+	}
+	if in.Privileged != nil {
+		build.PrivilegedMode = *in.Privileged
+	}
+	for name, value := range in.Variables {
+		if !buildEnvVarNameExp.MatchString(name) {
+			return nil, fmt.Errorf("build variable %q must start with a letter or underscore and contain only letters, numbers and underscores", name)
+		}
+		if strings.HasPrefix(strings.ToUpper(name), reservedCodeBuildEnvVarPrefix) {
+			return nil, fmt.Errorf("build variable %q must not start with the prefix %s reserved by CodeBuild", name, reservedCodeBuildEnvVarPrefix)
+		}
+		if build.EnvironmentVariables == nil {
+			build.EnvironmentVariables = make(map[string]string)
+		}
+		build.EnvironmentVariables[name] = value
+	}
+	if in.Timeout != nil {
+		timeout := *in.Timeout
+		if timeout%time.Minute != 0 || timeout < minPipelineBuildTimeout || timeout > maxPipelineBuildTimeout {
+			return nil, fmt.Errorf("build timeout %s must be a whole number of minutes between %s and %s", timeout, minPipelineBuildTimeout, maxPipelineBuildTimeout)
+		}
+		build.TimeoutInMinutes = int(timeout.Minutes())
+	}
+	return build, nil
+}
+
+// EnvironmentVariableNames returns the names of the environment variables of the build in alphabetical order.
+func (b *PipelineBuild) EnvironmentVariableNames() []string {
+	var names []string
+	for name := range b.EnvironmentVariables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ecrRepositoryARN returns the ARN of the ECR repository of the image, or an empty string if the image isn't stored in ECR.
+func ecrRepositoryARN(image string) string {
+	matches := ecrImageURIExp.FindStringSubmatch(image)
+	if matches == nil {
+		return ""
+	}
+	account, region, chinaSuffix, repo := matches[1], matches[2], matches[3], matches[4]
+	partition := "aws"
+	if chinaSuffix != "" {
+		partition = "aws-cn"
+	}
+	return arn.ARN{
+		Partition: partition,
+		Service:   "ecr",
+		Region:    region,
+		AccountID: account,
+		Resource:  "repository/" + repo,
+	}.String()
+}
+
 // ArtifactBucket represents an S3 bucket used by the CodePipeline to store
 // intermediate artifacts produced by the pipeline.
 type ArtifactBucket struct {
@@ -101,13 +278,86 @@ func (s *Source) GitHubPersonalAccessTokenSecretID() (string, error) {
 	return id, nil
 }
 
+// HasAccessToken returns true if the source is a GitHub repository accessed with a personal access token
+// stored in Secrets Manager.
+func (s *Source) HasAccessToken() bool {
+	if s.ProviderName != manifest.GithubProviderName {
+		return false
+	}
+	_, ok := s.Properties[manifest.GithubSecretIdKeyName]
+	return ok
+}
+
+// IsCodeCommit returns true if the source is a CodeCommit repository.
+func (s *Source) IsCodeCommit() bool {
+	return s.ProviderName == manifest.CodeCommitProviderName
+}
+
+// IsCodeStarConnection returns true if the source repository is accessed through an AWS CodeStar connection.
+func (s *Source) IsCodeStarConnection() bool {
+	switch s.ProviderName {
+	case manifest.BitbucketProviderName, manifest.GitHubEnterpriseProviderName:
+		return true
+	case manifest.GithubProviderName:
+		return !s.HasAccessToken()
+	default:
+		return false
+	}
+}
+
+// ConnectionARN returns the ARN of the existing CodeStar connection to the repository.
+// If the source doesn't configure an existing connection, it returns an empty string.
+func (s *Source) ConnectionARN() string {
+	arn, _ := s.Properties[manifest.ConnectionARNKeyName].(string)
+	return arn
+}
+
+// ConnectionName returns the name of the CodeStar connection created for the source.
+// For example, given "https://bitbucket.org/aws/copilot", this function returns "copilot-aws-copilot".
+func (s *Source) ConnectionName() (string, error) {
+	oAndR, err := s.parseOwnerAndRepo()
+	if err != nil {
+		return "", err
+	}
+	name := fmt.Sprintf("copilot-%s-%s", oAndR.owner, oAndR.repo)
+	if len(name) > maxConnectionNameLength {
+		name = name[:maxConnectionNameLength]
+	}
+	return name, nil
+}
+
+// Branch returns the branch of the repository that triggers the pipeline.
+func (s *Source) Branch() string {
+	branch, _ := s.Properties["branch"].(string)
+	return branch
+}
+
+// FullRepositoryID returns the owner and name of the repository. For example,
+// given "https://bitbucket.org/aws/copilot", this function returns "aws/copilot".
+func (s *Source) FullRepositoryID() (string, error) {
+	oAndR, err := s.parseOwnerAndRepo()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/%s", oAndR.owner, oAndR.repo), nil
+}
+
 type ownerAndRepo struct {
-	owner string
-	repo  string
+	owner  string
+	repo   string
+	region string // Only set for CodeCommit repositories.
 }
 
 func (s *Source) parseOwnerAndRepo() (*ownerAndRepo, error) {
-	if s.ProviderName != manifest.GithubProviderName {
+	var exp *regexp.Regexp
+	switch s.ProviderName {
+	case manifest.GithubProviderName:
+		exp = githubRepoExp
+	case manifest.BitbucketProviderName, manifest.GitHubEnterpriseProviderName:
+		exp = connectionRepoExp
+	case manifest.CodeCommitProviderName:
+		exp = codecommitRepoExp
+	default:
 		return nil, fmt.Errorf("invalid provider: %s", s.ProviderName)
 	}
 	ownerAndRepoI, exists := s.Properties["repository"]
@@ -119,26 +369,28 @@ func (s *Source) parseOwnerAndRepo() (*ownerAndRepo, error) {
 		return nil, fmt.Errorf(fmtInvalidGitHubRepo, ownerAndRepoI)
 	}
 
-	match := githubRepoExp.FindStringSubmatch(ownerAndRepoStr)
+	match := exp.FindStringSubmatch(ownerAndRepoStr)
 	if len(match) == 0 {
 		return nil, fmt.Errorf(fmtInvalidGitHubRepo, ownerAndRepoStr)
 	}
 
 	matches := make(map[string]string)
-	for i, name := range githubRepoExp.SubexpNames() {
+	for i, name := range exp.SubexpNames() {
 		if i != 0 && name != "" {
 			matches[name] = match[i]
 		}
 	}
 
 	return &ownerAndRepo{
-		owner: matches["owner"],
-		repo:  matches["repo"],
+		owner:  matches["owner"],
+		repo:   matches["repo"],
+		region: matches["region"],
 	}, nil
 }
 
 // Repository returns the repository portion. For example,
 // given "aws/amazon-ecs-cli-v2", this function returns "amazon-ecs-cli-v2".
+// For CodeCommit, it returns the name of the repository.
 func (s *Source) Repository() (string, error) {
 	oAndR, err := s.parseOwnerAndRepo()
 	if err != nil {
@@ -147,6 +399,17 @@ func (s *Source) Repository() (string, error) {
 	return oAndR.repo, nil
 }
 
+// RepositoryRegion returns the region of a CodeCommit repository. For example,
+// given "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/repo", this function returns "us-west-2".
+// For other providers, it returns an empty string.
+func (s *Source) RepositoryRegion() (string, error) {
+	oAndR, err := s.parseOwnerAndRepo()
+	if err != nil {
+		return "", err
+	}
+	return oAndR.region, nil
+}
+
 // Owner returns the repository owner portion. For example,
 // given "aws/amazon-ecs-cli-v2", this function returns "aws".
 func (s *Source) Owner() (string, error) {
@@ -159,22 +422,389 @@ func (s *Source) Owner() (string, error) {
 
 // PipelineStage represents configuration for each deployment stage
 // of a workspace. A stage consists of the Config Environment the pipeline
-// is deploying to, the containerized services that will be deployed, and
+// is deploying to, the containerized services and jobs that will be deployed, and
 // test commands, if the user has opted to add any.
 type PipelineStage struct {
 	*AssociatedEnvironment
 	LocalServices    []string
+	LocalJobs        []string
 	RequiresApproval bool
 	TestCommands     []string
+	// LoadBalancedServices are the LocalServices that are reachable through a URL.
+	LoadBalancedServices []string
+	PreDeployments       []PipelineAction
+	PostDeployments      []PipelineAction
+	// DependsOn maps a workload of the stage to the workloads that must be deployed before it.
+	DependsOn map[string][]string
+	// Approval holds the details shown to the reviewers if the stage RequiresApproval.
+	Approval PipelineApproval
+}
+
+// PipelineApproval represents the details shown to the reviewers of the manual approval of a stage.
+type PipelineApproval struct {
+	// Message is the custom data of the approval action.
+	Message string
+	// ReviewURL is the link that reviewers follow to validate the changes.
+	ReviewURL string
+}
+
+// PipelineNotification represents a CodeStar notification rule that notifies targets of an event of the pipeline.
+type PipelineNotification struct {
+	// Event is the name of the event in the pipeline manifest, such as "approval_needed".
+	Event string
+	// LogicalIDPrefix is the prefix of the logical IDs of the resources created for the event.
+	LogicalIDPrefix string
+	// EventTypeID is the ID of the CodeStar notification event, such as "codepipeline-pipeline-manual-approval-needed".
+	EventTypeID string
+	// TopicARNs are the existing SNS topics notified of the event.
+	TopicARNs []string
+	// Emails are the addresses subscribed to an SNS topic created for the event.
+	Emails []string
+}
+
+// PipelineAction represents a CodeBuild action that runs in a stage before or after its workloads are deployed.
+type PipelineAction struct {
+	Name string
+	// Path to the buildspec of the action, relative to the root of the source repository.
+	BuildspecPath string
+	// CodeBuild compute type of the action's project, such as "BUILD_GENERAL1_SMALL".
+	ComputeType string
+}
+
+// NewPipelineActions validates the actions of a stage in the pipeline manifest
+// and returns their deployment configuration.
+func NewPipelineActions(stageName string, actions []manifest.PipelineAction) ([]PipelineAction, error) {
+	var out []PipelineAction
+	seen := make(map[string]bool)
+	for _, action := range actions {
+		if !pipelineActionNameExp.MatchString(action.Name) || len(action.Name) > maxPipelineActionNameLength {
+			return nil, fmt.Errorf("action name %q in stage %s must start with a letter or number, contain only letters, numbers and hyphens, and be at most %d characters long", action.Name, stageName, maxPipelineActionNameLength)
+		}
+		if seen[action.Name] {
+			return nil, fmt.Errorf("action %s is defined more than once in stage %s", action.Name, stageName)
+		}
+		seen[action.Name] = true
+		if action.Buildspec == "" {
+			return nil, fmt.Errorf("action %s in stage %s must specify a buildspec", action.Name, stageName)
+		}
+		size := action.ComputeSize
+		if size == "" {
+			size = defaultPipelineActionComputeSize
+		}
+		computeType, ok := pipelineActionComputeTypes[size]
+		if !ok {
+			return nil, fmt.Errorf("compute size %q of action %s in stage %s must be one of small, medium, large or 2xlarge", action.ComputeSize, action.Name, stageName)
+		}
+		out = append(out, PipelineAction{
+			Name:          action.Name,
+			BuildspecPath: action.Buildspec,
+			ComputeType:   computeType,
+		})
+	}
+	return out, nil
+}
+
+// NewPipelineApproval validates the manual approval of a stage in the pipeline manifest and returns the details
+// shown to its reviewers. The message lists the URLs of the services deployed in the previous stage, whose changes
+// are promoted by the approval, as many as fit in the custom data of the approval action. Unless a review URL is set,
+// reviewers are linked to the first of these services.
+func NewPipelineApproval(stageName string, in *manifest.PipelineApproval, prev *PipelineStage) (PipelineApproval, error) {
+	var approval PipelineApproval
+	if in != nil {
+		if len(in.Message) > maxPipelineApprovalMessageLength {
+			return PipelineApproval{}, fmt.Errorf("approval message of stage %s must be at most %d characters long", stageName, maxPipelineApprovalMessageLength)
+		}
+		if in.ReviewURL != "" {
+			u, err := url.Parse(in.ReviewURL)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return PipelineApproval{}, fmt.Errorf("review URL %q of stage %s must be an http or https URL", in.ReviewURL, stageName)
+			}
+		}
+		approval.Message = in.Message
+		approval.ReviewURL = in.ReviewURL
+	}
+	if prev == nil || len(prev.LoadBalancedServices) == 0 {
+		return approval, nil
+	}
+	var urls []string
+	for _, svc := range prev.LoadBalancedServices {
+		urls = append(urls, fmt.Sprintf("%s: #{%s.%s}", svc, prev.ServiceURLNamespace(svc), ServiceURLOutputKey))
+	}
+	approval.Message = approvalMessageWithURLs(approval.Message, prev.Name, urls)
+	if approval.ReviewURL == "" {
+		svc := prev.LoadBalancedServices[0]
+		approval.ReviewURL = fmt.Sprintf("#{%s.%s}", prev.ServiceURLNamespace(svc), ServiceURLOutputKey)
+	}
+	return approval, nil
+}
+
+// approvalMessageWithURLs appends the service URLs to the approval message. The URLs that would make the message
+// longer than the custom data of the approval action allows are left out, and only their number is mentioned.
+func approvalMessageWithURLs(msg, envName string, urls []string) string {
+	for n := len(urls); n > 0; n-- {
+		list := strings.Join(urls[:n], ", ")
+		if omitted := len(urls) - n; omitted > 0 {
+			list = fmt.Sprintf("%s and %d more", list, omitted)
+		}
+		out := strings.TrimSpace(fmt.Sprintf("%s Services deployed to %s: %s.", msg, envName, list))
+		if len(out) <= maxPipelineApprovalCustomDataLength {
+			return out
+		}
+	}
+	return msg
+}
+
+// NewPipelineNotifications validates the notifications of the pipeline manifest
+// and returns a notification rule for each event that has targets.
+func NewPipelineNotifications(in *manifest.PipelineNotifications) ([]PipelineNotification, error) {
+	if in == nil {
+		return nil, nil
+	}
+	events := []struct {
+		targets []string
+		rule    PipelineNotification
+	}{
+		{
+			targets: in.ApprovalNeeded,
+			rule: PipelineNotification{
+				Event:           "approval_needed",
+				LogicalIDPrefix: "ApprovalNeeded",
+				EventTypeID:     "codepipeline-pipeline-manual-approval-needed",
+			},
+		},
+		{
+			targets: in.Failed,
+			rule: PipelineNotification{
+				Event:           "failed",
+				LogicalIDPrefix: "Failed",
+				EventTypeID:     "codepipeline-pipeline-pipeline-execution-failed",
+			},
+		},
+		{
+			targets: in.Succeeded,
+			rule: PipelineNotification{
+				Event:           "succeeded",
+				LogicalIDPrefix: "Succeeded",
+				EventTypeID:     "codepipeline-pipeline-pipeline-execution-succeeded",
+			},
+		},
+	}
+	var rules []PipelineNotification
+	for _, event := range events {
+		if len(event.targets) == 0 {
+			continue
+		}
+		rule := event.rule
+		for _, target := range event.targets {
+			if emailExp.MatchString(target) {
+				rule.Emails = append(rule.Emails, target)
+				continue
+			}
+			parsed, err := arn.Parse(target)
+			if err != nil || parsed.Service != "sns" {
+				return nil, fmt.Errorf("notification target %q of event %s must be an email address or the ARN of an SNS topic", target, rule.Event)
+			}
+			rule.TopicARNs = append(rule.TopicARNs, target)
+		}
+		numTargets := len(rule.TopicARNs)
+		if len(rule.Emails) > 0 {
+			// The email addresses share a single topic.
+			numTargets++
+		}
+		if numTargets > maxNotificationRuleTargets {
+			return nil, fmt.Errorf("event %s must have at most %d SNS topics, including the one created for its email addresses", rule.Event, maxNotificationRuleTargets)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// RuleName returns the name of the notification rule of the pipeline named pipelineName.
+func (n PipelineNotification) RuleName(pipelineName string) string {
+	suffix := "-" + strings.ReplaceAll(n.Event, "_", "-")
+	name := notificationRuleNameInvalidCharsExp.ReplaceAllString(pipelineName, "-")
+	if len(name)+len(suffix) > maxNotificationRuleNameLength {
+		name = name[:maxNotificationRuleNameLength-len(suffix)]
+	}
+	return name + suffix
+}
+
+// NewPipelineDependencies validates the depends_on fields of the deployments of a stage in the pipeline manifest
+// and returns the workloads that each workload depends on.
+func NewPipelineDependencies(stageName string, deployments map[string]*manifest.PipelineDeployment) (map[string][]string, error) {
+	var names []string
+	for name := range deployments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	dependsOn := make(map[string][]string)
+	for _, name := range names {
+		deployment := deployments[name]
+		if deployment == nil || len(deployment.DependsOn) == 0 {
+			continue
+		}
+		for _, dep := range deployment.DependsOn {
+			if _, ok := deployments[dep]; !ok {
+				return nil, fmt.Errorf("deployment %s in stage %s depends on %s, which is not deployed in the stage", name, stageName, dep)
+			}
+		}
+		dependsOn[name] = deployment.DependsOn
+	}
+	if len(dependsOn) == 0 {
+		return nil, nil
+	}
+
+	// Walk the dependencies depth-first to detect cycles.
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int)
+	var path []string
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("deployments in stage %s have a circular dependency: %s", stageName, strings.Join(append(path, name), " -> "))
+		case visited:
+			return nil
+		}
+		state[name] = visiting
+		path = append(path, name)
+		for _, dep := range dependsOn[name] {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+	for _, name := range names {
+		if err := visit(name); err != nil {
+			return nil, err
+		}
+	}
+	return dependsOn, nil
+}
+
+// DeploymentRunOrder returns the run order of the actions that deploy the workloads of the stage without dependencies.
+// The manual approval runs first, followed by the pre-deployment actions.
+func (s *PipelineStage) DeploymentRunOrder() int {
+	if len(s.PreDeployments) > 0 {
+		return 3
+	}
+	return 2
+}
+
+// WorkloadRunOrder returns the run order of the action that deploys the workload,
+// which runs after the actions that deploy the workloads it depends on.
+func (s *PipelineStage) WorkloadRunOrder(name string) int {
+	return s.DeploymentRunOrder() + s.dependencyDepth(name)
+}
+
+// PostDeploymentRunOrder returns the run order of the test commands and post-deployment actions of the stage.
+func (s *PipelineStage) PostDeploymentRunOrder() int {
+	order := s.DeploymentRunOrder()
+	for _, name := range s.LocalWorkloads() {
+		if o := s.WorkloadRunOrder(name); o > order {
+			order = o
+		}
+	}
+	return order + 1
+}
+
+// dependencyDepth returns the length of the longest chain of dependencies of the workload.
+func (s *PipelineStage) dependencyDepth(name string) int {
+	depth := 0
+	for _, dep := range s.DependsOn[name] {
+		if d := s.dependencyDepth(dep) + 1; d > depth {
+			depth = d
+		}
+	}
+	return depth
+}
+
+// ServiceURLNamespace returns the namespace of the variables emitted by the deploy action of a service.
+// If the service isn't reachable through a URL, it returns an empty string.
+func (s *PipelineStage) ServiceURLNamespace(svcName string) string {
+	for _, name := range s.LoadBalancedServices {
+		if name == svcName {
+			return fmt.Sprintf("%s-%s", s.Name, svcName)
+		}
+	}
+	return ""
+}
+
+// PreDeploymentEnvironmentVariables returns the JSON-encoded CodeBuild environment variables
+// of the actions that run before the workloads of the stage are deployed.
+func (s *PipelineStage) PreDeploymentEnvironmentVariables(appName string) (string, error) {
+	return marshalCodeBuildEnvVars(s.baseEnvVars(appName))
+}
+
+// PostDeploymentEnvironmentVariables returns the JSON-encoded CodeBuild environment variables of the test commands
+// and the post-deployment actions of the stage, including the URL of each service deployed in the stage.
+// For example, the URL of the "frontend" service is available under COPILOT_FRONTEND_URL.
+func (s *PipelineStage) PostDeploymentEnvironmentVariables(appName string) (string, error) {
+	vars := s.baseEnvVars(appName)
+	for _, svc := range s.LoadBalancedServices {
+		vars = append(vars, codeBuildEnvVar{
+			Name:  fmt.Sprintf(fmtTestEnvVarServiceURL, strings.ToUpper(strings.ReplaceAll(svc, "-", "_"))),
+			Value: fmt.Sprintf("#{%s.%s}", s.ServiceURLNamespace(svc), ServiceURLOutputKey),
+			Type:  codeBuildEnvVarTypePlaintext,
+		})
+	}
+	return marshalCodeBuildEnvVars(vars)
+}
+
+func (s *PipelineStage) baseEnvVars(appName string) []codeBuildEnvVar {
+	return []codeBuildEnvVar{
+		{
+			Name:  testEnvVarAppName,
+			Value: appName,
+			Type:  codeBuildEnvVarTypePlaintext,
+		},
+		{
+			Name:  testEnvVarEnvName,
+			Value: s.Name,
+			Type:  codeBuildEnvVarTypePlaintext,
+		},
+	}
+}
+
+const codeBuildEnvVarTypePlaintext = "PLAINTEXT"
+
+// codeBuildEnvVar is an environment variable override of a CodeBuild action in CodePipeline.
+type codeBuildEnvVar struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	Type  string `json:"type"`
+}
+
+func marshalCodeBuildEnvVars(vars []codeBuildEnvVar) (string, error) {
+	out, err := json.Marshal(vars)
+	if err != nil {
+		return "", fmt.Errorf("marshal CodeBuild environment variables: %w", err)
+	}
+	return string(out), nil
+}
+
+// LocalWorkloads returns the names of the services and jobs deployed in the stage.
+func (s *PipelineStage) LocalWorkloads() []string {
+	var workloads []string
+	workloads = append(workloads, s.LocalServices...)
+	return append(workloads, s.LocalJobs...)
 }
 
-// ServiceTemplatePath returns the full path to the service CFN template
+// ServiceTemplatePath returns the full path to the service or job CFN template
 // built during the build stage.
 func (s *PipelineStage) ServiceTemplatePath(svcName string) string {
 	return fmt.Sprintf(config.ServiceCfnTemplateNameFormat, svcName, s.Name)
 }
 
-// ServiceTemplateConfigurationPath returns the full path to the service CFN
+// ServiceTemplateConfigurationPath returns the full path to the service or job CFN
 // template configuration file built during the build stage.
 func (s *PipelineStage) ServiceTemplateConfigurationPath(svcName string) string {
 	return fmt.Sprintf(config.ServiceCfnTemplateConfigurationNameFormat,
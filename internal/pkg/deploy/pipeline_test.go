@@ -4,9 +4,14 @@
 package deploy
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/stretchr/testify/require"
 )
 
@@ -16,6 +21,7 @@ func TestParseOwnerAndRepo(t *testing.T) {
 		expectedErrMsg *string
 		expectedOwner  string
 		expectedRepo   string
+		expectedRegion string
 	}{
 		"unsupported source provider": {
 			src: &Source{
@@ -62,6 +68,46 @@ func TestParseOwnerAndRepo(t *testing.T) {
 			expectedOwner:  "badgoose",
 			expectedRepo:   "chaOS",
 		},
+		"valid bitbucket repository": {
+			src: &Source{
+				ProviderName: "Bitbucket",
+				Properties: map[string]interface{}{
+					"repository": "https://bitbucket.org/huanjani/sample",
+				},
+			},
+			expectedOwner: "huanjani",
+			expectedRepo:  "sample",
+		},
+		"valid github enterprise repository": {
+			src: &Source{
+				ProviderName: "GitHubEnterpriseServer",
+				Properties: map[string]interface{}{
+					"repository": "https://github.mycompany.com/badgoose/chaOS",
+				},
+			},
+			expectedOwner: "badgoose",
+			expectedRepo:  "chaOS",
+		},
+		"valid codecommit repository": {
+			src: &Source{
+				ProviderName: "CodeCommit",
+				Properties: map[string]interface{}{
+					"repository": "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/wings",
+				},
+			},
+			expectedOwner:  "",
+			expectedRepo:   "wings",
+			expectedRegion: "us-west-2",
+		},
+		"invalid codecommit repository": {
+			src: &Source{
+				ProviderName: "CodeCommit",
+				Properties: map[string]interface{}{
+					"repository": "https://github.com/badgoose/chaOS",
+				},
+			},
+			expectedErrMsg: aws.String("unable to locate the repository from the properties"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -73,7 +119,610 @@ func TestParseOwnerAndRepo(t *testing.T) {
 				require.NoError(t, err, "expected error")
 				require.Equal(t, tc.expectedOwner, oAndR.owner, "mismatched owner")
 				require.Equal(t, tc.expectedRepo, oAndR.repo, "mismatched repo")
+				require.Equal(t, tc.expectedRegion, oAndR.region, "mismatched region")
 			}
 		})
 	}
 }
+
+func TestSource_Connection(t *testing.T) {
+	testCases := map[string]struct {
+		src *Source
+
+		wantedAccessToken    bool
+		wantedConnection     bool
+		wantedConnectionARN  string
+		wantedConnectionName string
+		wantedFullRepoID     string
+	}{
+		"github with a personal access token": {
+			src: &Source{
+				ProviderName: "GitHub",
+				Properties: map[string]interface{}{
+					"access_token_secret": "github-token-badgoose-backend",
+					"repository":          "badgoose/backend",
+				},
+			},
+			wantedAccessToken:    true,
+			wantedConnectionName: "copilot-badgoose-backend",
+			wantedFullRepoID:     "badgoose/backend",
+		},
+		"github through a connection": {
+			src: &Source{
+				ProviderName: "GitHub",
+				Properties: map[string]interface{}{
+					"repository": "https://github.com/badgoose/a-very-long-repository-name",
+				},
+			},
+			wantedConnection:     true,
+			wantedConnectionName: "copilot-badgoose-a-very-long-rep",
+			wantedFullRepoID:     "badgoose/a-very-long-repository-name",
+		},
+		"bitbucket with an existing connection": {
+			src: &Source{
+				ProviderName: "Bitbucket",
+				Properties: map[string]interface{}{
+					"repository":     "https://bitbucket.org/huanjani/sample",
+					"connection_arn": "arn:aws:codestar-connections:us-west-2:1234:connection/abcd",
+				},
+			},
+			wantedConnection:     true,
+			wantedConnectionARN:  "arn:aws:codestar-connections:us-west-2:1234:connection/abcd",
+			wantedConnectionName: "copilot-huanjani-sample",
+			wantedFullRepoID:     "huanjani/sample",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			connName, err := tc.src.ConnectionName()
+			require.NoError(t, err)
+			fullRepoID, err := tc.src.FullRepositoryID()
+			require.NoError(t, err)
+
+			// THEN
+			require.Equal(t, tc.wantedAccessToken, tc.src.HasAccessToken())
+			require.Equal(t, tc.wantedConnection, tc.src.IsCodeStarConnection())
+			require.Equal(t, tc.wantedConnectionARN, tc.src.ConnectionARN())
+			require.Equal(t, tc.wantedConnectionName, connName)
+			require.Equal(t, tc.wantedFullRepoID, fullRepoID)
+		})
+	}
+}
+
+func TestNewPipelineActions(t *testing.T) {
+	testCases := map[string]struct {
+		in []manifest.PipelineAction
+
+		wantedActions []PipelineAction
+		wantedErr     error
+	}{
+		"defaults to the small compute size": {
+			in: []manifest.PipelineAction{
+				{
+					Name:      "integration-tests",
+					Buildspec: "copilot/pipelines/release/integration.yml",
+				},
+				{
+					Name:        "load-tests",
+					Buildspec:   "copilot/pipelines/release/load.yml",
+					ComputeSize: "2xlarge",
+				},
+			},
+			wantedActions: []PipelineAction{
+				{
+					Name:          "integration-tests",
+					BuildspecPath: "copilot/pipelines/release/integration.yml",
+					ComputeType:   "BUILD_GENERAL1_SMALL",
+				},
+				{
+					Name:          "load-tests",
+					BuildspecPath: "copilot/pipelines/release/load.yml",
+					ComputeType:   "BUILD_GENERAL1_2XLARGE",
+				},
+			},
+		},
+		"returns an error if the name is invalid": {
+			in: []manifest.PipelineAction{
+				{
+					Name:      "integration_tests",
+					Buildspec: "copilot/pipelines/release/integration.yml",
+				},
+			},
+			wantedErr: errors.New(`action name "integration_tests" in stage test must start with a letter or number, contain only letters, numbers and hyphens, and be at most 64 characters long`),
+		},
+		"returns an error if the name is duplicated": {
+			in: []manifest.PipelineAction{
+				{
+					Name:      "smoke",
+					Buildspec: "copilot/pipelines/release/smoke.yml",
+				},
+				{
+					Name:      "smoke",
+					Buildspec: "copilot/pipelines/release/smoke.yml",
+				},
+			},
+			wantedErr: errors.New("action smoke is defined more than once in stage test"),
+		},
+		"returns an error if the buildspec is missing": {
+			in: []manifest.PipelineAction{
+				{
+					Name: "smoke",
+				},
+			},
+			wantedErr: errors.New("action smoke in stage test must specify a buildspec"),
+		},
+		"returns an error if the compute size is invalid": {
+			in: []manifest.PipelineAction{
+				{
+					Name:        "smoke",
+					Buildspec:   "copilot/pipelines/release/smoke.yml",
+					ComputeSize: "huge",
+				},
+			},
+			wantedErr: errors.New(`compute size "huge" of action smoke in stage test must be one of small, medium, large or 2xlarge`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			actions, err := NewPipelineActions("test", tc.in)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedActions, actions)
+			}
+		})
+	}
+}
+
+func TestNewPipelineApproval(t *testing.T) {
+	prev := &PipelineStage{
+		AssociatedEnvironment: &AssociatedEnvironment{
+			Name: "test",
+		},
+		LocalServices:        []string{"frontend", "api", "worker"},
+		LoadBalancedServices: []string{"frontend", "api"},
+	}
+	var manyServices, manyURLs []string
+	for i := 0; i < 20; i++ {
+		svc := fmt.Sprintf("service-%02d", i)
+		manyServices = append(manyServices, svc)
+		manyURLs = append(manyURLs, fmt.Sprintf("%s: #{test-%s.ServiceURL}", svc, svc))
+	}
+	testCases := map[string]struct {
+		in   *manifest.PipelineApproval
+		prev *PipelineStage
+
+		wantedApproval PipelineApproval
+		wantedErr      error
+	}{
+		"returns no details for the first stage without a message": {
+			in: &manifest.PipelineApproval{Required: true},
+		},
+		"returns the message and review URL of the first stage": {
+			in: &manifest.PipelineApproval{
+				Required:  true,
+				Message:   "Check the dashboards.",
+				ReviewURL: "https://dashboards.example.com",
+			},
+			wantedApproval: PipelineApproval{
+				Message:   "Check the dashboards.",
+				ReviewURL: "https://dashboards.example.com",
+			},
+		},
+		"links to the services deployed in the previous stage": {
+			in: &manifest.PipelineApproval{
+				Required: true,
+				Message:  "Check the dashboards.",
+			},
+			prev: prev,
+			wantedApproval: PipelineApproval{
+				Message:   "Check the dashboards. Services deployed to test: frontend: #{test-frontend.ServiceURL}, api: #{test-api.ServiceURL}.",
+				ReviewURL: "#{test-frontend.ServiceURL}",
+			},
+		},
+		"keeps the review URL when there is a previous stage": {
+			in: &manifest.PipelineApproval{
+				Required:  true,
+				ReviewURL: "https://dashboards.example.com",
+			},
+			prev: prev,
+			wantedApproval: PipelineApproval{
+				Message:   "Services deployed to test: frontend: #{test-frontend.ServiceURL}, api: #{test-api.ServiceURL}.",
+				ReviewURL: "https://dashboards.example.com",
+			},
+		},
+		"leaves out the URLs that don't fit in the custom data of the approval": {
+			in: &manifest.PipelineApproval{
+				Required: true,
+				Message:  "Check the dashboards.",
+			},
+			prev: &PipelineStage{
+				AssociatedEnvironment: &AssociatedEnvironment{
+					Name: "test",
+				},
+				LocalServices:        manyServices,
+				LoadBalancedServices: manyServices,
+			},
+			wantedApproval: PipelineApproval{
+				Message:   fmt.Sprintf("Check the dashboards. Services deployed to test: %s and 10 more.", strings.Join(manyURLs[:10], ", ")),
+				ReviewURL: "#{test-service-00.ServiceURL}",
+			},
+		},
+		"returns an error if the message is too long": {
+			in: &manifest.PipelineApproval{
+				Required: true,
+				Message:  strings.Repeat("a", 257),
+			},
+			wantedErr: errors.New("approval message of stage prod must be at most 256 characters long"),
+		},
+		"returns an error if the review URL is invalid": {
+			in: &manifest.PipelineApproval{
+				Required:  true,
+				ReviewURL: "dashboards.example.com",
+			},
+			wantedErr: errors.New(`review URL "dashboards.example.com" of stage prod must be an http or https URL`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			approval, err := NewPipelineApproval("prod", tc.in, tc.prev)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApproval, approval)
+			}
+		})
+	}
+}
+
+func TestNewPipelineBuild(t *testing.T) {
+	timeout := func(d time.Duration) *time.Duration {
+		return &d
+	}
+	testCases := map[string]struct {
+		in *manifest.PipelineBuild
+
+		wantedBuild *PipelineBuild
+		wantedErr   error
+	}{
+		"returns the default build without a build section": {
+			wantedBuild: &PipelineBuild{
+				Image:            "aws/codebuild/amazonlinux2-x86_64-standard:1.0",
+				ComputeType:      "BUILD_GENERAL1_SMALL",
+				PrivilegedMode:   true,
+				TimeoutInMinutes: 60,
+			},
+		},
+		"overrides the defaults with the build section": {
+			in: &manifest.PipelineBuild{
+				Image:       "aws/codebuild/standard:5.0",
+				ComputeSize: "2xlarge",
+				Privileged:  aws.Bool(false),
+				Variables: map[string]string{
+					"GOFLAGS":    "-mod=vendor",
+					"_CACHE_DIR": "/tmp/cache",
+				},
+				Timeout: timeout(2 * time.Hour),
+			},
+			wantedBuild: &PipelineBuild{
+				Image:          "aws/codebuild/standard:5.0",
+				ComputeType:    "BUILD_GENERAL1_2XLARGE",
+				PrivilegedMode: false,
+				EnvironmentVariables: map[string]string{
+					"GOFLAGS":    "-mod=vendor",
+					"_CACHE_DIR": "/tmp/cache",
+				},
+				TimeoutInMinutes: 120,
+			},
+		},
+		"sets the repository of an ECR image": {
+			in: &manifest.PipelineBuild{
+				Image: "123456789012.dkr.ecr.cn-north-1.amazonaws.com.cn/team/toolchain:1.2",
+			},
+			wantedBuild: &PipelineBuild{
+				Image:              "123456789012.dkr.ecr.cn-north-1.amazonaws.com.cn/team/toolchain:1.2",
+				ComputeType:        "BUILD_GENERAL1_SMALL",
+				PrivilegedMode:     true,
+				TimeoutInMinutes:   60,
+				ImageRepositoryARN: "arn:aws-cn:ecr:cn-north-1:123456789012:repository/team/toolchain",
+			},
+		},
+		"returns an error if the compute size is invalid": {
+			in: &manifest.PipelineBuild{
+				ComputeSize: "huge",
+			},
+			wantedErr: errors.New(`build compute size "huge" must be one of small, medium, large or 2xlarge`),
+		},
+		"returns an error if a variable name is invalid": {
+			in: &manifest.PipelineBuild{
+				Variables: map[string]string{
+					"GO-FLAGS": "-mod=vendor",
+				},
+			},
+			wantedErr: errors.New(`build variable "GO-FLAGS" must start with a letter or underscore and contain only letters, numbers and underscores`),
+		},
+		"returns an error if a variable name is reserved": {
+			in: &manifest.PipelineBuild{
+				Variables: map[string]string{
+					"CODEBUILD_SRC_DIR": "/src",
+				},
+			},
+			wantedErr: errors.New(`build variable "CODEBUILD_SRC_DIR" must not start with the prefix CODEBUILD_ reserved by CodeBuild`),
+		},
+		"returns an error if the timeout is out of range": {
+			in: &manifest.PipelineBuild{
+				Timeout: timeout(9 * time.Hour),
+			},
+			wantedErr: errors.New("build timeout 9h0m0s must be a whole number of minutes between 5m0s and 8h0m0s"),
+		},
+		"returns an error if the timeout is not a whole number of minutes": {
+			in: &manifest.PipelineBuild{
+				Timeout: timeout(90 * time.Second),
+			},
+			wantedErr: errors.New("build timeout 1m30s must be a whole number of minutes between 5m0s and 8h0m0s"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			build, err := NewPipelineBuild(tc.in)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedBuild, build)
+			}
+		})
+	}
+}
+
+func TestNewPipelineNotifications(t *testing.T) {
+	var tooManyTargets []string
+	for i := 0; i < 10; i++ {
+		tooManyTargets = append(tooManyTargets, fmt.Sprintf("arn:aws:sns:us-west-2:123456789012:oncall-%d", i))
+	}
+	tooManyTargets = append(tooManyTargets, "oncall@example.com")
+	testCases := map[string]struct {
+		in *manifest.PipelineNotifications
+
+		wantedNotifications []PipelineNotification
+		wantedErr           error
+	}{
+		"returns nil without notifications": {},
+		"returns a rule for each event with targets": {
+			in: &manifest.PipelineNotifications{
+				ApprovalNeeded: []string{"reviewers@example.com", "arn:aws:sns:us-west-2:123456789012:reviewers"},
+				Succeeded:      []string{"team@example.com", "ops@example.com"},
+			},
+			wantedNotifications: []PipelineNotification{
+				{
+					Event:           "approval_needed",
+					LogicalIDPrefix: "ApprovalNeeded",
+					EventTypeID:     "codepipeline-pipeline-manual-approval-needed",
+					TopicARNs:       []string{"arn:aws:sns:us-west-2:123456789012:reviewers"},
+					Emails:          []string{"reviewers@example.com"},
+				},
+				{
+					Event:           "succeeded",
+					LogicalIDPrefix: "Succeeded",
+					EventTypeID:     "codepipeline-pipeline-pipeline-execution-succeeded",
+					Emails:          []string{"team@example.com", "ops@example.com"},
+				},
+			},
+		},
+		"returns an error if a target is neither an email address nor an SNS topic": {
+			in: &manifest.PipelineNotifications{
+				Failed: []string{"arn:aws:sqs:us-west-2:123456789012:oncall"},
+			},
+			wantedErr: errors.New(`notification target "arn:aws:sqs:us-west-2:123456789012:oncall" of event failed must be an email address or the ARN of an SNS topic`),
+		},
+		"returns an error if an event has too many targets": {
+			in: &manifest.PipelineNotifications{
+				Failed: tooManyTargets,
+			},
+			wantedErr: errors.New("event failed must have at most 10 SNS topics, including the one created for its email addresses"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			notifications, err := NewPipelineNotifications(tc.in)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedNotifications, notifications)
+			}
+		})
+	}
+}
+
+func TestPipelineNotification_RuleName(t *testing.T) {
+	testCases := map[string]struct {
+		inPipelineName string
+
+		wantedName string
+	}{
+		"appends the event to the pipeline name": {
+			inPipelineName: "pipeline-badgoose-repo",
+			wantedName:     "pipeline-badgoose-repo-approval-needed",
+		},
+		"replaces invalid characters": {
+			inPipelineName: "release@v1.2",
+			wantedName:     "release-v1-2-approval-needed",
+		},
+		"truncates long pipeline names": {
+			inPipelineName: strings.Repeat("a", 100),
+			wantedName:     strings.Repeat("a", 48) + "-approval-needed",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			n := PipelineNotification{Event: "approval_needed"}
+			require.Equal(t, tc.wantedName, n.RuleName(tc.inPipelineName))
+		})
+	}
+}
+
+func TestNewPipelineDependencies(t *testing.T) {
+	testCases := map[string]struct {
+		in map[string]*manifest.PipelineDeployment
+
+		wantedDependsOn map[string][]string
+		wantedErr       error
+	}{
+		"returns nil if there are no dependencies": {
+			in: map[string]*manifest.PipelineDeployment{
+				"api":      nil,
+				"frontend": {},
+			},
+		},
+		"returns the dependencies of each workload": {
+			in: map[string]*manifest.PipelineDeployment{
+				"db-migrate": nil,
+				"api": {
+					DependsOn: []string{"db-migrate"},
+				},
+				"frontend": {
+					DependsOn: []string{"api"},
+				},
+			},
+			wantedDependsOn: map[string][]string{
+				"api":      {"db-migrate"},
+				"frontend": {"api"},
+			},
+		},
+		"returns an error if a dependency is not deployed in the stage": {
+			in: map[string]*manifest.PipelineDeployment{
+				"frontend": {
+					DependsOn: []string{"api"},
+				},
+			},
+			wantedErr: errors.New("deployment frontend in stage test depends on api, which is not deployed in the stage"),
+		},
+		"returns an error if the dependencies are circular": {
+			in: map[string]*manifest.PipelineDeployment{
+				"api": {
+					DependsOn: []string{"worker"},
+				},
+				"frontend": {
+					DependsOn: []string{"api"},
+				},
+				"worker": {
+					DependsOn: []string{"frontend"},
+				},
+			},
+			wantedErr: errors.New("deployments in stage test have a circular dependency: api -> worker -> frontend -> api"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			dependsOn, err := NewPipelineDependencies("test", tc.in)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDependsOn, dependsOn)
+			}
+		})
+	}
+}
+
+func TestPipelineStage_RunOrder(t *testing.T) {
+	testCases := map[string]struct {
+		in PipelineStage
+
+		wantedWorkloadRunOrders   map[string]int
+		wantedPostDeploymentOrder int
+	}{
+		"deploys all the workloads at once without dependencies": {
+			in: PipelineStage{
+				LocalServices: []string{"api", "frontend"},
+			},
+			wantedWorkloadRunOrders: map[string]int{
+				"api":      2,
+				"frontend": 2,
+			},
+			wantedPostDeploymentOrder: 3,
+		},
+		"deploys the workloads after their dependencies": {
+			in: PipelineStage{
+				LocalServices:  []string{"api", "frontend", "admin"},
+				LocalJobs:      []string{"db-migrate"},
+				PreDeployments: []PipelineAction{{Name: "lint"}},
+				DependsOn: map[string][]string{
+					"api":      {"db-migrate"},
+					"frontend": {"api", "db-migrate"},
+				},
+			},
+			wantedWorkloadRunOrders: map[string]int{
+				"db-migrate": 3,
+				"admin":      3,
+				"api":        4,
+				"frontend":   5,
+			},
+			wantedPostDeploymentOrder: 6,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for workload, order := range tc.wantedWorkloadRunOrders {
+				require.Equal(t, order, tc.in.WorkloadRunOrder(workload), "run order of %s", workload)
+			}
+			require.Equal(t, tc.wantedPostDeploymentOrder, tc.in.PostDeploymentRunOrder())
+		})
+	}
+}
+
+func TestPipelineStage_EnvironmentVariables(t *testing.T) {
+	stage := PipelineStage{
+		AssociatedEnvironment: &AssociatedEnvironment{
+			Name: "test",
+		},
+		LocalServices:        []string{"front-end", "api"},
+		LoadBalancedServices: []string{"front-end"},
+		PreDeployments: []PipelineAction{
+			{
+				Name: "db-migration",
+			},
+		},
+	}
+
+	// WHEN
+	preVars, err := stage.PreDeploymentEnvironmentVariables("phonetool")
+	require.NoError(t, err)
+	postVars, err := stage.PostDeploymentEnvironmentVariables("phonetool")
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, `[{"name":"COPILOT_APPLICATION_NAME","value":"phonetool","type":"PLAINTEXT"},{"name":"COPILOT_ENVIRONMENT_NAME","value":"test","type":"PLAINTEXT"}]`, preVars)
+	require.Equal(t, `[{"name":"COPILOT_APPLICATION_NAME","value":"phonetool","type":"PLAINTEXT"},{"name":"COPILOT_ENVIRONMENT_NAME","value":"test","type":"PLAINTEXT"},{"name":"COPILOT_FRONT_END_URL","value":"#{test-front-end.ServiceURL}","type":"PLAINTEXT"}]`, postVars)
+	require.Equal(t, "test-front-end", stage.ServiceURLNamespace("front-end"))
+	require.Equal(t, "", stage.ServiceURLNamespace("api"))
+	require.Equal(t, 3, stage.DeploymentRunOrder())
+	require.Equal(t, 4, stage.PostDeploymentRunOrder())
+}
@@ -29,6 +29,7 @@ type BackendServiceDescriber struct {
 	enableResources bool
 
 	store                DeployedEnvServicesLister
+	pausedSvcGetter      pausedServiceGetter
 	svcDescriber         map[string]svcDescriber
 	initServiceDescriber func(string) error
 }
@@ -47,6 +48,7 @@ func NewBackendServiceDescriber(opt NewBackendServiceConfig) (*BackendServiceDes
 		svc:             opt.Svc,
 		enableResources: opt.EnableResources,
 		store:           opt.DeployStore,
+		pausedSvcGetter: opt.ConfigStore,
 		svcDescriber:    make(map[string]svcDescriber),
 	}
 	describer.initServiceDescriber = func(env string) error {
@@ -118,12 +120,17 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 				App:     d.app,
 			}, env)
 		}
+		paused, err := isServicePaused(d.pausedSvcGetter, d.app, env, d.svc)
+		if err != nil {
+			return nil, fmt.Errorf("check if service is paused: %w", err)
+		}
 		configs = append(configs, &ServiceConfig{
 			Environment: env,
 			Port:        port,
 			Tasks:       svcParams[stack.WorkloadTaskCountParamKey],
 			CPU:         svcParams[stack.WorkloadTaskCPUParamKey],
 			Memory:      svcParams[stack.WorkloadTaskMemoryParamKey],
+			Paused:      paused,
 		})
 		backendSvcEnvVars, err := d.svcDescriber[env].EnvVars()
 		if err != nil {
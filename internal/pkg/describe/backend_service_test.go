@@ -10,6 +10,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
 	"github.com/golang/mock/gomock"
@@ -17,8 +18,9 @@ import (
 )
 
 type backendSvcDescriberMocks struct {
-	storeSvc     *mocks.MockDeployedEnvServicesLister
-	svcDescriber *mocks.MocksvcDescriber
+	storeSvc        *mocks.MockDeployedEnvServicesLister
+	pausedSvcGetter *mocks.MockpausedServiceGetter
+	svcDescriber    *mocks.MocksvcDescriber
 }
 
 func TestBackendServiceDescriber_Describe(t *testing.T) {
@@ -65,11 +67,27 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						stack.WorkloadTaskCPUParamKey:           "256",
 						stack.WorkloadTaskMemoryParamKey:        "512",
 					}, nil),
+					m.pausedSvcGetter.EXPECT().GetPausedService(testApp, gomock.Any(), testSvc).Return(nil, &config.ErrServiceNotPaused{}),
 					m.svcDescriber.EXPECT().EnvVars().Return(nil, mockErr),
 				)
 			},
 			wantedError: fmt.Errorf("retrieve environment variables: some error"),
 		},
+		"return error if fail to check if the service is paused": {
+			setupMocks: func(m backendSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceContainerPortParamKey: "80",
+						stack.WorkloadTaskCountParamKey:         "1",
+						stack.WorkloadTaskCPUParamKey:           "256",
+						stack.WorkloadTaskMemoryParamKey:        "512",
+					}, nil),
+					m.pausedSvcGetter.EXPECT().GetPausedService(testApp, testEnv, testSvc).Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("check if service is paused: some error"),
+		},
 		"success": {
 			shouldOutputResources: true,
 			setupMocks: func(m backendSvcDescriberMocks) {
@@ -82,6 +100,7 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						stack.WorkloadTaskCPUParamKey:           "256",
 						stack.WorkloadTaskMemoryParamKey:        "512",
 					}, nil),
+					m.pausedSvcGetter.EXPECT().GetPausedService(testApp, testEnv, testSvc).Return(nil, &config.ErrServiceNotPaused{}),
 					m.svcDescriber.EXPECT().EnvVars().Return(
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": testEnv,
@@ -93,6 +112,7 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						stack.WorkloadTaskCPUParamKey:           "512",
 						stack.WorkloadTaskMemoryParamKey:        "1024",
 					}, nil),
+					m.pausedSvcGetter.EXPECT().GetPausedService(testApp, prodEnv, testSvc).Return(&config.PausedService{DesiredCount: 2}, nil),
 					m.svcDescriber.EXPECT().EnvVars().Return(
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": prodEnv,
@@ -104,6 +124,7 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						stack.WorkloadTaskCPUParamKey:           "512",
 						stack.WorkloadTaskMemoryParamKey:        "1024",
 					}, nil),
+					m.pausedSvcGetter.EXPECT().GetPausedService(testApp, mockEnv, testSvc).Return(nil, &config.ErrServiceNotPaused{}),
 					m.svcDescriber.EXPECT().EnvVars().Return(
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": mockEnv,
@@ -147,6 +168,7 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						Memory:      "1024",
 						Port:        "5000",
 						Tasks:       "2",
+						Paused:      true,
 					},
 					{
 						CPU:         "512",
@@ -209,10 +231,12 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockStore := mocks.NewMockDeployedEnvServicesLister(ctrl)
+			mockPausedSvcGetter := mocks.NewMockpausedServiceGetter(ctrl)
 			mockSvcDescriber := mocks.NewMocksvcDescriber(ctrl)
 			mocks := backendSvcDescriberMocks{
-				storeSvc:     mockStore,
-				svcDescriber: mockSvcDescriber,
+				storeSvc:        mockStore,
+				pausedSvcGetter: mockPausedSvcGetter,
+				svcDescriber:    mockSvcDescriber,
 			}
 
 			tc.setupMocks(mocks)
@@ -222,6 +246,7 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 				svc:             testSvc,
 				enableResources: tc.shouldOutputResources,
 				store:           mockStore,
+				pausedSvcGetter: mockPausedSvcGetter,
 				svcDescriber: map[string]svcDescriber{
 					"test":    mockSvcDescriber,
 					"prod":    mockSvcDescriber,
@@ -74,6 +74,7 @@ type WebServiceDescriber struct {
 	enableResources bool
 
 	store                DeployedEnvServicesLister
+	pausedSvcGetter      pausedServiceGetter
 	svcDescriber         map[string]svcDescriber
 	initServiceDescriber func(string) error
 
@@ -95,6 +96,7 @@ func NewWebServiceDescriber(opt NewWebServiceConfig) (*WebServiceDescriber, erro
 		svc:             opt.Svc,
 		enableResources: opt.EnableResources,
 		store:           opt.DeployStore,
+		pausedSvcGetter: opt.ConfigStore,
 		svcDescriber:    make(map[string]svcDescriber),
 	}
 	describer.initServiceDescriber = func(env string) error {
@@ -140,12 +142,17 @@ func (d *WebServiceDescriber) Describe() (HumanJSONStringer, error) {
 			Environment: env,
 			URL:         webServiceURI,
 		})
+		paused, err := isServicePaused(d.pausedSvcGetter, d.app, env, d.svc)
+		if err != nil {
+			return nil, fmt.Errorf("check if service is paused: %w", err)
+		}
 		configs = append(configs, &ServiceConfig{
 			Environment: env,
 			Port:        d.svcParams[stack.LBWebServiceContainerPortParamKey],
 			Tasks:       d.svcParams[stack.WorkloadTaskCountParamKey],
 			CPU:         d.svcParams[stack.WorkloadTaskCPUParamKey],
 			Memory:      d.svcParams[stack.WorkloadTaskMemoryParamKey],
+			Paused:      paused,
 		})
 		serviceDiscoveries = appendServiceDiscovery(serviceDiscoveries, serviceDiscovery{
 			Service: d.svc,
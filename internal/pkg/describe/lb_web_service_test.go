@@ -10,6 +10,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
 	"github.com/golang/mock/gomock"
@@ -56,8 +57,9 @@ func TestWebServiceURI_String(t *testing.T) {
 }
 
 type webSvcDescriberMocks struct {
-	storeSvc     *mocks.MockDeployedEnvServicesLister
-	svcDescriber *mocks.MocksvcDescriber
+	storeSvc        *mocks.MockDeployedEnvServicesLister
+	pausedSvcGetter *mocks.MockpausedServiceGetter
+	svcDescriber    *mocks.MocksvcDescriber
 }
 
 func TestWebServiceDescriber_URI(t *testing.T) {
@@ -214,6 +216,7 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						stack.WorkloadTaskMemoryParamKey:        "512",
 						stack.LBWebServiceRulePathParamKey:      testSvcPath,
 					}, nil),
+					m.pausedSvcGetter.EXPECT().GetPausedService(testApp, gomock.Any(), testSvc).Return(nil, &config.ErrServiceNotPaused{}),
 					m.svcDescriber.EXPECT().EnvVars().Return(nil, mockErr),
 				)
 			},
@@ -234,6 +237,7 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						stack.WorkloadTaskCPUParamKey:           "256",
 						stack.WorkloadTaskMemoryParamKey:        "512",
 					}, nil),
+					m.pausedSvcGetter.EXPECT().GetPausedService(testApp, gomock.Any(), testSvc).Return(nil, &config.ErrServiceNotPaused{}),
 					m.svcDescriber.EXPECT().EnvVars().Return(
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": testEnv,
@@ -259,6 +263,7 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						stack.WorkloadTaskCPUParamKey:           "256",
 						stack.WorkloadTaskMemoryParamKey:        "512",
 					}, nil),
+					m.pausedSvcGetter.EXPECT().GetPausedService(testApp, gomock.Any(), testSvc).Return(nil, &config.ErrServiceNotPaused{}),
 					m.svcDescriber.EXPECT().EnvVars().Return(
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": testEnv,
@@ -274,6 +279,7 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						stack.WorkloadTaskCPUParamKey:           "512",
 						stack.WorkloadTaskMemoryParamKey:        "1024",
 					}, nil),
+					m.pausedSvcGetter.EXPECT().GetPausedService(testApp, gomock.Any(), testSvc).Return(nil, &config.ErrServiceNotPaused{}),
 					m.svcDescriber.EXPECT().EnvVars().Return(
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": prodEnv,
@@ -365,10 +371,12 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockStore := mocks.NewMockDeployedEnvServicesLister(ctrl)
+			mockPausedSvcGetter := mocks.NewMockpausedServiceGetter(ctrl)
 			mockSvcDescriber := mocks.NewMocksvcDescriber(ctrl)
 			mocks := webSvcDescriberMocks{
-				storeSvc:     mockStore,
-				svcDescriber: mockSvcDescriber,
+				storeSvc:        mockStore,
+				pausedSvcGetter: mockPausedSvcGetter,
+				svcDescriber:    mockSvcDescriber,
 			}
 
 			tc.setupMocks(mocks)
@@ -378,6 +386,7 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 				svc:             testSvc,
 				enableResources: tc.shouldOutputResources,
 				store:           mockStore,
+				pausedSvcGetter: mockPausedSvcGetter,
 				svcDescriber: map[string]svcDescriber{
 					"test": mockSvcDescriber,
 					"prod": mockSvcDescriber,
@@ -186,6 +186,59 @@ func (mr *MockConfigStoreSvcMockRecorder) ListServices(appName interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockConfigStoreSvc)(nil).ListServices), appName)
 }
 
+// GetPausedService mocks base method
+func (m *MockConfigStoreSvc) GetPausedService(appName, envName, svcName string) (*config.PausedService, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPausedService", appName, envName, svcName)
+	ret0, _ := ret[0].(*config.PausedService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPausedService indicates an expected call of GetPausedService
+func (mr *MockConfigStoreSvcMockRecorder) GetPausedService(appName, envName, svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPausedService", reflect.TypeOf((*MockConfigStoreSvc)(nil).GetPausedService), appName, envName, svcName)
+}
+
+// MockpausedServiceGetter is a mock of pausedServiceGetter interface
+type MockpausedServiceGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockpausedServiceGetterMockRecorder
+}
+
+// MockpausedServiceGetterMockRecorder is the mock recorder for MockpausedServiceGetter
+type MockpausedServiceGetterMockRecorder struct {
+	mock *MockpausedServiceGetter
+}
+
+// NewMockpausedServiceGetter creates a new mock instance
+func NewMockpausedServiceGetter(ctrl *gomock.Controller) *MockpausedServiceGetter {
+	mock := &MockpausedServiceGetter{ctrl: ctrl}
+	mock.recorder = &MockpausedServiceGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockpausedServiceGetter) EXPECT() *MockpausedServiceGetterMockRecorder {
+	return m.recorder
+}
+
+// GetPausedService mocks base method
+func (m *MockpausedServiceGetter) GetPausedService(appName, envName, svcName string) (*config.PausedService, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPausedService", appName, envName, svcName)
+	ret0, _ := ret[0].(*config.PausedService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPausedService indicates an expected call of GetPausedService
+func (mr *MockpausedServiceGetterMockRecorder) GetPausedService(appName, envName, svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPausedService", reflect.TypeOf((*MockpausedServiceGetter)(nil).GetPausedService), appName, envName, svcName)
+}
+
 // MockDeployedEnvServicesLister is a mock of DeployedEnvServicesLister interface
 type MockDeployedEnvServicesLister struct {
 	ctrl     *gomock.Controller
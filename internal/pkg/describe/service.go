@@ -4,6 +4,7 @@
 package describe
 
 import (
+	"errors"
 	"fmt"
 	"io"
 
@@ -37,6 +38,11 @@ type ConfigStoreSvc interface {
 	GetEnvironment(appName string, environmentName string) (*config.Environment, error)
 	ListEnvironments(appName string) ([]*config.Environment, error)
 	ListServices(appName string) ([]*config.Workload, error)
+	GetPausedService(appName, envName, svcName string) (*config.PausedService, error)
+}
+
+type pausedServiceGetter interface {
+	GetPausedService(appName, envName, svcName string) (*config.PausedService, error)
 }
 
 // DeployedEnvServicesLister wraps methods of deploy store.
@@ -52,6 +58,7 @@ type ServiceConfig struct {
 	Tasks       string `json:"tasks"`
 	CPU         string `json:"cpu"`
 	Memory      string `json:"memory"`
+	Paused      bool   `json:"paused,omitempty"`
 }
 
 type configurations []*ServiceConfig
@@ -59,7 +66,11 @@ type configurations []*ServiceConfig
 func (c configurations) humanString(w io.Writer) {
 	fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\n", "Environment", "Tasks", "CPU (vCPU)", "Memory (MiB)", "Port")
 	for _, config := range c {
-		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\n", config.Environment, config.Tasks, cpuToString(config.CPU), config.Memory, config.Port)
+		tasks := config.Tasks
+		if config.Paused {
+			tasks = "paused"
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\n", config.Environment, tasks, cpuToString(config.CPU), config.Memory, config.Port)
 	}
 }
 
@@ -161,3 +172,16 @@ func (d *ServiceDescriber) Params() (map[string]string, error) {
 	}
 	return params, nil
 }
+
+// isServicePaused returns whether the service was paused in the environment with "copilot svc pause".
+func isServicePaused(getter pausedServiceGetter, app, env, svc string) (bool, error) {
+	_, err := getter.GetPausedService(app, env, svc)
+	if err == nil {
+		return true, nil
+	}
+	var errNotPaused *config.ErrServiceNotPaused
+	if errors.As(err, &errNotPaused) {
+		return false, nil
+	}
+	return false, err
+}
@@ -0,0 +1,326 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	cfnStackResourceType = "AWS::CloudFormation::Stack"
+	ecsServiceStackType  = "AWS::ECS::Service"
+
+	failedStatusSuffix = "_FAILED"
+	// The reason of resources whose operation is cancelled because another resource failed.
+	cancelledReasonPrefix = "Resource creation cancelled"
+	cancelledUpdateReason = "Resource update cancelled"
+	// Tasks stopped by the scheduler to replace them are not failures.
+	scaledInTaskReasonPrefix = "Scaling activity initiated by"
+	// The reason of tasks that could not pull their container image.
+	cannotPullContainerReason = "CannotPullContainerError"
+)
+
+// Statuses of a stack that start a new operation.
+var operationStartStatuses = map[string]bool{
+	"CREATE_IN_PROGRESS": true,
+	"UPDATE_IN_PROGRESS": true,
+	"DELETE_IN_PROGRESS": true,
+	"IMPORT_IN_PROGRESS": true,
+}
+
+type stackEventsDescriber interface {
+	Describe(stackName string) (*cloudformation.StackDescription, error)
+	Events(stackName string) ([]cloudformation.StackEvent, error)
+}
+
+type ecsStoppedTasksGetter interface {
+	Service(clusterName, serviceName string) (*ecs.Service, error)
+	StoppedServiceTasks(clusterName, serviceName string) ([]*ecs.Task, error)
+}
+
+type targetHealthGetter interface {
+	TargetsHealth(targetGroupARN string) ([]*elbv2.TargetHealth, error)
+}
+
+// ReasonCount is a reason and the number of times it occurred.
+type ReasonCount struct {
+	Reason string `json:"reason"`
+	Count  int    `json:"count"`
+}
+
+// StackFailure is the root cause of a failed stack operation.
+type StackFailure struct {
+	StackName     string `json:"stackName"`     // The stack of the failed resource.
+	InNestedStack bool   `json:"inNestedStack"` // True if the resource belongs to a nested stack, such as the addons stack.
+	LogicalID     string `json:"logicalID"`
+	PhysicalID    string `json:"physicalID"`
+	ResourceType  string `json:"resourceType"`
+	Status        string `json:"status"`
+	Reason        string `json:"reason"`
+
+	// The reasons why the tasks of an ECS service stopped, and why its targets fail the load balancer health checks.
+	StoppedTaskReasons     []ReasonCount `json:"stoppedTaskReasons,omitempty"`
+	UnhealthyTargetReasons []ReasonCount `json:"unhealthyTargetReasons,omitempty"`
+}
+
+// StackFailureAnalyzer finds the root cause of failed stack operations.
+type StackFailureAnalyzer struct {
+	cfn stackEventsDescriber
+	ecs ecsStoppedTasksGetter
+	elb targetHealthGetter
+}
+
+// NewStackFailureAnalyzer instantiates a new StackFailureAnalyzer against the session of the stacks' account and region.
+func NewStackFailureAnalyzer(sess *session.Session) *StackFailureAnalyzer {
+	return &StackFailureAnalyzer{
+		cfn: cloudformation.New(sess),
+		ecs: ecs.New(sess),
+		elb: elbv2.New(sess),
+	}
+}
+
+// LastOperationTime returns when the most recent operation on the stack started according to CloudFormation,
+// or the zero time if the stack doesn't exist yet.
+// Record it before deploying the stack and pass it to Analyze, so that the clock of this machine doesn't matter.
+func (a *StackFailureAnalyzer) LastOperationTime(stackName string) (time.Time, error) {
+	descr, err := a.cfn.Describe(stackName)
+	if err != nil {
+		var errNotFound *cloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, err
+	}
+	if descr.LastUpdatedTime != nil {
+		return aws.TimeValue(descr.LastUpdatedTime), nil
+	}
+	return aws.TimeValue(descr.CreationTime), nil
+}
+
+// Analyze walks the events of the most recent operation on the stack, including the ones of its nested stacks,
+// and returns the first resource that failed. If the resource is an ECS service, the reasons why its tasks stopped
+// and its targets are unhealthy are returned as well.
+// It returns nil if no resource failed during the operation, or if the operation didn't start after since.
+func (a *StackFailureAnalyzer) Analyze(stackName string, since time.Time) (*StackFailure, error) {
+	failure, err := a.analyze(stackName, since)
+	if err != nil {
+		return nil, err
+	}
+	if failure == nil {
+		return nil, nil
+	}
+	failure.InNestedStack = failure.StackName != stackName
+	return failure, nil
+}
+
+func (a *StackFailureAnalyzer) analyze(stackID string, since time.Time) (*StackFailure, error) {
+	events, err := a.cfn.Events(stackID)
+	if err != nil {
+		return nil, err
+	}
+	operation := lastOperation(events)
+	if len(operation) == 0 || !aws.TimeValue(operation[0].Timestamp).After(since) {
+		// The stack was not deployed since then, its last failure is not the one we are looking for.
+		return nil, nil
+	}
+	failed := firstFailedEvent(operation)
+	if failed == nil {
+		return nil, nil
+	}
+	started := aws.TimeValue(operation[0].Timestamp)
+	failure := &StackFailure{
+		StackName:    aws.StringValue(failed.StackName),
+		LogicalID:    aws.StringValue(failed.LogicalResourceId),
+		PhysicalID:   aws.StringValue(failed.PhysicalResourceId),
+		ResourceType: aws.StringValue(failed.ResourceType),
+		Status:       aws.StringValue(failed.ResourceStatus),
+		Reason:       aws.StringValue(failed.ResourceStatusReason),
+	}
+	switch failure.ResourceType {
+	case cfnStackResourceType:
+		if failure.PhysicalID == "" {
+			break
+		}
+		// The nested stack reports that one of its resources failed, look for it.
+		nested, err := a.analyze(failure.PhysicalID, since)
+		if err == nil && nested != nil {
+			return nested, nil
+		}
+	case ecsServiceStackType:
+		// Correlating the failure with the service is a best effort, the failure event is helpful on its own.
+		a.addServiceReasons(failure, started)
+	}
+	return failure, nil
+}
+
+// addServiceReasons adds the reasons why the tasks of the failed ECS service stopped since the operation started,
+// and why its targets are unhealthy.
+func (a *StackFailureAnalyzer) addServiceReasons(failure *StackFailure, since time.Time) {
+	serviceArn := ecs.ServiceArn(failure.PhysicalID)
+	clusterName, err := serviceArn.ClusterName()
+	if err != nil {
+		return
+	}
+	serviceName, err := serviceArn.ServiceName()
+	if err != nil {
+		return
+	}
+	if tasks, err := a.ecs.StoppedServiceTasks(clusterName, serviceName); err == nil {
+		var reasons []string
+		for _, task := range tasks {
+			if task.StoppedAt == nil || task.StoppedAt.Before(since) {
+				continue
+			}
+			if strings.HasPrefix(aws.StringValue(task.StoppedReason), scaledInTaskReasonPrefix) {
+				continue
+			}
+			reasons = append(reasons, stoppedTaskReason(task))
+		}
+		failure.StoppedTaskReasons = countReasons(reasons)
+	}
+	service, err := a.ecs.Service(clusterName, serviceName)
+	if err != nil {
+		return
+	}
+	var reasons []string
+	for _, lb := range service.LoadBalancers {
+		targets, err := a.elb.TargetsHealth(aws.StringValue(lb.TargetGroupArn))
+		if err != nil {
+			continue
+		}
+		for _, target := range targets {
+			if target.IsHealthy() || target.TargetHealth == nil {
+				continue
+			}
+			reason := aws.StringValue(target.TargetHealth.Description)
+			if reason == "" {
+				reason = aws.StringValue(target.TargetHealth.Reason)
+			}
+			reasons = append(reasons, reason)
+		}
+	}
+	failure.UnhealthyTargetReasons = countReasons(reasons)
+}
+
+// HumanString returns a concise description of the failure.
+func (f *StackFailure) HumanString() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s (%s) in stack %s is %s: %s\n", color.HighlightResource(f.LogicalID), f.ResourceType,
+		color.HighlightResource(f.StackName), f.Status, f.Reason)
+	for _, r := range f.StoppedTaskReasons {
+		fmt.Fprintf(&b, "  %s stopped: %s\n", pluralize(r.Count, "task", "tasks"), r.Reason)
+	}
+	for _, r := range f.UnhealthyTargetReasons {
+		fmt.Fprintf(&b, "  %s unhealthy: %s\n", pluralize(r.Count, "target is", "targets are"), r.Reason)
+	}
+	return b.String()
+}
+
+// RecommendedActions returns the actions to take to fix the failure.
+func (f *StackFailure) RecommendedActions() []string {
+	var actions []string
+	if f.InNestedStack {
+		actions = append(actions, fmt.Sprintf("Fix resource %s in the templates of the %s directory.",
+			color.HighlightResource(f.LogicalID), color.HighlightResource("addons")))
+	}
+	for _, r := range f.StoppedTaskReasons {
+		if strings.Contains(r.Reason, cannotPullContainerReason) {
+			actions = append(actions, "Make sure that the container image exists and that the task execution role can pull it.")
+			break
+		}
+	}
+	if len(f.StoppedTaskReasons) != 0 {
+		actions = append(actions, fmt.Sprintf("Run %s to see why the containers stopped.", color.HighlightCode("copilot svc logs")))
+	}
+	if len(f.UnhealthyTargetReasons) != 0 {
+		actions = append(actions, fmt.Sprintf("Make sure that the container responds to the %s path of the manifest with a 200 status.",
+			color.HighlightCode("http.healthcheck")))
+	}
+	actions = append(actions, fmt.Sprintf("Run %s to see all the events of the stack.",
+		color.HighlightCode(fmt.Sprintf("aws cloudformation describe-stack-events --stack-name %s", f.StackName))))
+	return actions
+}
+
+// lastOperation returns the events of the most recent operation on the stack, from oldest to newest.
+// It returns nil if the events don't contain the start of an operation.
+func lastOperation(events []cloudformation.StackEvent) []cloudformation.StackEvent {
+	for i := len(events) - 1; i >= 0; i-- {
+		event := events[i]
+		isStackEvent := aws.StringValue(event.LogicalResourceId) == aws.StringValue(event.StackName)
+		if isStackEvent && operationStartStatuses[aws.StringValue(event.ResourceStatus)] {
+			return events[i:]
+		}
+	}
+	return nil
+}
+
+// firstFailedEvent returns the first resource that failed among the events of an operation.
+// Resources whose operation was cancelled because of another failure are returned only if there is no other failure.
+func firstFailedEvent(events []cloudformation.StackEvent) *cloudformation.StackEvent {
+	var cancelled *cloudformation.StackEvent
+	for i := range events {
+		event := &events[i]
+		if aws.StringValue(event.LogicalResourceId) == aws.StringValue(event.StackName) {
+			continue
+		}
+		if !strings.HasSuffix(aws.StringValue(event.ResourceStatus), failedStatusSuffix) {
+			continue
+		}
+		reason := aws.StringValue(event.ResourceStatusReason)
+		if strings.HasPrefix(reason, cancelledReasonPrefix) || strings.HasPrefix(reason, cancelledUpdateReason) {
+			if cancelled == nil {
+				cancelled = event
+			}
+			continue
+		}
+		return event
+	}
+	return cancelled
+}
+
+// stoppedTaskReason returns why the task stopped, along with the reason or exit code of the container that stopped it.
+func stoppedTaskReason(task *ecs.Task) string {
+	reason := aws.StringValue(task.StoppedReason)
+	for _, container := range task.Containers {
+		if containerReason := aws.StringValue(container.Reason); containerReason != "" {
+			return fmt.Sprintf("%s: %s", reason, containerReason)
+		}
+		if exitCode := aws.Int64Value(container.ExitCode); exitCode != 0 {
+			return fmt.Sprintf("%s: container %s exited with code %d", reason, aws.StringValue(container.Name), exitCode)
+		}
+	}
+	return reason
+}
+
+// countReasons groups identical reasons together in the order they first occurred.
+func countReasons(reasons []string) []ReasonCount {
+	var counts []ReasonCount
+	index := make(map[string]int)
+	for _, reason := range reasons {
+		if i, ok := index[reason]; ok {
+			counts[i].Count++
+			continue
+		}
+		index[reason] = len(counts)
+		counts = append(counts, ReasonCount{Reason: reason, Count: 1})
+	}
+	return counts
+}
+
+func pluralize(count int, singular, plural string) string {
+	if count == 1 {
+		return fmt.Sprintf("1 %s", singular)
+	}
+	return fmt.Sprintf("%d %s", count, plural)
+}
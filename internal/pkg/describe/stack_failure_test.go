@@ -0,0 +1,319 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
+	elbv2api "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type stackFailureAnalyzerMocks struct {
+	cfn *mocks.MockstackEventsDescriber
+	ecs *mocks.MockecsStoppedTasksGetter
+	elb *mocks.MocktargetHealthGetter
+}
+
+func TestStackFailureAnalyzer_Analyze(t *testing.T) {
+	const (
+		mockStack       = "phonetool-test-api"
+		mockNestedStack = "arn:aws:cloudformation:us-west-2:1111:stack/phonetool-test-api-AddonsStack-1A2B/abcd"
+		mockServiceArn  = "arn:aws:ecs:us-west-2:1111:service/phonetool-test-Cluster/phonetool-test-api"
+		mockTargetGroup = "arn:aws:elasticloadbalancing:us-west-2:1111:targetgroup/api/1234"
+	)
+	start := time.Date(2020, 12, 3, 10, 0, 0, 0, time.UTC)
+	event := func(stackName, logicalID, physicalID, resourceType, status, reason string, at time.Time) cloudformation.StackEvent {
+		return cloudformation.StackEvent{
+			StackName:            aws.String(stackName),
+			LogicalResourceId:    aws.String(logicalID),
+			PhysicalResourceId:   aws.String(physicalID),
+			ResourceType:         aws.String(resourceType),
+			ResourceStatus:       aws.String(status),
+			ResourceStatusReason: aws.String(reason),
+			Timestamp:            aws.Time(at),
+		}
+	}
+	testCases := map[string]struct {
+		setupMocks func(m stackFailureAnalyzerMocks)
+
+		wantedFailure *StackFailure
+		wantedError   error
+	}{
+		"errors if failed to get the stack events": {
+			setupMocks: func(m stackFailureAnalyzerMocks) {
+				m.cfn.EXPECT().Events(mockStack).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"returns nil if the last operation succeeded": {
+			setupMocks: func(m stackFailureAnalyzerMocks) {
+				m.cfn.EXPECT().Events(mockStack).Return([]cloudformation.StackEvent{
+					event(mockStack, mockStack, "", cfnStackResourceType, "UPDATE_IN_PROGRESS", "User Initiated", start.Add(-time.Hour)),
+					event(mockStack, "Queue", "", "AWS::SQS::Queue", "UPDATE_FAILED", "Access denied", start.Add(-time.Hour)),
+					event(mockStack, mockStack, "", cfnStackResourceType, "UPDATE_ROLLBACK_COMPLETE", "", start.Add(-time.Hour)),
+					event(mockStack, mockStack, "", cfnStackResourceType, "UPDATE_IN_PROGRESS", "User Initiated", start),
+					event(mockStack, "Queue", "", "AWS::SQS::Queue", "UPDATE_COMPLETE", "", start),
+					event(mockStack, mockStack, "", cfnStackResourceType, "UPDATE_COMPLETE", "", start),
+				}, nil)
+			},
+		},
+		"returns nil if the stack was not deployed since the given time": {
+			setupMocks: func(m stackFailureAnalyzerMocks) {
+				m.cfn.EXPECT().Events(mockStack).Return([]cloudformation.StackEvent{
+					event(mockStack, mockStack, "", cfnStackResourceType, "UPDATE_IN_PROGRESS", "User Initiated", start.Add(-time.Hour)),
+					event(mockStack, "Queue", "", "AWS::SQS::Queue", "UPDATE_FAILED", "Access denied", start.Add(-time.Hour)),
+					event(mockStack, mockStack, "", cfnStackResourceType, "UPDATE_ROLLBACK_COMPLETE", "", start.Add(-time.Hour)),
+				}, nil)
+			},
+		},
+		"returns the first failure instead of the resources cancelled because of it": {
+			setupMocks: func(m stackFailureAnalyzerMocks) {
+				m.cfn.EXPECT().Events(mockStack).Return([]cloudformation.StackEvent{
+					event(mockStack, mockStack, "", cfnStackResourceType, "CREATE_IN_PROGRESS", "User Initiated", start),
+					event(mockStack, "Topic", "", "AWS::SNS::Topic", "CREATE_FAILED", "Resource creation cancelled", start),
+					event(mockStack, "Queue", "", "AWS::SQS::Queue", "CREATE_FAILED", "Access denied", start),
+					event(mockStack, mockStack, "", cfnStackResourceType, "ROLLBACK_IN_PROGRESS", "The following resource(s) failed to create: [Queue, Topic].", start),
+				}, nil)
+			},
+
+			wantedFailure: &StackFailure{
+				StackName:    mockStack,
+				LogicalID:    "Queue",
+				ResourceType: "AWS::SQS::Queue",
+				Status:       "CREATE_FAILED",
+				Reason:       "Access denied",
+			},
+		},
+		"returns the failure of the nested stack": {
+			setupMocks: func(m stackFailureAnalyzerMocks) {
+				m.cfn.EXPECT().Events(mockStack).Return([]cloudformation.StackEvent{
+					event(mockStack, mockStack, "", cfnStackResourceType, "UPDATE_IN_PROGRESS", "User Initiated", start),
+					event(mockStack, "AddonsStack", mockNestedStack, cfnStackResourceType, "UPDATE_FAILED", "Embedded stack was not successfully updated", start),
+				}, nil)
+				m.cfn.EXPECT().Events(mockNestedStack).Return([]cloudformation.StackEvent{
+					event("phonetool-test-api-AddonsStack-1A2B", "phonetool-test-api-AddonsStack-1A2B", mockNestedStack, cfnStackResourceType, "UPDATE_IN_PROGRESS", "", start),
+					event("phonetool-test-api-AddonsStack-1A2B", "MyTable", "my-table", "AWS::DynamoDB::Table", "UPDATE_FAILED", "Invalid KeySchema", start),
+				}, nil)
+			},
+
+			wantedFailure: &StackFailure{
+				StackName:     "phonetool-test-api-AddonsStack-1A2B",
+				InNestedStack: true,
+				LogicalID:     "MyTable",
+				PhysicalID:    "my-table",
+				ResourceType:  "AWS::DynamoDB::Table",
+				Status:        "UPDATE_FAILED",
+				Reason:        "Invalid KeySchema",
+			},
+		},
+		"correlates the stopped tasks and unhealthy targets of a failed service": {
+			setupMocks: func(m stackFailureAnalyzerMocks) {
+				m.cfn.EXPECT().Events(mockStack).Return([]cloudformation.StackEvent{
+					event(mockStack, mockStack, "", cfnStackResourceType, "UPDATE_IN_PROGRESS", "User Initiated", start),
+					event(mockStack, "Service", mockServiceArn, ecsServiceStackType, "UPDATE_FAILED", "Service arn:aws:ecs:us-west-2:1111:service/phonetool-test-Cluster/phonetool-test-api did not stabilize.", start.Add(time.Hour)),
+				}, nil)
+				m.ecs.EXPECT().StoppedServiceTasks("phonetool-test-Cluster", "phonetool-test-api").Return([]*ecs.Task{
+					{
+						StoppedReason: aws.String("Essential container in task exited"),
+						StoppedAt:     aws.Time(start.Add(time.Minute)),
+						Containers: []*ecsapi.Container{
+							{Name: aws.String("api"), ExitCode: aws.Int64(1)},
+						},
+					},
+					{
+						StoppedReason: aws.String("Essential container in task exited"),
+						StoppedAt:     aws.Time(start.Add(2 * time.Minute)),
+						Containers: []*ecsapi.Container{
+							{Name: aws.String("api"), ExitCode: aws.Int64(1)},
+						},
+					},
+					{
+						StoppedReason: aws.String("Scaling activity initiated by (deployment ecs-svc/123)"),
+						StoppedAt:     aws.Time(start.Add(time.Minute)),
+					},
+					{
+						StoppedReason: aws.String("Task failed ELB health checks"),
+						StoppedAt:     aws.Time(start.Add(-time.Hour)),
+					},
+				}, nil)
+				m.ecs.EXPECT().Service("phonetool-test-Cluster", "phonetool-test-api").Return(&ecs.Service{
+					LoadBalancers: []*ecsapi.LoadBalancer{
+						{TargetGroupArn: aws.String(mockTargetGroup)},
+					},
+				}, nil)
+				m.elb.EXPECT().TargetsHealth(mockTargetGroup).Return([]*elbv2.TargetHealth{
+					{
+						TargetHealth: &elbv2api.TargetHealth{
+							State:       aws.String(elbv2api.TargetHealthStateEnumUnhealthy),
+							Reason:      aws.String(elbv2api.TargetHealthReasonEnumTargetResponseCodeMismatch),
+							Description: aws.String("Health checks failed with these codes: [404]"),
+						},
+					},
+					{
+						TargetHealth: &elbv2api.TargetHealth{
+							State: aws.String(elbv2api.TargetHealthStateEnumHealthy),
+						},
+					},
+				}, nil)
+			},
+
+			wantedFailure: &StackFailure{
+				StackName:    mockStack,
+				LogicalID:    "Service",
+				PhysicalID:   mockServiceArn,
+				ResourceType: ecsServiceStackType,
+				Status:       "UPDATE_FAILED",
+				Reason:       "Service arn:aws:ecs:us-west-2:1111:service/phonetool-test-Cluster/phonetool-test-api did not stabilize.",
+				StoppedTaskReasons: []ReasonCount{
+					{Reason: "Essential container in task exited: container api exited with code 1", Count: 2},
+				},
+				UnhealthyTargetReasons: []ReasonCount{
+					{Reason: "Health checks failed with these codes: [404]", Count: 1},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := stackFailureAnalyzerMocks{
+				cfn: mocks.NewMockstackEventsDescriber(ctrl),
+				ecs: mocks.NewMockecsStoppedTasksGetter(ctrl),
+				elb: mocks.NewMocktargetHealthGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			analyzer := &StackFailureAnalyzer{
+				cfn: m.cfn,
+				ecs: m.ecs,
+				elb: m.elb,
+			}
+
+			// WHEN
+			failure, err := analyzer.Analyze(mockStack, start.Add(-time.Hour))
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedFailure, failure)
+			}
+		})
+	}
+}
+
+func TestStackFailureAnalyzer_LastOperationTime(t *testing.T) {
+	const mockStack = "phonetool-test-api"
+	created := time.Date(2020, 12, 3, 10, 0, 0, 0, time.UTC)
+	updated := created.Add(time.Hour)
+	testCases := map[string]struct {
+		setupMocks func(m stackFailureAnalyzerMocks)
+
+		wantedTime  time.Time
+		wantedError error
+	}{
+		"returns the zero time if the stack doesn't exist": {
+			setupMocks: func(m stackFailureAnalyzerMocks) {
+				m.cfn.EXPECT().Describe(mockStack).Return(nil, &cloudformation.ErrStackNotFound{})
+			},
+		},
+		"returns the error if fail to describe the stack": {
+			setupMocks: func(m stackFailureAnalyzerMocks) {
+				m.cfn.EXPECT().Describe(mockStack).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns the creation time of a stack that was never updated": {
+			setupMocks: func(m stackFailureAnalyzerMocks) {
+				m.cfn.EXPECT().Describe(mockStack).Return(&cloudformation.StackDescription{
+					CreationTime: aws.Time(created),
+				}, nil)
+			},
+			wantedTime: created,
+		},
+		"returns the time of the last update": {
+			setupMocks: func(m stackFailureAnalyzerMocks) {
+				m.cfn.EXPECT().Describe(mockStack).Return(&cloudformation.StackDescription{
+					CreationTime:    aws.Time(created),
+					LastUpdatedTime: aws.Time(updated),
+				}, nil)
+			},
+			wantedTime: updated,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := stackFailureAnalyzerMocks{
+				cfn: mocks.NewMockstackEventsDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+			analyzer := &StackFailureAnalyzer{
+				cfn: m.cfn,
+			}
+
+			// WHEN
+			got, err := analyzer.LastOperationTime(mockStack)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTime, got)
+			}
+		})
+	}
+}
+
+func TestStackFailure_HumanString(t *testing.T) {
+	// GIVEN
+	failure := &StackFailure{
+		StackName:    "phonetool-test-api",
+		LogicalID:    "Service",
+		ResourceType: "AWS::ECS::Service",
+		Status:       "UPDATE_FAILED",
+		Reason:       "Service did not stabilize.",
+		StoppedTaskReasons: []ReasonCount{
+			{Reason: "Essential container in task exited: container api exited with code 1", Count: 2},
+		},
+		UnhealthyTargetReasons: []ReasonCount{
+			{Reason: "Health checks failed with these codes: [404]", Count: 1},
+		},
+	}
+
+	// WHEN
+	human := failure.HumanString()
+	actions := failure.RecommendedActions()
+
+	// THEN
+	require.Equal(t, `Service (AWS::ECS::Service) in stack phonetool-test-api is UPDATE_FAILED: Service did not stabilize.
+  2 tasks stopped: Essential container in task exited: container api exited with code 1
+  1 target is unhealthy: Health checks failed with these codes: [404]
+`, human)
+	require.Equal(t, []string{
+		"Run `copilot svc logs` to see why the containers stopped.",
+		"Make sure that the container responds to the `http.healthcheck` path of the manifest with a 200 status.",
+		"Run `aws cloudformation describe-stack-events --stack-name phonetool-test-api` to see all the events of the stack.",
+	}, actions)
+}
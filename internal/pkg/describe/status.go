@@ -23,6 +23,9 @@ import (
 const (
 	ecsServiceResourceType    = "ecs:service"
 	maxAlarmStatusColumnWidth = 30
+
+	// servicePausedStatus replaces the status of the ECS service if it's paused with "copilot svc pause".
+	servicePausedStatus = "PAUSED"
 )
 
 type alarmStatusGetter interface {
@@ -53,6 +56,8 @@ type ServiceStatus struct {
 	cwSvc  alarmStatusGetter
 	aasSvc autoscalingAlarmNamesGetter
 	rgSvc  resourcesGetter
+
+	pausedSvcGetter pausedServiceGetter
 }
 
 // ServiceStatusDesc contains the status for a service.
@@ -60,6 +65,7 @@ type ServiceStatusDesc struct {
 	Service ecs.ServiceStatus
 	Tasks   []ecs.TaskStatus         `json:"tasks"`
 	Alarms  []cloudwatch.AlarmStatus `json:"alarms"`
+	Paused  bool                     `json:"paused,omitempty"`
 }
 
 // NewServiceStatusConfig contains fields that initiates ServiceStatus struct.
@@ -88,6 +94,8 @@ func NewServiceStatus(opt *NewServiceStatusConfig) (*ServiceStatus, error) {
 		cwSvc:  cloudwatch.New(sess),
 		ecsSvc: ecs.New(sess),
 		aasSvc: aas.New(sess),
+
+		pausedSvcGetter: opt.ConfigStore,
 	}, nil
 }
 
@@ -152,10 +160,15 @@ func (s *ServiceStatus) Describe() (*ServiceStatusDesc, error) {
 		return nil, err
 	}
 	alarms = append(alarms, autoscalingAlarms...)
+	paused, err := isServicePaused(s.pausedSvcGetter, s.app, s.env, s.svc)
+	if err != nil {
+		return nil, fmt.Errorf("check if service %s is paused: %w", s.svc, err)
+	}
 	return &ServiceStatusDesc{
 		Service: service.ServiceStatus(),
 		Tasks:   taskStatus,
 		Alarms:  alarms,
+		Paused:  paused,
 	}, nil
 }
 
@@ -186,7 +199,11 @@ func (s *ServiceStatusDesc) HumanString() string {
 	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, statusCellPaddingWidth, paddingChar, noAdditionalFormatting)
 	fmt.Fprint(writer, color.Bold.Sprint("Service Status\n\n"))
 	writer.Flush()
-	fmt.Fprintf(writer, "  %s %v / %v running tasks (%v pending)\n", statusColor(s.Service.Status),
+	status := s.Service.Status
+	if s.Paused {
+		status = servicePausedStatus
+	}
+	fmt.Fprintf(writer, "  %s %v / %v running tasks (%v pending)\n", statusColor(status),
 		s.Service.RunningCount, s.Service.DesiredCount, s.Service.DesiredCount-s.Service.RunningCount)
 	fmt.Fprint(writer, color.Bold.Sprint("\nLast Deployment\n\n"))
 	writer.Flush()
@@ -260,7 +277,7 @@ func statusColor(status string) string {
 	switch status {
 	case "ACTIVE":
 		return color.Green.Sprint(status)
-	case "DRAINING":
+	case "DRAINING", servicePausedStatus:
 		return color.Yellow.Sprint(status)
 	default:
 		return color.Red.Sprint(status)
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 
 	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
@@ -28,6 +29,7 @@ type serviceStatusMocks struct {
 	alarmStatusGetter *mocks.MockalarmStatusGetter
 	resourcesGetter   *mocks.MockresourcesGetter
 	aas               *mocks.MockautoscalingAlarmNamesGetter
+	pausedSvcGetter   *mocks.MockpausedServiceGetter
 }
 
 func TestServiceStatus_Describe(t *testing.T) {
@@ -188,6 +190,25 @@ func TestServiceStatus_Describe(t *testing.T) {
 
 			wantedError: fmt.Errorf("get auto scaling CloudWatch alarms: some error"),
 		},
+		"errors if failed to check if the service is paused": {
+			setupMocks: func(m serviceStatusMocks) {
+				gomock.InOrder(
+					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+						{
+							ARN: mockServiceArn,
+						},
+					}, nil),
+					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&ecs.Service{}, nil),
+					m.ecsServiceGetter.EXPECT().ServiceTasks(mockCluster, mockService).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return(nil, nil),
+					m.aas.EXPECT().ECSServiceAlarmNames(mockCluster, mockService).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmStatus(nil).Return(nil, nil),
+					m.pausedSvcGetter.EXPECT().GetPausedService("mockApp", "mockEnv", "mockSvc").Return(nil, mockError),
+				)
+			},
+
+			wantedError: fmt.Errorf("check if service mockSvc is paused: some error"),
+		},
 		"success": {
 			setupMocks: func(m serviceStatusMocks) {
 				gomock.InOrder(
@@ -252,6 +273,7 @@ func TestServiceStatus_Describe(t *testing.T) {
 							UpdatedTimes: updateTime,
 						},
 					}, nil),
+					m.pausedSvcGetter.EXPECT().GetPausedService("mockApp", "mockEnv", "mockSvc").Return(nil, &config.ErrServiceNotPaused{}),
 				)
 			},
 
@@ -314,11 +336,13 @@ func TestServiceStatus_Describe(t *testing.T) {
 			mockcwSvc := mocks.NewMockalarmStatusGetter(ctrl)
 			mockrgSvc := mocks.NewMockresourcesGetter(ctrl)
 			mockaasClient := mocks.NewMockautoscalingAlarmNamesGetter(ctrl)
+			mockPausedSvcGetter := mocks.NewMockpausedServiceGetter(ctrl)
 			mocks := serviceStatusMocks{
 				ecsServiceGetter:  mockecsSvc,
 				alarmStatusGetter: mockcwSvc,
 				resourcesGetter:   mockrgSvc,
 				aas:               mockaasClient,
+				pausedSvcGetter:   mockPausedSvcGetter,
 			}
 
 			tc.setupMocks(mocks)
@@ -331,6 +355,8 @@ func TestServiceStatus_Describe(t *testing.T) {
 				ecsSvc: mockecsSvc,
 				rgSvc:  mockrgSvc,
 				aasSvc: mockaasClient,
+
+				pausedSvcGetter: mockPausedSvcGetter,
 			}
 
 			// WHEN
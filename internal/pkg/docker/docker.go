@@ -102,6 +102,32 @@ func (r Runner) Push(uri, imageTag string, additionalTags ...string) error {
 	return nil
 }
 
+// Tag will run `docker tag` commands to tag the image built for the source URI with the target URI, for each input image tag.
+func (r Runner) Tag(sourceURI, targetURI, imageTag string, additionalTags ...string) error {
+	for _, imageTag := range append(additionalTags, imageTag) {
+		if err := r.TagImage(imageName(sourceURI, imageTag), imageName(targetURI, imageTag)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TagImage will run `docker tag` to tag the source image, such as "uri:tag" or "uri@sha256:digest", with the target image name.
+func (r Runner) TagImage(source, target string) error {
+	if err := r.Run("docker", []string{"tag", source, target}); err != nil {
+		return fmt.Errorf("docker tag %s %s: %w", source, target, err)
+	}
+	return nil
+}
+
+// Pull will run `docker pull` against the image, such as "uri:tag" or "uri@sha256:digest".
+func (r Runner) Pull(image string) error {
+	if err := r.Run("docker", []string{"pull", image}); err != nil {
+		return fmt.Errorf("docker pull %s: %w", image, err)
+	}
+	return nil
+}
+
 func imageName(uri, tag string) string {
 	return fmt.Sprintf("%s:%s", uri, tag)
 }
@@ -227,3 +227,97 @@ func TestPush(t *testing.T) {
 		})
 	}
 }
+
+func TestTag(t *testing.T) {
+	mockError := errors.New("mockError")
+
+	mockSourceURI := "sourceURI"
+	mockTargetURI := "targetURI"
+
+	mockTag1 := "tag1"
+	mockTag2 := "tag2"
+
+	var mockRunner *mocks.Mockrunner
+
+	tests := map[string]struct {
+		setupMocks func(controller *gomock.Controller)
+
+		want error
+	}{
+		"error running tag": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", []string{"tag", mockSourceURI + ":" + mockTag1, mockTargetURI + ":" + mockTag1}).Return(mockError)
+			},
+			want: fmt.Errorf("docker tag %s %s: %w", mockSourceURI+":"+mockTag1, mockTargetURI+":"+mockTag1, mockError),
+		},
+		"success": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", []string{"tag", mockSourceURI + ":" + mockTag1, mockTargetURI + ":" + mockTag1}).Return(nil)
+				mockRunner.EXPECT().Run("docker", []string{"tag", mockSourceURI + ":" + mockTag2, mockTargetURI + ":" + mockTag2}).Return(nil)
+			},
+			want: nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			test.setupMocks(controller)
+			s := Runner{
+				runner: mockRunner,
+			}
+
+			got := s.Tag(mockSourceURI, mockTargetURI, mockTag2, mockTag1)
+
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestPull(t *testing.T) {
+	mockError := errors.New("mockError")
+	mockImage := "mockURI@sha256:1234"
+
+	var mockRunner *mocks.Mockrunner
+
+	tests := map[string]struct {
+		setupMocks func(controller *gomock.Controller)
+
+		want error
+	}{
+		"error running pull": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", []string{"pull", mockImage}).Return(mockError)
+			},
+			want: fmt.Errorf("docker pull %s: %w", mockImage, mockError),
+		},
+		"success": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", []string{"pull", mockImage}).Return(nil)
+			},
+			want: nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			test.setupMocks(controller)
+			s := Runner{
+				runner: mockRunner,
+			}
+
+			got := s.Pull(mockImage)
+
+			require.Equal(t, test.want, got)
+		})
+	}
+}
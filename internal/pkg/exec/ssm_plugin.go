@@ -0,0 +1,80 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package exec provides an interface to the Session Manager plugin, which connects to the sessions
+// of the commands executed in running containers.
+package exec
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+)
+
+const (
+	ssmPluginBinaryName = "session-manager-plugin"
+	startSessionAction  = "StartSession"
+
+	ssmPluginInstallURL = "https://docs.aws.amazon.com/systems-manager/latest/userguide/session-manager-working-with-install-plugin.html"
+)
+
+// ErrSSMPluginNotExist means the Session Manager plugin is not installed.
+var ErrSSMPluginNotExist = fmt.Errorf("%s is not installed, see %s to install it", ssmPluginBinaryName, ssmPluginInstallURL)
+
+type runner interface {
+	Run(name string, args []string, options ...command.Option) error
+}
+
+// SSMPluginCommand represents the commands of the Session Manager plugin.
+type SSMPluginCommand struct {
+	runner
+	region   string
+	lookPath func(file string) (string, error)
+}
+
+// NewSSMPluginCommand returns a SSMPluginCommand that connects to sessions in the region.
+func NewSSMPluginCommand(region string) SSMPluginCommand {
+	return SSMPluginCommand{
+		runner:   command.New(),
+		region:   region,
+		lookPath: exec.LookPath,
+	}
+}
+
+// ValidateInstalled returns ErrSSMPluginNotExist if the Session Manager plugin is not installed.
+// Call it before executing a command so that no session is opened that the plugin can't connect to.
+func (s SSMPluginCommand) ValidateInstalled() error {
+	if _, err := s.lookPath(ssmPluginBinaryName); err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return ErrSSMPluginNotExist
+		}
+		return fmt.Errorf("look up %s: %w", ssmPluginBinaryName, err)
+	}
+	return nil
+}
+
+// StartSession connects the terminal to the session until the executed command exits.
+func (s SSMPluginCommand) StartSession(sess *ecs.Session) error {
+	if err := s.ValidateInstalled(); err != nil {
+		return err
+	}
+	response, err := json.Marshal(sess)
+	if err != nil {
+		return fmt.Errorf("marshal session: %w", err)
+	}
+	// Interrupts are forwarded to the command by the plugin instead of stopping the session.
+	signal.Ignore(os.Interrupt)
+	defer signal.Reset(os.Interrupt)
+	if err := s.Run(ssmPluginBinaryName, []string{string(response), s.region, startSessionAction},
+		command.Stdin(os.Stdin), command.Stdout(os.Stdout), command.Stderr(os.Stderr)); err != nil {
+		return fmt.Errorf("start session %s: %w", aws.StringValue(sess.SessionId), err)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package exec
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/exec/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSSMPluginCommand_StartSession(t *testing.T) {
+	mockError := errors.New("some error")
+	mockSession := &ecs.Session{
+		SessionId:  aws.String("mockSessionID"),
+		StreamUrl:  aws.String("mockStreamURL"),
+		TokenValue: aws.String("mockToken"),
+	}
+	foundPlugin := func(string) (string, error) {
+		return "/usr/local/bin/session-manager-plugin", nil
+	}
+
+	testCases := map[string]struct {
+		lookPath   func(string) (string, error)
+		setupMocks func(m *mocks.Mockrunner)
+
+		wantedError error
+	}{
+		"errors if the plugin is not installed": {
+			lookPath: func(string) (string, error) {
+				return "", &exec.Error{Name: "session-manager-plugin", Err: exec.ErrNotFound}
+			},
+			setupMocks: func(m *mocks.Mockrunner) {},
+
+			wantedError: ErrSSMPluginNotExist,
+		},
+		"errors if the session fails": {
+			lookPath: foundPlugin,
+			setupMocks: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("session-manager-plugin", gomock.Any(), gomock.Any()).Return(mockError)
+			},
+
+			wantedError: fmt.Errorf("start session mockSessionID: %w", mockError),
+		},
+		"starts the session in the region": {
+			lookPath: foundPlugin,
+			setupMocks: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("session-manager-plugin", []string{
+					`{"SessionId":"mockSessionID","StreamUrl":"mockStreamURL","TokenValue":"mockToken"}`,
+					"us-west-2",
+					"StartSession",
+				}, gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRunner := mocks.NewMockrunner(ctrl)
+			tc.setupMocks(mockRunner)
+			s := SSMPluginCommand{
+				runner:   mockRunner,
+				region:   "us-west-2",
+				lookPath: tc.lookPath,
+			}
+
+			// WHEN
+			err := s.StartSession(mockSession)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSSMPluginCommand_ValidateInstalled(t *testing.T) {
+	testCases := map[string]struct {
+		lookPath func(string) (string, error)
+
+		wantedError error
+	}{
+		"errors if the plugin is not installed": {
+			lookPath: func(string) (string, error) {
+				return "", &exec.Error{Name: "session-manager-plugin", Err: exec.ErrNotFound}
+			},
+
+			wantedError: ErrSSMPluginNotExist,
+		},
+		"wraps other errors from looking up the plugin": {
+			lookPath: func(string) (string, error) {
+				return "", errors.New("some error")
+			},
+
+			wantedError: errors.New("look up session-manager-plugin: some error"),
+		},
+		"succeeds if the plugin is installed": {
+			lookPath: func(string) (string, error) {
+				return "/usr/local/bin/session-manager-plugin", nil
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			s := SSMPluginCommand{
+				lookPath: tc.lookPath,
+			}
+
+			// WHEN
+			err := s.ValidateInstalled()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
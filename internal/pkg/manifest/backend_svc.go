@@ -27,6 +27,7 @@ type BackendServiceProps struct {
 type BackendService struct {
 	Workload             `yaml:",inline"`
 	BackendServiceConfig `yaml:",inline"`
+	DependsOn            []string `yaml:"depends_on"` // Services to deploy before this one, in every environment.
 	// Use *BackendServiceConfig because of https://github.com/imdario/mergo/issues/146
 	Environments map[string]*BackendServiceConfig `yaml:",flow"`
 
@@ -39,6 +40,7 @@ type BackendServiceConfig struct {
 	TaskConfig  `yaml:",inline"`
 	*Logging    `yaml:"logging,flow"`
 	Sidecar     `yaml:",inline"`
+	Exec        *bool `yaml:"exec"` // Allows "copilot svc exec" to run commands in the containers of the service.
 }
 
 // LogConfigOpts converts the service's Firelens configuration into a format parsable by the templates pkg.
@@ -118,6 +120,7 @@ func (s BackendService) ApplyEnv(envName string) (*BackendService, error) {
 	// Apply overrides to the original service s.
 	err := mergo.Merge(&s, BackendService{
 		BackendServiceConfig: *overrideConfig,
+		DependsOn:            s.DependsOn, // Dependencies can't be overridden, keep them.
 	}, mergo.WithOverride, mergo.WithOverwriteWithEmptyValue)
 	if err != nil {
 		return nil, err
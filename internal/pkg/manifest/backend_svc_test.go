@@ -208,6 +208,7 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 				},
 			},
 		},
+		DependsOn: []string{"db"},
 		Environments: map[string]*BackendServiceConfig{
 			"test": {
 				ImageConfig: imageWithPortAndHealthcheck{
@@ -302,6 +303,7 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 						},
 					},
 				},
+				DependsOn: []string{"db"},
 			},
 			original: &mockBackendServiceWithMinimalOverride,
 		},
@@ -4,9 +4,13 @@
 package manifest
 
 import (
+	"errors"
 	"fmt"
 )
 
+// ErrPipelineManifestUpToDate occurs when upgrading a pipeline manifest that already uses the latest schema version.
+var ErrPipelineManifestUpToDate = errors.New("pipeline manifest already uses the latest schema version")
+
 // ErrInvalidWorkloadType occurs when a user requested a manifest template type that doesn't exist.
 type ErrInvalidWorkloadType struct {
 	Type string
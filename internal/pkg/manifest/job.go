@@ -13,6 +13,9 @@ import (
 const (
 	// ScheduledJobType is a recurring ECS Fargate task which runs on a schedule.
 	ScheduledJobType = "Scheduled Job"
+
+	// DefaultJobTimezone is the time zone in which schedules are evaluated when the manifest doesn't set one.
+	DefaultJobTimezone = "UTC"
 )
 
 const (
@@ -42,11 +45,31 @@ type ScheduledJobConfig struct {
 	Sidecar                 `yaml:",inline"`
 	On                      JobTriggerConfig `yaml:"on,flow"`
 	JobFailureHandlerConfig `yaml:",inline"`
+	Steps                   []JobStep `yaml:"steps"`
 }
 
 // JobTriggerConfig represents the configuration for the event that triggers the job.
 type JobTriggerConfig struct {
-	Schedule string `yaml:"schedule"`
+	Schedule  string   `yaml:"schedule"`
+	Schedules []string `yaml:"schedules"`
+	Timezone  string   `yaml:"timezone"` // IANA time zone name, such as "Europe/Berlin", used to evaluate cron schedules.
+}
+
+// JobStep represents a container that runs as one step of a multi-step job.
+// Steps run once all the steps they depend on have completed, and steps without
+// dependencies between each other run in parallel.
+type JobStep struct {
+	Name      string   `yaml:"name"`
+	Image     *string  `yaml:"image"` // Location of an existing image. Defaults to the job's image.
+	Command   []string `yaml:"command"`
+	CPU       *int     `yaml:"cpu"`    // Defaults to the job's cpu.
+	Memory    *int     `yaml:"memory"` // Defaults to the job's memory.
+	DependsOn []string `yaml:"depends_on"`
+	Retries   *int     `yaml:"retries"` // Defaults to the job's retries.
+	// Output is true if the step reports an output by calling the Step Functions SendTaskSuccess API
+	// with the token in the COPILOT_TASK_TOKEN environment variable. The step fails if its task stops
+	// without reporting an output or if the job's timeout elapses first.
+	Output bool `yaml:"output"`
 }
 
 // JobFailureHandlerConfig represents the error handling configuration for the job.
@@ -59,6 +82,7 @@ type JobFailureHandlerConfig struct {
 type ScheduledJobProps struct {
 	*WorkloadProps
 	Schedule string
+	Timezone string
 	Timeout  string
 	Retries  int
 }
@@ -98,6 +122,9 @@ func NewScheduledJob(props *ScheduledJobProps) *ScheduledJob {
 	job.ScheduledJobConfig.ImageConfig.Build.BuildArgs.Dockerfile = stringP(props.Dockerfile)
 	job.ScheduledJobConfig.ImageConfig.Location = stringP(props.Image)
 	job.On.Schedule = props.Schedule
+	if props.Timezone != DefaultJobTimezone {
+		job.On.Timezone = props.Timezone
+	}
 	job.Retries = props.Retries
 	job.Timeout = props.Timeout
 
@@ -82,3 +82,36 @@ func TestScheduledJob_MarshalBinary(t *testing.T) {
 		})
 	}
 }
+
+func TestNewScheduledJob_Timezone(t *testing.T) {
+	testCases := map[string]struct {
+		inTimezone string
+
+		wantedTimezone string
+	}{
+		"omits the default time zone": {
+			inTimezone:     "UTC",
+			wantedTimezone: "",
+		},
+		"keeps other time zones": {
+			inTimezone:     "Europe/Berlin",
+			wantedTimezone: "Europe/Berlin",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			job := NewScheduledJob(&ScheduledJobProps{
+				WorkloadProps: &WorkloadProps{
+					Name: "cuteness-aggregator",
+				},
+				Schedule: "0 9 * * MON-FRI",
+				Timezone: tc.inTimezone,
+			})
+
+			// THEN
+			require.Equal(t, tc.wantedTimezone, job.On.Timezone)
+		})
+	}
+}
@@ -23,6 +23,7 @@ const (
 type LoadBalancedWebService struct {
 	Workload                     `yaml:",inline"`
 	LoadBalancedWebServiceConfig `yaml:",inline"`
+	DependsOn                    []string `yaml:"depends_on"` // Services to deploy before this one, in every environment.
 	// Use *LoadBalancedWebServiceConfig because of https://github.com/imdario/mergo/issues/146
 	Environments map[string]*LoadBalancedWebServiceConfig `yaml:",flow"` // Fields to override per environment.
 
@@ -36,6 +37,7 @@ type LoadBalancedWebServiceConfig struct {
 	TaskConfig  `yaml:",inline"`
 	*Logging    `yaml:"logging,flow"`
 	Sidecar     `yaml:",inline"`
+	Exec        *bool `yaml:"exec"` // Allows "copilot svc exec" to run commands in the containers of the service.
 }
 
 // LogConfigOpts converts the service's Firelens configuration into a format parsable by the templates pkg.
@@ -134,6 +136,7 @@ func (s LoadBalancedWebService) ApplyEnv(envName string) (*LoadBalancedWebServic
 	// Apply overrides to the original service s.
 	err := mergo.Merge(&s, LoadBalancedWebService{
 		LoadBalancedWebServiceConfig: *overrideConfig,
+		DependsOn:                    s.DependsOn, // Dependencies can't be overridden, keep them.
 	}, mergo.WithOverride, mergo.WithOverwriteWithEmptyValue)
 	if err != nil {
 		return nil, err
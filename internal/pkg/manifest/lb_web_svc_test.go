@@ -308,6 +308,42 @@ func TestLoadBalancedWebService_ApplyEnv(t *testing.T) {
 				},
 			},
 		},
+		"with exec override": {
+			in: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					Exec: aws.Bool(true),
+				},
+				Environments: map[string]*LoadBalancedWebServiceConfig{
+					"prod-iad": {
+						Exec: aws.Bool(false),
+					},
+				},
+			},
+			envToApply: "prod-iad",
+
+			wanted: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					Exec: aws.Bool(false),
+				},
+			},
+		},
+		"with exec not overridden": {
+			in: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					Exec: aws.Bool(true),
+				},
+				Environments: map[string]*LoadBalancedWebServiceConfig{
+					"prod-iad": {},
+				},
+			},
+			envToApply: "prod-iad",
+
+			wanted: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					Exec: aws.Bool(true),
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -6,6 +6,7 @@ package manifest
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/fatih/structs"
@@ -16,6 +17,14 @@ const (
 	GithubProviderName    = "GitHub"
 	GithubSecretIdKeyName = "access_token_secret"
 
+	CodeCommitProviderName       = "CodeCommit"
+	BitbucketProviderName        = "Bitbucket"
+	GitHubEnterpriseProviderName = "GitHubEnterpriseServer"
+
+	// ConnectionARNKeyName is the name of the property that holds the ARN of an existing
+	// AWS CodeStar connection to the repository.
+	ConnectionARNKeyName = "connection_arn"
+
 	pipelineManifestPath = "cicd/pipeline.yml"
 )
 
@@ -43,6 +52,54 @@ func (p *githubProvider) Properties() map[string]interface{} {
 	return structs.Map(p.properties)
 }
 
+type codecommitProvider struct {
+	properties *CodeCommitProperties
+}
+
+func (p *codecommitProvider) Name() string {
+	return CodeCommitProviderName
+}
+
+func (p *codecommitProvider) String() string {
+	return CodeCommitProviderName
+}
+
+func (p *codecommitProvider) Properties() map[string]interface{} {
+	return structs.Map(p.properties)
+}
+
+type bitbucketProvider struct {
+	properties *BitbucketProperties
+}
+
+func (p *bitbucketProvider) Name() string {
+	return BitbucketProviderName
+}
+
+func (p *bitbucketProvider) String() string {
+	return BitbucketProviderName
+}
+
+func (p *bitbucketProvider) Properties() map[string]interface{} {
+	return structs.Map(p.properties)
+}
+
+type githubEnterpriseProvider struct {
+	properties *GitHubEnterpriseProperties
+}
+
+func (p *githubEnterpriseProvider) Name() string {
+	return GitHubEnterpriseProviderName
+}
+
+func (p *githubEnterpriseProvider) String() string {
+	return GitHubEnterpriseProviderName
+}
+
+func (p *githubEnterpriseProvider) Properties() map[string]interface{} {
+	return structs.Map(p.properties)
+}
+
 // GitHubProperties contain information for configuring a Github
 // source provider.
 type GitHubProperties struct {
@@ -50,9 +107,38 @@ type GitHubProperties struct {
 	// to specify the name of the field in the output properties
 
 	// An example for OwnerAndRepository would be: "aws/copilot"
-	OwnerAndRepository    string `structs:"repository" yaml:"repository"`
-	Branch                string `structs:"branch" yaml:"branch"`
-	GithubSecretIdKeyName string `structs:"access_token_secret" yaml:"access_token_secret"`
+	OwnerAndRepository string `structs:"repository" yaml:"repository"`
+	Branch             string `structs:"branch" yaml:"branch"`
+	// GithubSecretIdKeyName is the name of the secret that stores the personal access token.
+	// If it is empty, the repository is accessed through an AWS CodeStar connection with the GitHub App.
+	GithubSecretIdKeyName string `structs:"access_token_secret,omitempty" yaml:"access_token_secret,omitempty"`
+	ConnectionARN         string `structs:"connection_arn,omitempty" yaml:"connection_arn,omitempty"`
+}
+
+// CodeCommitProperties contain information for configuring a CodeCommit
+// source provider.
+type CodeCommitProperties struct {
+	// An example for Repository would be: "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/my-repo"
+	Repository string `structs:"repository" yaml:"repository"`
+	Branch     string `structs:"branch" yaml:"branch"`
+}
+
+// BitbucketProperties contain information for configuring a Bitbucket
+// source provider. The repository is accessed through an AWS CodeStar connection.
+type BitbucketProperties struct {
+	// An example for Repository would be: "https://bitbucket.org/myCompany/myRepo"
+	Repository    string `structs:"repository" yaml:"repository"`
+	Branch        string `structs:"branch" yaml:"branch"`
+	ConnectionARN string `structs:"connection_arn,omitempty" yaml:"connection_arn,omitempty"`
+}
+
+// GitHubEnterpriseProperties contain information for configuring a GitHub Enterprise Server
+// source provider. The repository is accessed through an existing AWS CodeStar connection.
+type GitHubEnterpriseProperties struct {
+	// An example for Repository would be: "https://github.mycompany.com/myTeam/myRepo"
+	Repository    string `structs:"repository" yaml:"repository"`
+	Branch        string `structs:"branch" yaml:"branch"`
+	ConnectionARN string `structs:"connection_arn" yaml:"connection_arn"`
 }
 
 // NewProvider creates a source provider based on the type of
@@ -63,6 +149,18 @@ func NewProvider(configs interface{}) (Provider, error) {
 		return &githubProvider{
 			properties: props,
 		}, nil
+	case *CodeCommitProperties:
+		return &codecommitProvider{
+			properties: props,
+		}, nil
+	case *BitbucketProperties:
+		return &bitbucketProvider{
+			properties: props,
+		}, nil
+	case *GitHubEnterpriseProperties:
+		return &githubEnterpriseProvider{
+			properties: props,
+		}, nil
 	default:
 		return nil, &ErrUnknownProvider{unknownProviderProperties: props}
 	}
@@ -73,8 +171,11 @@ func NewProvider(configs interface{}) (Provider, error) {
 type PipelineSchemaMajorVersion int
 
 const (
-	// Ver1 is the current schema major version of the pipeline.yml file.
+	// Ver1 is the first schema major version of the pipeline.yml file.
 	Ver1 PipelineSchemaMajorVersion = iota + 1
+	// Ver2 is the current schema major version of the pipeline.yml file.
+	// It replaces the requires_approval, services and jobs fields of a stage with approval and deployments.
+	Ver2
 )
 
 // PipelineManifest contains information that defines the relationship
@@ -85,6 +186,10 @@ type PipelineManifest struct {
 	Version PipelineSchemaMajorVersion `yaml:"version"`
 	Source  *Source                    `yaml:"source"`
 	Stages  []PipelineStage            `yaml:"stages"`
+	// Build configures the CodeBuild project of the build stage.
+	Build *PipelineBuild `yaml:"build,omitempty"`
+	// Notifications configures who is notified of the events of the pipeline.
+	Notifications *PipelineNotifications `yaml:"notifications,omitempty"`
 
 	parser template.Parser
 }
@@ -95,11 +200,77 @@ type Source struct {
 	Properties   map[string]interface{} `yaml:"properties"`
 }
 
+// PipelineBuild represents the environment of the CodeBuild project that builds the workloads of the pipeline.
+type PipelineBuild struct {
+	// Image is the Docker image of the build environment, such as "aws/codebuild/standard:5.0"
+	// or the URI of an image in an ECR repository.
+	Image       string `yaml:"image,omitempty"`
+	ComputeSize string `yaml:"compute_size,omitempty"` // One of "small", "medium", "large" or "2xlarge".
+	// Privileged grants the build access to the Docker daemon, it's required to build container images.
+	Privileged *bool `yaml:"privileged,omitempty"`
+	// Buildspec is the path to the buildspec, relative to the root of the source repository.
+	Buildspec string            `yaml:"buildspec,omitempty"`
+	Variables map[string]string `yaml:"variables,omitempty"`
+	Timeout   *time.Duration    `yaml:"timeout,omitempty"`
+}
+
 // PipelineStage represents a stage in the pipeline manifest
 type PipelineStage struct {
-	Name             string   `yaml:"name"`
+	Name         string   `yaml:"name"`
+	TestCommands []string `yaml:"test_commands,omitempty"`
+	// Approval configures the manual approval of the stage.
+	Approval *PipelineApproval `yaml:"approval,omitempty"`
+	// Deployments select the workloads deployed in the stage, keyed by name.
+	// If the field is omitted, all the services and jobs in the workspace are deployed.
+	Deployments map[string]*PipelineDeployment `yaml:"deployments,omitempty"`
+	// PreDeployments run before the workloads of the stage are deployed, and PostDeployments run after.
+	PreDeployments  []PipelineAction `yaml:"pre_deployments,omitempty"`
+	PostDeployments []PipelineAction `yaml:"post_deployments,omitempty"`
+
+	// RequiresApproval, Services and Jobs are the Ver1 equivalents of Approval and Deployments.
+	// If Services or Jobs is omitted, all the services or jobs in the workspace are deployed.
 	RequiresApproval bool     `yaml:"requires_approval,omitempty"`
-	TestCommands     []string `yaml:"test_commands,omitempty"`
+	Services         []string `yaml:"services,omitempty"`
+	Jobs             []string `yaml:"jobs,omitempty"`
+}
+
+// PipelineApproval represents the manual approval that runs before the workloads of a stage are deployed.
+type PipelineApproval struct {
+	Required bool `yaml:"required"`
+	// Message is shown to the reviewers along with the URLs of the services deployed in the previous stage.
+	Message string `yaml:"message,omitempty"`
+	// ReviewURL is the link that reviewers follow to validate the changes.
+	// It defaults to the URL of a service deployed in the previous stage.
+	ReviewURL string `yaml:"review_url,omitempty"`
+}
+
+// PipelineNotifications represents the targets notified of the events of the pipeline.
+// Each target is either an email address or the ARN of an SNS topic.
+type PipelineNotifications struct {
+	ApprovalNeeded []string `yaml:"approval_needed,omitempty"`
+	Failed         []string `yaml:"failed,omitempty"`
+	Succeeded      []string `yaml:"succeeded,omitempty"`
+}
+
+// PipelineDeployment represents the deployment of a workload in a stage.
+type PipelineDeployment struct {
+	// DependsOn lists the workloads of the stage that must be deployed before this one.
+	DependsOn []string `yaml:"depends_on,omitempty"`
+}
+
+// ApprovalRequired returns true if the stage must be manually approved before its workloads are deployed.
+func (s PipelineStage) ApprovalRequired() bool {
+	if s.Approval != nil {
+		return s.Approval.Required
+	}
+	return s.RequiresApproval
+}
+
+// PipelineAction represents a CodeBuild action that runs in a stage of the pipeline.
+type PipelineAction struct {
+	Name        string `yaml:"name"`
+	Buildspec   string `yaml:"buildspec"`
+	ComputeSize string `yaml:"compute_size,omitempty"` // One of "small", "medium", "large" or "2xlarge".
 }
 
 // NewPipelineManifest returns a pipeline manifest object.
@@ -112,7 +283,7 @@ func NewPipelineManifest(pipelineName string, provider Provider, stages []Pipeli
 
 	return &PipelineManifest{
 		Name:    pipelineName,
-		Version: Ver1,
+		Version: Ver2,
 		Source: &Source{
 			ProviderName: provider.Name(),
 			Properties:   provider.Properties(),
@@ -147,20 +318,52 @@ func UnmarshalPipeline(in []byte) (*PipelineManifest, error) {
 	if version, err = validateVersion(&pm); err != nil {
 		return nil, err
 	}
+	if err := validateSource(pm.Source); err != nil {
+		return nil, err
+	}
 
 	// TODO: #221 Do more validations
 	switch version {
 	case Ver1:
+		if pm.Build != nil {
+			return nil, fmt.Errorf("field build requires version %d of the pipeline manifest, run `copilot pipeline upgrade-manifest` to upgrade it", Ver2)
+		}
+		if pm.Notifications != nil {
+			return nil, fmt.Errorf("field notifications requires version %d of the pipeline manifest, run `copilot pipeline upgrade-manifest` to upgrade it", Ver2)
+		}
+		for _, stage := range pm.Stages {
+			if stage.Approval != nil || stage.Deployments != nil {
+				return nil, fmt.Errorf("stage %s: fields approval and deployments require version %d of the pipeline manifest, run `copilot pipeline upgrade-manifest` to upgrade it", stage.Name, Ver2)
+			}
+		}
+		return &pm, nil
+	case Ver2:
+		for _, stage := range pm.Stages {
+			if stage.RequiresApproval || stage.Services != nil || stage.Jobs != nil {
+				return nil, fmt.Errorf("stage %s: fields requires_approval, services and jobs are replaced by approval and deployments in version %d of the pipeline manifest", stage.Name, Ver2)
+			}
+		}
 		return &pm, nil
 	}
 	// we should never reach here, this is just to make the compiler happy
 	return nil, errors.New("unexpected error occurs while unmarshalling pipeline.yml")
 }
 
+func validateSource(src *Source) error {
+	if src == nil || src.ProviderName != GitHubEnterpriseProviderName {
+		return nil
+	}
+	// The pipeline can't create a connection to a GitHub Enterprise Server: it requires the host that is set up in the console.
+	if arn, _ := src.Properties[ConnectionARNKeyName].(string); arn == "" {
+		return fmt.Errorf("source provider %s requires the property %s", GitHubEnterpriseProviderName, ConnectionARNKeyName)
+	}
+	return nil
+}
+
 func validateVersion(pm *PipelineManifest) (PipelineSchemaMajorVersion, error) {
 	switch pm.Version {
-	case Ver1:
-		return Ver1, nil
+	case Ver1, Ver2:
+		return pm.Version, nil
 	default:
 		return pm.Version,
 			&ErrInvalidPipelineManifestVersion{
@@ -8,7 +8,9 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
 	"github.com/fatih/structs"
@@ -24,24 +26,88 @@ const (
 func TestNewProvider(t *testing.T) {
 	testCases := map[string]struct {
 		providerConfig interface{}
-		expectedErr    error
+
+		expectedName       string
+		expectedProperties map[string]interface{}
+		expectedErr        error
 	}{
 		"successfully create GitHub provider": {
+			providerConfig: &GitHubProperties{
+				OwnerAndRepository:    "aws/amazon-ecs-cli-v2",
+				Branch:                defaultBranch,
+				GithubSecretIdKeyName: "github-token",
+			},
+			expectedName: GithubProviderName,
+			expectedProperties: map[string]interface{}{
+				"repository":          "aws/amazon-ecs-cli-v2",
+				"branch":              defaultBranch,
+				"access_token_secret": "github-token",
+			},
+		},
+		"successfully create GitHub provider with a connection": {
 			providerConfig: &GitHubProperties{
 				OwnerAndRepository: "aws/amazon-ecs-cli-v2",
 				Branch:             defaultBranch,
 			},
+			expectedName: GithubProviderName,
+			expectedProperties: map[string]interface{}{
+				"repository": "aws/amazon-ecs-cli-v2",
+				"branch":     defaultBranch,
+			},
+		},
+		"successfully create CodeCommit provider": {
+			providerConfig: &CodeCommitProperties{
+				Repository: "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/wings",
+				Branch:     defaultBranch,
+			},
+			expectedName: CodeCommitProviderName,
+			expectedProperties: map[string]interface{}{
+				"repository": "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/wings",
+				"branch":     defaultBranch,
+			},
+		},
+		"successfully create Bitbucket provider": {
+			providerConfig: &BitbucketProperties{
+				Repository:    "https://bitbucket.org/huanjani/aws-copilot-sample-service",
+				Branch:        defaultBranch,
+				ConnectionARN: "arn:aws:codestar-connections:us-west-2:1234:connection/abcd",
+			},
+			expectedName: BitbucketProviderName,
+			expectedProperties: map[string]interface{}{
+				"repository":     "https://bitbucket.org/huanjani/aws-copilot-sample-service",
+				"branch":         defaultBranch,
+				"connection_arn": "arn:aws:codestar-connections:us-west-2:1234:connection/abcd",
+			},
+		},
+		"successfully create GitHub Enterprise Server provider": {
+			providerConfig: &GitHubEnterpriseProperties{
+				Repository:    "https://github.mycompany.com/goose/wings",
+				Branch:        defaultBranch,
+				ConnectionARN: "arn:aws:codestar-connections:us-west-2:1234:connection/abcd",
+			},
+			expectedName: GitHubEnterpriseProviderName,
+			expectedProperties: map[string]interface{}{
+				"repository":     "https://github.mycompany.com/goose/wings",
+				"branch":         defaultBranch,
+				"connection_arn": "arn:aws:codestar-connections:us-west-2:1234:connection/abcd",
+			},
+		},
+		"errors out with unknown properties": {
+			providerConfig: "gitlab",
+			expectedErr:    &ErrUnknownProvider{unknownProviderProperties: "gitlab"},
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			_, err := NewProvider(tc.providerConfig)
+			p, err := NewProvider(tc.providerConfig)
 
 			if tc.expectedErr != nil {
 				require.EqualError(t, err, tc.expectedErr.Error())
 			} else {
 				require.NoError(t, err, "unexpected error while calling NewProvider()")
+				require.Equal(t, tc.expectedName, p.Name())
+				require.Equal(t, tc.expectedProperties, p.Properties())
 			}
 		})
 	}
@@ -81,17 +147,16 @@ func TestNewPipelineManifest(t *testing.T) {
 			}(),
 			inputStages: []PipelineStage{
 				{
-					Name:             "chicken",
-					RequiresApproval: false,
+					Name: "chicken",
 				},
 				{
-					Name:             "wings",
-					RequiresApproval: true,
+					Name:     "wings",
+					Approval: &PipelineApproval{Required: true},
 				},
 			},
 			expectedManifest: &PipelineManifest{
 				Name:    "pipepiper",
-				Version: Ver1,
+				Version: Ver2,
 				Source: &Source{
 					ProviderName: "GitHub",
 					Properties: structs.Map(GitHubProperties{
@@ -101,12 +166,11 @@ func TestNewPipelineManifest(t *testing.T) {
 				},
 				Stages: []PipelineStage{
 					{
-						Name:             "chicken",
-						RequiresApproval: false,
+						Name: "chicken",
 					},
 					{
-						Name:             "wings",
-						RequiresApproval: true,
+						Name:     "wings",
+						Approval: &PipelineApproval{Required: true},
 					},
 				},
 			},
@@ -254,6 +318,183 @@ stages:
 				},
 			},
 		},
+		"valid version 2 pipeline.yml": {
+			inContent: `
+name: pipepiper
+version: 2
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: test
+      deployments:
+        api:
+        frontend:
+          depends_on: [api]
+    -
+      name: prod
+      approval:
+        required: true
+        message: Check the dashboards.
+        review_url: https://dashboards.example.com
+
+build:
+  image: aws/codebuild/standard:5.0
+  compute_size: large
+  privileged: false
+  buildspec: ci/buildspec.yml
+  variables:
+    GOFLAGS: -mod=vendor
+  timeout: 90m
+
+notifications:
+  approval_needed: [reviewers@example.com]
+  failed: [arn:aws:sns:us-west-2:123456789012:oncall]
+`,
+			expectedManifest: &PipelineManifest{
+				Name:    "pipepiper",
+				Version: Ver2,
+				Source: &Source{
+					ProviderName: "GitHub",
+					Properties: map[string]interface{}{
+						"repository": "aws/somethingCool",
+						"branch":     defaultBranch,
+					},
+				},
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						Deployments: map[string]*PipelineDeployment{
+							"api": nil,
+							"frontend": {
+								DependsOn: []string{"api"},
+							},
+						},
+					},
+					{
+						Name: "prod",
+						Approval: &PipelineApproval{
+							Required:  true,
+							Message:   "Check the dashboards.",
+							ReviewURL: "https://dashboards.example.com",
+						},
+					},
+				},
+				Build: &PipelineBuild{
+					Image:       "aws/codebuild/standard:5.0",
+					ComputeSize: "large",
+					Privileged:  aws.Bool(false),
+					Buildspec:   "ci/buildspec.yml",
+					Variables: map[string]string{
+						"GOFLAGS": "-mod=vendor",
+					},
+					Timeout: durationp(90 * time.Minute),
+				},
+				Notifications: &PipelineNotifications{
+					ApprovalNeeded: []string{"reviewers@example.com"},
+					Failed:         []string{"arn:aws:sns:us-west-2:123456789012:oncall"},
+				},
+			},
+		},
+		"version 1 pipeline.yml with notifications": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: test
+
+notifications:
+  failed: [oncall@example.com]
+`,
+			expectedErr: errors.New("field notifications requires version 2 of the pipeline manifest, run `copilot pipeline upgrade-manifest` to upgrade it"),
+		},
+		"version 1 pipeline.yml with a build section": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: test
+
+build:
+  compute_size: large
+`,
+			expectedErr: errors.New("field build requires version 2 of the pipeline manifest, run `copilot pipeline upgrade-manifest` to upgrade it"),
+		},
+		"version 1 pipeline.yml with version 2 fields": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: prod
+      approval:
+        required: true
+`,
+			expectedErr: errors.New("stage prod: fields approval and deployments require version 2 of the pipeline manifest, run `copilot pipeline upgrade-manifest` to upgrade it"),
+		},
+		"github enterprise server source without a connection": {
+			inContent: `
+name: pipepiper
+version: 2
+
+source:
+  provider: GitHubEnterpriseServer
+  properties:
+    repository: https://github.mycompany.com/aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: test
+`,
+			expectedErr: errors.New("source provider GitHubEnterpriseServer requires the property connection_arn"),
+		},
+		"version 2 pipeline.yml with version 1 fields": {
+			inContent: `
+name: pipepiper
+version: 2
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: test
+      services: [api]
+`,
+			expectedErr: errors.New("stage test: fields requires_approval, services and jobs are replaced by approval and deployments in version 2 of the pipeline manifest"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -0,0 +1,216 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	pipelineManifestIndent = 2
+
+	yamlMapTag  = "!!map"
+	yamlStrTag  = "!!str"
+	yamlNullTag = "!!null"
+)
+
+var (
+	// Commented-out Ver1 fields generated by "copilot pipeline init", such as "# requires_approval: true".
+	v1ApprovalCommentExp  = regexp.MustCompile(`^#\s*requires_approval:\s*(\S+)\s*$`)
+	v1WorkloadsCommentExp = regexp.MustCompile(`^#\s*(?:services|jobs):\s*\[(.*)\]\s*$`)
+)
+
+// UpgradePipelineManifest rewrites the Ver1 pipeline manifest in into the latest schema version
+// while preserving its comments. Stages that omit services or jobs deploy all the workloads
+// of that type in the workspace, so svcNames and jobNames are used to list them explicitly.
+// If the manifest already uses the latest version, it returns ErrPipelineManifestUpToDate.
+func UpgradePipelineManifest(in []byte, svcNames, jobNames []string) ([]byte, error) {
+	pm, err := UnmarshalPipeline(in)
+	if err != nil {
+		return nil, err
+	}
+	if pm.Version == Ver2 {
+		return nil, ErrPipelineManifestUpToDate
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(in, &doc); err != nil {
+		return nil, fmt.Errorf("unmarshal pipeline manifest: %w", err)
+	}
+	root := doc.Content[0]
+	if version := mappingValue(root, "version"); version != nil {
+		version.Value = strconv.Itoa(int(Ver2))
+	}
+	if stages := mappingValue(root, "stages"); stages != nil && stages.Kind == yaml.SequenceNode {
+		for i, stage := range stages.Content {
+			upgradePipelineStage(stage, pm.Stages[i], svcNames, jobNames)
+		}
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(pipelineManifestIndent)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, fmt.Errorf("marshal pipeline manifest: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("marshal pipeline manifest: %w", err)
+	}
+	return separateTopLevelSections(buf.Bytes()), nil
+}
+
+// upgradePipelineStage replaces the requires_approval field of the stage node with approval,
+// and its services and jobs fields with deployments.
+func upgradePipelineStage(node *yaml.Node, stage PipelineStage, svcNames, jobNames []string) {
+	upgradeComments(node)
+	if node.Kind != yaml.MappingNode {
+		return
+	}
+	var content []*yaml.Node
+	var deploymentsKey *yaml.Node
+	for i := 0; i < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		switch key.Value {
+		case "requires_approval":
+			key.Value = "approval"
+			value = &yaml.Node{
+				Kind: yaml.MappingNode,
+				Tag:  yamlMapTag,
+				Content: []*yaml.Node{
+					{Kind: yaml.ScalarNode, Tag: yamlStrTag, Value: "required"},
+					value,
+				},
+			}
+		case "services", "jobs":
+			if deploymentsKey != nil {
+				// Both fields are merged into the deployments field that replaced the first one.
+				deploymentsKey.HeadComment = joinComments(deploymentsKey.HeadComment, key.HeadComment, value.LineComment)
+				deploymentsKey.FootComment = joinComments(deploymentsKey.FootComment, key.FootComment)
+				continue
+			}
+			key.Value = "deployments"
+			key.HeadComment = joinComments(key.HeadComment, value.LineComment)
+			deploymentsKey = key
+			value = deploymentsNode(stage, svcNames, jobNames)
+		}
+		content = append(content, key, value)
+	}
+	node.Content = content
+}
+
+// deploymentsNode returns the mapping node of the workloads deployed in the Ver1 stage.
+func deploymentsNode(stage PipelineStage, svcNames, jobNames []string) *yaml.Node {
+	svcs, jobs := stage.Services, stage.Jobs
+	if svcs == nil {
+		svcs = svcNames
+	}
+	if jobs == nil {
+		jobs = jobNames
+	}
+	node := &yaml.Node{
+		Kind: yaml.MappingNode,
+		Tag:  yamlMapTag,
+	}
+	seen := make(map[string]bool)
+	for _, name := range append(append([]string{}, svcs...), jobs...) {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		node.Content = append(node.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: yamlStrTag, Value: name},
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: yamlNullTag})
+	}
+	return node
+}
+
+// upgradeComments rewrites the commented-out Ver1 fields of the node and its children into their Ver2 equivalents.
+func upgradeComments(node *yaml.Node) {
+	node.HeadComment = upgradeComment(node.HeadComment)
+	node.LineComment = upgradeComment(node.LineComment)
+	node.FootComment = upgradeComment(node.FootComment)
+	for _, child := range node.Content {
+		upgradeComments(child)
+	}
+}
+
+func upgradeComment(comment string) string {
+	if comment == "" {
+		return comment
+	}
+	var lines []string
+	inDeployments := false
+	for _, line := range strings.Split(comment, "\n") {
+		if m := v1ApprovalCommentExp.FindStringSubmatch(line); m != nil {
+			lines = append(lines, "# approval:", "#   required: "+m[1])
+			inDeployments = false
+			continue
+		}
+		if m := v1WorkloadsCommentExp.FindStringSubmatch(line); m != nil {
+			if !inDeployments {
+				lines = append(lines, "# deployments:")
+				inDeployments = true
+			}
+			for _, name := range strings.Split(m[1], ",") {
+				if name = strings.TrimSpace(name); name != "" {
+					lines = append(lines, fmt.Sprintf("#   %s:", name))
+				}
+			}
+			continue
+		}
+		lines = append(lines, line)
+		inDeployments = false
+	}
+	return strings.Join(lines, "\n")
+}
+
+// mappingValue returns the value of key in the mapping node, or nil if the key doesn't exist.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func joinComments(comments ...string) string {
+	var nonEmpty []string
+	for _, c := range comments {
+		if c != "" {
+			nonEmpty = append(nonEmpty, c)
+		}
+	}
+	return strings.Join(nonEmpty, "\n")
+}
+
+// separateTopLevelSections restores the blank lines between the top-level fields of the manifest,
+// which are dropped when it's encoded from yaml nodes.
+func separateTopLevelSections(in []byte) []byte {
+	lines := strings.Split(string(in), "\n")
+	var out []string
+	for i, line := range lines {
+		if i > 0 && startsTopLevelSection(line) {
+			prev := lines[i-1]
+			if prev != "" && !strings.HasPrefix(prev, "#") {
+				out = append(out, "")
+			}
+		}
+		out = append(out, line)
+	}
+	return []byte(strings.Join(out, "\n"))
+}
+
+func startsTopLevelSection(line string) bool {
+	return line != "" && !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "-")
+}
@@ -0,0 +1,171 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradePipelineManifest(t *testing.T) {
+	testCases := map[string]struct {
+		inContent  string
+		inSvcNames []string
+		inJobNames []string
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"upgrades a generated manifest": {
+			inContent: `# This YAML file defines the relationship and deployment ordering of your environments.
+
+# The name of the pipeline
+name: pipeline-badgoose-repo
+
+# The version of the schema used in this template
+version: 1
+
+# This section defines the source artifacts.
+source:
+  # The name of the provider that is used to store the source artifacts.
+  provider: GitHub
+  properties:
+    branch: main
+    repository: https://github.com/badgoose/repo
+
+# The deployment section defines the order the pipeline will deploy
+# to your environments.
+stages:
+    - # The name of the environment to deploy to.
+      name: test
+      # Optional: flag for manual approval action before deployment.
+      # requires_approval: true
+      # Optional: the services and jobs to deploy to this environment, defaults to all of them.
+      # services: [frontend, api]
+      # jobs: [report-generator]
+    - # The name of the environment to deploy to.
+      name: prod
+      # Optional: flag for manual approval action before deployment.
+      requires_approval: true # Approved by the on-call.
+      # Deploy only the frontend.
+      services: [frontend]
+      test_commands: [make test]
+`,
+			inSvcNames: []string{"frontend", "api"},
+			inJobNames: []string{"report-generator"},
+
+			wantedContent: `# This YAML file defines the relationship and deployment ordering of your environments.
+
+# The name of the pipeline
+name: pipeline-badgoose-repo
+
+# The version of the schema used in this template
+version: 2
+
+# This section defines the source artifacts.
+source:
+  # The name of the provider that is used to store the source artifacts.
+  provider: GitHub
+  properties:
+    branch: main
+    repository: https://github.com/badgoose/repo
+
+# The deployment section defines the order the pipeline will deploy
+# to your environments.
+stages:
+  - # The name of the environment to deploy to.
+    name: test
+    # Optional: flag for manual approval action before deployment.
+    # approval:
+    #   required: true
+    # Optional: the services and jobs to deploy to this environment, defaults to all of them.
+    # deployments:
+    #   frontend:
+    #   api:
+    #   report-generator:
+  - # The name of the environment to deploy to.
+    name: prod
+    # Optional: flag for manual approval action before deployment.
+    approval:
+      required: true # Approved by the on-call.
+    # Deploy only the frontend.
+    deployments:
+      frontend:
+      report-generator:
+    test_commands: [make test]
+`,
+		},
+		"merges services and jobs into deployments": {
+			inContent: `name: pipeline-badgoose-repo
+version: 1
+source:
+  provider: CodeCommit
+  properties:
+    branch: main
+    repository: https://git-codecommit.us-west-2.amazonaws.com/v1/repos/repo
+stages:
+  - name: test
+    services: []
+    jobs:
+      - report-generator
+      - report-generator
+`,
+			inSvcNames: []string{"frontend", "api"},
+			inJobNames: []string{"report-generator"},
+
+			wantedContent: `name: pipeline-badgoose-repo
+
+version: 2
+
+source:
+  provider: CodeCommit
+  properties:
+    branch: main
+    repository: https://git-codecommit.us-west-2.amazonaws.com/v1/repos/repo
+
+stages:
+  - name: test
+    deployments:
+      report-generator:
+`,
+		},
+		"errors if the manifest is already up to date": {
+			inContent: `name: pipeline-badgoose-repo
+version: 2
+stages:
+  - name: test
+`,
+			wantedErr: ErrPipelineManifestUpToDate,
+		},
+		"errors if the manifest is invalid": {
+			inContent: `name: pipeline-badgoose-repo
+version: 3
+`,
+			wantedErr: &ErrInvalidPipelineManifestVersion{
+				invalidVersion: PipelineSchemaMajorVersion(3),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			out, err := UpgradePipelineManifest([]byte(tc.inContent), tc.inSvcNames, tc.inJobNames)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.True(t, errors.Is(err, tc.wantedErr), "expected error %v, got %v", tc.wantedErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, string(out))
+
+			upgraded, err := UnmarshalPipeline(out)
+			require.NoError(t, err)
+			require.Equal(t, Ver2, upgraded.Version)
+		})
+	}
+}
@@ -134,6 +134,34 @@ func durationp(v time.Duration) *time.Duration {
 	return &v
 }
 
+// ServiceDependencies returns the names of the services that must be deployed before the workload.
+// Only services can declare dependencies, it returns nil for other workloads.
+func ServiceDependencies(mft interface{}) []string {
+	switch v := mft.(type) {
+	case *LoadBalancedWebService:
+		return v.DependsOn
+	case *BackendService:
+		return v.DependsOn
+	default:
+		return nil
+	}
+}
+
+// ServicePort returns the port exposed by the main container of the service, or false if it doesn't expose any.
+func ServicePort(mft interface{}) (uint16, bool) {
+	var port *uint16
+	switch v := mft.(type) {
+	case *LoadBalancedWebService:
+		port = v.ImageConfig.Port
+	case *BackendService:
+		port = v.ImageConfig.Port
+	}
+	if port == nil {
+		return 0, false
+	}
+	return *port, true
+}
+
 // ServiceDockerfileBuildRequired returns if the service container image should be built from local Dockerfile.
 func ServiceDockerfileBuildRequired(svc interface{}) (bool, error) {
 	return dockerfileBuildRequired("service", svc)
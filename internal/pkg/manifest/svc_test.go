@@ -146,7 +146,9 @@ image:
 cpu: 1024
 memory: 1024
 secrets:
-  API_TOKEN: SUBS_API_TOKEN`,
+  API_TOKEN: SUBS_API_TOKEN
+depends_on:
+  - users`,
 			requireCorrectValues: func(t *testing.T, i interface{}) {
 				actualManifest, ok := i.(*BackendService)
 				require.True(t, ok)
@@ -184,6 +186,7 @@ secrets:
 							},
 						},
 					},
+					DependsOn: []string{"users"},
 				}
 				require.Equal(t, wantedManifest, actualManifest)
 			},
@@ -432,3 +435,66 @@ func Test_ServiceDockerfileBuildRequired(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceDependencies(t *testing.T) {
+	testCases := map[string]struct {
+		mft interface{}
+
+		wanted []string
+	}{
+		"load balanced web service": {
+			mft: &LoadBalancedWebService{
+				DependsOn: []string{"migrations", "api"},
+			},
+			wanted: []string{"migrations", "api"},
+		},
+		"backend service": {
+			mft: &BackendService{
+				DependsOn: []string{"migrations"},
+			},
+			wanted: []string{"migrations"},
+		},
+		"jobs don't have dependencies": {
+			mft: &ScheduledJob{},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, ServiceDependencies(tc.mft))
+		})
+	}
+}
+
+func TestServicePort(t *testing.T) {
+	testCases := map[string]struct {
+		mft interface{}
+
+		wantedPort uint16
+		wantedOK   bool
+	}{
+		"load balanced web service": {
+			mft: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: ServiceImageWithPort{
+						Port: aws.Uint16(8080),
+					},
+				},
+			},
+			wantedPort: 8080,
+			wantedOK:   true,
+		},
+		"backend service without port": {
+			mft: &BackendService{},
+		},
+		"job": {
+			mft: &ScheduledJob{},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			port, ok := ServicePort(tc.mft)
+			require.Equal(t, tc.wantedPort, port)
+			require.Equal(t, tc.wantedOK, ok)
+		})
+	}
+}
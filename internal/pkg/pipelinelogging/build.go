@@ -0,0 +1,108 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pipelinelogging contains utility functions for pipeline logging.
+package pipelinelogging
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
+	"github.com/aws/copilot-cli/internal/pkg/ecslogging"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+type logGetter interface {
+	LogEvents(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error)
+}
+
+type buildGetter interface {
+	Builds(ids []string) ([]*codebuild.Build, error)
+}
+
+// BuildClient retrieves the logs of the CodeBuild builds run by a pipeline.
+type BuildClient struct {
+	eventsGetter logGetter
+	buildGetter  buildGetter
+	w            io.Writer
+}
+
+// WriteLogEventsOpts wraps the parameters to call WriteLogEvents.
+type WriteLogEventsOpts struct {
+	Follow bool
+	// OnEvents is a handler that's invoked when logs are retrieved from a build.
+	OnEvents func(w io.Writer, logs []ecslogging.HumanJSONStringer) error
+}
+
+// NewBuildClient returns a BuildClient initialized from the given sess session.
+func NewBuildClient(sess *session.Session) *BuildClient {
+	return &BuildClient{
+		eventsGetter: cloudwatchlogs.New(sess),
+		buildGetter:  codebuild.New(sess),
+		w:            log.OutputWriter,
+	}
+}
+
+// WriteLogEvents writes the logs of the builds.
+// If opts.Follow is set, it keeps writing new log events until every build is finished.
+func (c *BuildClient) WriteLogEvents(builds []*codebuild.Build, opts WriteLogEventsOpts) error {
+	lastEventTime := make(map[string]map[string]int64)
+	for {
+		for _, build := range builds {
+			if build.LogGroupName == "" || build.LogStreamName == "" {
+				// The build hasn't started writing logs yet.
+				continue
+			}
+			out, err := c.eventsGetter.LogEvents(cloudwatchlogs.LogEventsOpts{
+				LogGroup:            build.LogGroupName,
+				LogStreams:          []string{build.LogStreamName},
+				StreamLastEventTime: lastEventTime[build.ID],
+			})
+			if err != nil {
+				return fmt.Errorf("get log events of build %s: %w", build.ID, err)
+			}
+			if err := opts.OnEvents(c.w, cwEventsToHumanJSONStringers(out.Events)); err != nil {
+				return err
+			}
+			lastEventTime[build.ID] = out.StreamLastEventTime
+		}
+		if !opts.Follow || !inProgress(builds) {
+			return nil
+		}
+		time.Sleep(cloudwatchlogs.SleepDuration)
+		refreshed, err := c.buildGetter.Builds(buildIDs(builds))
+		if err != nil {
+			return err
+		}
+		builds = refreshed
+	}
+}
+
+func inProgress(builds []*codebuild.Build) bool {
+	for _, build := range builds {
+		if build.InProgress() {
+			return true
+		}
+	}
+	return false
+}
+
+func buildIDs(builds []*codebuild.Build) []string {
+	ids := make([]string, len(builds))
+	for i, build := range builds {
+		ids[i] = build.ID
+	}
+	return ids
+}
+
+func cwEventsToHumanJSONStringers(events []*cloudwatchlogs.Event) []ecslogging.HumanJSONStringer {
+	logStringers := make([]ecslogging.HumanJSONStringer, len(events))
+	for i, event := range events {
+		logStringers[i] = event
+	}
+	return logStringers
+}
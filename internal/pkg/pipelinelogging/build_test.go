@@ -0,0 +1,159 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package pipelinelogging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
+	"github.com/aws/copilot-cli/internal/pkg/ecslogging"
+	"github.com/aws/copilot-cli/internal/pkg/pipelinelogging/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type buildLogsMocks struct {
+	logGetter   *mocks.MocklogGetter
+	buildGetter *mocks.MockbuildGetter
+}
+
+func TestBuildClient_WriteLogEvents(t *testing.T) {
+	finishedBuild := &codebuild.Build{
+		ID:            "BuildProject:1",
+		Status:        "FAILED",
+		LogGroupName:  "/aws/codebuild/BuildProject",
+		LogStreamName: "1",
+	}
+	runningBuild := &codebuild.Build{
+		ID:            "BuildTestCommands:2",
+		Status:        "IN_PROGRESS",
+		LogGroupName:  "/aws/codebuild/BuildTestCommands",
+		LogStreamName: "2",
+	}
+	notStartedBuild := &codebuild.Build{
+		ID:     "BuildTestCommands:2",
+		Status: "IN_PROGRESS",
+	}
+	mockLastEventTime := map[string]int64{
+		"2": 123456,
+	}
+
+	testCases := map[string]struct {
+		inBuilds   []*codebuild.Build
+		follow     bool
+		setupMocks func(m buildLogsMocks)
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"writes the logs of every build": {
+			inBuilds: []*codebuild.Build{finishedBuild, runningBuild},
+			setupMocks: func(m buildLogsMocks) {
+				gomock.InOrder(
+					m.logGetter.EXPECT().LogEvents(cloudwatchlogs.LogEventsOpts{
+						LogGroup:   "/aws/codebuild/BuildProject",
+						LogStreams: []string{"1"},
+					}).Return(&cloudwatchlogs.LogEventsOutput{
+						Events: []*cloudwatchlogs.Event{{LogStreamName: "1", Message: "make test"}},
+					}, nil),
+					m.logGetter.EXPECT().LogEvents(cloudwatchlogs.LogEventsOpts{
+						LogGroup:   "/aws/codebuild/BuildTestCommands",
+						LogStreams: []string{"2"},
+					}).Return(&cloudwatchlogs.LogEventsOutput{
+						Events: []*cloudwatchlogs.Event{{LogStreamName: "2", Message: "curl $COPILOT_API_URL"}},
+					}, nil),
+				)
+			},
+			wantedContent: "1 make test\n2 curl $COPILOT_API_URL\n",
+		},
+		"skips builds without logs": {
+			inBuilds:   []*codebuild.Build{notStartedBuild},
+			setupMocks: func(m buildLogsMocks) {},
+		},
+		"follows the builds until they finish": {
+			inBuilds: []*codebuild.Build{runningBuild},
+			follow:   true,
+			setupMocks: func(m buildLogsMocks) {
+				gomock.InOrder(
+					m.logGetter.EXPECT().LogEvents(cloudwatchlogs.LogEventsOpts{
+						LogGroup:   "/aws/codebuild/BuildTestCommands",
+						LogStreams: []string{"2"},
+					}).Return(&cloudwatchlogs.LogEventsOutput{
+						Events:              []*cloudwatchlogs.Event{{LogStreamName: "2", Message: "running"}},
+						StreamLastEventTime: mockLastEventTime,
+					}, nil),
+					m.buildGetter.EXPECT().Builds([]string{"BuildTestCommands:2"}).Return([]*codebuild.Build{
+						{
+							ID:            "BuildTestCommands:2",
+							Status:        "SUCCEEDED",
+							LogGroupName:  "/aws/codebuild/BuildTestCommands",
+							LogStreamName: "2",
+						},
+					}, nil),
+					m.logGetter.EXPECT().LogEvents(cloudwatchlogs.LogEventsOpts{
+						LogGroup:            "/aws/codebuild/BuildTestCommands",
+						LogStreams:          []string{"2"},
+						StreamLastEventTime: mockLastEventTime,
+					}).Return(&cloudwatchlogs.LogEventsOutput{
+						Events: []*cloudwatchlogs.Event{{LogStreamName: "2", Message: "done"}},
+					}, nil),
+				)
+			},
+			wantedContent: "2 running\n2 done\n",
+		},
+		"wraps error from getting log events": {
+			inBuilds: []*codebuild.Build{finishedBuild},
+			setupMocks: func(m buildLogsMocks) {
+				m.logGetter.EXPECT().LogEvents(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("get log events of build BuildProject:1: some error"),
+		},
+		"returns error from refreshing the builds": {
+			inBuilds: []*codebuild.Build{notStartedBuild},
+			follow:   true,
+			setupMocks: func(m buildLogsMocks) {
+				m.buildGetter.EXPECT().Builds(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := buildLogsMocks{
+				logGetter:   mocks.NewMocklogGetter(ctrl),
+				buildGetter: mocks.NewMockbuildGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+			client := &BuildClient{
+				eventsGetter: m.logGetter,
+				buildGetter:  m.buildGetter,
+				w:            b,
+			}
+
+			// WHEN
+			err := client.WriteLogEvents(tc.inBuilds, WriteLogEventsOpts{
+				Follow:   tc.follow,
+				OnEvents: ecslogging.WriteHumanLogs,
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
@@ -47,6 +47,25 @@ func (mr *MockContainerLoginBuildPusherMockRecorder) Build(args interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockContainerLoginBuildPusher)(nil).Build), args)
 }
 
+// Tag mocks base method
+func (m *MockContainerLoginBuildPusher) Tag(sourceURI, targetURI, imageTag string, additionalTags ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{sourceURI, targetURI, imageTag}
+	for _, a := range additionalTags {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Tag", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Tag indicates an expected call of Tag
+func (mr *MockContainerLoginBuildPusherMockRecorder) Tag(sourceURI, targetURI, imageTag interface{}, additionalTags ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{sourceURI, targetURI, imageTag}, additionalTags...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tag", reflect.TypeOf((*MockContainerLoginBuildPusher)(nil).Tag), varargs...)
+}
+
 // Login mocks base method
 func (m *MockContainerLoginBuildPusher) Login(uri, username, password string) error {
 	m.ctrl.T.Helper()
@@ -80,6 +99,146 @@ func (mr *MockContainerLoginBuildPusherMockRecorder) Push(uri, imageTag interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockContainerLoginBuildPusher)(nil).Push), varargs...)
 }
 
+// MockContainerLoginPullPusher is a mock of ContainerLoginPullPusher interface
+type MockContainerLoginPullPusher struct {
+	ctrl     *gomock.Controller
+	recorder *MockContainerLoginPullPusherMockRecorder
+}
+
+// MockContainerLoginPullPusherMockRecorder is the mock recorder for MockContainerLoginPullPusher
+type MockContainerLoginPullPusherMockRecorder struct {
+	mock *MockContainerLoginPullPusher
+}
+
+// NewMockContainerLoginPullPusher creates a new mock instance
+func NewMockContainerLoginPullPusher(ctrl *gomock.Controller) *MockContainerLoginPullPusher {
+	mock := &MockContainerLoginPullPusher{ctrl: ctrl}
+	mock.recorder = &MockContainerLoginPullPusherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockContainerLoginPullPusher) EXPECT() *MockContainerLoginPullPusherMockRecorder {
+	return m.recorder
+}
+
+// Login mocks base method
+func (m *MockContainerLoginPullPusher) Login(uri, username, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", uri, username, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Login indicates an expected call of Login
+func (mr *MockContainerLoginPullPusherMockRecorder) Login(uri, username, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockContainerLoginPullPusher)(nil).Login), uri, username, password)
+}
+
+// Pull mocks base method
+func (m *MockContainerLoginPullPusher) Pull(image string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pull", image)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Pull indicates an expected call of Pull
+func (mr *MockContainerLoginPullPusherMockRecorder) Pull(image interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pull", reflect.TypeOf((*MockContainerLoginPullPusher)(nil).Pull), image)
+}
+
+// TagImage mocks base method
+func (m *MockContainerLoginPullPusher) TagImage(source, target string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagImage", source, target)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TagImage indicates an expected call of TagImage
+func (mr *MockContainerLoginPullPusherMockRecorder) TagImage(source, target interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagImage", reflect.TypeOf((*MockContainerLoginPullPusher)(nil).TagImage), source, target)
+}
+
+// Push mocks base method
+func (m *MockContainerLoginPullPusher) Push(uri, imageTag string, additionalTags ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{uri, imageTag}
+	for _, a := range additionalTags {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Push", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Push indicates an expected call of Push
+func (mr *MockContainerLoginPullPusherMockRecorder) Push(uri, imageTag interface{}, additionalTags ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{uri, imageTag}, additionalTags...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockContainerLoginPullPusher)(nil).Push), varargs...)
+}
+
+// MockcontainerLoginPusher is a mock of containerLoginPusher interface
+type MockcontainerLoginPusher struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontainerLoginPusherMockRecorder
+}
+
+// MockcontainerLoginPusherMockRecorder is the mock recorder for MockcontainerLoginPusher
+type MockcontainerLoginPusherMockRecorder struct {
+	mock *MockcontainerLoginPusher
+}
+
+// NewMockcontainerLoginPusher creates a new mock instance
+func NewMockcontainerLoginPusher(ctrl *gomock.Controller) *MockcontainerLoginPusher {
+	mock := &MockcontainerLoginPusher{ctrl: ctrl}
+	mock.recorder = &MockcontainerLoginPusherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockcontainerLoginPusher) EXPECT() *MockcontainerLoginPusherMockRecorder {
+	return m.recorder
+}
+
+// Login mocks base method
+func (m *MockcontainerLoginPusher) Login(uri, username, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", uri, username, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Login indicates an expected call of Login
+func (mr *MockcontainerLoginPusherMockRecorder) Login(uri, username, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockcontainerLoginPusher)(nil).Login), uri, username, password)
+}
+
+// Push mocks base method
+func (m *MockcontainerLoginPusher) Push(uri, imageTag string, additionalTags ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{uri, imageTag}
+	for _, a := range additionalTags {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Push", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Push indicates an expected call of Push
+func (mr *MockcontainerLoginPusherMockRecorder) Push(uri, imageTag interface{}, additionalTags ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{uri, imageTag}, additionalTags...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockcontainerLoginPusher)(nil).Push), varargs...)
+}
+
 // MockRegistry is a mock of Registry interface
 type MockRegistry struct {
 	ctrl     *gomock.Controller
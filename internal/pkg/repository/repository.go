@@ -10,9 +10,23 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/docker"
 )
 
-// ContainerLoginBuildPusher provides support for logging in to repositories, building images and pushing images to repositories.
+// ContainerLoginBuildPusher provides support for logging in to repositories, building and tagging images, and pushing images to repositories.
 type ContainerLoginBuildPusher interface {
 	Build(args *docker.BuildArguments) error
+	Tag(sourceURI, targetURI, imageTag string, additionalTags ...string) error
+	Login(uri, username, password string) error
+	Push(uri, imageTag string, additionalTags ...string) error
+}
+
+// ContainerLoginPullPusher provides support for logging in to repositories, pulling and tagging images, and pushing images to repositories.
+type ContainerLoginPullPusher interface {
+	Login(uri, username, password string) error
+	Pull(image string) error
+	TagImage(source, target string) error
+	Push(uri, imageTag string, additionalTags ...string) error
+}
+
+type containerLoginPusher interface {
 	Login(uri, username, password string) error
 	Push(uri, imageTag string, additionalTags ...string) error
 }
@@ -53,22 +67,61 @@ func (r *Repository) BuildAndPush(docker ContainerLoginBuildPusher, args *docker
 	if err := docker.Build(args); err != nil {
 		return fmt.Errorf("build Dockerfile at %s: %w", args.Dockerfile, err)
 	}
+	return r.push(docker, args.URI, args.ImageTag, args.AdditionalTags...)
+}
 
-	username, password, err := r.registry.Auth()
-	if err != nil {
-		return fmt.Errorf("get auth: %w", err)
+// TagAndPush tags the image that was built with args for another repository, and pushes it to the repository with tags.
+// It avoids building the same image again for each repository, such as the repositories of an application in other regions.
+func (r *Repository) TagAndPush(docker ContainerLoginBuildPusher, args *docker.BuildArguments) error {
+	if err := docker.Tag(args.URI, r.uri, args.ImageTag, args.AdditionalTags...); err != nil {
+		return fmt.Errorf("tag image %s for repo %s: %w", args.URI, r.name, err)
 	}
+	return r.push(docker, r.uri, args.ImageTag, args.AdditionalTags...)
+}
 
-	if err := docker.Login(args.URI, username, password); err != nil {
-		return fmt.Errorf("login to repo %s: %w", r.name, err)
+// Pull pulls the image with the digest from the repository, and returns the name of the pulled image.
+func (r *Repository) Pull(docker ContainerLoginPullPusher, digest string) (string, error) {
+	if err := r.login(docker, r.uri); err != nil {
+		return "", err
+	}
+	image := fmt.Sprintf("%s@%s", r.uri, digest)
+	if err := docker.Pull(image); err != nil {
+		return "", fmt.Errorf("pull from repo %s: %w", r.name, err)
 	}
+	return image, nil
+}
+
+// PushImage tags a local image, such as an image pulled from another repository, for the repository
+// and pushes it to the repository with the tag.
+func (r *Repository) PushImage(docker ContainerLoginPullPusher, image, imageTag string) error {
+	if err := docker.TagImage(image, fmt.Sprintf("%s:%s", r.uri, imageTag)); err != nil {
+		return fmt.Errorf("tag image %s for repo %s: %w", image, r.name, err)
+	}
+	return r.push(docker, r.uri, imageTag)
+}
 
-	if err := docker.Push(args.URI, args.ImageTag, args.AdditionalTags...); err != nil {
+func (r *Repository) push(docker containerLoginPusher, uri, imageTag string, additionalTags ...string) error {
+	if err := r.login(docker, uri); err != nil {
+		return err
+	}
+
+	if err := docker.Push(uri, imageTag, additionalTags...); err != nil {
 		return fmt.Errorf("push to repo %s: %w", r.name, err)
 	}
 	return nil
 }
 
+func (r *Repository) login(docker containerLoginPusher, uri string) error {
+	username, password, err := r.registry.Auth()
+	if err != nil {
+		return fmt.Errorf("get auth: %w", err)
+	}
+	if err := docker.Login(uri, username, password); err != nil {
+		return fmt.Errorf("login to repo %s: %w", r.name, err)
+	}
+	return nil
+}
+
 // URI returns the uri of the repository.
 func (r *Repository) URI() string {
 	return r.uri
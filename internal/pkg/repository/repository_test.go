@@ -132,3 +132,203 @@ func TestRepository_BuildAndPush(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_TagAndPush(t *testing.T) {
+	const (
+		mockRepoName  = "my-repo"
+		mockRepoURI   = "1111.dkr.ecr.us-east-1.amazonaws.com/my-repo"
+		mockSourceURI = "1111.dkr.ecr.us-west-2.amazonaws.com/my-repo"
+	)
+	mockArgs := &docker.BuildArguments{
+		URI:            mockSourceURI,
+		Dockerfile:     "path/to/dockerfile",
+		ImageTag:       "tag1",
+		AdditionalTags: []string{"tag2"},
+	}
+
+	testCases := map[string]struct {
+		mockRegistry func(m *mocks.MockRegistry)
+		mockDocker   func(m *mocks.MockContainerLoginBuildPusher)
+
+		wantedError error
+	}{
+		"failed to tag image": {
+			mockRegistry: func(m *mocks.MockRegistry) {},
+			mockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().Tag(mockSourceURI, mockRepoURI, "tag1", "tag2").Return(errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("tag image %s for repo my-repo: some error", mockSourceURI),
+		},
+		"success": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().Auth().Return("my-name", "my-pwd", nil)
+			},
+			mockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().Tag(mockSourceURI, mockRepoURI, "tag1", "tag2").Return(nil)
+				m.EXPECT().Login(mockRepoURI, "my-name", "my-pwd").Return(nil)
+				m.EXPECT().Push(mockRepoURI, "tag1", "tag2").Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRegistry := mocks.NewMockRegistry(ctrl)
+			mockDocker := mocks.NewMockContainerLoginBuildPusher(ctrl)
+			tc.mockRegistry(mockRegistry)
+			tc.mockDocker(mockDocker)
+			repo := &Repository{
+				name:     mockRepoName,
+				registry: mockRegistry,
+				uri:      mockRepoURI,
+			}
+
+			// WHEN
+			err := repo.TagAndPush(mockDocker, mockArgs)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRepository_Pull(t *testing.T) {
+	const (
+		mockRepoName = "my-repo"
+		mockRepoURI  = "1111.dkr.ecr.us-west-2.amazonaws.com/my-repo"
+		mockDigest   = "sha256:1234"
+	)
+
+	testCases := map[string]struct {
+		mockRegistry func(m *mocks.MockRegistry)
+		mockDocker   func(m *mocks.MockContainerLoginPullPusher)
+
+		wantedImage string
+		wantedError error
+	}{
+		"failed to login": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().Auth().Return("my-name", "my-pwd", nil)
+			},
+			mockDocker: func(m *mocks.MockContainerLoginPullPusher) {
+				m.EXPECT().Login(mockRepoURI, "my-name", "my-pwd").Return(errors.New("some error"))
+			},
+			wantedError: errors.New("login to repo my-repo: some error"),
+		},
+		"failed to pull": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().Auth().Return("my-name", "my-pwd", nil)
+			},
+			mockDocker: func(m *mocks.MockContainerLoginPullPusher) {
+				m.EXPECT().Login(mockRepoURI, "my-name", "my-pwd").Return(nil)
+				m.EXPECT().Pull(mockRepoURI + "@" + mockDigest).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("pull from repo my-repo: some error"),
+		},
+		"success": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().Auth().Return("my-name", "my-pwd", nil)
+			},
+			mockDocker: func(m *mocks.MockContainerLoginPullPusher) {
+				m.EXPECT().Login(mockRepoURI, "my-name", "my-pwd").Return(nil)
+				m.EXPECT().Pull(mockRepoURI + "@" + mockDigest).Return(nil)
+			},
+			wantedImage: mockRepoURI + "@" + mockDigest,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRegistry := mocks.NewMockRegistry(ctrl)
+			mockDocker := mocks.NewMockContainerLoginPullPusher(ctrl)
+			tc.mockRegistry(mockRegistry)
+			tc.mockDocker(mockDocker)
+			repo := &Repository{
+				name:     mockRepoName,
+				registry: mockRegistry,
+				uri:      mockRepoURI,
+			}
+
+			// WHEN
+			image, err := repo.Pull(mockDocker, mockDigest)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedImage, image)
+			}
+		})
+	}
+}
+
+func TestRepository_PushImage(t *testing.T) {
+	const (
+		mockRepoName = "my-repo"
+		mockRepoURI  = "1111.dkr.ecr.us-east-1.amazonaws.com/my-repo"
+		mockImage    = "1111.dkr.ecr.us-west-2.amazonaws.com/my-repo@sha256:1234"
+	)
+
+	testCases := map[string]struct {
+		mockRegistry func(m *mocks.MockRegistry)
+		mockDocker   func(m *mocks.MockContainerLoginPullPusher)
+
+		wantedError error
+	}{
+		"failed to tag image": {
+			mockRegistry: func(m *mocks.MockRegistry) {},
+			mockDocker: func(m *mocks.MockContainerLoginPullPusher) {
+				m.EXPECT().TagImage(mockImage, mockRepoURI+":v1").Return(errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("tag image %s for repo my-repo: some error", mockImage),
+		},
+		"success": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().Auth().Return("my-name", "my-pwd", nil)
+			},
+			mockDocker: func(m *mocks.MockContainerLoginPullPusher) {
+				m.EXPECT().TagImage(mockImage, mockRepoURI+":v1").Return(nil)
+				m.EXPECT().Login(mockRepoURI, "my-name", "my-pwd").Return(nil)
+				m.EXPECT().Push(mockRepoURI, "v1").Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRegistry := mocks.NewMockRegistry(ctrl)
+			mockDocker := mocks.NewMockContainerLoginPullPusher(ctrl)
+			tc.mockRegistry(mockRegistry)
+			tc.mockDocker(mockDocker)
+			repo := &Repository{
+				name:     mockRepoName,
+				registry: mockRegistry,
+				uri:      mockRepoURI,
+			}
+
+			// WHEN
+			err := repo.PushImage(mockDocker, mockImage, "v1")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -64,7 +64,7 @@ func (r *NetworkConfigRunner) Run() ([]*Task, error) {
 		Count:          r.Count,
 		Subnets:        r.Subnets,
 		SecurityGroups: r.SecurityGroups,
-		TaskFamilyName: taskFamilyName(r.GroupName),
+		TaskFamilyName: FamilyName(r.GroupName),
 		StartedBy:      startedBy,
 	})
 	if err != nil {
@@ -75,7 +75,7 @@ func (r *EnvRunner) Run() ([]*Task, error) {
 		Count:          r.Count,
 		Subnets:        subnets,
 		SecurityGroups: securityGroups,
-		TaskFamilyName: taskFamilyName(r.GroupName),
+		TaskFamilyName: FamilyName(r.GroupName),
 		StartedBy:      startedBy,
 	})
 	if err != nil {
@@ -88,7 +88,12 @@ func (r *EnvRunner) Run() ([]*Task, error) {
 }
 
 func (r *EnvRunner) cluster(app, env string) (string, error) {
-	clusters, err := r.ClusterGetter.GetResourcesByTags(clusterResourceType, map[string]string{
+	return EnvCluster(r.ClusterGetter, app, env)
+}
+
+// EnvCluster returns the ARN of the cluster of the environment in the application.
+func EnvCluster(getter ResourceGetter, app, env string) (string, error) {
+	clusters, err := getter.GetResourcesByTags(clusterResourceType, map[string]string{
 		deploy.AppTagKey: app,
 		deploy.EnvTagKey: env,
 	})
@@ -103,7 +108,7 @@ func (r *EnvRunner) cluster(app, env string) (string, error) {
 
 	// NOTE: only one cluster is associated with an application and an environment
 	if len(clusters) > 1 {
-		return "", fmt.Errorf(fmtErrMoreThanOneClusterFromEnv, r.Env)
+		return "", fmt.Errorf(fmtErrMoreThanOneClusterFromEnv, env)
 	}
 	return clusters[0].ARN, nil
 }
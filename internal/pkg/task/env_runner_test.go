@@ -140,7 +140,7 @@ func TestEnvRunner_Run(t *testing.T) {
 					Count:          1,
 					Subnets:        []string{"subnet-1", "subnet-2"},
 					SecurityGroups: []string{"sg-1", "sg-2"},
-					TaskFamilyName: taskFamilyName("my-task"),
+					TaskFamilyName: FamilyName("my-task"),
 					StartedBy:      startedBy,
 				}).Return(nil, errors.New("error running task"))
 			},
@@ -165,7 +165,7 @@ func TestEnvRunner_Run(t *testing.T) {
 					Count:          1,
 					Subnets:        []string{"subnet-1", "subnet-2"},
 					SecurityGroups: []string{"sg-1", "sg-2"},
-					TaskFamilyName: taskFamilyName("my-task"),
+					TaskFamilyName: FamilyName("my-task"),
 					StartedBy:      startedBy,
 				}).Return([]*ecs.Task{
 					{
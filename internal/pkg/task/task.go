@@ -51,7 +51,8 @@ var (
 	fmtTaskFamilyName = "copilot-%s"
 )
 
-func taskFamilyName(groupName string) string {
+// FamilyName returns the family of the task definition of the tasks in the group.
+func FamilyName(groupName string) string {
 	return fmt.Sprintf(fmtTaskFamilyName, groupName)
 }
 
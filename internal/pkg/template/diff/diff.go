@@ -0,0 +1,213 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package diff provides functionality to compare CloudFormation templates semantically.
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Types of changes.
+const (
+	Added    = "+"
+	Removed  = "-"
+	Modified = "~"
+)
+
+// Change is a difference between two documents at a path.
+type Change struct {
+	Type string // One of Added, Removed or Modified.
+	Path string // The keys and list indexes leading to the value, for example "Resources.Service.Properties.Tags[0]".
+	Old  interface{}
+	New  interface{}
+}
+
+// String returns the change as a single line, for example "~ Resources.Service.Properties.DesiredCount: 1 -> 2".
+func (c Change) String() string {
+	switch c.Type {
+	case Added:
+		return fmt.Sprintf("%s %s: %s", c.Type, c.Path, compact(c.New))
+	case Removed:
+		return fmt.Sprintf("%s %s: %s", c.Type, c.Path, compact(c.Old))
+	default:
+		return fmt.Sprintf("%s %s: %s -> %s", c.Type, c.Path, compact(c.Old), compact(c.New))
+	}
+}
+
+// YAML compares two YAML or JSON documents and returns their differences.
+// Key order, formatting and comments are ignored, and the short form of CloudFormation intrinsic
+// functions such as "!Ref" is equal to its full form "Ref:".
+func YAML(old, new []byte) ([]Change, error) {
+	oldDoc, err := parse(old)
+	if err != nil {
+		return nil, fmt.Errorf("parse old document: %w", err)
+	}
+	newDoc, err := parse(new)
+	if err != nil {
+		return nil, fmt.Errorf("parse new document: %w", err)
+	}
+	var changes []Change
+	compare("", oldDoc, newDoc, &changes)
+	return changes, nil
+}
+
+func parse(in []byte) (interface{}, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(in, &doc); err != nil {
+		return nil, err
+	}
+	v, err := normalize(&doc)
+	if err != nil {
+		return nil, err
+	}
+	if v == nil {
+		// Compare an empty document like an empty template so that each top-level key is reported.
+		return map[string]interface{}{}, nil
+	}
+	return v, nil
+}
+
+// normalize converts a YAML node into maps, lists and scalars.
+func normalize(node *yaml.Node) (interface{}, error) {
+	switch node.Kind {
+	case 0:
+		return nil, nil // Empty document.
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+		return normalize(node.Content[0])
+	case yaml.AliasNode:
+		return normalize(node.Alias)
+	}
+
+	var value interface{}
+	switch node.Kind {
+	case yaml.MappingNode:
+		m := make(map[string]interface{})
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			v, err := normalize(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			m[node.Content[i].Value] = v
+		}
+		value = m
+	case yaml.SequenceNode:
+		l := make([]interface{}, 0, len(node.Content))
+		for _, item := range node.Content {
+			v, err := normalize(item)
+			if err != nil {
+				return nil, err
+			}
+			l = append(l, v)
+		}
+		value = l
+	default:
+		scalar := *node
+		if isIntrinsicFunction(node.Tag) {
+			scalar.Tag = "" // Let the scalar resolve to its own type.
+		}
+		if err := scalar.Decode(&value); err != nil {
+			return nil, err
+		}
+	}
+	if isIntrinsicFunction(node.Tag) {
+		return intrinsicFunction(node.Tag, value), nil
+	}
+	return value, nil
+}
+
+func isIntrinsicFunction(tag string) bool {
+	return strings.HasPrefix(tag, "!") && !strings.HasPrefix(tag, "!!")
+}
+
+// intrinsicFunction converts the short form of an intrinsic function into its full form.
+func intrinsicFunction(tag string, value interface{}) interface{} {
+	name := strings.TrimPrefix(tag, "!")
+	switch name {
+	case "Ref", "Condition":
+		return map[string]interface{}{name: value}
+	case "GetAtt":
+		if s, ok := value.(string); ok {
+			// !GetAtt Resource.Attribute is the same as Fn::GetAtt: [Resource, Attribute].
+			if parts := strings.SplitN(s, ".", 2); len(parts) == 2 {
+				value = []interface{}{parts[0], parts[1]}
+			}
+		}
+	}
+	return map[string]interface{}{"Fn::" + name: value}
+}
+
+func compare(path string, old, new interface{}, changes *[]Change) {
+	oldMap, oldIsMap := old.(map[string]interface{})
+	newMap, newIsMap := new.(map[string]interface{})
+	if oldIsMap && newIsMap {
+		for _, key := range unionKeys(oldMap, newMap) {
+			keyPath := key
+			if path != "" {
+				keyPath = path + "." + key
+			}
+			oldValue, inOld := oldMap[key]
+			newValue, inNew := newMap[key]
+			switch {
+			case !inOld:
+				*changes = append(*changes, Change{Type: Added, Path: keyPath, New: newValue})
+			case !inNew:
+				*changes = append(*changes, Change{Type: Removed, Path: keyPath, Old: oldValue})
+			default:
+				compare(keyPath, oldValue, newValue, changes)
+			}
+		}
+		return
+	}
+	oldList, oldIsList := old.([]interface{})
+	newList, newIsList := new.([]interface{})
+	if oldIsList && newIsList {
+		for i := 0; i < len(oldList) || i < len(newList); i++ {
+			itemPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(oldList):
+				*changes = append(*changes, Change{Type: Added, Path: itemPath, New: newList[i]})
+			case i >= len(newList):
+				*changes = append(*changes, Change{Type: Removed, Path: itemPath, Old: oldList[i]})
+			default:
+				compare(itemPath, oldList[i], newList[i], changes)
+			}
+		}
+		return
+	}
+	if !reflect.DeepEqual(old, new) {
+		*changes = append(*changes, Change{Type: Modified, Path: path, Old: old, New: new})
+	}
+}
+
+func unionKeys(a, b map[string]interface{}) []string {
+	var keys []string
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// compact returns the value on a single line.
+func compact(v interface{}) string {
+	out, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(out)
+}
@@ -0,0 +1,106 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestYAML(t *testing.T) {
+	testCases := map[string]struct {
+		old string
+		new string
+
+		wantedChanges []string
+		wantedErr     string
+	}{
+		"ignores key order, formatting and comments": {
+			old: `Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties: {DesiredCount: 1, Cluster: !Ref Cluster}`,
+			new: `# The service.
+Resources:
+  Service:
+    Properties:
+      Cluster:
+        Ref: Cluster
+      DesiredCount: 1
+    Type: "AWS::ECS::Service"
+`,
+		},
+		"treats JSON and the short form of intrinsic functions like YAML": {
+			old: `{"Outputs": {"Arn": {"Value": {"Fn::GetAtt": ["Service", "Arn"]}}, "Name": {"Value": {"Fn::Sub": "${AWS::StackName}-svc"}}}}`,
+			new: `Outputs:
+  Arn:
+    Value: !GetAtt Service.Arn
+  Name:
+    Value: !Sub ${AWS::StackName}-svc
+`,
+		},
+		"returns the added, removed and modified values": {
+			old: `Resources:
+  Service:
+    Properties:
+      DesiredCount: 1
+      Tags:
+        - Key: app
+          Value: phonetool
+        - Key: env
+          Value: test
+  Queue:
+    Type: AWS::SQS::Queue
+`,
+			new: `Resources:
+  Service:
+    Properties:
+      DesiredCount: 2
+      Tags:
+        - Key: app
+          Value: phonetool
+  Topic:
+    Type: AWS::SNS::Topic
+`,
+			wantedChanges: []string{
+				`- Resources.Queue: {"Type":"AWS::SQS::Queue"}`,
+				`~ Resources.Service.Properties.DesiredCount: 1 -> 2`,
+				`- Resources.Service.Properties.Tags[1]: {"Key":"env","Value":"test"}`,
+				`+ Resources.Topic: {"Type":"AWS::SNS::Topic"}`,
+			},
+		},
+		"returns the whole document if the old one is empty": {
+			old: "",
+			new: "Parameters: {}\nResources: {}",
+			wantedChanges: []string{
+				`+ Parameters: {}`,
+				`+ Resources: {}`,
+			},
+		},
+		"errors on malformed documents": {
+			old:       "Resources: [",
+			wantedErr: "parse old document: yaml: line 1: did not find expected node content",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			changes, err := YAML([]byte(tc.old), []byte(tc.new))
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			var lines []string
+			for _, change := range changes {
+				lines = append(lines, change.String())
+			}
+			require.Equal(t, tc.wantedChanges, lines)
+		})
+	}
+}
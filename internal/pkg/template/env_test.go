@@ -5,10 +5,14 @@ package template
 
 import (
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/gobuffalo/packd"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestTemplate_ParseEnv(t *testing.T) {
@@ -73,3 +77,92 @@ func TestTemplate_ParseEnv(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplate_ParseEnv_EnvironmentManagerRole(t *testing.T) {
+	testCases := map[string]struct {
+		wantedActions []string
+	}{
+		"grants the actions used through the environment manager role": {
+			wantedActions: []string{
+				"ecs:ExecuteCommand",
+				"application-autoscaling:DescribeScalableTargets",
+				"application-autoscaling:RegisterScalableTarget",
+				"iam:CreateServiceLinkedRole",
+				"cloudformation:CancelUpdateStack",
+				"cloudformation:ContinueUpdateRollback",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := &Template{
+				box: newTemplatesDiskBox(t,
+					"environment/versions/cf-v1.1.0.yml",
+					"environment/partials/cfn-execution-role.yml",
+					"environment/partials/custom-resources.yml",
+					"environment/partials/custom-resources-role.yml",
+					"environment/partials/environment-manager-role.yml",
+					"environment/partials/lambdas.yml",
+					"environment/partials/vpc-resources.yml",
+				),
+			}
+
+			// WHEN
+			c, err := tpl.ParseEnv(&EnvOpts{
+				Version: "v1.1.0",
+				VPCConfig: &config.AdjustVPC{
+					CIDR:               "10.0.0.0/16",
+					PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+					PrivateSubnetCIDRs: []string{"10.0.2.0/24", "10.0.3.0/24"},
+				},
+			}, WithFuncs(map[string]interface{}{
+				"inc": IncFunc,
+			}))
+
+			// THEN
+			require.NoError(t, err)
+			var env struct {
+				Metadata struct {
+					Version string `yaml:"Version"`
+				} `yaml:"Metadata"`
+				Resources struct {
+					EnvironmentManagerRole struct {
+						Properties struct {
+							Policies []struct {
+								PolicyDocument struct {
+									Statement []struct {
+										Action []string `yaml:"Action"`
+									} `yaml:"Statement"`
+								} `yaml:"PolicyDocument"`
+							} `yaml:"Policies"`
+						} `yaml:"Properties"`
+					} `yaml:"EnvironmentManagerRole"`
+				} `yaml:"Resources"`
+			}
+			require.NoError(t, yaml.Unmarshal(c.Bytes(), &env))
+			require.Equal(t, "v1.1.0", env.Metadata.Version)
+			var actions []string
+			for _, policy := range env.Resources.EnvironmentManagerRole.Properties.Policies {
+				for _, statement := range policy.PolicyDocument.Statement {
+					actions = append(actions, statement.Action...)
+				}
+			}
+			for _, action := range tc.wantedActions {
+				require.Contains(t, actions, action)
+			}
+		})
+	}
+}
+
+// newTemplatesDiskBox returns a box holding the files under the "/templates/" directory at the given paths.
+func newTemplatesDiskBox(t *testing.T, paths ...string) *packd.MemoryBox {
+	box := packd.NewMemoryBox()
+	for _, path := range paths {
+		content, err := ioutil.ReadFile(filepath.Join("..", "..", "..", "templates", path))
+		require.NoError(t, err)
+		require.NoError(t, box.AddBytes(path, content))
+	}
+	return box
+}
@@ -37,6 +37,17 @@ func TestTemplate_ParseScheduledJob(t *testing.T) {
 				},
 			},
 		},
+		"renders with multiple schedules": {
+			opts: template.WorkloadOpts{
+				ScheduleExpressions: []string{"cron(0 8 ? * 2-6 *)", "cron(0 18 ? * 6 *)"},
+			},
+		},
+		"renders with schedules in a time zone": {
+			opts: template.WorkloadOpts{
+				ScheduleExpressions: []string{"cron(0 8 ? * 2-6 *)", "cron(0 18 ? * 6 *)"},
+				ScheduleTimezone:    "Europe/Berlin",
+			},
+		},
 		"renders with options and addons": {
 			opts: template.WorkloadOpts{
 				StateMachine: &template.StateMachineOpts{
@@ -40,6 +40,8 @@ var (
 		"eventrule",
 		"state-machine",
 		"state-machine-definition.json",
+		"state-machine-stages.json",
+		"state-machine-step.json",
 	}
 )
 
@@ -92,6 +94,127 @@ type AutoscalingOpts struct {
 type StateMachineOpts struct {
 	Timeout *int
 	Retries *int
+	Stages  []*JobStageOpts // Stages of a multi-step job, run sequentially. Empty if the job runs a single task.
+}
+
+// Steps returns every step of a multi-step job, including the steps of parallel branches.
+func (o StateMachineOpts) Steps() []*JobStepOpts {
+	return stagesSteps(o.Stages)
+}
+
+// HasStepOutputs returns true if any step of the job reports an output.
+func (o StateMachineOpts) HasStepOutputs() bool {
+	for _, step := range o.Steps() {
+		if step.Output {
+			return true
+		}
+	}
+	return false
+}
+
+// HasCoordinatedStages returns true if the steps of any parallel stage wait for each other.
+func (o StateMachineOpts) HasCoordinatedStages() bool {
+	return hasCoordinatedStages(o.Stages)
+}
+
+func hasCoordinatedStages(stages []*JobStageOpts) bool {
+	for _, stage := range stages {
+		if stage.Coordinated {
+			return true
+		}
+		for _, branch := range stage.Branches {
+			if hasCoordinatedStages(branch) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func stagesSteps(stages []*JobStageOpts) []*JobStepOpts {
+	var steps []*JobStepOpts
+	for _, stage := range stages {
+		if stage.Step != nil {
+			steps = append(steps, stage.Step)
+		}
+		for _, branch := range stage.Branches {
+			steps = append(steps, stagesSteps(branch)...)
+		}
+	}
+	return steps
+}
+
+// JobStageOpts holds configuration for a state of a multi-step job, which runs either a single step
+// or sequences of stages in parallel branches.
+type JobStageOpts struct {
+	Name       string
+	Next       string                // Name of the next stage in the same sequence. Empty if this is the last one.
+	Step       *JobStepOpts          // Step run by the stage. Nil if the stage runs parallel branches.
+	Branches   [][]*JobStageOpts     // Sequences of stages run in parallel.
+	ResultPath string                // Path under which the outputs of the branches are stored. Empty if they don't report any.
+	Outputs    []*JobStageOutputOpts // Outputs of the steps of the branches, stored under ResultPath.
+
+	// Dependencies between the steps of parallel branches that can't be expressed by the layout of the stages.
+	// The steps of a coordinated stage record their completion in the steps table of the job, and
+	// the steps that depend on them poll the table before running.
+	Coordinated bool                // Branches wait for each other's steps. Their records are deleted once the stage is over.
+	Waits       []*JobStageWaitOpts // Steps of other branches to wait for before running Step.
+	Signals     bool                // Record the completion of Step for the steps that wait for it.
+}
+
+// StartAt returns the name of the first state of the stage.
+func (o JobStageOpts) StartAt() string {
+	if len(o.Waits) > 0 {
+		return o.Waits[0].CheckState(o.Name)
+	}
+	return o.Name
+}
+
+// AfterWait returns the name of the state that follows the wait at index i.
+func (o JobStageOpts) AfterWait(i int) string {
+	if i+1 < len(o.Waits) {
+		return o.Waits[i+1].CheckState(o.Name)
+	}
+	return o.Name
+}
+
+// JobStageWaitOpts holds configuration to wait for the completion of a step that runs in another parallel branch.
+type JobStageWaitOpts struct {
+	Name string // Name of the step to wait for.
+	Key  string // Attribute under which the completion of the step is recorded.
+}
+
+// CheckState returns the name of the state that checks whether the step is done before running the step of the stage.
+func (o JobStageWaitOpts) CheckState(stage string) string {
+	return fmt.Sprintf("Check %s before %s", o.Name, stage)
+}
+
+// JobStageOutputOpts holds where to find the output of a step that ran in a parallel branch.
+type JobStageOutputOpts struct {
+	Key  string // Key under which the output is stored.
+	Path string // Path to the output in the results of the branches.
+}
+
+// JobStepOpts holds configuration for a container that runs as a step of a multi-step job.
+type JobStepOpts struct {
+	Name      string
+	LogicalID string  // Suffix of the logical ID of the step's task definition.
+	Image     *string // Location of the image. If nil, the job's image is used.
+	Command   []*string
+	CPU       int
+	Memory    int
+	Retries   *int
+	Output    bool
+	OutputKey string // Key under which the output of the step is stored in the state machine's data.
+	Timeout   *int   // Seconds after which the step fails if it didn't report its output. Nil if the job doesn't time out.
+	Inputs    []*JobStepInputOpts
+}
+
+// JobStepInputOpts holds configuration to pass the output of a step to another step as an environment variable.
+type JobStepInputOpts struct {
+	Name    string // Name of the environment variable.
+	Path    string // Path to the output in the state machine's data.
+	Encoded bool   // The output at Path is already encoded as a JSON string.
 }
 
 // WorkloadOpts holds optional data that can be provided to enable features in a workload stack template.
@@ -108,10 +231,12 @@ type WorkloadOpts struct {
 	HealthCheck        *ecs.HealthCheck
 	RulePriorityLambda string
 	DesiredCountLambda string
+	ExecuteCommand     bool // Allows "copilot svc exec" to run commands in the containers of the service.
 
 	// Additional options for job templates.
-	ScheduleExpression string
-	StateMachine       *StateMachineOpts
+	ScheduleExpressions []string
+	ScheduleTimezone    string // IANA time zone in which the schedules are evaluated, empty for UTC.
+	StateMachine        *StateMachineOpts
 }
 
 // ParseLoadBalancedWebService parses a load balanced web service's CloudFormation template
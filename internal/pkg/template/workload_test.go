@@ -4,11 +4,13 @@
 package template
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
 	"github.com/gobuffalo/packd"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestTemplate_ParseSvc(t *testing.T) {
@@ -42,6 +44,8 @@ func TestTemplate_ParseSvc(t *testing.T) {
 				mockBox.AddString("workloads/common/cf/state-machine-definition.json.yml", "state-machine-definition")
 				mockBox.AddString("workloads/common/cf/eventrule.yml", "eventrule")
 				mockBox.AddString("workloads/common/cf/state-machine.yml", "state-machine")
+				mockBox.AddString("workloads/common/cf/state-machine-stages.json.yml", "state-machine-stages")
+				mockBox.AddString("workloads/common/cf/state-machine-step.json.yml", "state-machine-step")
 
 				t.box = mockBox
 			},
@@ -59,6 +63,8 @@ func TestTemplate_ParseSvc(t *testing.T) {
   eventrule
   state-machine
   state-machine-definition
+  state-machine-stages
+  state-machine-step
 `,
 		},
 	}
@@ -121,3 +127,262 @@ func TestHasSecrets(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplate_ParseScheduledJob_Schedules(t *testing.T) {
+	testCases := map[string]struct {
+		opts WorkloadOpts
+
+		wantedTypes   map[string]string
+		unwantedNames []string
+	}{
+		"creates EventBridge rules without a time zone": {
+			opts: WorkloadOpts{
+				ScheduleExpressions: []string{"cron(0 8 ? * 2-6 *)", "cron(0 18 ? * 6 *)"},
+			},
+			wantedTypes: map[string]string{
+				"Rule":  "AWS::Events::Rule",
+				"Rule1": "AWS::Events::Rule",
+			},
+			unwantedNames: []string{"SchedulerSchedule", "SchedulerSchedule1"},
+		},
+		"creates EventBridge Scheduler schedules under their own logical IDs in a time zone": {
+			opts: WorkloadOpts{
+				ScheduleExpressions: []string{"cron(0 8 ? * 2-6 *)", "cron(0 18 ? * 6 *)"},
+				ScheduleTimezone:    "Europe/Berlin",
+			},
+			wantedTypes: map[string]string{
+				"SchedulerSchedule":  "AWS::Scheduler::Schedule",
+				"SchedulerSchedule1": "AWS::Scheduler::Schedule",
+			},
+			unwantedNames: []string{"Rule", "Rule1"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			paths := []string{fmt.Sprintf(fmtWkldCFTemplatePath, jobDirName, scheduledJobTplName)}
+			for _, name := range commonWorkloadCFTemplateNames {
+				paths = append(paths, fmt.Sprintf(fmtWkldCommonCFTemplatePath, name))
+			}
+			tpl := &Template{
+				box: newTemplatesDiskBox(t, paths...),
+			}
+
+			// WHEN
+			c, err := tpl.ParseScheduledJob(tc.opts)
+
+			// THEN
+			require.NoError(t, err)
+			var job struct {
+				Resources map[string]struct {
+					Type string `yaml:"Type"`
+				} `yaml:"Resources"`
+			}
+			require.NoError(t, yaml.Unmarshal(c.Bytes(), &job), c.String())
+			for name, typ := range tc.wantedTypes {
+				require.Contains(t, job.Resources, name)
+				require.Equal(t, typ, job.Resources[name].Type)
+			}
+			for _, name := range tc.unwantedNames {
+				require.NotContains(t, job.Resources, name)
+			}
+		})
+	}
+}
+
+func TestTemplate_ParseLoadBalancedWebService_ExecuteCommand(t *testing.T) {
+	testCases := map[string]struct {
+		opts WorkloadOpts
+
+		wantedEnabled  bool
+		wantedPolicies []string
+	}{
+		"does not allow executing commands by default": {
+			opts:           WorkloadOpts{},
+			wantedEnabled:  false,
+			wantedPolicies: []string{"DenyIAMExceptTaggedRoles"},
+		},
+		"enables execute command and allows the session channels if enabled": {
+			opts: WorkloadOpts{
+				ExecuteCommand: true,
+			},
+			wantedEnabled:  true,
+			wantedPolicies: []string{"DenyIAMExceptTaggedRoles", "ExecuteCommand"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			paths := []string{fmt.Sprintf(fmtWkldCFTemplatePath, servicesDirName, lbWebSvcTplName)}
+			for _, name := range commonWorkloadCFTemplateNames {
+				paths = append(paths, fmt.Sprintf(fmtWkldCommonCFTemplatePath, name))
+			}
+			tpl := &Template{
+				box: newTemplatesDiskBox(t, paths...),
+			}
+
+			// WHEN
+			c, err := tpl.ParseLoadBalancedWebService(tc.opts)
+
+			// THEN
+			require.NoError(t, err)
+			var svc struct {
+				Resources struct {
+					Service struct {
+						Properties struct {
+							EnableExecuteCommand bool `yaml:"EnableExecuteCommand"`
+						} `yaml:"Properties"`
+					} `yaml:"Service"`
+					TaskRole struct {
+						Properties struct {
+							Policies []struct {
+								PolicyName string `yaml:"PolicyName"`
+							} `yaml:"Policies"`
+						} `yaml:"Properties"`
+					} `yaml:"TaskRole"`
+				} `yaml:"Resources"`
+			}
+			require.NoError(t, yaml.Unmarshal(c.Bytes(), &svc), c.String())
+			require.Equal(t, tc.wantedEnabled, svc.Resources.Service.Properties.EnableExecuteCommand)
+			var policies []string
+			for _, policy := range svc.Resources.TaskRole.Properties.Policies {
+				policies = append(policies, policy.PolicyName)
+			}
+			require.Equal(t, tc.wantedPolicies, policies)
+		})
+	}
+}
+
+func TestTemplate_ParseScheduledJob_CoordinatedStages(t *testing.T) {
+	// GIVEN
+	step := func(name, logicalID string, output bool, inputs ...*JobStepInputOpts) *JobStepOpts {
+		return &JobStepOpts{
+			Name:      name,
+			LogicalID: logicalID,
+			CPU:       256,
+			Memory:    512,
+			Output:    output,
+			OutputKey: logicalID + "Output",
+			Inputs:    inputs,
+		}
+	}
+	extractInput := &JobStepInputOpts{
+		Name:    "COPILOT_STEP_EXTRACT_OUTPUT",
+		Path:    "$.ExtractCompletion.Item.Extract.S",
+		Encoded: true,
+	}
+	opts := WorkloadOpts{
+		ScheduleExpressions: []string{"rate(1 day)"},
+		StateMachine: &StateMachineOpts{
+			Stages: []*JobStageOpts{
+				{
+					Name: "Parallel 1",
+					Branches: [][]*JobStageOpts{
+						{{Name: "extract", Step: step("extract", "Extract", true), Signals: true}},
+						{{Name: "fetch-rates", Step: step("fetch-rates", "FetchRates", false), Signals: true}},
+						{{Name: "clean", Step: step("clean", "Clean", false, extractInput), Waits: []*JobStageWaitOpts{
+							{Name: "extract", Key: "Extract"},
+						}}},
+						{{Name: "convert", Step: step("convert", "Convert", false, extractInput), Waits: []*JobStageWaitOpts{
+							{Name: "extract", Key: "Extract"},
+							{Name: "fetch-rates", Key: "FetchRates"},
+						}}},
+					},
+					ResultPath:  "Parallel1Outputs",
+					Outputs:     []*JobStageOutputOpts{{Key: "ExtractOutput", Path: "$[0].ExtractOutput"}},
+					Coordinated: true,
+				},
+			},
+		},
+	}
+	paths := []string{fmt.Sprintf(fmtWkldCFTemplatePath, jobDirName, scheduledJobTplName)}
+	for _, name := range commonWorkloadCFTemplateNames {
+		paths = append(paths, fmt.Sprintf(fmtWkldCommonCFTemplatePath, name))
+	}
+	tpl := &Template{
+		box: newTemplatesDiskBox(t, paths...),
+	}
+
+	// WHEN
+	c, err := tpl.ParseScheduledJob(opts)
+
+	// THEN
+	require.NoError(t, err)
+	var job struct {
+		Resources struct {
+			StateMachine struct {
+				Properties struct {
+					DefinitionSubstitutions map[string]interface{} `yaml:"DefinitionSubstitutions"`
+					DefinitionString        string                 `yaml:"DefinitionString"`
+				} `yaml:"Properties"`
+			} `yaml:"StateMachine"`
+			StepsTable struct {
+				Type string `yaml:"Type"`
+			} `yaml:"StepsTable"`
+		} `yaml:"Resources"`
+	}
+	require.NoError(t, yaml.Unmarshal(c.Bytes(), &job), c.String())
+	require.Equal(t, "AWS::DynamoDB::Table", job.Resources.StepsTable.Type)
+	require.Contains(t, job.Resources.StateMachine.Properties.DefinitionSubstitutions, "StepsTable")
+
+	type state struct {
+		Type    string `json:"Type"`
+		Next    string `json:"Next"`
+		Default string `json:"Default"`
+		Choices []struct {
+			Next string `json:"Next"`
+		} `json:"Choices"`
+		Catch []struct {
+			Next string `json:"Next"`
+		} `json:"Catch"`
+		Branches []struct {
+			StartAt string           `json:"StartAt"`
+			States  map[string]state `json:"States"`
+		} `json:"Branches"`
+	}
+	var definition struct {
+		StartAt string           `json:"StartAt"`
+		States  map[string]state `json:"States"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(job.Resources.StateMachine.Properties.DefinitionString), &definition), job.Resources.StateMachine.Properties.DefinitionString)
+
+	// Every transition targets a state of the same scope.
+	var transitions func(states map[string]state, from string) []string
+	transitions = func(states map[string]state, from string) []string {
+		var path []string
+		for name := from; name != ""; {
+			require.Contains(t, states, name)
+			path = append(path, name)
+			s := states[name]
+			for _, choice := range s.Choices {
+				require.Contains(t, states, choice.Next)
+			}
+			for _, catch := range s.Catch {
+				require.Contains(t, states, catch.Next)
+			}
+			name = s.Next
+			if s.Type == "Choice" {
+				// Follow the path taken once the step is done.
+				name = s.Choices[0].Next
+			}
+		}
+		return path
+	}
+	require.Equal(t, []string{"Parallel 1", "Clean up Parallel 1"}, transitions(definition.States, definition.StartAt))
+	require.Equal(t, []string{"Clean up failed Parallel 1", "Parallel 1 failed"}, transitions(definition.States, "Clean up failed Parallel 1"))
+
+	branches := definition.States["Parallel 1"].Branches
+	require.Len(t, branches, 4)
+	require.Equal(t, []string{"extract", "Signal extract"}, transitions(branches[0].States, branches[0].StartAt))
+	require.Equal(t, []string{"fetch-rates", "Signal fetch-rates"}, transitions(branches[1].States, branches[1].StartAt))
+	require.Equal(t, []string{"Check extract before clean", "Is extract done before clean", "clean"}, transitions(branches[2].States, branches[2].StartAt))
+	require.Equal(t, []string{
+		"Check extract before convert", "Is extract done before convert",
+		"Check fetch-rates before convert", "Is fetch-rates done before convert",
+		"convert",
+	}, transitions(branches[3].States, branches[3].StartAt))
+	require.Equal(t, "Check fetch-rates before convert", branches[3].States["Wait for fetch-rates before convert"].Next)
+	require.Equal(t, "Wait for fetch-rates before convert", branches[3].States["Is fetch-rates done before convert"].Default)
+}
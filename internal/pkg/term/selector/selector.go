@@ -38,6 +38,10 @@ const (
 	ratePrompt = "How long would you like to wait between executions?"
 	rateHelp   = `You can specify the time as a duration string. (For example, 2m, 1h30m, 24h)`
 
+	timezonePrompt = "Which time zone would you like to run this schedule in?"
+	timezoneHelp   = `Cron schedules are evaluated in this time zone, including its daylight saving time.
+Use an IANA time zone name. (For example, UTC, Europe/Berlin, America/New_York)`
+
 	schedulePrompt = "What schedule would you like to use?"
 	scheduleHelp   = `Predefined schedules run at midnight or the top of the hour.
 For example, "Daily" runs at midnight. "Weekly" runs at midnight on Mondays.`
@@ -325,6 +329,44 @@ func (s *WorkspaceSelect) Job(msg, help string) (string, error) {
 	return selectedJobName, nil
 }
 
+// Workload fetches all services and jobs in the workspace and then prompts the user to select one.
+func (s *WorkspaceSelect) Workload(msg, help string) (string, error) {
+	summary, err := s.ws.Summary()
+	if err != nil {
+		return "", fmt.Errorf("read workspace summary: %w", err)
+	}
+	wsServiceNames, err := s.retrieveWorkspaceServices()
+	if err != nil {
+		return "", fmt.Errorf("retrieve services from workspace: %w", err)
+	}
+	wsJobNames, err := s.retrieveWorkspaceJobs()
+	if err != nil {
+		return "", fmt.Errorf("retrieve jobs from workspace: %w", err)
+	}
+	storeServiceNames, err := s.Select.config.ListServices(summary.Application)
+	if err != nil {
+		return "", fmt.Errorf("retrieve services from store: %w", err)
+	}
+	storeJobNames, err := s.Select.config.ListJobs(summary.Application)
+	if err != nil {
+		return "", fmt.Errorf("retrieve jobs from store: %w", err)
+	}
+	names := append(filterWlsByName(storeServiceNames, wsServiceNames), filterWlsByName(storeJobNames, wsJobNames)...)
+	if len(names) == 0 {
+		return "", errors.New("no services or jobs found")
+	}
+	if len(names) == 1 {
+		log.Infof("Only found one workload, defaulting to: %s\n", color.HighlightUserInput(names[0]))
+		return names[0], nil
+	}
+
+	selectedName, err := s.prompt.SelectOne(msg, help, names, prompt.WithFinalMessage("Name:"))
+	if err != nil {
+		return "", fmt.Errorf("select service or job: %w", err)
+	}
+	return selectedName, nil
+}
+
 func filterWlsByName(wls []*config.Workload, wantedNames []string) []string {
 	isWanted := make(map[string]bool)
 	for _, name := range wantedNames {
@@ -594,6 +636,22 @@ func (s *WorkspaceSelect) askCron(scheduleValidator prompt.ValidatorFunc) (strin
 	return customSchedule, nil
 }
 
+// Timezone asks the user for the IANA time zone in which a cron schedule is evaluated.
+// The default input "UTC" is the time zone that schedules are evaluated in without one.
+func (s *WorkspaceSelect) Timezone(timezoneValidator prompt.ValidatorFunc) (string, error) {
+	timezone, err := s.prompt.Get(
+		timezonePrompt,
+		timezoneHelp,
+		timezoneValidator,
+		prompt.WithDefaultInput("UTC"),
+		prompt.WithFinalMessage("Time zone:"),
+	)
+	if err != nil {
+		return "", fmt.Errorf("get time zone: %w", err)
+	}
+	return timezone, nil
+}
+
 func presetScheduleToDefinitionString(input string) string {
 	return fmt.Sprintf("@%s", strings.ToLower(input))
 }
@@ -784,6 +784,110 @@ func TestWorkspaceSelect_Job(t *testing.T) {
 	}
 }
 
+func TestWorkspaceSelect_Workload(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(mocks workspaceSelectMocks)
+		wantErr    error
+		want       string
+	}{
+		"with no workspace services or jobs": {
+			setupMocks: func(m workspaceSelectMocks) {
+				m.workloadLister.EXPECT().Summary().Return(&workspace.Summary{Application: "app-name"}, nil)
+				m.workloadLister.EXPECT().ServiceNames().Return([]string{}, nil)
+				m.workloadLister.EXPECT().JobNames().Return([]string{}, nil)
+				m.configLister.EXPECT().ListServices("app-name").Return([]*config.Workload{{Name: "api"}}, nil)
+				m.configLister.EXPECT().ListJobs("app-name").Return([]*config.Workload{{Name: "report"}}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantErr: errors.New("no services or jobs found"),
+		},
+		"with only one job in both workspace and store (skips prompting)": {
+			setupMocks: func(m workspaceSelectMocks) {
+				m.workloadLister.EXPECT().Summary().Return(&workspace.Summary{Application: "app-name"}, nil)
+				m.workloadLister.EXPECT().ServiceNames().Return([]string{"api"}, nil)
+				m.workloadLister.EXPECT().JobNames().Return([]string{"report"}, nil)
+				m.configLister.EXPECT().ListServices("app-name").Return([]*config.Workload{}, nil)
+				m.configLister.EXPECT().ListJobs("app-name").Return([]*config.Workload{{Name: "report"}}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			want: "report",
+		},
+		"with services and jobs in both workspace and store": {
+			setupMocks: func(m workspaceSelectMocks) {
+				m.workloadLister.EXPECT().Summary().Return(&workspace.Summary{Application: "app-name"}, nil)
+				m.workloadLister.EXPECT().ServiceNames().Return([]string{"api", "frontend"}, nil)
+				m.workloadLister.EXPECT().JobNames().Return([]string{"report"}, nil)
+				m.configLister.EXPECT().ListServices("app-name").Return([]*config.Workload{{Name: "api"}, {Name: "frontend"}}, nil)
+				m.configLister.EXPECT().ListJobs("app-name").Return([]*config.Workload{{Name: "report"}}, nil)
+				m.prompt.EXPECT().SelectOne("Select a workload", "Help text", []string{"api", "frontend", "report"}, gomock.Any()).
+					Return("report", nil)
+			},
+			want: "report",
+		},
+		"with error retrieving jobs from workspace": {
+			setupMocks: func(m workspaceSelectMocks) {
+				m.workloadLister.EXPECT().Summary().Return(&workspace.Summary{Application: "app-name"}, nil)
+				m.workloadLister.EXPECT().ServiceNames().Return([]string{"api"}, nil)
+				m.workloadLister.EXPECT().JobNames().Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("retrieve jobs from workspace: some error"),
+		},
+		"with error retrieving services from store": {
+			setupMocks: func(m workspaceSelectMocks) {
+				m.workloadLister.EXPECT().Summary().Return(&workspace.Summary{Application: "app-name"}, nil)
+				m.workloadLister.EXPECT().ServiceNames().Return([]string{"api"}, nil)
+				m.workloadLister.EXPECT().JobNames().Return([]string{"report"}, nil)
+				m.configLister.EXPECT().ListServices("app-name").Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("retrieve services from store: some error"),
+		},
+		"with error selecting": {
+			setupMocks: func(m workspaceSelectMocks) {
+				m.workloadLister.EXPECT().Summary().Return(&workspace.Summary{Application: "app-name"}, nil)
+				m.workloadLister.EXPECT().ServiceNames().Return([]string{"api"}, nil)
+				m.workloadLister.EXPECT().JobNames().Return([]string{"report"}, nil)
+				m.configLister.EXPECT().ListServices("app-name").Return([]*config.Workload{{Name: "api"}}, nil)
+				m.configLister.EXPECT().ListJobs("app-name").Return([]*config.Workload{{Name: "report"}}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("", errors.New("error selecting"))
+			},
+			wantErr: errors.New("select service or job: error selecting"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockwsRetriever := mocks.NewMockWorkspaceRetriever(ctrl)
+			mockconfigLister := mocks.NewMockConfigLister(ctrl)
+			mockprompt := mocks.NewMockPrompter(ctrl)
+			mocks := workspaceSelectMocks{
+				workloadLister: mockwsRetriever,
+				configLister:   mockconfigLister,
+				prompt:         mockprompt,
+			}
+			tc.setupMocks(mocks)
+
+			sel := WorkspaceSelect{
+				Select: &Select{
+					prompt: mockprompt,
+					config: mockconfigLister,
+				},
+				ws: mockwsRetriever,
+			}
+			got, err := sel.Workload("Select a workload", "Help text")
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
+
 type configSelectMocks struct {
 	serviceLister *mocks.MockConfigLister
 	prompt        *mocks.MockPrompter
@@ -1453,3 +1557,48 @@ func TestWorkspaceSelect_Schedule(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkspaceSelect_Timezone(t *testing.T) {
+	testCases := map[string]struct {
+		mockPrompt     func(*mocks.MockPrompter)
+		wantedTimezone string
+		wantedErr      error
+	}{
+		"ask for time zone": {
+			mockPrompt: func(m *mocks.MockPrompter) {
+				m.EXPECT().Get(timezonePrompt, timezoneHelp, gomock.Any(), gomock.Any(), gomock.Any()).Return("Europe/Berlin", nil)
+			},
+			wantedTimezone: "Europe/Berlin",
+		},
+		"error getting time zone": {
+			mockPrompt: func(m *mocks.MockPrompter) {
+				m.EXPECT().Get(timezonePrompt, timezoneHelp, gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("get time zone: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			p := mocks.NewMockPrompter(ctrl)
+			tc.mockPrompt(p)
+			sel := WorkspaceSelect{
+				Select: &Select{
+					prompt: p,
+				},
+			}
+
+			// WHEN
+			timezone, err := sel.Timezone(func(interface{}) error { return nil })
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.Equal(t, tc.wantedTimezone, timezone)
+			}
+		})
+	}
+}
@@ -9,8 +9,10 @@
 //  │   ├── .workspace                 (workspace summary)
 //  │   └── my-service
 //  │   │   └── manifest.yml           (service manifest)
-//  │   ├── buildspec.yml              (buildspec for the pipeline's build stage)
-//  │   └── pipeline.yml               (pipeline manifest)
+//  │   └── pipelines
+//  │       └── my-pipeline
+//  │           ├── manifest.yml       (pipeline manifest)
+//  │           └── buildspec.yml      (buildspec for the pipeline's build stage)
 //  └── my-service-src                 (customer service code)
 package workspace
 
@@ -35,7 +37,8 @@ const (
 
 	addonsDirName             = "addons"
 	maximumParentDirsToSearch = 5
-	pipelineFileName          = "pipeline.yml"
+	pipelineFileName          = "pipeline.yml" // Legacy pipeline manifest under copilot/.
+	pipelinesDirName          = "pipelines"
 	manifestFileName          = "manifest.yml"
 	buildspecFileName         = "buildspec.yml"
 
@@ -49,6 +52,12 @@ type Summary struct {
 	Application string `yaml:"application"` // Name of the application.
 }
 
+// PipelineManifest is a pipeline manifest file in the workspace.
+type PipelineManifest struct {
+	Name string // Name of the pipeline inside the manifest file.
+	Path string // Absolute path to the manifest file.
+}
+
 // Workspace typically represents a Git repository where the user has its infrastructure-as-code files as well as source files.
 type Workspace struct {
 	workingDir string
@@ -131,6 +140,13 @@ func (ws *Workspace) ServiceNames() ([]string, error) {
 	})
 }
 
+// LoadBalancedWebServiceNames returns the names of the services in the workspace that are reachable through a URL.
+func (ws *Workspace) LoadBalancedWebServiceNames() ([]string, error) {
+	return ws.workloadNames(func(wlType string) bool {
+		return wlType == manifest.LoadBalancedWebServiceType
+	})
+}
+
 // JobNames returns the names of all jobs in the workspace.
 func (ws *Workspace) JobNames() ([]string, error) {
 	return ws.workloadNames(func(wlType string) bool {
@@ -199,21 +215,96 @@ func (ws *Workspace) readWorkloadManifest(name string) ([]byte, error) {
 	return ws.read(name, manifestFileName)
 }
 
-// ReadPipelineManifest returns the contents of the pipeline manifest under copilot/pipeline.yml.
-func (ws *Workspace) ReadPipelineManifest() ([]byte, error) {
-	pmPath, err := ws.pipelineManifestPath()
+// ListPipelines returns the pipeline manifests in the workspace sorted by name. The legacy manifest under
+// copilot/pipeline.yml is listed along with the manifests under copilot/pipelines/{name}/manifest.yml.
+func (ws *Workspace) ListPipelines() ([]PipelineManifest, error) {
+	copilotPath, err := ws.CopilotDirPath()
 	if err != nil {
 		return nil, err
 	}
-	manifestExists, err := ws.fsUtils.Exists(pmPath)
+	var paths []string
+	legacyPath := filepath.Join(copilotPath, pipelineFileName)
+	if exists, _ := ws.fsUtils.Exists(legacyPath); exists {
+		paths = append(paths, legacyPath)
+	}
+	pipelinesPath := filepath.Join(copilotPath, pipelinesDirName)
+	if exists, _ := ws.fsUtils.DirExists(pipelinesPath); exists {
+		files, err := ws.fsUtils.ReadDir(pipelinesPath)
+		if err != nil {
+			return nil, fmt.Errorf("read directory %s: %w", pipelinesPath, err)
+		}
+		for _, f := range files {
+			if !f.IsDir() {
+				continue
+			}
+			path := filepath.Join(pipelinesPath, f.Name(), manifestFileName)
+			if exists, _ := ws.fsUtils.Exists(path); !exists {
+				continue
+			}
+			paths = append(paths, path)
+		}
+	}
 
+	var pipelines []PipelineManifest
+	for _, path := range paths {
+		data, err := ws.fsUtils.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read pipeline manifest %s: %w", path, err)
+		}
+		name, err := ws.readPipelineName(data)
+		if err != nil {
+			return nil, fmt.Errorf("read pipeline name from %s: %w", path, err)
+		}
+		pipelines = append(pipelines, PipelineManifest{
+			Name: name,
+			Path: path,
+		})
+	}
+	sort.Slice(pipelines, func(i, j int) bool {
+		return pipelines[i].Name < pipelines[j].Name
+	})
+	return pipelines, nil
+}
+
+// ReadPipelineManifest returns the contents of the pipeline manifest at path.
+func (ws *Workspace) ReadPipelineManifest(path string) ([]byte, error) {
+	manifestExists, err := ws.fsUtils.Exists(path)
 	if err != nil {
 		return nil, err
 	}
 	if !manifestExists {
 		return nil, ErrNoPipelineInWorkspace
 	}
-	return ws.read(pipelineFileName)
+	return ws.fsUtils.ReadFile(path)
+}
+
+// OverwritePipelineManifest replaces the contents of the existing pipeline manifest at path.
+func (ws *Workspace) OverwritePipelineManifest(path string, data []byte) error {
+	manifestExists, err := ws.fsUtils.Exists(path)
+	if err != nil {
+		return err
+	}
+	if !manifestExists {
+		return ErrNoPipelineInWorkspace
+	}
+	if err := ws.fsUtils.WriteFile(path, data, 0644 /* -rw-r--r-- */); err != nil {
+		return fmt.Errorf("write pipeline manifest %s: %w", path, err)
+	}
+	return nil
+}
+
+// PipelineBuildspecPath returns the path of the buildspec that belongs to the pipeline manifest at manifestPath,
+// relative to the root of the workspace. For example, "copilot/pipelines/release/buildspec.yml".
+func (ws *Workspace) PipelineBuildspecPath(manifestPath string) (string, error) {
+	copilotPath, err := ws.CopilotDirPath()
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(filepath.Dir(copilotPath), filepath.Join(filepath.Dir(manifestPath), buildspecFileName))
+	if err != nil {
+		return "", fmt.Errorf("get buildspec path relative to the workspace: %w", err)
+	}
+	return filepath.ToSlash(rel), nil
 }
 
 // WriteServiceManifest writes the service's manifest under the copilot/{name}/ directory.
@@ -234,24 +325,24 @@ func (ws *Workspace) WriteJobManifest(marshaler encoding.BinaryMarshaler, name s
 	return ws.write(data, name, manifestFileName)
 }
 
-// WritePipelineBuildspec writes the pipeline buildspec under the copilot/ directory.
+// WritePipelineBuildspec writes the pipeline buildspec under the copilot/pipelines/{name}/ directory.
 // If successful returns the full path of the file, otherwise returns an empty string and the error.
-func (ws *Workspace) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler) (string, error) {
+func (ws *Workspace) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler, name string) (string, error) {
 	data, err := marshaler.MarshalBinary()
 	if err != nil {
 		return "", fmt.Errorf("marshal pipeline buildspec to binary: %w", err)
 	}
-	return ws.write(data, buildspecFileName)
+	return ws.write(data, pipelinesDirName, name, buildspecFileName)
 }
 
-// WritePipelineManifest writes the pipeline manifest under the copilot directory.
+// WritePipelineManifest writes the pipeline manifest under the copilot/pipelines/{name}/ directory.
 // If successful returns the full path of the file, otherwise returns an empty string and the error.
-func (ws *Workspace) WritePipelineManifest(marshaler encoding.BinaryMarshaler) (string, error) {
+func (ws *Workspace) WritePipelineManifest(marshaler encoding.BinaryMarshaler, name string) (string, error) {
 	data, err := marshaler.MarshalBinary()
 	if err != nil {
 		return "", fmt.Errorf("marshal pipeline manifest to binary: %w", err)
 	}
-	return ws.write(data, pipelineFileName)
+	return ws.write(data, pipelinesDirName, name, manifestFileName)
 }
 
 // DeleteWorkspaceFile removes the .workspace file under copilot/ directory.
@@ -323,15 +414,6 @@ func (ws *Workspace) writeSummary(appName string) error {
 	return ws.fsUtils.WriteFile(summaryPath, serializedWorkspaceSummary, 0644)
 }
 
-func (ws *Workspace) pipelineManifestPath() (string, error) {
-	copilotPath, err := ws.CopilotDirPath()
-	if err != nil {
-		return "", err
-	}
-	pipelineManifestPath := filepath.Join(copilotPath, pipelineFileName)
-	return pipelineManifestPath, nil
-}
-
 func (ws *Workspace) summaryPath() (string, error) {
 	copilotPath, err := ws.CopilotDirPath()
 	if err != nil {
@@ -392,6 +474,16 @@ func (ws *Workspace) readWorkloadType(dat []byte) (string, error) {
 	return wl.Type, nil
 }
 
+func (ws *Workspace) readPipelineName(dat []byte) (string, error) {
+	pipeline := struct {
+		Name string `yaml:"name"`
+	}{}
+	if err := yaml.Unmarshal(dat, &pipeline); err != nil {
+		return "", err
+	}
+	return pipeline.Name, nil
+}
+
 // write flushes the data to a file under the copilot directory joined by path elements.
 func (ws *Workspace) write(data []byte, elem ...string) (string, error) {
 	copilotPath, err := ws.CopilotDirPath()
@@ -330,6 +330,68 @@ func TestWorkspace_ServiceNames(t *testing.T) {
 	}
 }
 
+func TestWorkspace_LoadBalancedWebServiceNames(t *testing.T) {
+	testCases := map[string]struct {
+		copilotDir string
+		fs         func() afero.Fs
+
+		wantedNames []string
+		wantedErr   error
+	}{
+		"read not-existing directory": {
+			copilotDir: "/copilot",
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				return fs
+			},
+			wantedErr: errors.New("read directory /copilot: open /copilot: file does not exist"),
+		},
+		"retrieve only load balanced web services": {
+			copilotDir: "/copilot",
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.Mkdir("/copilot", 0755)
+
+				fs.Mkdir("/copilot/frontend", 0755)
+				manifest, _ := fs.Create("/copilot/frontend/manifest.yml")
+				defer manifest.Close()
+				manifest.Write([]byte("type: Load Balanced Web Service"))
+
+				fs.Mkdir("/copilot/api", 0755)
+				manifest2, _ := fs.Create("/copilot/api/manifest.yml")
+				defer manifest2.Close()
+				manifest2.Write([]byte("type: Backend Service"))
+
+				fs.Mkdir("/copilot/report", 0755)
+				manifest3, _ := fs.Create("/copilot/report/manifest.yml")
+				defer manifest3.Close()
+				manifest3.Write([]byte("type: Scheduled Job"))
+				return fs
+			},
+
+			wantedNames: []string{"frontend"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ws := &Workspace{
+				copilotDir: tc.copilotDir,
+				fsUtils: &afero.Afero{
+					Fs: tc.fs(),
+				},
+			}
+
+			names, err := ws.LoadBalancedWebServiceNames()
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.ElementsMatch(t, tc.wantedNames, names)
+			}
+		})
+	}
+}
+
 func TestWorkspace_JobNames(t *testing.T) {
 	testCases := map[string]struct {
 		copilotDir string
@@ -700,7 +762,7 @@ func TestWorkspace_ReadPipelineManifest(t *testing.T) {
 			}
 
 			// WHEN
-			_, err := ws.ReadPipelineManifest()
+			_, err := ws.ReadPipelineManifest("/copilot/pipeline.yml")
 
 			// THEN
 			if tc.expectedError != nil {
@@ -712,6 +774,160 @@ func TestWorkspace_ReadPipelineManifest(t *testing.T) {
 	}
 }
 
+func TestWorkspace_OverwritePipelineManifest(t *testing.T) {
+	copilotDir := "/copilot"
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedErr error
+	}{
+		"overwrites the existing pipeline manifest": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/pipelines/release", 0755)
+				afero.WriteFile(fs, "/copilot/pipelines/release/manifest.yml", []byte("version: 1"), 0644)
+				return fs
+			},
+		},
+		"errors if the pipeline manifest does not exist": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.Mkdir(copilotDir, 0755)
+				return fs
+			},
+			wantedErr: ErrNoPipelineInWorkspace,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := tc.fs()
+			ws := &Workspace{
+				copilotDir: copilotDir,
+				fsUtils:    &afero.Afero{Fs: fs},
+			}
+
+			// WHEN
+			err := ws.OverwritePipelineManifest("/copilot/pipelines/release/manifest.yml", []byte("version: 2"))
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			data, err := afero.ReadFile(fs, "/copilot/pipelines/release/manifest.yml")
+			require.NoError(t, err)
+			require.Equal(t, "version: 2", string(data))
+		})
+	}
+}
+
+func TestWorkspace_ListPipelines(t *testing.T) {
+	copilotDir := "/copilot"
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedPipelines []PipelineManifest
+		wantedErr       error
+	}{
+		"lists the legacy manifest and the manifests under the pipelines directory": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/pipelines/release", 0755)
+				fs.MkdirAll("/copilot/pipelines/empty", 0755)
+				afero.WriteFile(fs, "/copilot/pipeline.yml", []byte("name: pipeline-legacy"), 0644)
+				afero.WriteFile(fs, "/copilot/pipelines/release/manifest.yml", []byte("name: release"), 0644)
+				afero.WriteFile(fs, "/copilot/pipelines/release/buildspec.yml", []byte("version: 0.2"), 0644)
+				afero.WriteFile(fs, "/copilot/pipelines/README.md", []byte("hello"), 0644)
+				return fs
+			},
+			wantedPipelines: []PipelineManifest{
+				{
+					Name: "pipeline-legacy",
+					Path: "/copilot/pipeline.yml",
+				},
+				{
+					Name: "release",
+					Path: "/copilot/pipelines/release/manifest.yml",
+				},
+			},
+		},
+		"returns nothing if there are no pipelines": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.Mkdir(copilotDir, 0755)
+				return fs
+			},
+		},
+		"returns an error if a manifest is malformed": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/pipelines/release", 0755)
+				afero.WriteFile(fs, "/copilot/pipelines/release/manifest.yml", []byte("name: [release"), 0644)
+				return fs
+			},
+			wantedErr: errors.New("read pipeline name from /copilot/pipelines/release/manifest.yml: yaml: line 1: did not find expected ',' or ']'"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ws := &Workspace{
+				copilotDir: copilotDir,
+				fsUtils:    &afero.Afero{Fs: tc.fs()},
+			}
+
+			// WHEN
+			pipelines, err := ws.ListPipelines()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedPipelines, pipelines)
+			}
+		})
+	}
+}
+
+func TestWorkspace_PipelineBuildspecPath(t *testing.T) {
+	testCases := map[string]struct {
+		manifestPath string
+
+		wanted string
+	}{
+		"legacy manifest": {
+			manifestPath: "/code/copilot/pipeline.yml",
+			wanted:       "copilot/buildspec.yml",
+		},
+		"manifest under the pipelines directory": {
+			manifestPath: "/code/copilot/pipelines/release/manifest.yml",
+			wanted:       "copilot/pipelines/release/buildspec.yml",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ws := &Workspace{
+				copilotDir: "/code/copilot",
+				fsUtils:    &afero.Afero{Fs: afero.NewMemMapFs()},
+			}
+
+			// WHEN
+			path, err := ws.PipelineBuildspecPath(tc.manifestPath)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, path)
+		})
+	}
+}
+
 func TestWorkspace_DeleteWorkspaceFile(t *testing.T) {
 	testCases := map[string]struct {
 		copilotDir string